@@ -7,8 +7,13 @@ import (
 	"log"
 	"time"
 
+	authApp "github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
 	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
 	"github.com/honeybadger-io/honeybadger-go"
 	"github.com/newrelic/go-agent/v3/newrelic"
 	"gorm.io/driver/mysql"
@@ -64,7 +69,7 @@ func initDb(c sharedApp.ConfigurationService) (*gorm.DB, error) {
 	return gormdb, nil
 }
 
-func initDeleteExpiredTokensProcess(cfg sharedApp.ConfigurationService, authRepo authDomain.AuthRepository, newRelicApp *newrelic.Application) {
+func initDeleteExpiredTokensProcess(cfg sharedApp.ConfigurationService, authRepo authDomain.AuthRepository, usersRepo authDomain.UsersRepository, storage storage.Storage, newRelicApp *newrelic.Application) {
 	duration := cfg.GetDeleteExpiredRefreshTokensIntervalDuration()
 	ticker := time.NewTicker(duration)
 	done := make(chan bool)
@@ -82,8 +87,52 @@ func initDeleteExpiredTokensProcess(cfg sharedApp.ConfigurationService, authRepo
 					log.Printf("Error deleting expired refresh tokens: %v", err)
 					honeybadger.Notify(err)
 				}
+				if err := authRepo.DeleteExpiredUserTokens(ctx, t); err != nil {
+					log.Printf("Error deleting expired user tokens: %v", err)
+					honeybadger.Notify(err)
+				}
+				if err := authApp.NewPurgeExpiredUserExportsService(usersRepo, storage).PurgeExpiredUserExports(ctx, t); err != nil {
+					log.Printf("Error purging expired user exports: %v", err)
+					honeybadger.Notify(err)
+				}
 				txn.End()
 			}
 		}
 	}()
 }
+
+func initResumeUserDeletionJobs(usersRepo authDomain.UsersRepository, eventBus events.EventBus) {
+	resumed, err := authApp.NewResumeUserDeletionJobsService(usersRepo, eventBus).ResumeUserDeletionJobs(context.Background())
+	if err != nil {
+		log.Printf("Error resuming the user deletion jobs: %v", err)
+		honeybadger.Notify(err)
+		return
+	}
+
+	if resumed > 0 {
+		log.Printf("Resumed %v user deletion jobs", resumed)
+	}
+}
+
+func initAdminBootstrap(cfg sharedApp.ConfigurationService, authRepo authDomain.AuthRepository, usersRepo authDomain.UsersRepository, passGen passgen.PasswordGenerator, auditLogRepo audit.AuditLogRepository, rotateSetupToken bool) {
+	srv := authApp.NewAdminBootstrapService(authRepo, usersRepo, passGen, cfg, auditLogRepo)
+	opened, setupToken, err := srv.OpenBootstrap(context.Background(), rotateSetupToken)
+	if err != nil {
+		log.Printf("Error opening the admin bootstrap: %v", err)
+		honeybadger.Notify(err)
+		return
+	}
+
+	if !opened {
+		log.Println("Admin bootstrap disabled")
+		return
+	}
+
+	if len(setupToken) > 0 {
+		log.Printf("Admin bootstrap enabled, use the setup token %v to create the first admin", setupToken)
+	} else if len(cfg.GetAdminSetupToken()) > 0 {
+		log.Println("Admin bootstrap enabled with the configured setup token")
+	} else {
+		log.Println("Admin bootstrap enabled with the setup token shown by a previous start, run with -rotate-admin-setup-token to get a new one")
+	}
+}
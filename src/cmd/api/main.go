@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net"
@@ -20,6 +21,9 @@ import (
 )
 
 func main() {
+	rotateAdminSetupToken := flag.Bool("rotate-admin-setup-token", false, "replaces the pending admin setup token with a new one and exits")
+	flag.Parse()
+
 	cfg := wire.InitConfigurationService()
 
 	initHoneyBadger(cfg)
@@ -36,16 +40,25 @@ func main() {
 	}
 
 	authRepo := wire.InitAuthRepository(db)
+	usersRepo := wire.InitUsersRepository(db)
 
-	go initDeleteExpiredTokensProcess(cfg, authRepo, newRelicApp)
+	initAdminBootstrap(cfg, authRepo, usersRepo, wire.InitPasswordGenerator(), wire.InitAuditLogRepository(db), *rotateAdminSetupToken)
+	if *rotateAdminSetupToken {
+		return
+	}
+
+	go initDeleteExpiredTokensProcess(cfg, authRepo, usersRepo, wire.InitStorage(), newRelicApp)
 
 	eb := wire.InitEventBus(map[string]events.DataChannelSlice{})
 
 	server := server.NewServer(db, eb, newRelicApp)
 
+	// the subscribers are started by the server, so the unfinished jobs can be published now
+	initResumeUserDeletionJobs(usersRepo, eb)
+
 	validCorsHeaders := handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "Authorization"})
 	validCorsOrigins := handlers.AllowedOrigins(cfg.GetCorsAllowedOrigins())
-	validCorsMethods := handlers.AllowedMethods([]string{"GET", "DELETE", "POST", "PATCH", "OPTIONS"})
+	validCorsMethods := handlers.AllowedMethods([]string{"GET", "DELETE", "POST", "PUT", "PATCH", "OPTIONS"})
 	allowCredentials := handlers.AllowCredentials()
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1 @@
+ALTER TABLE `users` ADD `displayName` varchar(50) NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+DROP TABLE `user_tokens`;
+
+ALTER TABLE `users` DROP INDEX `idx_users_email`;
+ALTER TABLE `users` DROP `emailVerified`;
+ALTER TABLE `users` DROP `email`;
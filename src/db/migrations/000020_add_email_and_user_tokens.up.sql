@@ -0,0 +1,15 @@
+ALTER TABLE `users` ADD `email` varchar(100) NULL;
+ALTER TABLE `users` ADD `emailVerified` tinyint NOT NULL DEFAULT 0;
+ALTER TABLE `users` ADD UNIQUE KEY `idx_users_email` (`email`);
+
+CREATE TABLE `user_tokens` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `purpose` varchar(20) NOT NULL,
+    `tokenHash` varchar(64) NOT NULL,
+    `expirationDate` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_user_tokens_token_hash` (`tokenHash`),
+    KEY `idx_user_tokens_expiration_date` (`expirationDate`),
+    CONSTRAINT `fk_user_token_user_id` FOREIGN KEY (`userId`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,3 @@
+ALTER TABLE `users` DROP INDEX `idx_users_oidc_identity`;
+ALTER TABLE `users` DROP `oidcSubject`;
+ALTER TABLE `users` DROP `oidcProvider`;
@@ -0,0 +1,3 @@
+ALTER TABLE `users` ADD `oidcProvider` varchar(50) NULL;
+ALTER TABLE `users` ADD `oidcSubject` varchar(255) NULL;
+ALTER TABLE `users` ADD UNIQUE KEY `idx_users_oidc_identity` (`oidcProvider`, `oidcSubject`);
@@ -0,0 +1,12 @@
+CREATE TABLE `invites` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `codeHash` varchar(64) NOT NULL,
+    `isAdmin` tinyint NOT NULL DEFAULT 0,
+    `maxUses` int(32) NOT NULL,
+    `usesCount` int(32) NOT NULL DEFAULT 0,
+    `expirationDate` timestamp NOT NULL,
+    `createdBy` int(32) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_invites_code_hash` (`codeHash`),
+    CONSTRAINT `fk_invite_created_by` FOREIGN KEY (`createdBy`) REFERENCES `users` (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
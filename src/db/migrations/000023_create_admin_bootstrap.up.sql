@@ -0,0 +1,6 @@
+CREATE TABLE `admin_bootstrap` (
+    `id` int(32) NOT NULL,
+    `setupTokenHash` varchar(64) NULL,
+    `completedAt` timestamp NULL,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
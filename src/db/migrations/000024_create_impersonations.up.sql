@@ -0,0 +1,12 @@
+CREATE TABLE `impersonations` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `adminId` int(32) NOT NULL,
+    `userId` int(32) NOT NULL,
+    `startedAt` timestamp NOT NULL,
+    `expirationDate` timestamp NOT NULL,
+    `endedAt` timestamp NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_impersonations_admin_id` (`adminId`),
+    CONSTRAINT `fk_impersonation_admin` FOREIGN KEY (`adminId`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_impersonation_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,17 @@
+CREATE TABLE `audit_log` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `actorId` int(32) NULL,
+    `impersonatorId` int(32) NULL,
+    `action` varchar(50) NOT NULL,
+    `targetType` varchar(50) NOT NULL,
+    `targetId` int(32) NULL,
+    `requestId` varchar(64) NOT NULL,
+    `ip` varchar(45) NOT NULL,
+    `userAgent` varchar(255) NOT NULL,
+    `diff` json NOT NULL,
+    `createdAt` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_audit_log_actor_id` (`actorId`),
+    KEY `idx_audit_log_target` (`targetType`, `targetId`),
+    KEY `idx_audit_log_created_at` (`createdAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,14 @@
+CREATE TABLE `activity` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `listId` int(32) NOT NULL,
+    `relatedListId` int(32) NULL,
+    `action` varchar(50) NOT NULL,
+    `summary` varchar(255) NOT NULL,
+    `createdAt` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    KEY `idx_activity_user_id` (`userId`, `id`),
+    KEY `idx_activity_list_id` (`listId`),
+    KEY `idx_activity_related_list_id` (`relatedListId`),
+    CONSTRAINT `fk_activity_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
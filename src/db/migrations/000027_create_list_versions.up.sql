@@ -0,0 +1,13 @@
+CREATE TABLE `listVersions` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `listId` int(32) NOT NULL,
+    `userId` int(32) NOT NULL,
+    `version` int(32) NOT NULL,
+    `name` varchar(50) NOT NULL,
+    `categoryId` int(32) NULL,
+    `items` json NOT NULL,
+    `createdAt` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_list_versions_list_id_version` (`listId`, `version`),
+    CONSTRAINT `fk_list_version_list` FOREIGN KEY (`listId`) REFERENCES `lists` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
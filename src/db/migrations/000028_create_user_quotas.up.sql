@@ -0,0 +1,8 @@
+CREATE TABLE `userQuotas` (
+    `userId` int(32) NOT NULL,
+    `maxLists` int(32) NULL,
+    `maxItemsPerList` int(32) NULL,
+    `maxCategories` int(32) NULL,
+    PRIMARY KEY (`userId`),
+    CONSTRAINT `fk_user_quota_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
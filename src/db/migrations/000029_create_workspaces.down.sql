@@ -0,0 +1,15 @@
+ALTER TABLE `categories` DROP FOREIGN KEY `fk_category_workspace_id`;
+
+ALTER TABLE `categories` DROP `workspaceId`;
+
+ALTER TABLE `lists` DROP INDEX `idx_lists_name`;
+
+ALTER TABLE `lists` ADD UNIQUE KEY `idx_lists_name` (`name`, `userId`);
+
+ALTER TABLE `lists` DROP FOREIGN KEY `fk_list_workspace_id`;
+
+ALTER TABLE `lists` DROP `workspaceId`;
+
+DROP TABLE `workspaceMembers`;
+
+DROP TABLE `workspaces`;
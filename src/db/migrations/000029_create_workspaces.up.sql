@@ -0,0 +1,51 @@
+CREATE TABLE `workspaces` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `name` varchar(50) NOT NULL,
+    `personalUserId` int(32) NULL,
+    `createdAt` timestamp NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_workspaces_personal_user_id` (`personalUserId`),
+    CONSTRAINT `fk_workspace_personal_user` FOREIGN KEY (`personalUserId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `workspaceMembers` (
+    `workspaceId` int(32) NOT NULL,
+    `userId` int(32) NOT NULL,
+    `role` varchar(10) NOT NULL,
+    `createdAt` timestamp NOT NULL,
+    PRIMARY KEY (`workspaceId`, `userId`),
+    CONSTRAINT `fk_workspace_member_workspace` FOREIGN KEY (`workspaceId`) REFERENCES `workspaces` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_workspace_member_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+INSERT INTO `workspaces` (`name`, `personalUserId`, `createdAt`)
+SELECT 'Personal', `id`, NOW() FROM `users`;
+
+INSERT INTO `workspaceMembers` (`workspaceId`, `userId`, `role`, `createdAt`)
+SELECT `id`, `personalUserId`, 'owner', NOW() FROM `workspaces` WHERE `personalUserId` IS NOT NULL;
+
+ALTER TABLE `lists` ADD `workspaceId` int(32) NULL;
+
+UPDATE `lists` l INNER JOIN `workspaces` w ON w.`personalUserId` = l.`userId` SET l.`workspaceId` = w.`id`;
+
+ALTER TABLE `lists` MODIFY `workspaceId` int(32) NOT NULL;
+
+ALTER TABLE `lists`
+ADD CONSTRAINT `fk_list_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`);
+
+ALTER TABLE `lists` DROP INDEX `idx_lists_name`;
+
+ALTER TABLE `lists` ADD UNIQUE KEY `idx_lists_name` (`name`, `workspaceId`);
+
+ALTER TABLE `categories` ADD `workspaceId` int(32) NULL;
+
+UPDATE `categories` c INNER JOIN `workspaces` w ON w.`personalUserId` = c.`userId` SET c.`workspaceId` = w.`id`;
+
+ALTER TABLE `categories` MODIFY `workspaceId` int(32) NOT NULL;
+
+ALTER TABLE `categories`
+ADD CONSTRAINT `fk_category_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`);
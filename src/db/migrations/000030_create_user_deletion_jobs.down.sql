@@ -0,0 +1,7 @@
+ALTER TABLE `invites` DROP FOREIGN KEY `fk_invite_created_by`;
+ALTER TABLE `invites` ADD CONSTRAINT `fk_invite_created_by` FOREIGN KEY (`createdBy`) REFERENCES `users` (`id`);
+
+ALTER TABLE `user_tokens` DROP FOREIGN KEY `fk_user_token_user_id`;
+ALTER TABLE `user_tokens` ADD CONSTRAINT `fk_user_token_user_id` FOREIGN KEY (`userId`) REFERENCES `users` (`id`);
+
+DROP TABLE `userDeletionJobs`;
@@ -0,0 +1,20 @@
+CREATE TABLE `userDeletionJobs` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `strategy` varchar(10) NOT NULL,
+    `transferToUserId` int(32) NULL,
+    `status` varchar(10) NOT NULL,
+    `step` varchar(50) NOT NULL DEFAULT '',
+    `progress` int(32) NOT NULL DEFAULT 0,
+    `error` varchar(500) NOT NULL DEFAULT '',
+    `createdAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updatedAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    KEY `idx_user_deletion_jobs_user_id` (`userId`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+ALTER TABLE `user_tokens` DROP FOREIGN KEY `fk_user_token_user_id`;
+ALTER TABLE `user_tokens` ADD CONSTRAINT `fk_user_token_user_id` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `invites` DROP FOREIGN KEY `fk_invite_created_by`;
+ALTER TABLE `invites` ADD CONSTRAINT `fk_invite_created_by` FOREIGN KEY (`createdBy`) REFERENCES `users` (`id`) ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+CREATE TABLE `userExports` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `requestedBy` int(32) NOT NULL,
+    `status` varchar(10) NOT NULL,
+    `tokenHash` varchar(64) NOT NULL,
+    `fileName` varchar(100) NOT NULL DEFAULT '',
+    `error` varchar(500) NOT NULL DEFAULT '',
+    `expirationDate` timestamp NOT NULL,
+    `createdAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    `updatedAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_user_exports_token_hash` (`tokenHash`),
+    KEY `idx_user_exports_user_id` (`userId`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
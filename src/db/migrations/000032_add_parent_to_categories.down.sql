@@ -0,0 +1,3 @@
+ALTER TABLE `categories` DROP FOREIGN KEY `fk_category_parent_id`;
+
+ALTER TABLE `categories` DROP `parentId`;
@@ -0,0 +1,6 @@
+ALTER TABLE `categories` ADD `parentId` int(32) NULL;
+
+ALTER TABLE `categories`
+ADD CONSTRAINT `fk_category_parent_id`
+FOREIGN KEY (`parentId`)
+REFERENCES `categories` (`id`) ON DELETE SET NULL;
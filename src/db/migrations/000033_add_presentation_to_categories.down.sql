@@ -0,0 +1,7 @@
+DROP TABLE `defaultCategories`;
+
+ALTER TABLE `categories` DROP `position`;
+
+ALTER TABLE `categories` DROP `icon`;
+
+ALTER TABLE `categories` DROP `color`;
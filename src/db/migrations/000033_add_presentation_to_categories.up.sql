@@ -0,0 +1,17 @@
+ALTER TABLE `categories` ADD `color` varchar(7) NOT NULL DEFAULT '';
+
+ALTER TABLE `categories` ADD `icon` varchar(30) NOT NULL DEFAULT '';
+
+ALTER TABLE `categories` ADD `position` int(32) NOT NULL DEFAULT 0;
+
+UPDATE `categories` SET `position` = `id`;
+
+CREATE TABLE `defaultCategories` (
+    `userId` int(32) NOT NULL,
+    `workspaceId` int(32) NOT NULL,
+    `categoryId` int(32) NOT NULL,
+    PRIMARY KEY (`userId`, `workspaceId`),
+    CONSTRAINT `fk_default_category_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_default_category_workspace` FOREIGN KEY (`workspaceId`) REFERENCES `workspaces` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_default_category_category` FOREIGN KEY (`categoryId`) REFERENCES `categories` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,5 @@
+DROP TABLE `listItemTags`;
+
+DROP TABLE `listTags`;
+
+DROP TABLE `tags`;
@@ -0,0 +1,24 @@
+CREATE TABLE `tags` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `name` varchar(30) NOT NULL,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_tag_user_name` (`userId`, `name`),
+    CONSTRAINT `fk_tag_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `listTags` (
+    `listId` int(32) NOT NULL,
+    `tagId` int(32) NOT NULL,
+    PRIMARY KEY (`listId`, `tagId`),
+    CONSTRAINT `fk_list_tag_list` FOREIGN KEY (`listId`) REFERENCES `lists` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_list_tag_tag` FOREIGN KEY (`tagId`) REFERENCES `tags` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `listItemTags` (
+    `listItemId` int(32) NOT NULL,
+    `tagId` int(32) NOT NULL,
+    PRIMARY KEY (`listItemId`, `tagId`),
+    CONSTRAINT `fk_list_item_tag_list_item` FOREIGN KEY (`listItemId`) REFERENCES `listItems` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_list_item_tag_tag` FOREIGN KEY (`tagId`) REFERENCES `tags` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,3 @@
+DROP TABLE `calendarFeeds`;
+
+ALTER TABLE `listItems` DROP `dueDate`;
@@ -0,0 +1,20 @@
+ALTER TABLE `listItems` ADD `dueDate` timestamp NULL;
+
+CREATE TABLE `calendarFeeds` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `workspaceId` int(32) NOT NULL,
+    `tokenHash` varchar(64) NOT NULL,
+    `categoryId` int(32) NULL,
+    `listId` int(32) NULL,
+    `contentHash` varchar(64) NOT NULL DEFAULT '',
+    `lastModified` timestamp NULL,
+    `createdAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `idx_calendar_feeds_token_hash` (`tokenHash`),
+    KEY `idx_calendar_feeds_user_workspace` (`userId`, `workspaceId`),
+    CONSTRAINT `fk_calendar_feed_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_calendar_feed_workspace` FOREIGN KEY (`workspaceId`) REFERENCES `workspaces` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_calendar_feed_category` FOREIGN KEY (`categoryId`) REFERENCES `categories` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_calendar_feed_list` FOREIGN KEY (`listId`) REFERENCES `lists` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,3 @@
+DROP TABLE `listTemplateShares`;
+
+DROP TABLE `listTemplates`;
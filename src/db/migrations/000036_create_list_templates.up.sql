@@ -0,0 +1,19 @@
+CREATE TABLE `listTemplates` (
+    `id` int(32) NOT NULL AUTO_INCREMENT,
+    `userId` int(32) NOT NULL,
+    `name` varchar(50) NOT NULL,
+    `items` json NOT NULL,
+    `createdAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_list_template_user_name` (`userId`, `name`),
+    CONSTRAINT `fk_list_template_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+CREATE TABLE `listTemplateShares` (
+    `templateId` int(32) NOT NULL,
+    `userId` int(32) NOT NULL,
+    `createdAt` timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (`templateId`, `userId`),
+    CONSTRAINT `fk_list_template_share_template` FOREIGN KEY (`templateId`) REFERENCES `listTemplates` (`id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_list_template_share_user` FOREIGN KEY (`userId`) REFERENCES `users` (`id`) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
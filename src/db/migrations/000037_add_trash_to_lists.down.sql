@@ -0,0 +1,9 @@
+DELETE FROM `listItems` WHERE `listId` IN (SELECT `id` FROM `lists` WHERE `trashedAt` IS NOT NULL);
+
+DELETE FROM `lists` WHERE `trashedAt` IS NOT NULL;
+
+ALTER TABLE `lists` DROP INDEX `idx_lists_name`;
+
+ALTER TABLE `lists` ADD UNIQUE KEY `idx_lists_name` (`name`, `workspaceId`);
+
+ALTER TABLE `lists` DROP `trashedAt`;
@@ -0,0 +1,5 @@
+ALTER TABLE `lists` ADD `trashedAt` timestamp NULL;
+
+ALTER TABLE `lists` DROP INDEX `idx_lists_name`;
+
+ALTER TABLE `lists` ADD UNIQUE KEY `idx_lists_name` (`name`, `workspaceId`, (IF(`trashedAt` IS NULL, 0, `id`)));
@@ -0,0 +1,15 @@
+ALTER TABLE `categories` DROP FOREIGN KEY `fk_category_workspace_id`;
+
+ALTER TABLE `categories`
+ADD CONSTRAINT `fk_category_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`);
+
+ALTER TABLE `lists` DROP FOREIGN KEY `fk_list_workspace_id`;
+
+ALTER TABLE `lists`
+ADD CONSTRAINT `fk_list_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`);
+
+ALTER TABLE `listItems` DROP FOREIGN KEY `fk_list_item_list_id`;
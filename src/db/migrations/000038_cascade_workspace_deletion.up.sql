@@ -0,0 +1,20 @@
+DELETE FROM `listItems` WHERE `listId` NOT IN (SELECT `id` FROM `lists`);
+
+ALTER TABLE `listItems`
+ADD CONSTRAINT `fk_list_item_list_id`
+FOREIGN KEY (`listId`)
+REFERENCES `lists` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `lists` DROP FOREIGN KEY `fk_list_workspace_id`;
+
+ALTER TABLE `lists`
+ADD CONSTRAINT `fk_list_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`) ON DELETE CASCADE;
+
+ALTER TABLE `categories` DROP FOREIGN KEY `fk_category_workspace_id`;
+
+ALTER TABLE `categories`
+ADD CONSTRAINT `fk_category_workspace_id`
+FOREIGN KEY (`workspaceId`)
+REFERENCES `workspaces` (`id`) ON DELETE CASCADE;
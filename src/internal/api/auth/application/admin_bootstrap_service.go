@@ -0,0 +1,171 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"gorm.io/gorm"
+)
+
+type AdminBootstrapService struct {
+	authRepo    domain.AuthRepository
+	usersRepo   domain.UsersRepository
+	passGen     passgen.PasswordGenerator
+	cfgSvr      sharedApp.ConfigurationService
+	auditRepo   audit.AuditLogRepository
+	auditLogger *sharedApp.AuditLogger
+}
+
+// adminBootstrapAttempt is what the audit log records of every admin bootstrap attempt
+type adminBootstrapAttempt struct {
+	UserName string `json:"userName"`
+	UserID   int32  `json:"userId,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func NewAdminBootstrapService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator, cfgSvr sharedApp.ConfigurationService, auditRepo audit.AuditLogRepository) *AdminBootstrapService {
+	return &AdminBootstrapService{authRepo, usersRepo, passGen, cfgSvr, auditRepo, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+// OpenBootstrap enables the creation of the first admin while there aren't any users and it
+// hasn't been done before. When there isn't a configured setup token it generates one, which
+// is returned so it can be shown once. A pending generated token is kept, so the token shown
+// by another instance stays valid, unless it's explicitly rotated
+func (s *AdminBootstrapService) OpenBootstrap(ctx context.Context, rotateSetupToken bool) (bool, string, error) {
+	available, err := s.isAvailable(ctx)
+	if err != nil || !available {
+		return false, "", err
+	}
+
+	if err := s.authRepo.OpenAdminBootstrap(ctx); err != nil {
+		return false, "", err
+	}
+
+	if len(s.cfgSvr.GetAdminSetupToken()) > 0 {
+		return true, "", nil
+	}
+
+	setupToken, setupTokenHash, err := domain.NewAdminSetupToken()
+	if err != nil {
+		return false, "", err
+	}
+
+	stored, err := s.authRepo.SetAdminSetupTokenHash(ctx, setupTokenHash, rotateSetupToken)
+	if err != nil {
+		return false, "", err
+	}
+
+	if !stored {
+		return true, "", nil
+	}
+
+	return true, setupToken, nil
+}
+
+// BootstrapAdmin creates the first admin user. The endpoint doesn't require authentication, so
+// every attempt is written to the audit log before it's done, and it's refused when that
+// fails, and then its result is also written whether it succeeds or not
+func (s *AdminBootstrapService) BootstrapAdmin(ctx context.Context, setupToken string, userName string, password string, confirmPassword string) (*domain.UserEntity, error) {
+	attempt := adminBootstrapAttempt{UserName: userName}
+
+	if err := s.auditLogger.Log(ctx, audit.ActionAdminBootstrapTried, audit.TargetAdminBootstrap, 0, nil, attempt); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error writing the admin bootstrap attempt to the audit log", InternalError: err}
+	}
+
+	user, err := s.bootstrapAdmin(ctx, setupToken, userName, password, confirmPassword)
+	if err != nil {
+		attempt.Error = err.Error()
+		s.auditLogger.Log(ctx, audit.ActionAdminBootstrapFailed, audit.TargetAdminBootstrap, 0, nil, attempt)
+
+		return nil, err
+	}
+
+	attempt.UserID = user.ID
+	s.auditLogger.Log(ctx, audit.ActionAdminBootstrapDone, audit.TargetAdminBootstrap, 0, nil, attempt)
+
+	return user, nil
+}
+
+// bootstrapAdmin claims the bootstrap before creating the user so concurrent attempts can't
+// create more than one, and it's released if the creation fails
+func (s *AdminBootstrapService) bootstrapAdmin(ctx context.Context, setupToken string, userName string, password string, confirmPassword string) (*domain.UserEntity, error) {
+	nvo, err := domain.NewUserNameValueObject(userName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := domain.NewUserPasswordValueObject(password); err != nil {
+		return nil, err
+	}
+
+	notAvailableErr := &appErrors.BadRequestError{Msg: "The admin bootstrap is not available"}
+
+	bootstrap, err := s.authRepo.GetAdminBootstrap(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notAvailableErr
+		}
+
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the admin bootstrap", InternalError: err}
+	}
+
+	if bootstrap.IsCompleted() {
+		return nil, notAvailableErr
+	}
+
+	if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{}); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error checking if there are users", InternalError: err}
+	} else if existsUser {
+		return nil, notAvailableErr
+	}
+
+	if !bootstrap.CheckSetupToken(setupToken, s.cfgSvr.GetAdminSetupToken()) {
+		return nil, &appErrors.UnauthorizedError{Msg: "Invalid setup token"}
+	}
+
+	claimed, err := s.authRepo.ClaimAdminBootstrap(ctx, time.Now())
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error claiming the admin bootstrap", InternalError: err}
+	}
+
+	if !claimed {
+		return nil, notAvailableErr
+	}
+
+	createSrv := NewCreateUserService(s.usersRepo, s.passGen, s.cfgSvr, s.auditRepo)
+	user, err := createSrv.CreateUser(ctx, nvo, password, confirmPassword, true)
+	if err != nil {
+		if releaseErr := s.authRepo.ReleaseAdminBootstrap(ctx); releaseErr != nil {
+			log.Printf("Error releasing the admin bootstrap: %v", releaseErr)
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (s *AdminBootstrapService) isAvailable(ctx context.Context) (bool, error) {
+	bootstrap, err := s.authRepo.GetAdminBootstrap(ctx)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, err
+	}
+
+	if bootstrap != nil && bootstrap.IsCompleted() {
+		return false, nil
+	}
+
+	existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{})
+	if err != nil {
+		return false, err
+	}
+
+	return !existsUser, nil
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type ChangePasswordService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	cfgSvr    sharedApp.ConfigurationService
+	tokenSrv  domain.TokenService
+	passGen   passgen.PasswordGenerator
+}
+
+func NewChangePasswordService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, tokenSrv domain.TokenService, passGen passgen.PasswordGenerator) *ChangePasswordService {
+	return &ChangePasswordService{authRepo, usersRepo, cfgSvr, tokenSrv, passGen}
+}
+
+// ChangePassword sets a new password for the user, revokes all its refresh tokens and
+// returns a new token and refresh token so the session that made the change stays logged in
+func (s *ChangePasswordService) ChangePassword(ctx context.Context, userID int32, currentPassword string, newPassword domain.UserPasswordValueObject) (string, string, error) {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return "", "", err
+	}
+
+	entity := foundUser.ToUserEntity()
+
+	if err := entity.HasPassword(currentPassword); err != nil {
+		return "", "", &appErrors.BadRequestError{Msg: "Invalid current password", InternalError: err}
+	}
+
+	if err := domain.NewPasswordPolicy(s.cfgSvr).Check(newPassword.String()); err != nil {
+		return "", "", err
+	}
+
+	hasshedPass, err := s.passGen.GenerateFromPassword(newPassword.String())
+	if err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error encrypting password", InternalError: err}
+	}
+
+	foundUser.PasswordHash = hasshedPass
+
+	if err := s.usersRepo.Update(ctx, foundUser); err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
+	}
+
+	if err := s.authRepo.DeleteRefreshTokensByUserID(ctx, userID); err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error deleting the user refresh tokens", InternalError: err}
+	}
+
+	token, err := s.tokenSrv.GenerateToken(entity)
+	if err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error creating jwt token", InternalError: err}
+	}
+
+	refreshTokenExpDate := s.cfgSvr.GetRefreshTokenExpirationTime()
+
+	refreshToken, err := s.tokenSrv.GenerateRefreshToken(entity, refreshTokenExpDate)
+	if err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error creating jwt refresh token", InternalError: err}
+	}
+
+	if err := s.authRepo.CreateRefreshTokenIfNotExist(ctx, &domain.RefreshTokenEntity{UserID: userID, RefreshToken: refreshToken, ExpirationDate: refreshTokenExpDate}); err != nil {
+		return "", "", &appErrors.UnexpectedError{Msg: "Error saving the refresh token", InternalError: err}
+	}
+
+	return token, refreshToken, nil
+}
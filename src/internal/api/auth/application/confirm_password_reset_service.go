@@ -0,0 +1,94 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"gorm.io/gorm"
+)
+
+type ConfirmPasswordResetService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	passGen   passgen.PasswordGenerator
+	cfgSvr    sharedApp.ConfigurationService
+}
+
+func NewConfirmPasswordResetService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator, cfgSvr sharedApp.ConfigurationService) *ConfirmPasswordResetService {
+	return &ConfirmPasswordResetService{authRepo, usersRepo, passGen, cfgSvr}
+}
+
+// ConfirmPasswordReset sets the new password of the user the token was issued for. The token
+// can only be used once and all the sessions of the user are revoked
+func (s *ConfirmPasswordResetService) ConfirmPasswordReset(ctx context.Context, token string, newPassword domain.UserPasswordValueObject) error {
+	if err := domain.NewPasswordPolicy(s.cfgSvr).Check(newPassword.String()); err != nil {
+		return err
+	}
+
+	userToken, err := consumeUserToken(ctx, s.authRepo, token, domain.PasswordResetTokenPurpose)
+	if err != nil {
+		return err
+	}
+
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userToken.UserID})
+	if err != nil {
+		return err
+	}
+
+	hasshedPass, err := s.passGen.GenerateFromPassword(newPassword.String())
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error encrypting password", InternalError: err}
+	}
+
+	foundUser.PasswordHash = hasshedPass
+
+	if err := s.usersRepo.Update(ctx, foundUser); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
+	}
+
+	if err := s.authRepo.DeleteRefreshTokensByUserID(ctx, foundUser.ID); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the user refresh tokens", InternalError: err}
+	}
+
+	return nil
+}
+
+// consumeUserToken finds the token for the given purpose, deletes it so it can't be used
+// again, even by a concurrent request, and checks that it hasn't expired. The other tokens
+// of the user for that purpose are deleted too
+func consumeUserToken(ctx context.Context, authRepo domain.AuthRepository, token string, purpose string) (*domain.UserTokenEntity, error) {
+	invalidTokenErr := &appErrors.BadRequestError{Msg: "The token is not valid"}
+
+	userToken, err := authRepo.FindUserToken(ctx, domain.UserTokenEntity{TokenHash: domain.HashUserToken(token), Purpose: purpose})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalidTokenErr
+		}
+
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the token", InternalError: err}
+	}
+
+	consumed, err := authRepo.ConsumeUserToken(ctx, userToken.ID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error deleting the used token", InternalError: err}
+	}
+
+	if !consumed {
+		return nil, invalidTokenErr
+	}
+
+	if err := authRepo.DeleteUserTokens(ctx, domain.UserTokenEntity{UserID: userToken.UserID, Purpose: purpose}); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error deleting the user tokens", InternalError: err}
+	}
+
+	if userToken.IsExpired(time.Now()) {
+		return nil, &appErrors.BadRequestError{Msg: "The token has expired"}
+	}
+
+	return userToken, nil
+}
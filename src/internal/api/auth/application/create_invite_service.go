@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CreateInviteService struct {
+	authRepo domain.AuthRepository
+	cfgSvr   sharedApp.ConfigurationService
+}
+
+func NewCreateInviteService(authRepo domain.AuthRepository, cfgSvr sharedApp.ConfigurationService) *CreateInviteService {
+	return &CreateInviteService{authRepo, cfgSvr}
+}
+
+// CreateInvite stores a new invite and returns it together with the code, which is only
+// available at this point because just its hash is stored. A nil expiration date means
+// the configured default one and maxUses defaults to a single use
+func (s *CreateInviteService) CreateInvite(ctx context.Context, createdBy int32, isAdmin bool, maxUses int32, expirationDate *time.Time) (*domain.InviteEntity, string, error) {
+	if maxUses < 0 {
+		return nil, "", &appErrors.BadRequestError{Msg: "The max uses can't be negative"}
+	}
+
+	if maxUses == 0 {
+		maxUses = 1
+	}
+
+	expDate := s.cfgSvr.GetInviteExpirationTime()
+	if expirationDate != nil {
+		if !expirationDate.After(time.Now()) {
+			return nil, "", &appErrors.BadRequestError{Msg: "The expiration date must be in the future"}
+		}
+
+		expDate = *expirationDate
+	}
+
+	invite, code, err := domain.NewInviteEntity(createdBy, isAdmin, maxUses, expDate)
+	if err != nil {
+		return nil, "", &appErrors.UnexpectedError{Msg: "Error generating the invite code", InternalError: err}
+	}
+
+	if err := s.authRepo.CreateInvite(ctx, invite); err != nil {
+		return nil, "", &appErrors.UnexpectedError{Msg: "Error creating the invite", InternalError: err}
+	}
+
+	return invite, code, nil
+}
@@ -5,25 +5,37 @@ import (
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
 type CreateUserService struct {
-	usersRepo domain.UsersRepository
-	passGen   passgen.PasswordGenerator
+	usersRepo   domain.UsersRepository
+	passGen     passgen.PasswordGenerator
+	cfgSvr      sharedApp.ConfigurationService
+	auditLogger *sharedApp.AuditLogger
 }
 
-func NewCreateUserService(usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator) *CreateUserService {
-	return &CreateUserService{usersRepo, passGen}
+func NewCreateUserService(usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator, cfgSvr sharedApp.ConfigurationService, auditRepo audit.AuditLogRepository) *CreateUserService {
+	return &CreateUserService{usersRepo, passGen, cfgSvr, sharedApp.NewAuditLogger(auditRepo)}
 }
 
-func (s *CreateUserService) CreateUser(ctx context.Context, userName domain.UserNameValueObject, password string, isAdmin bool) (*domain.UserEntity, error) {
+func (s *CreateUserService) CreateUser(ctx context.Context, userName domain.UserNameValueObject, password string, confirmPassword string, isAdmin bool) (*domain.UserEntity, error) {
+	if password != confirmPassword {
+		return nil, &appErrors.BadRequestError{Msg: "Passwords don't match"}
+	}
+
 	if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Name: userName.String()}); err != nil {
 		return nil, &appErrors.UnexpectedError{Msg: "Error checking if a user with the same name already exists", InternalError: err}
 	} else if existsUser {
 		return nil, &appErrors.BadRequestError{Msg: "A user with the same user name already exists", InternalError: nil}
 	}
 
+	if err := domain.NewPasswordPolicy(s.cfgSvr).Check(password); err != nil {
+		return nil, err
+	}
+
 	hasshedPass, err := s.passGen.GenerateFromPassword(string(password))
 	if err != nil {
 		return nil, &appErrors.UnexpectedError{Msg: "Error encrypting password", InternalError: err}
@@ -40,5 +52,7 @@ func (s *CreateUserService) CreateUser(ctx context.Context, userName domain.User
 		return nil, &appErrors.UnexpectedError{Msg: "Error creating the user", InternalError: err}
 	}
 
+	s.auditLogger.Log(ctx, audit.ActionUserCreated, audit.TargetUser, user.ID, nil, user)
+
 	return user.ToUserEntity(), nil
 }
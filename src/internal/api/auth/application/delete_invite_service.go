@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type DeleteInviteService struct {
+	authRepo domain.AuthRepository
+}
+
+func NewDeleteInviteService(authRepo domain.AuthRepository) *DeleteInviteService {
+	return &DeleteInviteService{authRepo}
+}
+
+func (s *DeleteInviteService) DeleteInvite(ctx context.Context, id int32) error {
+	if _, err := s.authRepo.FindInvite(ctx, domain.InviteEntity{ID: id}); err != nil {
+		return err
+	}
+
+	if err := s.authRepo.DeleteInvite(ctx, id); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the invite", InternalError: err}
+	}
+
+	return nil
+}
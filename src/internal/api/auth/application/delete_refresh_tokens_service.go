@@ -4,15 +4,18 @@ import (
 	"context"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
 type DeleteRefreshTokensService struct {
-	repo domain.AuthRepository
+	repo        domain.AuthRepository
+	auditLogger *sharedApp.AuditLogger
 }
 
-func NewDeleteRefreshTokensService(repo domain.AuthRepository) *DeleteRefreshTokensService {
-	return &DeleteRefreshTokensService{repo}
+func NewDeleteRefreshTokensService(repo domain.AuthRepository, auditRepo audit.AuditLogRepository) *DeleteRefreshTokensService {
+	return &DeleteRefreshTokensService{repo, sharedApp.NewAuditLogger(auditRepo)}
 }
 
 func (s *DeleteRefreshTokensService) DeleteRefreshTokens(ctx context.Context, ids []int32) error {
@@ -21,5 +24,7 @@ func (s *DeleteRefreshTokensService) DeleteRefreshTokens(ctx context.Context, id
 		return &appErrors.UnexpectedError{Msg: "Error deleting the refresh tokens", InternalError: err}
 	}
 
+	s.auditLogger.Log(ctx, audit.ActionRefreshTokensRevoked, audit.TargetRefreshToken, 0, nil, map[string][]int32{"ids": ids})
+
 	return nil
 }
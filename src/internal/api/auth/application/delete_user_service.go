@@ -5,30 +5,96 @@ import (
 	"strings"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 )
 
 type DeleteUserService struct {
-	usersRepo domain.UsersRepository
+	usersRepo   domain.UsersRepository
+	eventBus    events.EventBus
+	auditLogger *sharedApp.AuditLogger
 }
 
-func NewDeleteUserService(usersRepo domain.UsersRepository) *DeleteUserService {
-	return &DeleteUserService{usersRepo}
+func NewDeleteUserService(usersRepo domain.UsersRepository, eventBus events.EventBus, auditRepo audit.AuditLogRepository) *DeleteUserService {
+	return &DeleteUserService{usersRepo, eventBus, sharedApp.NewAuditLogger(auditRepo)}
 }
 
-func (s *DeleteUserService) DeleteUser(ctx context.Context, userID int32) error {
+// DeleteUser deletes the user right away when there isn't a strategy. Otherwise it creates a
+// job that transfers or deletes the data of the user in background and returns it
+func (s *DeleteUserService) DeleteUser(ctx context.Context, userID int32, strategy string, transferToUserID *int32) (*domain.UserDeletionJobEntity, error) {
 	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if strings.ToLower(string(foundUser.Name)) == "admin" {
-		return &appErrors.BadRequestError{Msg: "It is not possible to delete the admin user"}
+		return nil, &appErrors.BadRequestError{Msg: "It is not possible to delete the admin user"}
 	}
 
-	err = s.usersRepo.Delete(ctx, domain.UserRecord{ID: userID})
+	if len(strategy) == 0 {
+		err = s.usersRepo.Delete(ctx, domain.UserRecord{ID: userID})
+		if err != nil {
+			return nil, &appErrors.UnexpectedError{Msg: "Error deleting the user", InternalError: err}
+		}
+
+		s.auditLogger.Log(ctx, audit.ActionUserDeleted, audit.TargetUser, userID, foundUser, nil)
+
+		return nil, nil
+	}
+
+	strategy, err = domain.NewUserDeletionStrategy(strategy)
+	if err != nil {
+		return nil, &appErrors.BadRequestError{Msg: err.Error()}
+	}
+
+	if err := s.checkTransferToUser(ctx, userID, strategy, transferToUserID); err != nil {
+		return nil, err
+	}
+
+	job := &domain.UserDeletionJobRecord{
+		UserID:           userID,
+		Strategy:         strategy,
+		TransferToUserID: transferToUserID,
+		Status:           domain.UserDeletionJobPending,
+	}
+
+	if err := s.usersRepo.CreateUserDeletionJob(ctx, job); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error creating the user deletion job", InternalError: err}
+	}
+
+	s.auditLogger.Log(ctx, audit.ActionUserDeletionRequested, audit.TargetUser, userID, foundUser, job.ToUserDeletionJobEntity())
+
+	go s.eventBus.Publish(events.UserDeletionRequested, job.ID)
+
+	return job.ToUserDeletionJobEntity(), nil
+}
+
+func (s *DeleteUserService) checkTransferToUser(ctx context.Context, userID int32, strategy string, transferToUserID *int32) error {
+	if strategy == domain.UserDeletionStrategyCascade {
+		if transferToUserID != nil {
+			return &appErrors.BadRequestError{Msg: "The user to transfer the data to can only be set with the transfer strategy"}
+		}
+
+		return nil
+	}
+
+	if transferToUserID == nil {
+		return &appErrors.BadRequestError{Msg: "The user to transfer the data to is required"}
+	}
+
+	if *transferToUserID == userID {
+		return &appErrors.BadRequestError{Msg: "The data can't be transferred to the user being deleted"}
+	}
+
+	exists, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{ID: *transferToUserID})
 	if err != nil {
-		return &appErrors.UnexpectedError{Msg: "Error deleting the user", InternalError: err}
+		return &appErrors.UnexpectedError{Msg: "Error checking if the user exists", InternalError: err}
+	}
+
+	if !exists {
+		return &appErrors.BadRequestError{Msg: "The user to transfer the data to doesn't exist"}
 	}
 
 	return nil
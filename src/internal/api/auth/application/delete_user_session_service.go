@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type DeleteUserSessionService struct {
+	repo domain.AuthRepository
+}
+
+func NewDeleteUserSessionService(repo domain.AuthRepository) *DeleteUserSessionService {
+	return &DeleteUserSessionService{repo}
+}
+
+func (s *DeleteUserSessionService) DeleteUserSession(ctx context.Context, userID int32, sessionID int32) error {
+	if exists, err := s.repo.ExistsRefreshToken(ctx, domain.RefreshTokenEntity{ID: sessionID, UserID: userID}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the session", InternalError: err}
+	} else if !exists {
+		return &appErrors.BadRequestError{Msg: "The session does not exist"}
+	}
+
+	if err := s.repo.DeleteRefreshTokensByID(ctx, []int32{sessionID}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the session", InternalError: err}
+	}
+
+	return nil
+}
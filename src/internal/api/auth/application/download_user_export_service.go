@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+)
+
+type DownloadUserExportService struct {
+	usersRepo domain.UsersRepository
+	storage   storage.Storage
+}
+
+func NewDownloadUserExportService(usersRepo domain.UsersRepository, storage storage.Storage) *DownloadUserExportService {
+	return &DownloadUserExportService{usersRepo, storage}
+}
+
+// DownloadUserExport returns the archive of the export with the given token. The token can
+// be used as many times as needed until the export expires
+func (s *DownloadUserExportService) DownloadUserExport(ctx context.Context, token string) ([]byte, error) {
+	foundExport, err := s.usersRepo.FindUserExport(ctx, domain.UserExportRecord{TokenHash: domain.HashUserToken(token)})
+	if err != nil {
+		return nil, err
+	}
+
+	if foundExport.IsExpired(time.Now()) {
+		if err := NewPurgeExpiredUserExportsService(s.usersRepo, s.storage).PurgeUserExport(ctx, foundExport); err != nil {
+			log.Printf("Error purging the expired user export. Error: %v", err)
+		}
+
+		return nil, &appErrors.BadRequestError{Msg: "The export has expired"}
+	}
+
+	switch foundExport.Status {
+	case domain.UserExportFailed:
+		return nil, &appErrors.BadRequestError{Msg: "The export failed"}
+	case domain.UserExportPending, domain.UserExportRunning:
+		return nil, &appErrors.BadRequestError{Msg: "The export isn't ready yet"}
+	}
+
+	content, err := s.storage.Load(ctx, foundExport.FileName)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error loading the export", InternalError: err}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,36 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type EndImpersonationService struct {
+	authRepo    domain.AuthRepository
+	auditLogger *sharedApp.AuditLogger
+}
+
+func NewEndImpersonationService(authRepo domain.AuthRepository, auditRepo audit.AuditLogRepository) *EndImpersonationService {
+	return &EndImpersonationService{authRepo, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+func (s *EndImpersonationService) EndImpersonation(ctx context.Context, impersonationID int32) error {
+	if impersonationID == 0 {
+		return &appErrors.BadRequestError{Msg: "There isn't an impersonation session"}
+	}
+
+	if err := s.authRepo.EndImpersonation(ctx, impersonationID, time.Now()); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error ending the impersonation", InternalError: err}
+	}
+
+	log.Printf("The impersonation %v has been ended", impersonationID)
+	s.auditLogger.Log(ctx, audit.ActionImpersonationEnded, audit.TargetImpersonation, impersonationID, nil, nil)
+
+	return nil
+}
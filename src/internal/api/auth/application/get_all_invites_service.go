@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetAllInvitesService struct {
+	authRepo domain.AuthRepository
+}
+
+func NewGetAllInvitesService(authRepo domain.AuthRepository) *GetAllInvitesService {
+	return &GetAllInvitesService{authRepo}
+}
+
+func (s *GetAllInvitesService) GetAllInvites(ctx context.Context) ([]*domain.InviteEntity, error) {
+	found, err := s.authRepo.GetAllInvites(ctx)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting invites", InternalError: err}
+	}
+
+	return found, nil
+}
@@ -0,0 +1,24 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+type GetUserDeletionJobService struct {
+	repo domain.UsersRepository
+}
+
+func NewGetUserDeletionJobService(repo domain.UsersRepository) *GetUserDeletionJobService {
+	return &GetUserDeletionJobService{repo}
+}
+
+func (s *GetUserDeletionJobService) GetUserDeletionJob(ctx context.Context, jobID int32) (*domain.UserDeletionJobEntity, error) {
+	foundJob, err := s.repo.FindUserDeletionJob(ctx, domain.UserDeletionJobRecord{ID: jobID})
+	if err != nil {
+		return nil, err
+	}
+
+	return foundJob.ToUserDeletionJobEntity(), nil
+}
@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetUserSessionsService struct {
+	repo domain.AuthRepository
+}
+
+func NewGetUserSessionsService(repo domain.AuthRepository) *GetUserSessionsService {
+	return &GetUserSessionsService{repo}
+}
+
+func (s *GetUserSessionsService) GetUserSessions(ctx context.Context, userID int32) ([]*domain.RefreshTokenEntity, error) {
+	found, err := s.repo.GetRefreshTokensByUserID(ctx, userID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the user sessions", InternalError: err}
+	}
+
+	return found, nil
+}
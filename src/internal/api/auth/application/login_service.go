@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -15,10 +16,11 @@ type LoginService struct {
 	usersRepo domain.UsersRepository
 	cfgSvr    sharedApp.ConfigurationService
 	tokenSrv  domain.TokenService
+	passGen   passgen.PasswordGenerator
 }
 
-func NewLoginService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, tokenSrv domain.TokenService) *LoginService {
-	return &LoginService{authRepo, usersRepo, cfgSvr, tokenSrv}
+func NewLoginService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, tokenSrv domain.TokenService, passGen passgen.PasswordGenerator) *LoginService {
+	return &LoginService{authRepo, usersRepo, cfgSvr, tokenSrv, passGen}
 }
 
 func (s *LoginService) Login(ctx context.Context, userName domain.UserNameValueObject, password domain.UserPasswordValueObject) (string, string, *domain.UserEntity, error) {
@@ -34,6 +36,8 @@ func (s *LoginService) Login(ctx context.Context, userName domain.UserNameValueO
 		return "", "", nil, &appErrors.BadRequestError{Msg: "Invalid password", InternalError: err}
 	}
 
+	s.rehashPasswordIfNeeded(ctx, foundUser, password)
+
 	token, err := s.tokenSrv.GenerateToken(entity)
 	if err != nil {
 		return "", "", nil, &appErrors.UnexpectedError{Msg: "Error creating jwt token", InternalError: err}
@@ -59,3 +63,23 @@ func (s *LoginService) Login(ctx context.Context, userName domain.UserNameValueO
 
 	return token, refreshToken, entity, nil
 }
+
+// rehashPasswordIfNeeded upgrades the stored hash when it was created with another algorithm
+// or with weaker parameters than the current ones. A failure here must not prevent the login
+func (s *LoginService) rehashPasswordIfNeeded(ctx context.Context, foundUser *domain.UserRecord, password domain.UserPasswordValueObject) {
+	if !s.passGen.NeedsRehash(foundUser.PasswordHash) {
+		return
+	}
+
+	hasshedPass, err := s.passGen.GenerateFromPassword(password.String())
+	if err != nil {
+		log.Printf("Error rehashing the password of the user %v. Error: %v", foundUser.ID, err)
+		return
+	}
+
+	foundUser.PasswordHash = hasshedPass
+
+	if err := s.usersRepo.Update(ctx, foundUser); err != nil {
+		log.Printf("Error saving the rehashed password of the user %v. Error: %v", foundUser.ID, err)
+	}
+}
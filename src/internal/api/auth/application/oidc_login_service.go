@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"gorm.io/gorm"
+)
+
+const maxOidcUserNameAttempts = 100
+
+type OidcLoginService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	cfgSvr    sharedApp.ConfigurationService
+	tokenSrv  domain.TokenService
+}
+
+func NewOidcLoginService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, tokenSrv domain.TokenService) *OidcLoginService {
+	return &OidcLoginService{authRepo, usersRepo, cfgSvr, tokenSrv}
+}
+
+// Login finds the user linked to the identity, creating it if it doesn't exist yet, and returns
+// a token and a refresh token for it
+func (s *OidcLoginService) Login(ctx context.Context, identity *domain.OidcIdentity) (string, string, *domain.UserEntity, error) {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{OidcProvider: &identity.Provider, OidcSubject: &identity.Subject})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		foundUser, err = s.provisionUser(ctx, identity)
+		if err != nil {
+			return "", "", nil, err
+		}
+	} else if err != nil {
+		return "", "", nil, &appErrors.UnexpectedError{Msg: "Error getting the user linked to the identity", InternalError: err}
+	}
+
+	entity := foundUser.ToUserEntity()
+
+	token, err := s.tokenSrv.GenerateToken(entity)
+	if err != nil {
+		return "", "", nil, &appErrors.UnexpectedError{Msg: "Error creating jwt token", InternalError: err}
+	}
+
+	refreshTokenExpDate := s.cfgSvr.GetRefreshTokenExpirationTime()
+
+	refreshToken, err := s.tokenSrv.GenerateRefreshToken(entity, refreshTokenExpDate)
+	if err != nil {
+		return "", "", nil, &appErrors.UnexpectedError{Msg: "Error creating jwt refresh token", InternalError: err}
+	}
+
+	if err := s.authRepo.CreateRefreshTokenIfNotExist(ctx, &domain.RefreshTokenEntity{UserID: foundUser.ID, RefreshToken: refreshToken, ExpirationDate: refreshTokenExpDate}); err != nil {
+		return "", "", nil, &appErrors.UnexpectedError{Msg: "Error saving the refresh token", InternalError: err}
+	}
+
+	return token, refreshToken, entity, nil
+}
+
+func (s *OidcLoginService) provisionUser(ctx context.Context, identity *domain.OidcIdentity) (*domain.UserRecord, error) {
+	userName, err := s.getAvailableUserName(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName, _ := domain.NewUserDisplayNameValueObject(identity.Name)
+
+	user := &domain.UserRecord{
+		Name:         userName,
+		DisplayName:  displayName.String(),
+		OidcProvider: &identity.Provider,
+		OidcSubject:  &identity.Subject,
+	}
+
+	// The email is only copied when the provider has verified it and no other user has it
+	if email, err := domain.NewUserEmailValueObject(identity.Email); err == nil && !email.IsEmpty() && identity.EmailVerified {
+		emailValue := email.String()
+		if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Email: &emailValue}); err != nil {
+			return nil, &appErrors.UnexpectedError{Msg: "Error checking if a user with the same email already exists", InternalError: err}
+		} else if !existsUser {
+			user.Email = &emailValue
+			user.EmailVerified = true
+		}
+	}
+
+	if err := s.usersRepo.Create(ctx, user); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error creating the user", InternalError: err}
+	}
+
+	return user, nil
+}
+
+func (s *OidcLoginService) getAvailableUserName(ctx context.Context, identity *domain.OidcIdentity) (string, error) {
+	for i := 0; i < maxOidcUserNameAttempts; i++ {
+		name := identity.UserNameCandidate(i)
+
+		if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Name: name}); err != nil {
+			return "", &appErrors.UnexpectedError{Msg: "Error checking if a user with the same name already exists", InternalError: err}
+		} else if !existsUser {
+			return name, nil
+		}
+	}
+
+	return "", &appErrors.BadRequestError{Msg: "It is not possible to find an available user name"}
+}
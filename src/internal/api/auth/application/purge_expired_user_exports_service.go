@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+)
+
+type PurgeExpiredUserExportsService struct {
+	usersRepo domain.UsersRepository
+	storage   storage.Storage
+}
+
+func NewPurgeExpiredUserExportsService(usersRepo domain.UsersRepository, storage storage.Storage) *PurgeExpiredUserExportsService {
+	return &PurgeExpiredUserExportsService{usersRepo, storage}
+}
+
+// PurgeExpiredUserExports deletes from the storage the archives of the exports that have
+// expired. The exports are kept, without a file name, so their tokens keep saying they have expired
+func (s *PurgeExpiredUserExportsService) PurgeExpiredUserExports(ctx context.Context, now time.Time) error {
+	foundExports, err := s.usersRepo.GetExpiredUserExports(ctx, now)
+	if err != nil {
+		return fmt.Errorf("error getting the expired exports: %w", err)
+	}
+
+	for _, e := range foundExports {
+		if err := s.PurgeUserExport(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PurgeUserExport deletes the archive of the given export from the storage
+func (s *PurgeExpiredUserExportsService) PurgeUserExport(ctx context.Context, export *domain.UserExportRecord) error {
+	if len(export.FileName) == 0 {
+		return nil
+	}
+
+	if err := s.storage.Delete(ctx, export.FileName); err != nil {
+		return fmt.Errorf("error deleting the archive of the export %v: %w", export.ID, err)
+	}
+
+	export.FileName = ""
+
+	if err := s.usersRepo.UpdateUserExport(ctx, export); err != nil {
+		return fmt.Errorf("error updating the export %v: %w", export.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"gorm.io/gorm"
+)
+
+type RegisterUserService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	passGen   passgen.PasswordGenerator
+	cfgSvr    sharedApp.ConfigurationService
+	auditRepo audit.AuditLogRepository
+}
+
+func NewRegisterUserService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator, cfgSvr sharedApp.ConfigurationService, auditRepo audit.AuditLogRepository) *RegisterUserService {
+	return &RegisterUserService{authRepo, usersRepo, passGen, cfgSvr, auditRepo}
+}
+
+// RegisterUser creates a user consuming one use of the invite. The use is taken before creating
+// the user so the same invite can't be used more times than allowed by concurrent requests, and
+// it's given back if the user can't be created
+func (s *RegisterUserService) RegisterUser(ctx context.Context, code string, userName domain.UserNameValueObject, password string, confirmPassword string) (*domain.UserEntity, error) {
+	invalidInviteErr := &appErrors.BadRequestError{Msg: "The invite code is not valid"}
+
+	invite, err := s.authRepo.FindInvite(ctx, domain.InviteEntity{CodeHash: domain.HashUserToken(code)})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalidInviteErr
+		}
+
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the invite", InternalError: err}
+	}
+
+	now := time.Now()
+	if invite.IsExpired(now) || invite.IsExhausted() {
+		return nil, invalidInviteErr
+	}
+
+	used, err := s.authRepo.UseInvite(ctx, invite.ID, now)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error using the invite", InternalError: err}
+	}
+
+	if !used {
+		return nil, invalidInviteErr
+	}
+
+	createSrv := NewCreateUserService(s.usersRepo, s.passGen, s.cfgSvr, s.auditRepo)
+	user, err := createSrv.CreateUser(ctx, userName, password, confirmPassword, invite.IsAdmin)
+	if err != nil {
+		if releaseErr := s.authRepo.ReleaseInvite(ctx, invite.ID); releaseErr != nil {
+			log.Printf("Error releasing the invite %v: %v", invite.ID, releaseErr)
+		}
+
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
+)
+
+type RequestPasswordResetService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	cfgSvr    sharedApp.ConfigurationService
+	mailer    mailer.Mailer
+}
+
+func NewRequestPasswordResetService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, mailer mailer.Mailer) *RequestPasswordResetService {
+	return &RequestPasswordResetService{authRepo, usersRepo, cfgSvr, mailer}
+}
+
+// RequestPasswordReset sends a password reset link to the given email when it belongs to a
+// user with a verified email. It doesn't return an error when there isn't such user to not
+// disclose which emails are registered
+func (s *RequestPasswordResetService) RequestPasswordReset(ctx context.Context, email domain.UserEmailValueObject) error {
+	emailValue := email.String()
+	query := domain.UserRecord{Email: &emailValue, EmailVerified: true}
+
+	if existsUser, err := s.usersRepo.ExistsUser(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the user exists", InternalError: err}
+	} else if !existsUser {
+		return nil
+	}
+
+	foundUser, err := s.usersRepo.FindUser(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authRepo.DeleteUserTokens(ctx, domain.UserTokenEntity{UserID: foundUser.ID, Purpose: domain.PasswordResetTokenPurpose}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the previous password reset tokens", InternalError: err}
+	}
+
+	userToken, token, err := domain.NewUserTokenEntity(foundUser.ID, domain.PasswordResetTokenPurpose, s.cfgSvr.GetPasswordResetTokenExpirationTime())
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error generating the password reset token", InternalError: err}
+	}
+
+	if err := s.authRepo.CreateUserToken(ctx, userToken); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error saving the password reset token", InternalError: err}
+	}
+
+	message := &mailer.Message{
+		To:      emailValue,
+		Subject: "Reset your password",
+		Body:    fmt.Sprintf("Use the following link to choose a new password for %v:\r\n\r\n%v/password-reset?token=%v\r\n", foundUser.Name, s.cfgSvr.GetFrontendUrl(), token),
+	}
+
+	if err := s.mailer.Send(message); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error sending the password reset email", InternalError: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type RequestUserExportService struct {
+	usersRepo   domain.UsersRepository
+	cfgSvr      sharedApp.ConfigurationService
+	eventBus    events.EventBus
+	auditLogger *sharedApp.AuditLogger
+}
+
+func NewRequestUserExportService(usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, eventBus events.EventBus, auditRepo audit.AuditLogRepository) *RequestUserExportService {
+	return &RequestUserExportService{usersRepo, cfgSvr, eventBus, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+// RequestUserExport creates a pending export of the personal data of the user that is built
+// in background. It returns the token of the download link, which is valid until the export expires
+func (s *RequestUserExportService) RequestUserExport(ctx context.Context, userID int32, requestedBy int32) (*domain.UserExportEntity, string, error) {
+	if _, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID}); err != nil {
+		return nil, "", err
+	}
+
+	export, token, err := domain.NewUserExportRecord(userID, requestedBy, s.cfgSvr.GetUserExportExpirationTime())
+	if err != nil {
+		return nil, "", &appErrors.UnexpectedError{Msg: "Error generating the export token", InternalError: err}
+	}
+
+	if err := s.usersRepo.CreateUserExport(ctx, export); err != nil {
+		return nil, "", &appErrors.UnexpectedError{Msg: "Error creating the user export", InternalError: err}
+	}
+
+	s.auditLogger.Log(ctx, audit.ActionUserExportRequested, audit.TargetUser, userID, nil, nil)
+
+	go s.eventBus.Publish(events.UserExportRequested, export.ID)
+
+	return export.ToUserExportEntity(), token, nil
+}
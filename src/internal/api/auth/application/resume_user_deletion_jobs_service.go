@@ -0,0 +1,34 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type ResumeUserDeletionJobsService struct {
+	usersRepo domain.UsersRepository
+	eventBus  events.EventBus
+}
+
+func NewResumeUserDeletionJobsService(usersRepo domain.UsersRepository, eventBus events.EventBus) *ResumeUserDeletionJobsService {
+	return &ResumeUserDeletionJobsService{usersRepo, eventBus}
+}
+
+// ResumeUserDeletionJobs publishes again the jobs that were pending or running when the api
+// stopped. The running ones start again from the first step, which is safe because every
+// step only touches what the user still has
+func (s *ResumeUserDeletionJobsService) ResumeUserDeletionJobs(ctx context.Context) (int, error) {
+	foundJobs, err := s.usersRepo.GetUnfinishedUserDeletionJobs(ctx)
+	if err != nil {
+		return 0, &appErrors.UnexpectedError{Msg: "Error getting the unfinished user deletion jobs", InternalError: err}
+	}
+
+	for _, j := range foundJobs {
+		s.eventBus.Publish(events.UserDeletionRequested, j.ID)
+	}
+
+	return len(foundJobs), nil
+}
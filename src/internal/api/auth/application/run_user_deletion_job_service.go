@@ -0,0 +1,273 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type userDeletionStep struct {
+	name string
+	run  func(ctx context.Context, job *domain.UserDeletionJobRecord) error
+}
+
+type RunUserDeletionJobService struct {
+	usersRepo         domain.UsersRepository
+	authRepo          domain.AuthRepository
+	listsRepo         listsDomain.ListsRepository
+	categoriesRepo    listsDomain.CategoriesRepository
+	workspacesRepo    workspacesDomain.WorkspacesRepository
+	tagsRepo          listsDomain.TagsRepository
+	listTemplatesRepo listsDomain.ListTemplatesRepository
+	listsSearchClient search.SearchIndexClient
+}
+
+func NewRunUserDeletionJobService(usersRepo domain.UsersRepository, authRepo domain.AuthRepository, listsRepo listsDomain.ListsRepository, categoriesRepo listsDomain.CategoriesRepository, workspacesRepo workspacesDomain.WorkspacesRepository, tagsRepo listsDomain.TagsRepository, listTemplatesRepo listsDomain.ListTemplatesRepository, listsSearchClient search.SearchIndexClient) *RunUserDeletionJobService {
+	return &RunUserDeletionJobService{usersRepo, authRepo, listsRepo, categoriesRepo, workspacesRepo, tagsRepo, listTemplatesRepo, listsSearchClient}
+}
+
+// RunUserDeletionJob runs the steps of the strategy of the job, storing the step being done
+// and the progress before each one. The job is marked as failed when a step fails
+func (s *RunUserDeletionJobService) RunUserDeletionJob(ctx context.Context, jobID int32) error {
+	job, err := s.usersRepo.FindUserDeletionJob(ctx, domain.UserDeletionJobRecord{ID: jobID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the user deletion job", InternalError: err}
+	}
+
+	steps := s.cascadeSteps()
+	if job.Strategy == domain.UserDeletionStrategyTransfer {
+		steps = s.transferSteps()
+	}
+
+	job.Status = domain.UserDeletionJobRunning
+
+	for i, step := range steps {
+		job.Step = step.name
+		job.Progress = int32(i * 100 / len(steps))
+
+		if err := s.usersRepo.UpdateUserDeletionJob(ctx, job); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error updating the user deletion job", InternalError: err}
+		}
+
+		if err := step.run(ctx, job); err != nil {
+			job.Status = domain.UserDeletionJobFailed
+			job.Error = err.Error()
+
+			if updateErr := s.usersRepo.UpdateUserDeletionJob(ctx, job); updateErr != nil {
+				return &appErrors.UnexpectedError{Msg: "Error updating the user deletion job", InternalError: updateErr}
+			}
+
+			return err
+		}
+	}
+
+	job.Status = domain.UserDeletionJobCompleted
+	job.Step = ""
+	job.Progress = 100
+
+	if err := s.usersRepo.UpdateUserDeletionJob(ctx, job); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user deletion job", InternalError: err}
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) cascadeSteps() []userDeletionStep {
+	return []userDeletionStep{
+		{"workspaces", s.deleteOwnedWorkspaces},
+		{"lists", s.deleteLists},
+		{"categories", s.deleteCategories},
+		{"refreshTokens", s.deleteRefreshTokens},
+		{"user", s.deleteUser},
+	}
+}
+
+func (s *RunUserDeletionJobService) transferSteps() []userDeletionStep {
+	return []userDeletionStep{
+		{"workspaces", s.transferOwnedWorkspaces},
+		{"tags", s.transferTags},
+		{"lists", s.transferLists},
+		{"categories", s.transferCategories},
+		{"templates", s.transferListTemplates},
+		{"refreshTokens", s.deleteRefreshTokens},
+		{"user", s.deleteUser},
+	}
+}
+
+// deleteOwnedWorkspaces deletes the workspaces owned by the user, including the lists and
+// the categories that other members have in them
+func (s *RunUserDeletionJobService) deleteOwnedWorkspaces(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	foundWorkspaces, err := s.workspacesRepo.GetUserWorkspaces(ctx, job.UserID)
+	if err != nil {
+		return fmt.Errorf("error getting the user workspaces: %w", err)
+	}
+
+	for _, w := range foundWorkspaces {
+		if w.Role != workspacesDomain.WorkspaceRoleOwner {
+			continue
+		}
+
+		if err := s.deleteListsMatching(ctx, listsDomain.ListRecord{WorkspaceID: w.ID}); err != nil {
+			return err
+		}
+
+		if err := s.categoriesRepo.DeleteCategories(ctx, listsDomain.CategoryRecord{WorkspaceID: w.ID}); err != nil {
+			return fmt.Errorf("error deleting the categories of the workspace %v: %w", w.ID, err)
+		}
+
+		if err := s.workspacesRepo.DeleteWorkspace(ctx, w.ID); err != nil {
+			return fmt.Errorf("error deleting the workspace %v: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) deleteLists(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	return s.deleteListsMatching(ctx, listsDomain.ListRecord{UserID: job.UserID})
+}
+
+func (s *RunUserDeletionJobService) deleteListsMatching(ctx context.Context, query listsDomain.ListRecord) error {
+	foundLists, err := s.listsRepo.GetLists(ctx, query)
+	if err != nil {
+		return fmt.Errorf("error getting the lists: %w", err)
+	}
+
+	for _, l := range foundLists {
+		if err := s.listsRepo.DeleteList(ctx, listsDomain.ListRecord{ID: l.ID}); err != nil {
+			return fmt.Errorf("error deleting the list %v: %w", l.ID, err)
+		}
+
+		if err := s.listsSearchClient.DeleteObject(fmt.Sprint(l.ID)); err != nil {
+			return fmt.Errorf("error removing the list %v from the search index: %w", l.ID, err)
+		}
+	}
+
+	// The trashed lists aren't returned by GetLists and they aren't in the search index anymore
+	if err := s.listsRepo.DeleteTrashedLists(ctx, query); err != nil {
+		return fmt.Errorf("error deleting the trashed lists: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) deleteCategories(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.categoriesRepo.DeleteCategories(ctx, listsDomain.CategoryRecord{UserID: job.UserID}); err != nil {
+		return fmt.Errorf("error deleting the categories: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) deleteRefreshTokens(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.authRepo.DeleteRefreshTokensByUserID(ctx, job.UserID); err != nil {
+		return fmt.Errorf("error deleting the refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) deleteUser(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.usersRepo.Delete(ctx, domain.UserRecord{ID: job.UserID}); err != nil {
+		return fmt.Errorf("error deleting the user: %w", err)
+	}
+
+	return nil
+}
+
+// transferOwnedWorkspaces gives the workspaces owned by the user to the other user. The
+// personal workspace becomes a shared one named after the deleted user
+func (s *RunUserDeletionJobService) transferOwnedWorkspaces(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: job.UserID})
+	if err != nil {
+		return fmt.Errorf("error getting the user: %w", err)
+	}
+
+	if err := s.workspacesRepo.TransferOwnedWorkspaces(ctx, job.UserID, *job.TransferToUserID, fmt.Sprintf("Workspace of %v", foundUser.Name)); err != nil {
+		return fmt.Errorf("error transferring the workspaces: %w", err)
+	}
+
+	return nil
+}
+
+// transferTags gives the tags to the other user before the lists, so the lists are indexed
+// again with the tags of their new owner
+func (s *RunUserDeletionJobService) transferTags(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.tagsRepo.TransferTags(ctx, job.UserID, *job.TransferToUserID); err != nil {
+		return fmt.Errorf("error transferring the tags: %w", err)
+	}
+
+	return nil
+}
+
+// transferLists gives the lists to the other user and indexes them again because the user
+// is part of the search documents
+func (s *RunUserDeletionJobService) transferLists(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	foundLists, err := s.listsRepo.GetLists(ctx, listsDomain.ListRecord{UserID: job.UserID})
+	if err != nil {
+		return fmt.Errorf("error getting the lists: %w", err)
+	}
+
+	if err := s.listsRepo.TransferLists(ctx, job.UserID, *job.TransferToUserID); err != nil {
+		return fmt.Errorf("error transferring the lists: %w", err)
+	}
+
+	if len(foundLists) == 0 {
+		return nil
+	}
+
+	listIDs := make([]int32, len(foundLists))
+	for i, l := range foundLists {
+		listIDs[i] = l.ID
+	}
+
+	foundTags, err := s.tagsRepo.GetListsOwnerTags(ctx, listIDs)
+	if err != nil {
+		return fmt.Errorf("error getting the lists tags: %w", err)
+	}
+
+	tagNames := foundTags.TagNamesByID()
+	documents := make([]listsDomain.ListSearchDocument, len(foundLists))
+
+	for i, l := range foundLists {
+		transferredList, err := s.listsRepo.FindList(ctx, listsDomain.ListRecord{ID: l.ID})
+		if err != nil {
+			return fmt.Errorf("error getting the list %v: %w", l.ID, err)
+		}
+
+		documents[i] = transferredList.ToListSearchDocument()
+
+		if tags, ok := tagNames[l.ID]; ok {
+			documents[i].Tags = tags
+		}
+	}
+
+	if err := s.listsSearchClient.SaveObjects(documents); err != nil {
+		return fmt.Errorf("error indexing the lists: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RunUserDeletionJobService) transferCategories(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.categoriesRepo.TransferCategories(ctx, job.UserID, *job.TransferToUserID); err != nil {
+		return fmt.Errorf("error transferring the categories: %w", err)
+	}
+
+	return nil
+}
+
+// transferListTemplates gives the templates to the other user, renaming the ones whose name
+// the other user already has
+func (s *RunUserDeletionJobService) transferListTemplates(ctx context.Context, job *domain.UserDeletionJobRecord) error {
+	if err := s.listTemplatesRepo.TransferListTemplates(ctx, job.UserID, *job.TransferToUserID); err != nil {
+		return fmt.Errorf("error transferring the templates: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+)
+
+type RunUserExportService struct {
+	usersRepo      domain.UsersRepository
+	authRepo       domain.AuthRepository
+	listsRepo      listsDomain.ListsRepository
+	categoriesRepo listsDomain.CategoriesRepository
+	auditRepo      audit.AuditLogRepository
+	storage        storage.Storage
+}
+
+func NewRunUserExportService(usersRepo domain.UsersRepository, authRepo domain.AuthRepository, listsRepo listsDomain.ListsRepository, categoriesRepo listsDomain.CategoriesRepository, auditRepo audit.AuditLogRepository, storage storage.Storage) *RunUserExportService {
+	return &RunUserExportService{usersRepo, authRepo, listsRepo, categoriesRepo, auditRepo, storage}
+}
+
+// RunUserExport builds the archive of the export and saves it in the storage. The export is
+// marked as failed when something goes wrong. Before that, the archives of the expired exports
+// are deleted from the storage
+func (s *RunUserExportService) RunUserExport(ctx context.Context, exportID int32) error {
+	if err := NewPurgeExpiredUserExportsService(s.usersRepo, s.storage).PurgeExpiredUserExports(ctx, time.Now()); err != nil {
+		log.Printf("Error purging the expired user exports. Error: %v", err)
+	}
+
+	export, err := s.usersRepo.FindUserExport(ctx, domain.UserExportRecord{ID: exportID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the user export", InternalError: err}
+	}
+
+	export.Status = domain.UserExportRunning
+
+	if err := s.usersRepo.UpdateUserExport(ctx, export); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user export", InternalError: err}
+	}
+
+	if err := s.saveArchive(ctx, export); err != nil {
+		export.Status = domain.UserExportFailed
+		export.Error = err.Error()
+
+		if updateErr := s.usersRepo.UpdateUserExport(ctx, export); updateErr != nil {
+			return &appErrors.UnexpectedError{Msg: "Error updating the user export", InternalError: updateErr}
+		}
+
+		return err
+	}
+
+	export.Status = domain.UserExportCompleted
+
+	if err := s.usersRepo.UpdateUserExport(ctx, export); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user export", InternalError: err}
+	}
+
+	return nil
+}
+
+func (s *RunUserExportService) saveArchive(ctx context.Context, export *domain.UserExportRecord) error {
+	archive, err := s.buildArchive(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding the archive: %w", err)
+	}
+
+	export.FileName = fmt.Sprintf("user-exports/%v.json", export.ID)
+
+	if err := s.storage.Save(ctx, export.FileName, content); err != nil {
+		return fmt.Errorf("error saving the archive: %w", err)
+	}
+
+	return nil
+}
+
+func (s *RunUserExportService) buildArchive(ctx context.Context, userID int32) (*domain.UserExportArchive, error) {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting the user: %w", err)
+	}
+
+	archive := &domain.UserExportArchive{ExportedAt: time.Now(), Profile: foundUser}
+
+	if archive.Lists, err = s.lists(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	foundCategories, err := s.categoriesRepo.GetCategories(ctx, listsDomain.CategoryRecord{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting the categories: %w", err)
+	}
+
+	archive.Categories = foundCategories.ToCategoriesEntities()
+
+	foundTokens, err := s.authRepo.GetRefreshTokensByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the sessions: %w", err)
+	}
+
+	archive.Sessions = make([]domain.UserExportArchiveSession, len(foundTokens))
+	for i, t := range foundTokens {
+		archive.Sessions[i] = domain.UserExportArchiveSession{ID: t.ID, ExpirationDate: t.ExpirationDate}
+	}
+
+	if archive.AuditEntries, err = s.auditEntries(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+// lists returns the lists of the user with their items, which aren't loaded when getting
+// all the lists at once
+func (s *RunUserExportService) lists(ctx context.Context, userID int32) ([]*listsDomain.ListEntity, error) {
+	foundLists, err := s.listsRepo.GetLists(ctx, listsDomain.ListRecord{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("error getting the lists: %w", err)
+	}
+
+	res := make([]*listsDomain.ListEntity, len(foundLists))
+
+	for i, l := range foundLists {
+		foundList, err := s.listsRepo.FindList(ctx, listsDomain.ListRecord{ID: l.ID})
+		if err != nil {
+			return nil, fmt.Errorf("error getting the list %v: %w", l.ID, err)
+		}
+
+		res[i] = foundList.ToListEntity()
+	}
+
+	return res, nil
+}
+
+// auditEntries returns the entries of the actions done by the user and the ones done by
+// others on the user
+func (s *RunUserExportService) auditEntries(ctx context.Context, userID int32) ([]domain.UserExportArchiveAuditEntry, error) {
+	pagInfo := sharedDomain.NewPaginationInfo(-1, 0, "id", sharedDomain.OrderAsc)
+
+	doneByUser, err := s.auditRepo.GetAll(ctx, audit.AuditLogFilter{ActorID: &userID}, pagInfo)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the audit entries: %w", err)
+	}
+
+	doneOnUser, err := s.auditRepo.GetAll(ctx, audit.AuditLogFilter{TargetType: audit.TargetUser, TargetID: &userID}, pagInfo)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the audit entries: %w", err)
+	}
+
+	found := map[int32]*audit.AuditLogEntryEntity{}
+	for _, e := range append(doneByUser, doneOnUser...) {
+		found[e.ID] = e
+	}
+
+	res := make([]domain.UserExportArchiveAuditEntry, 0, len(found))
+	for _, e := range found {
+		res = append(res, domain.UserExportArchiveAuditEntry{
+			ID:         e.ID,
+			ActorID:    e.ActorID,
+			Action:     e.Action,
+			TargetType: e.TargetType,
+			TargetID:   e.TargetID,
+			IP:         e.IP,
+			UserAgent:  e.UserAgent,
+			Diff:       json.RawMessage(e.Diff),
+			CreatedAt:  e.CreatedAt,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return res, nil
+}
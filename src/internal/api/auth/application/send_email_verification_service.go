@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
+)
+
+type SendEmailVerificationService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+	cfgSvr    sharedApp.ConfigurationService
+	mailer    mailer.Mailer
+}
+
+func NewSendEmailVerificationService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, mailer mailer.Mailer) *SendEmailVerificationService {
+	return &SendEmailVerificationService{authRepo, usersRepo, cfgSvr, mailer}
+}
+
+// SendEmailVerification sends a link to confirm the email of the user. Any link sent
+// before stops being valid
+func (s *SendEmailVerificationService) SendEmailVerification(ctx context.Context, userID int32) error {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return err
+	}
+
+	if foundUser.Email == nil {
+		return &appErrors.BadRequestError{Msg: "The user doesn't have an email"}
+	}
+
+	if foundUser.EmailVerified {
+		return &appErrors.BadRequestError{Msg: "The email is already verified"}
+	}
+
+	if err := s.authRepo.DeleteUserTokens(ctx, domain.UserTokenEntity{UserID: userID, Purpose: domain.EmailVerificationTokenPurpose}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the previous email verification tokens", InternalError: err}
+	}
+
+	userToken, token, err := domain.NewUserTokenEntity(userID, domain.EmailVerificationTokenPurpose, s.cfgSvr.GetEmailVerificationTokenExpirationTime())
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error generating the email verification token", InternalError: err}
+	}
+
+	if err := s.authRepo.CreateUserToken(ctx, userToken); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error saving the email verification token", InternalError: err}
+	}
+
+	message := &mailer.Message{
+		To:      *foundUser.Email,
+		Subject: "Verify your email",
+		Body:    fmt.Sprintf("Use the following link to verify your email:\r\n\r\n%v/verify-email?token=%v\r\n", s.cfgSvr.GetFrontendUrl(), token),
+	}
+
+	if err := s.mailer.Send(message); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error sending the email verification email", InternalError: err}
+	}
+
+	return nil
+}
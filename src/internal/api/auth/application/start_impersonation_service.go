@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type StartImpersonationService struct {
+	authRepo    domain.AuthRepository
+	usersRepo   domain.UsersRepository
+	cfgSvr      sharedApp.ConfigurationService
+	tokenSrv    domain.TokenService
+	auditLogger *sharedApp.AuditLogger
+}
+
+func NewStartImpersonationService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository, cfgSvr sharedApp.ConfigurationService, tokenSrv domain.TokenService, auditRepo audit.AuditLogRepository) *StartImpersonationService {
+	return &StartImpersonationService{authRepo, usersRepo, cfgSvr, tokenSrv, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+// StartImpersonation stores a new impersonation session and returns the token the admin
+// must use to act as the user
+func (s *StartImpersonationService) StartImpersonation(ctx context.Context, adminID int32, userID int32) (string, *domain.ImpersonationEntity, error) {
+	if adminID == userID {
+		return "", nil, &appErrors.BadRequestError{Msg: "It is not possible to impersonate yourself"}
+	}
+
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return "", nil, err
+	}
+
+	if foundUser.IsAdmin {
+		return "", nil, &appErrors.BadRequestError{Msg: "It is not possible to impersonate an admin user"}
+	}
+
+	foundAdmin, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: adminID})
+	if err != nil {
+		return "", nil, &appErrors.UnexpectedError{Msg: "Error getting the admin user", InternalError: err}
+	}
+
+	now := time.Now()
+	impersonation := &domain.ImpersonationEntity{
+		AdminID:        adminID,
+		UserID:         userID,
+		StartedAt:      now,
+		ExpirationDate: s.cfgSvr.GetImpersonationExpirationTime(),
+	}
+
+	if err := s.authRepo.CreateImpersonation(ctx, impersonation); err != nil {
+		return "", nil, &appErrors.UnexpectedError{Msg: "Error creating the impersonation", InternalError: err}
+	}
+
+	token, err := s.tokenSrv.GenerateImpersonationToken(foundAdmin.ToUserEntity(), foundUser.ToUserEntity(), impersonation)
+	if err != nil {
+		return "", nil, &appErrors.UnexpectedError{Msg: "Error creating the impersonation token", InternalError: err}
+	}
+
+	log.Printf("Admin %q (id %v) started the impersonation %v of the user %q (id %v)", foundAdmin.Name, adminID, impersonation.ID, foundUser.Name, userID)
+	s.auditLogger.Log(ctx, audit.ActionImpersonationStarted, audit.TargetImpersonation, impersonation.ID, nil, map[string]interface{}{"userId": userID, "expirationDate": impersonation.ExpirationDate})
+
+	return token, impersonation, nil
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type UpdateUserEmailService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+}
+
+func NewUpdateUserEmailService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository) *UpdateUserEmailService {
+	return &UpdateUserEmailService{authRepo, usersRepo}
+}
+
+// UpdateEmail changes the email of the user. The new email isn't verified until the user
+// confirms it and an empty email removes it
+func (s *UpdateUserEmailService) UpdateEmail(ctx context.Context, userID int32, email domain.UserEmailValueObject) (*domain.UserEntity, error) {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	entity := foundUser.ToUserEntity()
+	if entity.Email.String() == email.String() {
+		return entity, nil
+	}
+
+	if !email.IsEmpty() {
+		emailValue := email.String()
+		if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Email: &emailValue}); err != nil {
+			return nil, &appErrors.UnexpectedError{Msg: "Error checking if a user with the same email already exists", InternalError: err}
+		} else if existsUser {
+			return nil, &appErrors.BadRequestError{Msg: "A user with the same email already exists"}
+		}
+	}
+
+	entity.Email = email
+	entity.EmailVerified = false
+
+	if err := s.usersRepo.Update(ctx, entity.ToUserRecord()); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
+	}
+
+	if err := s.authRepo.DeleteUserTokens(ctx, domain.UserTokenEntity{UserID: userID, Purpose: domain.EmailVerificationTokenPurpose}); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error deleting the previous email verification tokens", InternalError: err}
+	}
+
+	return entity, nil
+}
@@ -5,16 +5,27 @@ import (
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
+// userAuditSnapshot is the user stored in the audit log. The password hash isn't serialized so
+// it records if the password has been changed instead
+type userAuditSnapshot struct {
+	*domain.UserRecord
+	PasswordChanged bool `json:"passwordChanged,omitempty"`
+}
+
 type UpdateUserService struct {
-	usersRepo domain.UsersRepository
-	passGen   passgen.PasswordGenerator
+	usersRepo   domain.UsersRepository
+	passGen     passgen.PasswordGenerator
+	cfgSvr      sharedApp.ConfigurationService
+	auditLogger *sharedApp.AuditLogger
 }
 
-func NewUpdateUserService(usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator) *UpdateUserService {
-	return &UpdateUserService{usersRepo, passGen}
+func NewUpdateUserService(usersRepo domain.UsersRepository, passGen passgen.PasswordGenerator, cfgSvr sharedApp.ConfigurationService, auditRepo audit.AuditLogRepository) *UpdateUserService {
+	return &UpdateUserService{usersRepo, passGen, cfgSvr, sharedApp.NewAuditLogger(auditRepo)}
 }
 
 func (s *UpdateUserService) UpdateUser(ctx context.Context, userID int32, userName domain.UserNameValueObject, password string, isAdmin bool) (*domain.UserEntity, error) {
@@ -25,25 +36,25 @@ func (s *UpdateUserService) UpdateUser(ctx context.Context, userID int32, userNa
 
 	entity := foundUser.ToUserEntity()
 
-	if entity.IsTheAdminUser() {
-		if userName.String() != "admin" {
-			return nil, &appErrors.BadRequestError{Msg: "It is not possible to change the admin user name"}
-		}
+	if err := s.checkAdminUserName(entity, userName); err != nil {
+		return nil, err
+	}
 
-		if !isAdmin {
-			return nil, &appErrors.BadRequestError{Msg: "The admin user must be an admin"}
-		}
+	if entity.IsTheAdminUser() && !isAdmin {
+		return nil, &appErrors.BadRequestError{Msg: "The admin user must be an admin"}
 	}
 
-	if entity.Name.String() != userName.String() {
-		if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Name: userName.String()}); err != nil {
-			return nil, &appErrors.UnexpectedError{Msg: "Error checking if a user with the same name already exists", InternalError: err}
-		} else if existsUser {
-			return nil, &appErrors.BadRequestError{Msg: "A user with the same user name already exists", InternalError: nil}
-		}
+	if err := s.checkUserNameIsAvailable(ctx, entity, userName); err != nil {
+		return nil, err
 	}
 
+	before := *foundUser
+
 	if len(password) > 0 {
+		if err := domain.NewPasswordPolicy(s.cfgSvr).Check(password); err != nil {
+			return nil, err
+		}
+
 		hasshedPass, err := s.passGen.GenerateFromPassword(string(password))
 		if err != nil {
 			return nil, &appErrors.UnexpectedError{Msg: "Error encrypting password", InternalError: err}
@@ -60,5 +71,59 @@ func (s *UpdateUserService) UpdateUser(ctx context.Context, userID int32, userNa
 		return nil, &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
 	}
 
+	s.auditLogger.Log(ctx, audit.ActionUserUpdated, audit.TargetUser, userID, userAuditSnapshot{&before, false}, userAuditSnapshot{foundUser, len(password) > 0})
+
+	return foundUser.ToUserEntity(), nil
+}
+
+// UpdateProfile updates the fields a user is allowed to change about itself
+func (s *UpdateUserService) UpdateProfile(ctx context.Context, userID int32, userName domain.UserNameValueObject, displayName domain.UserDisplayNameValueObject) (*domain.UserEntity, error) {
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	entity := foundUser.ToUserEntity()
+
+	if err := s.checkAdminUserName(entity, userName); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkUserNameIsAvailable(ctx, entity, userName); err != nil {
+		return nil, err
+	}
+
+	before := *foundUser
+
+	foundUser.Name = userName.String()
+	foundUser.DisplayName = displayName.String()
+
+	err = s.usersRepo.Update(ctx, foundUser)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
+	}
+
+	s.auditLogger.Log(ctx, audit.ActionUserUpdated, audit.TargetUser, userID, before, foundUser)
+
 	return foundUser.ToUserEntity(), nil
 }
+
+func (s *UpdateUserService) checkAdminUserName(entity *domain.UserEntity, userName domain.UserNameValueObject) error {
+	if entity.IsTheAdminUser() && userName.String() != "admin" {
+		return &appErrors.BadRequestError{Msg: "It is not possible to change the admin user name"}
+	}
+
+	return nil
+}
+
+func (s *UpdateUserService) checkUserNameIsAvailable(ctx context.Context, entity *domain.UserEntity, userName domain.UserNameValueObject) error {
+	if entity.Name.String() != userName.String() {
+		if existsUser, err := s.usersRepo.ExistsUser(ctx, domain.UserRecord{Name: userName.String()}); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error checking if a user with the same name already exists", InternalError: err}
+		} else if existsUser {
+			return &appErrors.BadRequestError{Msg: "A user with the same user name already exists", InternalError: nil}
+		}
+	}
+
+	return nil
+}
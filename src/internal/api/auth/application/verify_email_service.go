@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type VerifyEmailService struct {
+	authRepo  domain.AuthRepository
+	usersRepo domain.UsersRepository
+}
+
+func NewVerifyEmailService(authRepo domain.AuthRepository, usersRepo domain.UsersRepository) *VerifyEmailService {
+	return &VerifyEmailService{authRepo, usersRepo}
+}
+
+func (s *VerifyEmailService) VerifyEmail(ctx context.Context, token string) error {
+	userToken, err := consumeUserToken(ctx, s.authRepo, token, domain.EmailVerificationTokenPurpose)
+	if err != nil {
+		return err
+	}
+
+	foundUser, err := s.usersRepo.FindUser(ctx, domain.UserRecord{ID: userToken.UserID})
+	if err != nil {
+		return err
+	}
+
+	foundUser.EmailVerified = true
+
+	if err := s.usersRepo.Update(ctx, foundUser); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user", InternalError: err}
+	}
+
+	return nil
+}
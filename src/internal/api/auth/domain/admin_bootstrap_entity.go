@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"crypto/subtle"
+	"time"
+)
+
+// AdminBootstrapEntity is the state of the creation of the first admin user. The setup token
+// hash is only stored when the token has been generated at startup instead of being configured
+type AdminBootstrapEntity struct {
+	SetupTokenHash string
+	CompletedAt    *time.Time
+}
+
+func (e *AdminBootstrapEntity) IsCompleted() bool {
+	return e.CompletedAt != nil
+}
+
+// CheckSetupToken returns whether the given token is the configured one or, if there isn't
+// a configured one, the one generated at startup
+func (e *AdminBootstrapEntity) CheckSetupToken(token string, configuredToken string) bool {
+	expectedHash := e.SetupTokenHash
+	if len(configuredToken) > 0 {
+		expectedHash = HashUserToken(configuredToken)
+	}
+
+	if len(token) == 0 || len(expectedHash) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(HashUserToken(token)), []byte(expectedHash)) == 1
+}
+
+// NewAdminSetupToken generates a random setup token and returns it together with its hash
+func NewAdminSetupToken() (string, string, error) {
+	token, err := newRandomToken()
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, HashUserToken(token), nil
+}
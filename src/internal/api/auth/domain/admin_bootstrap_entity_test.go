@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminBootstrapEntity_CheckSetupToken(t *testing.T) {
+	generated := AdminBootstrapEntity{SetupTokenHash: HashUserToken("generated")}
+
+	assert.True(t, generated.CheckSetupToken("generated", ""))
+	assert.False(t, generated.CheckSetupToken("another", ""))
+	assert.False(t, generated.CheckSetupToken("", ""))
+
+	assert.True(t, generated.CheckSetupToken("configured", "configured"))
+	assert.False(t, generated.CheckSetupToken("generated", "configured"))
+
+	withoutToken := AdminBootstrapEntity{}
+	assert.False(t, withoutToken.CheckSetupToken("", ""))
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// AdminBootstrapID is the id of the only row of the admin bootstrap table
+const AdminBootstrapID = int32(1)
+
+type AdminBootstrapRecord struct {
+	ID             int32      `gorm:"type:int(32);primary_key"`
+	SetupTokenHash *string    `gorm:"column:setupTokenHash;type:varchar(64)"`
+	CompletedAt    *time.Time `gorm:"column:completedAt;type:timestamp"`
+}
+
+func (AdminBootstrapRecord) TableName() string {
+	return "admin_bootstrap"
+}
+
+func (r *AdminBootstrapRecord) ToAdminBootstrapEntity() *AdminBootstrapEntity {
+	entity := &AdminBootstrapEntity{CompletedAt: r.CompletedAt}
+	if r.SetupTokenHash != nil {
+		entity.SetupTokenHash = *r.SetupTokenHash
+	}
+
+	return entity
+}
@@ -13,4 +13,26 @@ type AuthRepository interface {
 	DeleteExpiredRefreshTokens(ctx context.Context, expTime time.Time) error
 	GetAllRefreshTokens(ctx context.Context, paginationInfo *sharedDomain.PaginationInfo) ([]*RefreshTokenEntity, error)
 	DeleteRefreshTokensByID(ctx context.Context, ids []int32) error
+	GetRefreshTokensByUserID(ctx context.Context, userID int32) ([]*RefreshTokenEntity, error)
+	DeleteRefreshTokensByUserID(ctx context.Context, userID int32) error
+	CreateUserToken(ctx context.Context, userToken *UserTokenEntity) error
+	FindUserToken(ctx context.Context, query UserTokenEntity) (*UserTokenEntity, error)
+	/* ConsumeUserToken deletes the token and returns false when it had already been deleted */
+	ConsumeUserToken(ctx context.Context, id int32) (bool, error)
+	DeleteUserTokens(ctx context.Context, query UserTokenEntity) error
+	DeleteExpiredUserTokens(ctx context.Context, expTime time.Time) error
+	CreateInvite(ctx context.Context, invite *InviteEntity) error
+	FindInvite(ctx context.Context, query InviteEntity) (*InviteEntity, error)
+	GetAllInvites(ctx context.Context) ([]*InviteEntity, error)
+	DeleteInvite(ctx context.Context, id int32) error
+	UseInvite(ctx context.Context, id int32, now time.Time) (bool, error)
+	ReleaseInvite(ctx context.Context, id int32) error
+	GetAdminBootstrap(ctx context.Context) (*AdminBootstrapEntity, error)
+	OpenAdminBootstrap(ctx context.Context) error
+	SetAdminSetupTokenHash(ctx context.Context, setupTokenHash string, replace bool) (bool, error)
+	ClaimAdminBootstrap(ctx context.Context, now time.Time) (bool, error)
+	ReleaseAdminBootstrap(ctx context.Context) error
+	CreateImpersonation(ctx context.Context, impersonation *ImpersonationEntity) error
+	FindImpersonation(ctx context.Context, query ImpersonationEntity) (*ImpersonationEntity, error)
+	EndImpersonation(ctx context.Context, id int32, now time.Time) error
 }
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// ImpersonationEntity is a session in which an admin acts as another user. It's stored so
+// it can be ended before the impersonation token expires and to keep a record of it
+type ImpersonationEntity struct {
+	ID             int32
+	AdminID        int32
+	UserID         int32
+	StartedAt      time.Time
+	ExpirationDate time.Time
+	EndedAt        *time.Time
+}
+
+func (e *ImpersonationEntity) IsActive(now time.Time) bool {
+	return e.EndedAt == nil && now.Before(e.ExpirationDate)
+}
+
+func (e *ImpersonationEntity) ToImpersonationRecord() *ImpersonationRecord {
+	return &ImpersonationRecord{
+		ID:             e.ID,
+		AdminID:        e.AdminID,
+		UserID:         e.UserID,
+		StartedAt:      e.StartedAt,
+		ExpirationDate: e.ExpirationDate,
+		EndedAt:        e.EndedAt,
+	}
+}
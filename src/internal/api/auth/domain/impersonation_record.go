@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+type ImpersonationRecord struct {
+	ID             int32      `gorm:"type:int(32);primary_key"`
+	AdminID        int32      `gorm:"column:adminId;type:int(32)"`
+	UserID         int32      `gorm:"column:userId;type:int(32)"`
+	StartedAt      time.Time  `gorm:"column:startedAt;type:timestamp"`
+	ExpirationDate time.Time  `gorm:"column:expirationDate;type:timestamp"`
+	EndedAt        *time.Time `gorm:"column:endedAt;type:timestamp"`
+}
+
+func (ImpersonationRecord) TableName() string {
+	return "impersonations"
+}
+
+func (r *ImpersonationRecord) ToImpersonationEntity() *ImpersonationEntity {
+	return &ImpersonationEntity{
+		ID:             r.ID,
+		AdminID:        r.AdminID,
+		UserID:         r.UserID,
+		StartedAt:      r.StartedAt,
+		ExpirationDate: r.ExpirationDate,
+		EndedAt:        r.EndedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// InviteEntity is a code generated by an admin that allows to register a limited number
+// of users until it expires. As with the user tokens only the hash of the code is stored
+type InviteEntity struct {
+	ID             int32
+	CodeHash       string
+	IsAdmin        bool
+	MaxUses        int32
+	UsesCount      int32
+	ExpirationDate time.Time
+	CreatedBy      int32
+}
+
+// NewInviteEntity generates a random code and returns it together with the entity
+// that must be stored to validate it later
+func NewInviteEntity(createdBy int32, isAdmin bool, maxUses int32, expirationDate time.Time) (*InviteEntity, string, error) {
+	code, err := newRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	entity := &InviteEntity{
+		CodeHash:       HashUserToken(code),
+		IsAdmin:        isAdmin,
+		MaxUses:        maxUses,
+		ExpirationDate: expirationDate,
+		CreatedBy:      createdBy,
+	}
+
+	return entity, code, nil
+}
+
+func (e *InviteEntity) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpirationDate)
+}
+
+func (e *InviteEntity) IsExhausted() bool {
+	return e.UsesCount >= e.MaxUses
+}
+
+func (e *InviteEntity) ToInviteRecord() *InviteRecord {
+	return &InviteRecord{
+		ID:             e.ID,
+		CodeHash:       e.CodeHash,
+		IsAdmin:        e.IsAdmin,
+		MaxUses:        e.MaxUses,
+		UsesCount:      e.UsesCount,
+		ExpirationDate: e.ExpirationDate,
+		CreatedBy:      e.CreatedBy,
+	}
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type InviteRecord struct {
+	ID             int32     `gorm:"type:int(32);primary_key"`
+	CodeHash       string    `gorm:"column:codeHash;type:varchar(64);index:idx_invites_code_hash,unique"`
+	IsAdmin        bool      `gorm:"column:isAdmin;type:tinyint"`
+	MaxUses        int32     `gorm:"column:maxUses;type:int(32)"`
+	UsesCount      int32     `gorm:"column:usesCount;type:int(32)"`
+	ExpirationDate time.Time `gorm:"column:expirationDate;type:timestamp"`
+	CreatedBy      int32     `gorm:"column:createdBy;type:int(32)"`
+}
+
+func (InviteRecord) TableName() string {
+	return "invites"
+}
+
+func (r *InviteRecord) ToInviteEntity() *InviteEntity {
+	return &InviteEntity{
+		ID:             r.ID,
+		CodeHash:       r.CodeHash,
+		IsAdmin:        r.IsAdmin,
+		MaxUses:        r.MaxUses,
+		UsesCount:      r.UsesCount,
+		ExpirationDate: r.ExpirationDate,
+		CreatedBy:      r.CreatedBy,
+	}
+}
@@ -27,6 +27,12 @@ func (m *MockedTokenService) GenerateRefreshToken(user *UserEntity, expirationDa
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockedTokenService) GenerateImpersonationToken(admin *UserEntity, user *UserEntity, impersonation *ImpersonationEntity) (string, error) {
+	args := m.Called(admin, user, impersonation)
+
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockedTokenService) ParseToken(tokenString string) (*jwt.Token, error) {
 	args := m.Called(tokenString)
 
@@ -56,3 +62,9 @@ func (m *MockedTokenService) GetRefreshTokenInfo(refreshToken *jwt.Token) *Refre
 
 	return args.Get(0).(*RefreshTokenClaimsInfo)
 }
+
+func (m *MockedTokenService) GetJsonWebKeySet() JsonWebKeySet {
+	args := m.Called()
+
+	return args.Get(0).(JsonWebKeySet)
+}
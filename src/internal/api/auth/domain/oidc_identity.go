@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// OidcIdentity contains the claims of a verified OpenID Connect id token
+type OidcIdentity struct {
+	Provider          string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+}
+
+// UserNameCandidate returns a valid user name built from the preferred username, the email or
+// the subject, in that order. From the second attempt on the name ends with the attempt number
+func (i *OidcIdentity) UserNameCandidate(attempt int) string {
+	name := i.baseUserName()
+
+	if attempt > 0 {
+		suffix := fmt.Sprint(attempt)
+		if len(name)+len(suffix) > userNameMaxLength {
+			name = name[:userNameMaxLength-len(suffix)]
+		}
+		name += suffix
+	}
+
+	return name
+}
+
+func (i *OidcIdentity) baseUserName() string {
+	for _, value := range []string{i.PreferredUsername, strings.Split(i.Email, "@")[0], i.Subject} {
+		name := strings.Map(func(r rune) rune {
+			if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, value)
+
+		if len(name) > userNameMaxLength {
+			name = name[:userNameMaxLength]
+		}
+
+		if len(name) > 0 {
+			return name
+		}
+	}
+
+	return "user"
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OidcIdentity_UserNameCandidate(t *testing.T) {
+	tests := []struct {
+		identity OidcIdentity
+		attempt  int
+		expected string
+	}{
+		{OidcIdentity{PreferredUsername: "John.Doe", Email: "jd@company.com", Subject: "123"}, 0, "johndoe"},
+		{OidcIdentity{Email: "jane_smith@company.com", Subject: "123"}, 0, "janesmith"},
+		{OidcIdentity{Subject: "a-very-long-subject"}, 0, "averylongs"},
+		{OidcIdentity{PreferredUsername: "..."}, 0, "user"},
+		{OidcIdentity{PreferredUsername: "johndoe"}, 2, "johndoe2"},
+		{OidcIdentity{PreferredUsername: "longusername"}, 12, "longuser12"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, tt.identity.UserNameCandidate(tt.attempt))
+	}
+}
@@ -0,0 +1,109 @@
+package passgen
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idPrefix  = "$argon2id$"
+	argon2idMemory  = 64 * 1024
+	argon2idTime    = 3
+	argon2idThreads = 2
+	argon2idSaltLen = 16
+	argon2idKeyLen  = 32
+)
+
+var errInvalidArgon2idHash = errors.New("invalid argon2id hash")
+
+type argon2idParams struct {
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+type Argon2idPasswordGenerator struct{}
+
+func NewArgon2idPasswordGenerator() *Argon2idPasswordGenerator {
+	return &Argon2idPasswordGenerator{}
+}
+
+// GenerateFromPassword returns the hash encoded in the PHC string format
+func (g *Argon2idPasswordGenerator) GenerateFromPassword(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argon2idTime, argon2idMemory, argon2idThreads, argon2idKeyLen)
+
+	return fmt.Sprintf("%vv=%d$m=%d,t=%d,p=%d$%v$%v",
+		argon2idPrefix,
+		argon2.Version,
+		argon2idMemory,
+		argon2idTime,
+		argon2idThreads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// NeedsRehash returns true when the hash wasn't generated with argon2id or it was generated
+// with different parameters
+func (g *Argon2idPasswordGenerator) NeedsRehash(hash string) bool {
+	params, _, _, err := decodeArgon2idHash(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.memory != argon2idMemory || params.time != argon2idTime || params.threads != argon2idThreads
+}
+
+func compareArgon2idHashAndPassword(hash string, password string) error {
+	params, salt, key, err := decodeArgon2idHash(hash)
+	if err != nil {
+		return err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, uint32(len(key)))
+
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return errors.New("hashedPassword is not the hash of the given password")
+	}
+
+	return nil
+}
+
+func decodeArgon2idHash(hash string) (*argon2idParams, []byte, []byte, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	params := argon2idParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return nil, nil, nil, errInvalidArgon2idHash
+	}
+
+	return &params, salt, key, nil
+}
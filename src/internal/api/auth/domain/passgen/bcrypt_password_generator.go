@@ -1,18 +1,39 @@
 package passgen
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"golang.org/x/crypto/bcrypt"
+)
 
-type BcryptPasswordGenerator struct{}
+type BcryptPasswordGenerator struct {
+	cost int
+}
+
+func NewBcryptPasswordGenerator(cfgSvr sharedApp.ConfigurationService) *BcryptPasswordGenerator {
+	cost := cfgSvr.GetBcryptCost()
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
 
-func NewBcryptPasswordGenerator() *BcryptPasswordGenerator {
-	return &BcryptPasswordGenerator{}
+	return &BcryptPasswordGenerator{cost}
 }
 
 func (g *BcryptPasswordGenerator) GenerateFromPassword(password string) (string, error) {
-	hasshedPass, err := bcrypt.GenerateFromPassword([]byte(password), 4)
+	hasshedPass, err := bcrypt.GenerateFromPassword([]byte(password), g.cost)
 	if err != nil {
 		return "", err
 	}
 
 	return string(hasshedPass), nil
 }
+
+// NeedsRehash returns true when the hash wasn't generated with bcrypt or it was generated
+// with a different cost
+func (g *BcryptPasswordGenerator) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return true
+	}
+
+	return cost != g.cost
+}
@@ -15,3 +15,9 @@ func (m *MockedPasswordGenerator) GenerateFromPassword(password string) (string,
 
 	return args.String(0), args.Error(1)
 }
+
+func (m *MockedPasswordGenerator) NeedsRehash(hash string) bool {
+	args := m.Called(hash)
+
+	return args.Bool(0)
+}
@@ -1,5 +1,22 @@
 package passgen
 
+import (
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
 type PasswordGenerator interface {
 	GenerateFromPassword(password string) (string, error)
+	NeedsRehash(hash string) bool
+}
+
+// CompareHashAndPassword checks the password against a hash generated by any of the
+// supported generators, so passwords keep working after changing the algorithm
+func CompareHashAndPassword(hash string, password string) error {
+	if strings.HasPrefix(hash, argon2idPrefix) {
+		return compareArgon2idHashAndPassword(hash, password)
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 }
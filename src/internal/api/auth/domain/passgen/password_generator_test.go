@@ -0,0 +1,56 @@
+package passgen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgon2idPasswordGenerator_Generates_A_Hash_That_Can_Be_Compared(t *testing.T) {
+	g := NewArgon2idPasswordGenerator()
+
+	hash, err := g.GenerateFromPassword("pass")
+
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=2$"))
+	assert.Nil(t, CompareHashAndPassword(hash, "pass"))
+	assert.NotNil(t, CompareHashAndPassword(hash, "other"))
+	assert.False(t, g.NeedsRehash(hash))
+}
+
+func TestArgon2idPasswordGenerator_NeedsRehash_Returns_True_For_Other_Algorithms_Or_Parameters(t *testing.T) {
+	g := NewArgon2idPasswordGenerator()
+
+	bcryptHash, _ := (&BcryptPasswordGenerator{cost: 4}).GenerateFromPassword("pass")
+
+	assert.True(t, g.NeedsRehash(bcryptHash))
+	assert.True(t, g.NeedsRehash("$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$a2V5"))
+}
+
+func TestBcryptPasswordGenerator_Uses_The_Configured_Cost(t *testing.T) {
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedCfgSrv.On("GetBcryptCost").Return(5).Once()
+	g := NewBcryptPasswordGenerator(&mockedCfgSrv)
+
+	hash, err := g.GenerateFromPassword("pass")
+
+	require.Nil(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$2a$05$"))
+	assert.Nil(t, CompareHashAndPassword(hash, "pass"))
+	assert.NotNil(t, CompareHashAndPassword(hash, "other"))
+	assert.False(t, g.NeedsRehash(hash))
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+func TestBcryptPasswordGenerator_NeedsRehash_Returns_True_For_Other_Algorithms_Or_Costs(t *testing.T) {
+	g := &BcryptPasswordGenerator{cost: 5}
+
+	oldHash, _ := (&BcryptPasswordGenerator{cost: 4}).GenerateFromPassword("pass")
+	argonHash, _ := NewArgon2idPasswordGenerator().GenerateFromPassword("pass")
+
+	assert.True(t, g.NeedsRehash(oldHash))
+	assert.True(t, g.NeedsRehash(argonHash))
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var commonPasswords = loadCommonPasswords(commonPasswordsFile)
+
+const (
+	PasswordLowerCharacterClass  = "lower"
+	PasswordUpperCharacterClass  = "upper"
+	PasswordDigitCharacterClass  = "digit"
+	PasswordSymbolCharacterClass = "symbol"
+)
+
+// PasswordPolicy contains the rules a password must follow when a user sets it. It is not
+// applied on login so passwords created with a weaker policy keep working
+type PasswordPolicy struct {
+	MinLength        int
+	CharacterClasses []string
+	RejectCommon     bool
+}
+
+func NewPasswordPolicy(cfgSvr sharedApp.ConfigurationService) *PasswordPolicy {
+	return &PasswordPolicy{
+		MinLength:        cfgSvr.GetPasswordMinLength(),
+		CharacterClasses: cfgSvr.GetPasswordCharacterClasses(),
+		RejectCommon:     cfgSvr.GetPasswordRejectCommon(),
+	}
+}
+
+func (p *PasswordPolicy) Check(value string) error {
+	if len([]rune(value)) < p.MinLength {
+		return &appErrors.BadRequestError{Msg: fmt.Sprintf("The password must have at least %v characters", p.MinLength)}
+	}
+
+	for _, class := range p.CharacterClasses {
+		if !containsCharacterClass(value, class) {
+			return &appErrors.BadRequestError{Msg: fmt.Sprintf("The password must contain at least one %v character", characterClassDescription(class))}
+		}
+	}
+
+	if p.RejectCommon {
+		if _, found := commonPasswords[strings.ToLower(value)]; found {
+			return &appErrors.BadRequestError{Msg: "The password is too common"}
+		}
+	}
+
+	return nil
+}
+
+func containsCharacterClass(value string, class string) bool {
+	for _, r := range value {
+		switch class {
+		case PasswordLowerCharacterClass:
+			if unicode.IsLower(r) {
+				return true
+			}
+		case PasswordUpperCharacterClass:
+			if unicode.IsUpper(r) {
+				return true
+			}
+		case PasswordDigitCharacterClass:
+			if unicode.IsDigit(r) {
+				return true
+			}
+		case PasswordSymbolCharacterClass:
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+
+	return false
+}
+
+func characterClassDescription(class string) string {
+	switch class {
+	case PasswordLowerCharacterClass:
+		return "lowercase"
+	case PasswordUpperCharacterClass:
+		return "uppercase"
+	case PasswordDigitCharacterClass:
+		return "numeric"
+	default:
+		return "symbol"
+	}
+}
+
+func loadCommonPasswords(content string) map[string]struct{} {
+	res := map[string]struct{}{}
+
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			res[strings.ToLower(line)] = struct{}{}
+		}
+	}
+
+	return res
+}
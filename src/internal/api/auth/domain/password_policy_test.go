@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func checkPasswordPolicyError(t *testing.T, err error, msg string) {
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, msg, badReqErr.Error())
+}
+
+func Test_PasswordPolicy_Validates_MinLength(t *testing.T) {
+	p := PasswordPolicy{MinLength: 8}
+	checkPasswordPolicyError(t, p.Check("short"), "The password must have at least 8 characters")
+}
+
+func Test_PasswordPolicy_Validates_The_CharacterClasses(t *testing.T) {
+	p := PasswordPolicy{CharacterClasses: []string{"lower", "upper", "digit", "symbol"}}
+
+	tests := []struct {
+		password string
+		msg      string
+	}{
+		{"ABCDEFG1!", "The password must contain at least one lowercase character"},
+		{"abcdefg1!", "The password must contain at least one uppercase character"},
+		{"abcdefgH!", "The password must contain at least one numeric character"},
+		{"abcdefgH1", "The password must contain at least one symbol character"},
+	}
+
+	for _, tt := range tests {
+		checkPasswordPolicyError(t, p.Check(tt.password), tt.msg)
+	}
+
+	assert.Nil(t, p.Check("abcdefgH1!"))
+}
+
+func Test_PasswordPolicy_Rejects_Common_Passwords(t *testing.T) {
+	p := PasswordPolicy{MinLength: 8, RejectCommon: true}
+	checkPasswordPolicyError(t, p.Check("Password123"), "The password is too common")
+
+	p.RejectCommon = false
+	assert.Nil(t, p.Check("Password123"))
+}
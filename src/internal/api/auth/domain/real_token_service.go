@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
@@ -10,36 +11,87 @@ import (
 
 type RealTokenService struct {
 	cfgSvc sharedApp.ConfigurationService
+	keys   *SigningKeySet
 }
 
 func NewRealTokenService(cfgSvc sharedApp.ConfigurationService) *RealTokenService {
-	return &RealTokenService{cfgSvc}
+	keys, err := LoadSigningKeySet(cfgSvc)
+	if err != nil {
+		log.Fatalf("Error loading the jwt keys: %v", err)
+	}
+
+	return &RealTokenService{cfgSvc, keys}
 }
 
 func (s *RealTokenService) GenerateToken(user *UserEntity) (string, error) {
 	t := s.getNewToken(user.ID, user.Name.String(), user.IsAdmin)
 
-	return s.signToken(t, s.cfgSvc.GetJwtSecret())
+	return s.signToken(t)
 
 }
 
 func (s *RealTokenService) GenerateRefreshToken(user *UserEntity, expirationDate time.Time) (string, error) {
 	rt := s.getNewRefreshToken(user.ID, expirationDate)
 
-	return s.signToken(rt, s.cfgSvc.GetJwtSecret())
+	return s.signToken(rt)
+}
+
+// GenerateImpersonationToken returns a token of the impersonated user that also carries the
+// admin and the impersonation session. It expires with the session
+func (s *RealTokenService) GenerateImpersonationToken(admin *UserEntity, user *UserEntity, impersonation *ImpersonationEntity) (string, error) {
+	t := s.getNewToken(user.ID, user.Name.String(), user.IsAdmin)
+
+	tc := s.getTokenClaims(t)
+	tc["impersonatorId"] = admin.ID
+	tc["impersonatorName"] = admin.Name.String()
+	tc["impersonationId"] = impersonation.ID
+	tc["exp"] = impersonation.ExpirationDate.Unix()
+
+	return s.signToken(t)
 }
 
-// ParseToken parses a refresh token string
+// ParseToken parses a token string checking its signature with the key of its kid header
+// and validating the registered claims
 func (s *RealTokenService) ParseToken(tokenString string) (*jwt.Token, error) {
-	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key, found := s.keys.VerificationKey(kid)
+		if !found {
+			return nil, fmt.Errorf("Unknown signing key: %q", kid)
 		}
 
-		secret := s.cfgSvc.GetJwtSecret()
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("Unexpected signing method: %v", token.Header["alg"])
+		}
 
-		return []byte(secret), nil
+		return key.PublicKey, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims := s.getTokenClaims(token)
+	now := time.Now().Unix()
+
+	if !claims.VerifyIssuer(s.cfgSvc.GetJwtIssuer(), true) {
+		return nil, fmt.Errorf("Invalid token issuer")
+	}
+
+	if !claims.VerifyAudience(s.cfgSvc.GetJwtAudience(), true) {
+		return nil, fmt.Errorf("Invalid token audience")
+	}
+
+	if !claims.VerifyIssuedAt(now, true) || !claims.VerifyNotBefore(now, true) {
+		return nil, fmt.Errorf("Invalid token issued at or not before claims")
+	}
+
+	return token, nil
+}
+
+// GetJsonWebKeySet returns the public keys that can verify the tokens
+func (s *RealTokenService) GetJsonWebKeySet() JsonWebKeySet {
+	return s.keys.JsonWebKeySet()
 }
 
 // GetTokenInfo returns a JwtClaimsInfo got from the token claims
@@ -47,9 +99,12 @@ func (s *RealTokenService) GetTokenInfo(token *jwt.Token) *TokenClaimsInfo {
 	claims := s.getTokenClaims(token)
 
 	info := TokenClaimsInfo{
-		UserName: s.parseStringClaim(claims["userName"]),
-		UserID:   s.parseInt32Claim(claims["userId"]),
-		IsAdmin:  s.parseBoolClaim(claims["isAdmin"]),
+		UserName:         s.parseStringClaim(claims["userName"]),
+		UserID:           s.parseInt32Claim(claims["userId"]),
+		IsAdmin:          s.parseBoolClaim(claims["isAdmin"]),
+		ImpersonatorID:   s.parseInt32Claim(claims["impersonatorId"]),
+		ImpersonatorName: s.parseStringClaim(claims["impersonatorName"]),
+		ImpersonationID:  s.parseInt32Claim(claims["impersonationId"]),
 	}
 
 	return &info
@@ -74,6 +129,7 @@ func (s *RealTokenService) getNewToken(userID int32, userName string, userIsAdmi
 	tc["isAdmin"] = userIsAdmin
 	tc["userId"] = userID
 	tc["exp"] = s.cfgSvc.GetTokenExpirationTime().Unix()
+	s.addRegisteredClaims(tc)
 
 	return t
 }
@@ -83,23 +139,41 @@ func (s *RealTokenService) getNewRefreshToken(userID int32, expirationDate time.
 	rtc := s.getTokenClaims(rt)
 	rtc["userId"] = userID
 	rtc["exp"] = expirationDate.Unix()
+	s.addRegisteredClaims(rtc)
 
 	return rt
 }
 
-// newToken returns a new Jwt tooken
+// addRegisteredClaims adds the issuer, audience, issued at and not before claims
+func (s *RealTokenService) addRegisteredClaims(claims map[string]interface{}) {
+	now := time.Now().Unix()
+
+	claims["iss"] = s.cfgSvc.GetJwtIssuer()
+	claims["aud"] = s.cfgSvc.GetJwtAudience()
+	claims["iat"] = now
+	claims["nbf"] = now
+}
+
+// newToken returns a new Jwt tooken signed with the current signing key
 func (s *RealTokenService) newToken() *jwt.Token {
-	return jwt.New(jwt.SigningMethodHS256)
+	key := s.keys.SigningKey(time.Now())
+
+	t := jwt.New(key.Method)
+	t.Header["kid"] = key.ID
+
+	return t
 }
 
 // getTokenClaims returns the claims for the given token as a map
-func (s *RealTokenService) getTokenClaims(token *jwt.Token) map[string]interface{} {
+func (s *RealTokenService) getTokenClaims(token *jwt.Token) jwt.MapClaims {
 	return token.Claims.(jwt.MapClaims)
 }
 
-// signToken signs the given token
-func (s *RealTokenService) signToken(token *jwt.Token, secret string) (string, error) {
-	return token.SignedString([]byte(secret))
+// signToken signs the given token with the private key of its kid header
+func (s *RealTokenService) signToken(token *jwt.Token) (string, error) {
+	key, _ := s.keys.VerificationKey(token.Header["kid"].(string))
+
+	return token.SignedString(key.PrivateKey)
 }
 
 func (s *RealTokenService) parseStringClaim(value interface{}) string {
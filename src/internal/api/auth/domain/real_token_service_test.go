@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEd25519SigningKey(t *testing.T, kid string) *SigningKey {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	return &SigningKey{ID: kid, Method: jwt.SigningMethodEdDSA, PrivateKey: priv, PublicKey: pub}
+}
+
+func newTestTokenService(t *testing.T, rotationInterval time.Duration, keys ...*SigningKey) (*RealTokenService, *sharedApp.MockedConfigurationService) {
+	set, err := NewSigningKeySet(keys, "EdDSA", rotationInterval)
+	require.Nil(t, err)
+
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtIssuer").Return("issuer").Maybe()
+	mockedCfgSrv.On("GetJwtAudience").Return("audience").Maybe()
+	mockedCfgSrv.On("GetTokenExpirationTime").Return(time.Now().Add(5 * time.Minute)).Maybe()
+
+	return &RealTokenService{&mockedCfgSrv, set}, &mockedCfgSrv
+}
+
+func Test_RealTokenService_Generates_And_Parses_A_Token(t *testing.T) {
+	srv, _ := newTestTokenService(t, 0, newEd25519SigningKey(t, "key1"))
+	userName, _ := NewUserNameValueObject("wadus")
+
+	token, err := srv.GenerateToken(&UserEntity{ID: 11, Name: userName, IsAdmin: true})
+	require.Nil(t, err)
+
+	parsed, err := srv.ParseToken(token)
+	require.Nil(t, err)
+	assert.Equal(t, "key1", parsed.Header["kid"])
+	assert.Equal(t, "EdDSA", parsed.Header["alg"])
+
+	claims := parsed.Claims.(jwt.MapClaims)
+	assert.Equal(t, "issuer", claims["iss"])
+	assert.Equal(t, "audience", claims["aud"])
+	assert.NotNil(t, claims["iat"])
+	assert.NotNil(t, claims["nbf"])
+
+	info := srv.GetTokenInfo(parsed)
+	assert.Equal(t, &TokenClaimsInfo{UserID: 11, UserName: "wadus", IsAdmin: true}, info)
+}
+
+func Test_RealTokenService_ParseToken_Validates_The_Issuer_And_The_Audience(t *testing.T) {
+	key := newEd25519SigningKey(t, "key1")
+	srv, _ := newTestTokenService(t, 0, key)
+	token, _ := srv.GenerateRefreshToken(&UserEntity{ID: 11}, time.Now().Add(time.Hour))
+
+	otherIssuerSrv, otherIssuerCfgSrv := newTestTokenService(t, 0, key)
+	otherIssuerCfgSrv.ExpectedCalls = nil
+	otherIssuerCfgSrv.On("GetJwtIssuer").Return("another")
+	_, err := otherIssuerSrv.ParseToken(token)
+	assert.EqualError(t, err, "Invalid token issuer")
+
+	otherAudienceSrv, otherAudienceCfgSrv := newTestTokenService(t, 0, key)
+	otherAudienceCfgSrv.ExpectedCalls = nil
+	otherAudienceCfgSrv.On("GetJwtIssuer").Return("issuer")
+	otherAudienceCfgSrv.On("GetJwtAudience").Return("another")
+	_, err = otherAudienceSrv.ParseToken(token)
+	assert.EqualError(t, err, "Invalid token audience")
+}
+
+func Test_RealTokenService_ParseToken_Rejects_Tokens_Without_Registered_Claims(t *testing.T) {
+	key := newEd25519SigningKey(t, "key1")
+	srv, _ := newTestTokenService(t, 0, key)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"userId": 1, "iss": "issuer", "aud": "audience"})
+	token.Header["kid"] = "key1"
+	signed, _ := token.SignedString(key.PrivateKey)
+
+	_, err := srv.ParseToken(signed)
+	assert.EqualError(t, err, "Invalid token issued at or not before claims")
+}
+
+func Test_RealTokenService_ParseToken_Rejects_Unknown_Keys_And_Other_Algorithms(t *testing.T) {
+	srv, _ := newTestTokenService(t, 0, newEd25519SigningKey(t, "key1"))
+
+	otherSrv, _ := newTestTokenService(t, 0, newEd25519SigningKey(t, "key2"))
+	token, _ := otherSrv.GenerateRefreshToken(&UserEntity{ID: 11}, time.Now().Add(time.Hour))
+	_, err := srv.ParseToken(token)
+	assert.EqualError(t, err, "Unknown signing key: \"key2\"")
+
+	hmacToken := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"userId": 1})
+	hmacToken.Header["kid"] = "key1"
+	signed, _ := hmacToken.SignedString([]byte("mySecret"))
+	_, err = srv.ParseToken(signed)
+	assert.EqualError(t, err, "Unexpected signing method: HS256")
+}
+
+func Test_RealTokenService_Rotates_The_Signing_Key_And_Verifies_With_All_Of_Them(t *testing.T) {
+	srv, _ := newTestTokenService(t, time.Hour, newEd25519SigningKey(t, "key1"), newEd25519SigningKey(t, "key2"))
+
+	now := time.Date(2022, 1, 1, 10, 30, 0, 0, time.UTC)
+	first := srv.keys.SigningKey(now)
+	second := srv.keys.SigningKey(now.Add(time.Hour))
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, first.ID, srv.keys.SigningKey(now.Add(2*time.Hour)).ID)
+
+	for _, key := range []*SigningKey{first, second} {
+		token := jwt.New(key.Method)
+		token.Header["kid"] = key.ID
+		srv.getTokenClaims(token)["userId"] = 1
+		srv.addRegisteredClaims(srv.getTokenClaims(token))
+		signed, _ := token.SignedString(key.PrivateKey)
+
+		_, err := srv.ParseToken(signed)
+		assert.Nil(t, err)
+	}
+}
+
+func Test_RealTokenService_GetJsonWebKeySet_Returns_The_Public_Keys(t *testing.T) {
+	key := newEd25519SigningKey(t, "key1")
+	srv, _ := newTestTokenService(t, 0, key, &SigningKey{ID: "old", Method: jwt.SigningMethodEdDSA, PublicKey: key.PublicKey})
+
+	jwks := srv.GetJsonWebKeySet()
+
+	require.Equal(t, 2, len(jwks.Keys))
+	assert.Equal(t, "key1", jwks.Keys[0].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[0].Crv)
+	assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+	assert.Equal(t, "sig", jwks.Keys[0].Use)
+	assert.Equal(t, "old", jwks.Keys[1].Kid)
+}
+
+func Test_LoadSigningKeySet_Loads_The_Keys_From_The_Path_And_From_The_Env(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkcs8, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "current.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}), 0600))
+
+	oldRsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	pkix, _ := x509.MarshalPKIXPublicKey(&oldRsaKey.PublicKey)
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "old.pub.pem"), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}), 0600))
+
+	envRsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	envKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Headers: map[string]string{"kid": "fromEnv"}, Bytes: x509.MarshalPKCS1PrivateKey(envRsaKey)})
+
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtSigningAlgorithm").Return("RS256")
+	mockedCfgSrv.On("GetJwtKeysPath").Return(dir)
+	mockedCfgSrv.On("GetJwtKeys").Return(string(envKey))
+	mockedCfgSrv.On("GetJwtKeyRotationInterval").Return(time.Duration(0))
+
+	set, err := LoadSigningKeySet(&mockedCfgSrv)
+	require.Nil(t, err)
+
+	for _, kid := range []string{"current", "old", "fromEnv"} {
+		key, found := set.VerificationKey(kid)
+		require.True(t, found, kid)
+		assert.Equal(t, "RS256", key.Method.Alg())
+	}
+
+	oldKey, _ := set.VerificationKey("old")
+	assert.False(t, oldKey.CanSign())
+	assert.Equal(t, "fromEnv", set.SigningKey(time.Now()).ID)
+}
+
+func Test_LoadSigningKeySet_Returns_An_Error_If_There_Is_Not_A_Private_Key_For_The_Algorithm(t *testing.T) {
+	pub, _, _ := ed25519.GenerateKey(rand.Reader)
+	pkix, _ := x509.MarshalPKIXPublicKey(pub)
+
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtSigningAlgorithm").Return("EdDSA")
+	mockedCfgSrv.On("GetJwtKeysPath").Return("")
+	mockedCfgSrv.On("GetJwtKeys").Return(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})))
+	mockedCfgSrv.On("GetJwtKeyRotationInterval").Return(time.Duration(0))
+
+	_, err := LoadSigningKeySet(&mockedCfgSrv)
+
+	assert.EqualError(t, err, "there isn't any private key for the EdDSA algorithm")
+}
+
+func Test_LoadSigningKeySet_Returns_An_Error_If_The_Algorithm_Is_Not_Supported(t *testing.T) {
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtSigningAlgorithm").Return("HS256")
+
+	_, err := LoadSigningKeySet(&mockedCfgSrv)
+
+	assert.EqualError(t, err, "unsupported jwt signing algorithm \"HS256\"")
+}
+
+func Test_LoadSigningKeySet_Returns_An_Error_If_There_Is_Not_Any_Key_And_The_Ephemeral_Key_Is_Not_Allowed(t *testing.T) {
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtSigningAlgorithm").Return("EdDSA")
+	mockedCfgSrv.On("GetJwtKeysPath").Return("")
+	mockedCfgSrv.On("GetJwtKeys").Return("")
+	mockedCfgSrv.On("GetJwtAllowEphemeralKey").Return(false)
+
+	_, err := LoadSigningKeySet(&mockedCfgSrv)
+
+	assert.EqualError(t, err, "there isn't any jwt key configured, set JWT_KEYS or JWT_KEYS_PATH")
+}
+
+func Test_LoadSigningKeySet_Uses_An_Ephemeral_Key_If_There_Is_Not_Any_Key_And_It_Is_Allowed(t *testing.T) {
+	mockedCfgSrv := sharedApp.MockedConfigurationService{}
+	mockedCfgSrv.On("GetJwtSigningAlgorithm").Return("EdDSA")
+	mockedCfgSrv.On("GetJwtKeysPath").Return("")
+	mockedCfgSrv.On("GetJwtKeys").Return("")
+	mockedCfgSrv.On("GetJwtAllowEphemeralKey").Return(true)
+	mockedCfgSrv.On("GetJwtKeyRotationInterval").Return(time.Duration(0))
+
+	set, err := LoadSigningKeySet(&mockedCfgSrv)
+	require.Nil(t, err)
+
+	assert.True(t, set.SigningKey(time.Now()).CanSign())
+	assert.Equal(t, "EdDSA", set.SigningKey(time.Now()).Method.Alg())
+}
+
+func Test_RealTokenService_Generates_An_Impersonation_Token(t *testing.T) {
+	srv, _ := newTestTokenService(t, 0, newEd25519SigningKey(t, "key1"))
+	adminName, _ := NewUserNameValueObject("admin")
+	userName, _ := NewUserNameValueObject("wadus")
+	expDate := time.Now().Add(15 * time.Minute)
+
+	token, err := srv.GenerateImpersonationToken(&UserEntity{ID: 1, Name: adminName, IsAdmin: true}, &UserEntity{ID: 11, Name: userName}, &ImpersonationEntity{ID: 5, ExpirationDate: expDate})
+	require.Nil(t, err)
+
+	parsed, err := srv.ParseToken(token)
+	require.Nil(t, err)
+	assert.Equal(t, float64(expDate.Unix()), parsed.Claims.(jwt.MapClaims)["exp"])
+
+	info := srv.GetTokenInfo(parsed)
+	assert.Equal(t, &TokenClaimsInfo{UserID: 11, UserName: "wadus", IsAdmin: false, ImpersonatorID: 1, ImpersonatorName: "admin", ImpersonationID: 5}, info)
+	assert.True(t, info.IsImpersonating())
+}
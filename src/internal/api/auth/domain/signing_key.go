@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// SigningKey is a key used to sign and verify jwt tokens. Keys only kept to verify the
+// tokens signed before a rotation don't have a private key
+type SigningKey struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.PrivateKey
+	PublicKey  crypto.PublicKey
+}
+
+type JsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JsonWebKeySet struct {
+	Keys []JsonWebKey `json:"keys"`
+}
+
+// NewSigningKeyFromPEM creates a key from a PEM block with a PKCS#8 or PKCS#1 private key or
+// with a PKIX or PKCS#1 public key. RSA keys are used with RS256 and ed25519 ones with EdDSA
+func NewSigningKeyFromPEM(kid string, block *pem.Block) (*SigningKey, error) {
+	var privateKey crypto.PrivateKey
+	var publicKey crypto.PublicKey
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = key
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		publicKey = key
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		publicKey = key
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		publicKey = &key.PublicKey
+	case ed25519.PrivateKey:
+		publicKey = key.Public()
+	case nil:
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+
+	var method jwt.SigningMethod
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+
+	signingKey := &SigningKey{ID: kid, Method: method, PrivateKey: privateKey, PublicKey: publicKey}
+
+	if headerKid, ok := block.Headers["kid"]; ok {
+		signingKey.ID = headerKid
+	}
+
+	if len(signingKey.ID) == 0 {
+		signingKey.ID = signingKey.Thumbprint()
+	}
+
+	return signingKey, nil
+}
+
+func (k *SigningKey) CanSign() bool {
+	return k.PrivateKey != nil
+}
+
+func (k *SigningKey) ToJsonWebKey() JsonWebKey {
+	jwk := JsonWebKey{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+
+	switch key := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(key)
+	}
+
+	return jwk
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of the public key
+func (k *SigningKey) Thumbprint() string {
+	jwk := k.ToJsonWebKey()
+
+	var members string
+	if jwk.Kty == "RSA" {
+		members = fmt.Sprintf(`{"e":%q,"kty":%q,"n":%q}`, jwk.E, jwk.Kty, jwk.N)
+	} else {
+		members = fmt.Sprintf(`{"crv":%q,"kty":%q,"x":%q}`, jwk.Crv, jwk.Kty, jwk.X)
+	}
+
+	sum := sha256.Sum256([]byte(members))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
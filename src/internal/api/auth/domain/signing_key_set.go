@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/golang-jwt/jwt"
+)
+
+var (
+	ephemeralKeysMutex sync.Mutex
+	ephemeralKeys      = map[string]*SigningKey{}
+)
+
+// SigningKeySet contains all the keys that can verify a token. The tokens are signed with the
+// private keys of the configured algorithm, rotating between them every rotation interval
+type SigningKeySet struct {
+	keys             map[string]*SigningKey
+	signingKeys      []*SigningKey
+	rotationInterval time.Duration
+}
+
+func NewSigningKeySet(keys []*SigningKey, algorithm string, rotationInterval time.Duration) (*SigningKeySet, error) {
+	set := &SigningKeySet{keys: map[string]*SigningKey{}, rotationInterval: rotationInterval}
+
+	for _, key := range keys {
+		if _, found := set.keys[key.ID]; found {
+			return nil, fmt.Errorf("duplicated jwt key id %q", key.ID)
+		}
+
+		set.keys[key.ID] = key
+
+		if key.CanSign() && key.Method.Alg() == algorithm {
+			set.signingKeys = append(set.signingKeys, key)
+		}
+	}
+
+	if len(set.signingKeys) == 0 {
+		return nil, fmt.Errorf("there isn't any private key for the %v algorithm", algorithm)
+	}
+
+	sort.Slice(set.signingKeys, func(i, j int) bool { return set.signingKeys[i].ID < set.signingKeys[j].ID })
+
+	return set, nil
+}
+
+// LoadSigningKeySet loads the keys from the configured folder and from the configured PEM blocks.
+// It fails when there isn't any key configured unless the ephemeral key is allowed. That key is
+// generated in memory, so the tokens won't be valid after a restart and other instances won't be
+// able to verify them
+func LoadSigningKeySet(cfgSvc sharedApp.ConfigurationService) (*SigningKeySet, error) {
+	algorithm := cfgSvc.GetJwtSigningAlgorithm()
+	if algorithm != "RS256" && algorithm != "EdDSA" {
+		return nil, fmt.Errorf("unsupported jwt signing algorithm %q", algorithm)
+	}
+
+	keys, err := loadSigningKeysFromPath(cfgSvc.GetJwtKeysPath())
+	if err != nil {
+		return nil, err
+	}
+
+	envKeys, err := parseSigningKeys(cfgSvc.GetJwtKeys(), "")
+	if err != nil {
+		return nil, err
+	}
+	keys = append(keys, envKeys...)
+
+	if len(keys) == 0 {
+		if !cfgSvc.GetJwtAllowEphemeralKey() {
+			return nil, fmt.Errorf("there isn't any jwt key configured, set JWT_KEYS or JWT_KEYS_PATH")
+		}
+
+		key, err := getEphemeralSigningKey(algorithm)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return NewSigningKeySet(keys, algorithm, cfgSvc.GetJwtKeyRotationInterval())
+}
+
+// SigningKey returns the key that must sign the tokens at the given time
+func (s *SigningKeySet) SigningKey(now time.Time) *SigningKey {
+	if s.rotationInterval <= 0 {
+		return s.signingKeys[len(s.signingKeys)-1]
+	}
+
+	period := now.UnixNano() / int64(s.rotationInterval)
+
+	return s.signingKeys[period%int64(len(s.signingKeys))]
+}
+
+func (s *SigningKeySet) VerificationKey(kid string) (*SigningKey, bool) {
+	key, found := s.keys[kid]
+
+	return key, found
+}
+
+func (s *SigningKeySet) JsonWebKeySet() JsonWebKeySet {
+	res := JsonWebKeySet{Keys: []JsonWebKey{}}
+
+	for _, key := range s.keys {
+		res.Keys = append(res.Keys, key.ToJsonWebKey())
+	}
+
+	sort.Slice(res.Keys, func(i, j int) bool { return res.Keys[i].Kid < res.Keys[j].Kid })
+
+	return res
+}
+
+func loadSigningKeysFromPath(path string) ([]*SigningKey, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*SigningKey{}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		kid := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(file), ".pem"), ".pub")
+
+		keys, err := parseSigningKeys(string(content), kid)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the jwt key %v: %v", file, err)
+		}
+
+		res = append(res, keys...)
+	}
+
+	return res, nil
+}
+
+func parseSigningKeys(content string, kid string) ([]*SigningKey, error) {
+	res := []*SigningKey{}
+
+	rest := []byte(content)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		key, err := NewSigningKeyFromPEM(kid, block)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, key)
+	}
+
+	if len(strings.TrimSpace(string(rest))) > 0 {
+		return nil, fmt.Errorf("invalid PEM content")
+	}
+
+	return res, nil
+}
+
+// getEphemeralSigningKey returns the same generated key to all the token services of the process
+func getEphemeralSigningKey(algorithm string) (*SigningKey, error) {
+	ephemeralKeysMutex.Lock()
+	defer ephemeralKeysMutex.Unlock()
+
+	if key, found := ephemeralKeys[algorithm]; found {
+		return key, nil
+	}
+
+	log.Printf("There isn't any jwt key configured, using a temporary %v key", algorithm)
+
+	key := &SigningKey{}
+	if algorithm == "EdDSA" {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodEdDSA, priv, pub
+	} else {
+		priv, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		key.Method, key.PrivateKey, key.PublicKey = jwt.SigningMethodRS256, priv, &priv.PublicKey
+	}
+	key.ID = key.Thumbprint()
+
+	ephemeralKeys[algorithm] = key
+
+	return key, nil
+}
@@ -1,8 +1,17 @@
 package domain
 
-// TokenClaimsInfo is the struct which contains the jwt token claims
+// TokenClaimsInfo is the struct which contains the jwt token claims. When the token is an
+// impersonation one the user fields are the ones of the impersonated user, who is the acting
+// one, and the impersonator fields are the ones of the admin
 type TokenClaimsInfo struct {
-	UserID   int32
-	UserName string
-	IsAdmin  bool
+	UserID           int32
+	UserName         string
+	IsAdmin          bool
+	ImpersonatorID   int32
+	ImpersonatorName string
+	ImpersonationID  int32
+}
+
+func (i *TokenClaimsInfo) IsImpersonating() bool {
+	return i.ImpersonationID > 0
 }
@@ -9,7 +9,9 @@ import (
 type TokenService interface {
 	GenerateToken(user *UserEntity) (string, error)
 	GenerateRefreshToken(user *UserEntity, expirationDate time.Time) (string, error)
+	GenerateImpersonationToken(admin *UserEntity, user *UserEntity, impersonation *ImpersonationEntity) (string, error)
 	ParseToken(tokenString string) (*jwt.Token, error)
 	GetTokenInfo(token *jwt.Token) *TokenClaimsInfo
 	GetRefreshTokenInfo(refreshToken *jwt.Token) *RefreshTokenClaimsInfo
+	GetJsonWebKeySet() JsonWebKeySet
 }
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// UserDeletionJobEntity is the state of the deletion of a user. The progress goes from 0
+// to 100 and the step is the part of the deletion being done
+type UserDeletionJobEntity struct {
+	ID               int32     `json:"id"`
+	UserID           int32     `json:"userId"`
+	Strategy         string    `json:"strategy"`
+	TransferToUserID *int32    `json:"transferToUserId,omitempty"`
+	Status           string    `json:"status"`
+	Step             string    `json:"step"`
+	Progress         int32     `json:"progress"`
+	Error            string    `json:"error,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
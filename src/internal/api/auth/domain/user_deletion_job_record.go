@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+const (
+	UserDeletionJobPending   = "pending"
+	UserDeletionJobRunning   = "running"
+	UserDeletionJobCompleted = "completed"
+	UserDeletionJobFailed    = "failed"
+)
+
+// UserDeletionJobRecord tracks the deletion of a user done in background. It isn't related
+// with the user so it can still be queried once the user has been deleted
+type UserDeletionJobRecord struct {
+	ID               int32     `gorm:"type:int(32);primary_key"`
+	UserID           int32     `gorm:"column:userId;type:int(32)"`
+	Strategy         string    `gorm:"column:strategy;type:varchar(10)"`
+	TransferToUserID *int32    `gorm:"column:transferToUserId;type:int(32)"`
+	Status           string    `gorm:"column:status;type:varchar(10)"`
+	Step             string    `gorm:"column:step;type:varchar(50)"`
+	Progress         int32     `gorm:"column:progress;type:int(32)"`
+	Error            string    `gorm:"column:error;type:varchar(500)"`
+	CreatedAt        time.Time `gorm:"column:createdAt;type:timestamp"`
+	UpdatedAt        time.Time `gorm:"column:updatedAt;type:timestamp"`
+}
+
+func (UserDeletionJobRecord) TableName() string {
+	return "userDeletionJobs"
+}
+
+func (r *UserDeletionJobRecord) ToUserDeletionJobEntity() *UserDeletionJobEntity {
+	return &UserDeletionJobEntity{
+		ID:               r.ID,
+		UserID:           r.UserID,
+		Strategy:         r.Strategy,
+		TransferToUserID: r.TransferToUserID,
+		Status:           r.Status,
+		Step:             r.Step,
+		Progress:         r.Progress,
+		Error:            r.Error,
+		CreatedAt:        r.CreatedAt,
+		UpdatedAt:        r.UpdatedAt,
+	}
+}
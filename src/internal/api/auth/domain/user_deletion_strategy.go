@@ -0,0 +1,20 @@
+package domain
+
+import "errors"
+
+const (
+	// UserDeletionStrategyTransfer gives the lists, the categories and the workspaces of
+	// the deleted user to another user
+	UserDeletionStrategyTransfer = "transfer"
+	// UserDeletionStrategyCascade deletes the lists, the categories and the workspaces of
+	// the deleted user
+	UserDeletionStrategyCascade = "cascade"
+)
+
+func NewUserDeletionStrategy(strategy string) (string, error) {
+	if strategy != UserDeletionStrategyTransfer && strategy != UserDeletionStrategyCascade {
+		return "", errors.New(`The strategy must be "transfer" or "cascade"`)
+	}
+
+	return strategy, nil
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserDeletionStrategy(t *testing.T) {
+	for _, strategy := range []string{UserDeletionStrategyTransfer, UserDeletionStrategyCascade} {
+		res, err := NewUserDeletionStrategy(strategy)
+
+		assert.Nil(t, err)
+		assert.Equal(t, strategy, res)
+	}
+
+	_, err := NewUserDeletionStrategy("wadus")
+
+	assert.EqualError(t, err, `The strategy must be "transfer" or "cascade"`)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type UserDisplayNameValueObject struct {
+	displayName string
+}
+
+const userDisplayNameMaxLength = 50
+
+func NewUserDisplayNameValueObject(displayName string) (UserDisplayNameValueObject, error) {
+	if len(displayName) > userDisplayNameMaxLength {
+		return UserDisplayNameValueObject{}, &appErrors.BadRequestError{Msg: fmt.Sprintf("The display name can not have more than %v characters", userDisplayNameMaxLength)}
+	}
+
+	return UserDisplayNameValueObject{displayName: displayName}, nil
+}
+
+func (v UserDisplayNameValueObject) String() string {
+	return v.displayName
+}
+
+func (v UserDisplayNameValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.displayName), nil
+}
+
+func (v *UserDisplayNameValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewUserDisplayNameValueObject(string(d))
+
+	return err
+}
+
+func (v UserDisplayNameValueObject) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+func (v *UserDisplayNameValueObject) Scan(value interface{}) error {
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewUserDisplayNameValueObject(fmt.Sprintf("%s", sv))
+		return nil
+	}
+
+	return errors.New("failed to scan UserDisplayNameValueObject")
+}
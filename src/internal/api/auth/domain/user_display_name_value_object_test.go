@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewUserDisplayNameValueObject_Validates_MaxLength(t *testing.T) {
+	displayName, err := NewUserDisplayNameValueObject(strings.Repeat("a", 51))
+
+	assert.Empty(t, displayName)
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The display name can not have more than 50 characters", badReqErr.Error())
+}
+
+func Test_NewUserDisplayNameValueObject_Allows_An_Empty_DisplayName(t *testing.T) {
+	displayName, err := NewUserDisplayNameValueObject("")
+
+	assert.Equal(t, "", displayName.String())
+	assert.NoError(t, err)
+}
+
+func Test_NewUserDisplayNameValueObject_Returns_A_Valid_DisplayName(t *testing.T) {
+	displayName, err := NewUserDisplayNameValueObject("One Display Name")
+
+	assert.Equal(t, "One Display Name", displayName.String())
+	assert.NoError(t, err)
+}
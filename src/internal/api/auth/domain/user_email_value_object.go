@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/mail"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type UserEmailValueObject struct {
+	email string
+}
+
+const userEmailMaxLength = 100
+
+func NewUserEmailValueObject(email string) (UserEmailValueObject, error) {
+	if len(email) == 0 {
+		return UserEmailValueObject{}, nil
+	}
+
+	if len(email) > userEmailMaxLength {
+		return UserEmailValueObject{}, &appErrors.BadRequestError{Msg: fmt.Sprintf("The email can not have more than %v characters", userEmailMaxLength)}
+	}
+
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return UserEmailValueObject{}, &appErrors.BadRequestError{Msg: "The email is not valid", InternalError: err}
+	}
+
+	return UserEmailValueObject{email: email}, nil
+}
+
+func (v UserEmailValueObject) String() string {
+	return v.email
+}
+
+func (v UserEmailValueObject) IsEmpty() bool {
+	return len(v.email) == 0
+}
+
+func (v UserEmailValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.email), nil
+}
+
+func (v *UserEmailValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewUserEmailValueObject(string(d))
+
+	return err
+}
+
+func (v UserEmailValueObject) Value() (driver.Value, error) {
+	if v.IsEmpty() {
+		return nil, nil
+	}
+
+	return v.String(), nil
+}
+
+func (v *UserEmailValueObject) Scan(value interface{}) error {
+	if value == nil {
+		*v = UserEmailValueObject{}
+		return nil
+	}
+
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewUserEmailValueObject(fmt.Sprintf("%s", sv))
+		return nil
+	}
+
+	return errors.New("failed to scan UserEmailValueObject")
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewUserEmailValueObject_Validates_MaxLength(t *testing.T) {
+	email, err := NewUserEmailValueObject(strings.Repeat("a", 95) + "@a.com")
+
+	assert.Empty(t, email)
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The email can not have more than 100 characters", badReqErr.Error())
+}
+
+func Test_NewUserEmailValueObject_Validates_The_Format(t *testing.T) {
+	for _, value := range []string{"wadus", "wadus@", "Wadus <wadus@wadus.com>"} {
+		email, err := NewUserEmailValueObject(value)
+
+		assert.Empty(t, email)
+		badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+		require.Equal(t, true, isBadReqErr, "should be a bad request error")
+		assert.Equal(t, "The email is not valid", badReqErr.Error())
+	}
+}
+
+func Test_NewUserEmailValueObject_Allows_An_Empty_Email(t *testing.T) {
+	email, err := NewUserEmailValueObject("")
+
+	assert.True(t, email.IsEmpty())
+	assert.NoError(t, err)
+}
+
+func Test_NewUserEmailValueObject_Returns_A_Valid_Email(t *testing.T) {
+	email, err := NewUserEmailValueObject("wadus@wadus.com")
+
+	assert.Equal(t, "wadus@wadus.com", email.String())
+	assert.NoError(t, err)
+}
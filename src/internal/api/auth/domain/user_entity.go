@@ -3,27 +3,39 @@ package domain
 import (
 	"strings"
 
-	"golang.org/x/crypto/bcrypt"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 )
 
 type UserEntity struct {
-	ID           int32
-	Name         UserNameValueObject
-	PasswordHash string
-	IsAdmin      bool
+	ID            int32
+	Name          UserNameValueObject
+	PasswordHash  string
+	IsAdmin       bool
+	DisplayName   UserDisplayNameValueObject
+	Email         UserEmailValueObject
+	EmailVerified bool
 }
 
 func (e *UserEntity) ToUserRecord() *UserRecord {
+	var email *string
+	if !e.Email.IsEmpty() {
+		value := e.Email.String()
+		email = &value
+	}
+
 	return &UserRecord{
-		ID:           e.ID,
-		Name:         e.Name.String(),
-		PasswordHash: e.PasswordHash,
-		IsAdmin:      e.IsAdmin,
+		ID:            e.ID,
+		Name:          e.Name.String(),
+		PasswordHash:  e.PasswordHash,
+		IsAdmin:       e.IsAdmin,
+		DisplayName:   e.DisplayName.String(),
+		Email:         email,
+		EmailVerified: e.EmailVerified,
 	}
 }
 
 func (e *UserEntity) HasPassword(value string) error {
-	return bcrypt.CompareHashAndPassword([]byte(e.PasswordHash), []byte(value))
+	return passgen.CompareHashAndPassword(e.PasswordHash, value)
 }
 
 func (e *UserEntity) IsTheAdminUser() bool {
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+// UserExportArchive is everything stored about a user. It is saved as a json document
+type UserExportArchive struct {
+	ExportedAt   time.Time                     `json:"exportedAt"`
+	Profile      *UserRecord                   `json:"profile"`
+	Lists        []*listsDomain.ListEntity     `json:"lists"`
+	Categories   []*listsDomain.CategoryEntity `json:"categories"`
+	Sessions     []UserExportArchiveSession    `json:"sessions"`
+	AuditEntries []UserExportArchiveAuditEntry `json:"auditEntries"`
+}
+
+// UserExportArchiveSession is a refresh token of the user without the token itself
+type UserExportArchiveSession struct {
+	ID             int32     `json:"id"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+type UserExportArchiveAuditEntry struct {
+	ID         int32           `json:"id"`
+	ActorID    *int32          `json:"actorId"`
+	Action     string          `json:"action"`
+	TargetType string          `json:"targetType"`
+	TargetID   *int32          `json:"targetId"`
+	IP         string          `json:"ip"`
+	UserAgent  string          `json:"userAgent"`
+	Diff       json.RawMessage `json:"diff"`
+	CreatedAt  time.Time       `json:"createdAt"`
+}
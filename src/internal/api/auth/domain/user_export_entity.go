@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+type UserExportEntity struct {
+	ID             int32     `json:"id"`
+	UserID         int32     `json:"userId"`
+	RequestedBy    int32     `json:"requestedBy"`
+	Status         string    `json:"status"`
+	Error          string    `json:"error,omitempty"`
+	ExpirationDate time.Time `json:"expirationDate"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
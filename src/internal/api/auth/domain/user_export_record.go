@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+const (
+	UserExportPending   = "pending"
+	UserExportRunning   = "running"
+	UserExportCompleted = "completed"
+	UserExportFailed    = "failed"
+)
+
+// UserExportRecord tracks the archive with the personal data of a user built in background.
+// Only the hash of the download token is stored, like it is done with the user tokens
+type UserExportRecord struct {
+	ID             int32     `gorm:"type:int(32);primary_key"`
+	UserID         int32     `gorm:"column:userId;type:int(32)"`
+	RequestedBy    int32     `gorm:"column:requestedBy;type:int(32)"`
+	Status         string    `gorm:"column:status;type:varchar(10)"`
+	TokenHash      string    `gorm:"column:tokenHash;type:varchar(64);index:idx_user_exports_token_hash,unique"`
+	FileName       string    `gorm:"column:fileName;type:varchar(100)"`
+	Error          string    `gorm:"column:error;type:varchar(500)"`
+	ExpirationDate time.Time `gorm:"column:expirationDate;type:timestamp"`
+	CreatedAt      time.Time `gorm:"column:createdAt;type:timestamp"`
+	UpdatedAt      time.Time `gorm:"column:updatedAt;type:timestamp"`
+}
+
+func (UserExportRecord) TableName() string {
+	return "userExports"
+}
+
+// NewUserExportRecord generates the token of the download link and returns it together with
+// the pending export that must be stored
+func NewUserExportRecord(userID int32, requestedBy int32, expirationDate time.Time) (*UserExportRecord, string, error) {
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	record := &UserExportRecord{
+		UserID:         userID,
+		RequestedBy:    requestedBy,
+		Status:         UserExportPending,
+		TokenHash:      HashUserToken(token),
+		ExpirationDate: expirationDate,
+	}
+
+	return record, token, nil
+}
+
+func (r *UserExportRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpirationDate)
+}
+
+func (r *UserExportRecord) ToUserExportEntity() *UserExportEntity {
+	return &UserExportEntity{
+		ID:             r.ID,
+		UserID:         r.UserID,
+		RequestedBy:    r.RequestedBy,
+		Status:         r.Status,
+		Error:          r.Error,
+		ExpirationDate: r.ExpirationDate,
+		CreatedAt:      r.CreatedAt,
+		UpdatedAt:      r.UpdatedAt,
+	}
+}
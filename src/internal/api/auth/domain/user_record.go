@@ -1,10 +1,15 @@
 package domain
 
 type UserRecord struct {
-	ID           int32  `gorm:"type:int(32);primary_key" json:"id"`
-	Name         string `gorm:"type:varchar(10);index:idx_users_name,unique" json:"name"`
-	PasswordHash string `gorm:"column:passwordHash;type:varchar(100)" json:"-"`
-	IsAdmin      bool   `gorm:"column:isAdmin;type:tinyint" json:"isAdmin"`
+	ID            int32   `gorm:"type:int(32);primary_key" json:"id"`
+	Name          string  `gorm:"type:varchar(10);index:idx_users_name,unique" json:"name"`
+	PasswordHash  string  `gorm:"column:passwordHash;type:varchar(100)" json:"-"`
+	IsAdmin       bool    `gorm:"column:isAdmin;type:tinyint" json:"isAdmin"`
+	DisplayName   string  `gorm:"column:displayName;type:varchar(50)" json:"displayName"`
+	Email         *string `gorm:"column:email;type:varchar(100);index:idx_users_email,unique" json:"email"`
+	EmailVerified bool    `gorm:"column:emailVerified;type:tinyint" json:"emailVerified"`
+	OidcProvider  *string `gorm:"column:oidcProvider;type:varchar(50);index:idx_users_oidc_identity,unique" json:"-"`
+	OidcSubject   *string `gorm:"column:oidcSubject;type:varchar(255);index:idx_users_oidc_identity,unique" json:"-"`
 }
 
 func (UserRecord) TableName() string {
@@ -13,11 +18,20 @@ func (UserRecord) TableName() string {
 
 func (r *UserRecord) ToUserEntity() *UserEntity {
 	nvo, _ := NewUserNameValueObject(r.Name)
+	dnvo, _ := NewUserDisplayNameValueObject(r.DisplayName)
+
+	evo := UserEmailValueObject{}
+	if r.Email != nil {
+		evo, _ = NewUserEmailValueObject(*r.Email)
+	}
 
 	return &UserEntity{
-		ID:           r.ID,
-		Name:         nvo,
-		PasswordHash: r.PasswordHash,
-		IsAdmin:      r.IsAdmin,
+		ID:            r.ID,
+		Name:          nvo,
+		PasswordHash:  r.PasswordHash,
+		IsAdmin:       r.IsAdmin,
+		DisplayName:   dnvo,
+		Email:         evo,
+		EmailVerified: r.EmailVerified,
 	}
 }
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	PasswordResetTokenPurpose     = "passwordReset"
+	EmailVerificationTokenPurpose = "emailVerification"
+)
+
+// UserTokenEntity is a single use token sent to the user by email. Only the hash of
+// the token is stored, the raw value is only known by the receiver of the email
+type UserTokenEntity struct {
+	ID             int32
+	UserID         int32
+	Purpose        string
+	TokenHash      string
+	ExpirationDate time.Time
+}
+
+// NewUserTokenEntity generates a random token and returns it together with the entity
+// that must be stored to validate it later
+func NewUserTokenEntity(userID int32, purpose string, expirationDate time.Time) (*UserTokenEntity, string, error) {
+	token, err := newRandomToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	entity := &UserTokenEntity{
+		UserID:         userID,
+		Purpose:        purpose,
+		TokenHash:      HashUserToken(token),
+		ExpirationDate: expirationDate,
+	}
+
+	return entity, token, nil
+}
+
+func newRandomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func HashUserToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (e *UserTokenEntity) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpirationDate)
+}
+
+func (e *UserTokenEntity) ToUserTokenRecord() *UserTokenRecord {
+	return &UserTokenRecord{
+		ID:             e.ID,
+		UserID:         e.UserID,
+		Purpose:        e.Purpose,
+		TokenHash:      e.TokenHash,
+		ExpirationDate: e.ExpirationDate,
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+type UserTokenRecord struct {
+	ID             int32     `gorm:"type:int(32);primary_key"`
+	UserID         int32     `gorm:"column:userId;type:int(32)"`
+	Purpose        string    `gorm:"column:purpose;type:varchar(20)"`
+	TokenHash      string    `gorm:"column:tokenHash;type:varchar(64);index:idx_user_tokens_token_hash,unique"`
+	ExpirationDate time.Time `gorm:"column:expirationDate;type:timestamp;index:idx_user_tokens_expiration_date"`
+}
+
+func (UserTokenRecord) TableName() string {
+	return "user_tokens"
+}
+
+func (r *UserTokenRecord) ToUserTokenEntity() *UserTokenEntity {
+	return &UserTokenEntity{
+		ID:             r.ID,
+		UserID:         r.UserID,
+		Purpose:        r.Purpose,
+		TokenHash:      r.TokenHash,
+		ExpirationDate: r.ExpirationDate,
+	}
+}
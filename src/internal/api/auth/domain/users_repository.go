@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type UsersRepository interface {
 	FindUser(ctx context.Context, query UserRecord) (*UserRecord, error)
@@ -9,4 +12,12 @@ type UsersRepository interface {
 	Create(ctx context.Context, record *UserRecord) error
 	Delete(ctx context.Context, query UserRecord) error
 	Update(ctx context.Context, record *UserRecord) error
+	CreateUserDeletionJob(ctx context.Context, record *UserDeletionJobRecord) error
+	FindUserDeletionJob(ctx context.Context, query UserDeletionJobRecord) (*UserDeletionJobRecord, error)
+	UpdateUserDeletionJob(ctx context.Context, record *UserDeletionJobRecord) error
+	GetUnfinishedUserDeletionJobs(ctx context.Context) ([]*UserDeletionJobRecord, error)
+	CreateUserExport(ctx context.Context, record *UserExportRecord) error
+	FindUserExport(ctx context.Context, query UserExportRecord) (*UserExportRecord, error)
+	UpdateUserExport(ctx context.Context, record *UserExportRecord) error
+	GetExpiredUserExports(ctx context.Context, now time.Time) ([]*UserExportRecord, error)
 }
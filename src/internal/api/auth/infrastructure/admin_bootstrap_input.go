@@ -0,0 +1,10 @@
+package infrastructure
+
+// AdminBootstrapInput isn't validated when it's decoded so the admin bootstrap service can
+// validate it and write the failed attempts to the audit log
+type AdminBootstrapInput struct {
+	SetupToken      string `json:"setupToken"`
+	Name            string `json:"name"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
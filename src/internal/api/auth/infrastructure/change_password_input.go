@@ -0,0 +1,38 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+type ChangePasswordInput struct {
+	CurrentPassword    string                         `json:"currentPassword"`
+	NewPassword        domain.UserPasswordValueObject `json:"newPassword"`
+	ConfirmNewPassword string                         `json:"confirmNewPassword"`
+}
+
+func (i *ChangePasswordInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		CurrentPassword    string `json:"currentPassword"`
+		NewPassword        string `json:"newPassword"`
+		ConfirmNewPassword string `json:"confirmNewPassword"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	pvo, err := domain.NewUserPasswordValueObject(realInput.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	*i = ChangePasswordInput{
+		CurrentPassword:    realInput.CurrentPassword,
+		NewPassword:        pvo,
+		ConfirmNewPassword: realInput.ConfirmNewPassword,
+	}
+
+	return nil
+}
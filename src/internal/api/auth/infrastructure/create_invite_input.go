@@ -0,0 +1,9 @@
+package infrastructure
+
+import "time"
+
+type CreateInviteInput struct {
+	IsAdmin        bool       `json:"isAdmin"`
+	MaxUses        int32      `json:"maxUses"`
+	ExpirationDate *time.Time `json:"expirationDate"`
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func AdminBootstrapHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	input, _ := h.RequestInput.(*infrastructure.AdminBootstrapInput)
+
+	srv := application.NewAdminBootstrapService(h.AuthRepository, h.UsersRepository, h.PassGen, h.CfgSrv, h.AuditLogRepository)
+	newUser, err := srv.BootstrapAdmin(r.Context(), input.SetupToken, input.Name, input.Password, input.ConfirmPassword)
+
+	requestID := helpers.GetRequestIDFromContext(r)
+	if err != nil {
+		log.Printf("[%v] Admin bootstrap attempt from %v for the user %q failed: %v", requestID, r.RemoteAddr, input.Name, err)
+
+		return results.ErrorResult{Err: err}
+	}
+
+	log.Printf("[%v] Admin bootstrap attempt from %v created the admin user %q with id %v", requestID, r.RemoteAddr, newUser.Name.String(), newUser.ID)
+
+	res := infrastructure.UserResponse{
+		ID:      newUser.ID,
+		Name:    newUser.Name.String(),
+		IsAdmin: newUser.IsAdmin,
+	}
+
+	return results.OkResult{Content: &res, StatusCode: http.StatusCreated}
+}
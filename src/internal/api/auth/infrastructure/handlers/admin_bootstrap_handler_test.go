@@ -0,0 +1,245 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type adminBootstrapMocks struct {
+	authRepo     *repository.MockedAuthRepository
+	usersRepo    *repository.MockedUsersRepository
+	passGen      *passgen.MockedPasswordGenerator
+	cfgSrv       *application.MockedConfigurationService
+	auditLogRepo *sharedRepository.MockedAuditLogRepository
+}
+
+func newAdminBootstrapHandler(setupToken string) (handler.Handler, adminBootstrapMocks) {
+	mocks := adminBootstrapMocks{
+		authRepo:     &repository.MockedAuthRepository{},
+		usersRepo:    &repository.MockedUsersRepository{},
+		passGen:      &passgen.MockedPasswordGenerator{},
+		cfgSrv:       &application.MockedConfigurationService{},
+		auditLogRepo: &sharedRepository.MockedAuditLogRepository{},
+	}
+	mockLenientPasswordPolicy(mocks.cfgSrv)
+	h := handler.Handler{
+		AuthRepository:     mocks.authRepo,
+		UsersRepository:    mocks.usersRepo,
+		PassGen:            mocks.passGen,
+		CfgSrv:             mocks.cfgSrv,
+		AuditLogRepository: mocks.auditLogRepo,
+		RequestInput:       &infrastructure.AdminBootstrapInput{SetupToken: setupToken, Name: "root", Password: "pass", ConfirmPassword: "pass"},
+	}
+
+	return h, mocks
+}
+
+func (m adminBootstrapMocks) expectAttempt(ctx context.Context, err error) {
+	m.auditLogRepo.On("Create", ctx, mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionAdminBootstrapTried && e.TargetType == audit.TargetAdminBootstrap && e.TargetID == nil &&
+			e.Diff == `{"userName":{"old":null,"new":"root"}}`
+	})).Return(err).Once()
+}
+
+func (m adminBootstrapMocks) expectFailedAttempt(ctx context.Context, errMsg string) {
+	m.auditLogRepo.On("Create", ctx, mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionAdminBootstrapFailed && e.TargetType == audit.TargetAdminBootstrap && e.TargetID == nil &&
+			e.Diff == fmt.Sprintf(`{"error":{"old":null,"new":%q},"userName":{"old":null,"new":"root"}}`, errMsg)
+	})).Return(nil).Once()
+}
+
+func TestAdminBootstrapHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Attempt_Can_Not_Be_Audited(t *testing.T) {
+	h, mocks := newAdminBootstrapHandler("theToken")
+	request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+	mocks.expectAttempt(request.Context(), fmt.Errorf("some error"))
+
+	result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error writing the admin bootstrap attempt to the audit log")
+	mocks.authRepo.AssertExpectations(t)
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.auditLogRepo.AssertExpectations(t)
+}
+
+func TestAdminBootstrapHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Bootstrap_Is_Not_Available(t *testing.T) {
+	completedAt := time.Now()
+
+	tests := map[string]struct {
+		bootstrap  *domain.AdminBootstrapEntity
+		err        error
+		existsUser bool
+	}{
+		"not opened":   {nil, gorm.ErrRecordNotFound, false},
+		"already done": {&domain.AdminBootstrapEntity{CompletedAt: &completedAt}, nil, false},
+		"users exist":  {&domain.AdminBootstrapEntity{}, nil, true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, mocks := newAdminBootstrapHandler("theToken")
+			request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+			mocks.expectAttempt(request.Context(), nil)
+			mocks.authRepo.On("GetAdminBootstrap", request.Context()).Return(tt.bootstrap, tt.err).Once()
+			mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{}).Return(tt.existsUser, nil).Maybe()
+			mocks.expectFailedAttempt(request.Context(), "The admin bootstrap is not available")
+
+			result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckBadRequestErrorResult(t, result, "The admin bootstrap is not available")
+			mocks.authRepo.AssertExpectations(t)
+			mocks.auditLogRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminBootstrapHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Input_Is_Not_Valid(t *testing.T) {
+	tests := map[string]struct {
+		input  infrastructure.AdminBootstrapInput
+		errMsg string
+	}{
+		"without name":     {infrastructure.AdminBootstrapInput{SetupToken: "theToken", Password: "pass", ConfirmPassword: "pass"}, "The user name can not be empty"},
+		"without password": {infrastructure.AdminBootstrapInput{SetupToken: "theToken", Name: "root"}, "Password can not be empty"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, mocks := newAdminBootstrapHandler("theToken")
+			h.RequestInput = &tt.input
+			request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+			mocks.auditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+				return e.Action == audit.ActionAdminBootstrapTried
+			})).Return(nil).Once()
+			mocks.auditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+				return e.Action == audit.ActionAdminBootstrapFailed && strings.Contains(e.Diff, tt.errMsg)
+			})).Return(nil).Once()
+
+			result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckBadRequestErrorResult(t, result, tt.errMsg)
+			mocks.authRepo.AssertExpectations(t)
+			mocks.auditLogRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminBootstrapHandler_Returns_An_ErrorResult_With_An_UnauthorizedError_If_The_Setup_Token_Is_Not_Valid(t *testing.T) {
+	tests := map[string]struct {
+		bootstrap       *domain.AdminBootstrapEntity
+		configuredToken string
+	}{
+		"generated token":  {&domain.AdminBootstrapEntity{SetupTokenHash: domain.HashUserToken("anotherToken")}, ""},
+		"configured token": {&domain.AdminBootstrapEntity{}, "anotherToken"},
+		"without token":    {&domain.AdminBootstrapEntity{}, ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, mocks := newAdminBootstrapHandler("theToken")
+			request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+			mocks.expectAttempt(request.Context(), nil)
+			mocks.authRepo.On("GetAdminBootstrap", request.Context()).Return(tt.bootstrap, nil).Once()
+			mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{}).Return(false, nil).Once()
+			mocks.cfgSrv.On("GetAdminSetupToken").Return(tt.configuredToken).Once()
+			mocks.expectFailedAttempt(request.Context(), "Invalid setup token")
+
+			result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckUnauthorizedErrorErrorResult(t, result, "Invalid setup token")
+			mocks.authRepo.AssertExpectations(t)
+			mocks.usersRepo.AssertExpectations(t)
+			mocks.auditLogRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAdminBootstrapHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_Another_Attempt_Claimed_The_Bootstrap(t *testing.T) {
+	h, mocks := newAdminBootstrapHandler("theToken")
+	request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+	mocks.expectAttempt(request.Context(), nil)
+	mocks.authRepo.On("GetAdminBootstrap", request.Context()).Return(&domain.AdminBootstrapEntity{}, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{}).Return(false, nil).Once()
+	mocks.cfgSrv.On("GetAdminSetupToken").Return("theToken").Once()
+	mocks.authRepo.On("ClaimAdminBootstrap", request.Context(), mock.AnythingOfType("time.Time")).Return(false, nil).Once()
+	mocks.expectFailedAttempt(request.Context(), "The admin bootstrap is not available")
+
+	result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The admin bootstrap is not available")
+	mocks.authRepo.AssertExpectations(t)
+	mocks.auditLogRepo.AssertExpectations(t)
+}
+
+func TestAdminBootstrapHandler_Releases_The_Bootstrap_If_The_Admin_Can_Not_Be_Created(t *testing.T) {
+	h, mocks := newAdminBootstrapHandler("theToken")
+	request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+	mocks.expectAttempt(request.Context(), nil)
+	mocks.authRepo.On("GetAdminBootstrap", request.Context()).Return(&domain.AdminBootstrapEntity{SetupTokenHash: domain.HashUserToken("theToken")}, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{}).Return(false, nil).Once()
+	mocks.cfgSrv.On("GetAdminSetupToken").Return("").Once()
+	mocks.authRepo.On("ClaimAdminBootstrap", request.Context(), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "root"}).Return(false, fmt.Errorf("some error")).Once()
+	mocks.authRepo.On("ReleaseAdminBootstrap", request.Context()).Return(nil).Once()
+	mocks.expectFailedAttempt(request.Context(), "Error checking if a user with the same name already exists")
+
+	result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if a user with the same name already exists")
+	mocks.authRepo.AssertExpectations(t)
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.auditLogRepo.AssertExpectations(t)
+}
+
+func TestAdminBootstrapHandler_Creates_The_First_Admin(t *testing.T) {
+	h, mocks := newAdminBootstrapHandler("theToken")
+	request, _ := http.NewRequest(http.MethodPost, "/auth/create_admin", nil)
+	mocks.expectAttempt(request.Context(), nil)
+	mocks.authRepo.On("GetAdminBootstrap", request.Context()).Return(&domain.AdminBootstrapEntity{SetupTokenHash: domain.HashUserToken("theToken")}, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{}).Return(false, nil).Once()
+	mocks.cfgSrv.On("GetAdminSetupToken").Return("").Once()
+	mocks.authRepo.On("ClaimAdminBootstrap", request.Context(), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "root"}).Return(false, nil).Once()
+	mocks.passGen.On("GenerateFromPassword", "pass").Return("hashed", nil).Once()
+	mocks.usersRepo.On("Create", request.Context(), &domain.UserRecord{Name: "root", PasswordHash: "hashed", IsAdmin: true}).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.UserRecord).ID = 1
+	}).Return(nil).Once()
+	mocks.auditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserCreated && *e.TargetID == 1
+	})).Return(nil).Once()
+	mocks.auditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionAdminBootstrapDone && e.TargetType == audit.TargetAdminBootstrap &&
+			e.Diff == `{"userId":{"old":null,"new":1},"userName":{"old":null,"new":"root"}}`
+	})).Return(nil).Once()
+
+	result := AdminBootstrapHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+	assert.Equal(t, &infrastructure.UserResponse{ID: 1, Name: "root", IsAdmin: true}, res)
+	mocks.authRepo.AssertExpectations(t)
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.passGen.AssertExpectations(t)
+	mocks.auditLogRepo.AssertExpectations(t)
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+// ChangeMyPasswordHandler is the handler for the /me/password endpoint
+func ChangeMyPasswordHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.ChangePasswordInput)
+
+	if input.NewPassword.String() != input.ConfirmNewPassword {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Passwords don't match"}}
+	}
+
+	srv := application.NewChangePasswordService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.TokenSrv, h.PassGen)
+	t, rt, err := srv.ChangePassword(r.Context(), userID, input.CurrentPassword, input.NewPassword)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	addTokenCookie(w, t)
+	addRefreshTokenCookie(w, rt)
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
@@ -0,0 +1,144 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type changeMyPasswordMocks struct {
+	authRepo  *repository.MockedAuthRepository
+	usersRepo *repository.MockedUsersRepository
+	cfgSrv    *application.MockedConfigurationService
+	tokenSrv  *domain.MockedTokenService
+	passGen   *passgen.MockedPasswordGenerator
+}
+
+func newChangeMyPasswordHandler(currentPassword string, newPassword string, confirmNewPassword string) (handler.Handler, changeMyPasswordMocks) {
+	mocks := changeMyPasswordMocks{
+		authRepo:  &repository.MockedAuthRepository{},
+		usersRepo: &repository.MockedUsersRepository{},
+		cfgSrv:    &application.MockedConfigurationService{},
+		tokenSrv:  &domain.MockedTokenService{},
+		passGen:   &passgen.MockedPasswordGenerator{},
+	}
+	mockLenientPasswordPolicy(mocks.cfgSrv)
+	newPass, _ := domain.NewUserPasswordValueObject(newPassword)
+	h := handler.Handler{
+		AuthRepository:  mocks.authRepo,
+		UsersRepository: mocks.usersRepo,
+		CfgSrv:          mocks.cfgSrv,
+		TokenSrv:        mocks.tokenSrv,
+		PassGen:         mocks.passGen,
+		RequestInput:    &infrastructure.ChangePasswordInput{CurrentPassword: currentPassword, NewPassword: newPass, ConfirmNewPassword: confirmNewPassword},
+	}
+
+	return h, mocks
+}
+
+func TestChangeMyPasswordHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_New_Passwords_Do_Not_Match(t *testing.T) {
+	h, _ := newChangeMyPasswordHandler("current", "one", "another")
+
+	result := ChangeMyPasswordHandler(httptest.NewRecorder(), meRequest(), h)
+
+	results.CheckBadRequestErrorResult(t, result, "Passwords don't match")
+}
+
+func TestChangeMyPasswordHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *testing.T) {
+	request := meRequest()
+	h, mocks := newChangeMyPasswordHandler("current", "new", "new")
+
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ChangeMyPasswordHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mocks.usersRepo.AssertExpectations(t)
+}
+
+func TestChangeMyPasswordHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Current_Password_Is_Not_Valid(t *testing.T) {
+	request := meRequest()
+	h, mocks := newChangeMyPasswordHandler("wrong", "new", "new")
+
+	hashedBytes, _ := bcrypt.GenerateFromPassword([]byte("current"), 10)
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: string(hashedBytes)}
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := ChangeMyPasswordHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid current password")
+	mocks.usersRepo.AssertExpectations(t)
+}
+
+func TestChangeMyPasswordHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Deleting_The_RefreshTokens_Fails(t *testing.T) {
+	request := meRequest()
+	h, mocks := newChangeMyPasswordHandler("current", "new", "new")
+
+	hashedBytes, _ := bcrypt.GenerateFromPassword([]byte("current"), 10)
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: string(hashedBytes)}
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mocks.passGen.On("GenerateFromPassword", "new").Return("newHash", nil).Once()
+	mocks.usersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "newHash"}).Return(nil).Once()
+	mocks.authRepo.On("DeleteRefreshTokensByUserID", request.Context(), int32(1)).Return(fmt.Errorf("some error")).Once()
+
+	result := ChangeMyPasswordHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the user refresh tokens")
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.passGen.AssertExpectations(t)
+	mocks.authRepo.AssertExpectations(t)
+}
+
+func TestChangeMyPasswordHandler_Changes_The_Password_Revokes_The_Sessions_And_Creates_The_Cookies(t *testing.T) {
+	request := meRequest()
+	h, mocks := newChangeMyPasswordHandler("current", "new", "new")
+
+	hashedBytes, _ := bcrypt.GenerateFromPassword([]byte("current"), 10)
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: string(hashedBytes)}
+	userEntity := foundUser.ToUserEntity()
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mocks.passGen.On("GenerateFromPassword", "new").Return("newHash", nil).Once()
+	mocks.usersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "newHash"}).Return(nil).Once()
+	mocks.authRepo.On("DeleteRefreshTokensByUserID", request.Context(), int32(1)).Return(nil).Once()
+	mocks.tokenSrv.On("GenerateToken", userEntity).Return("theToken", nil).Once()
+	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
+	mocks.cfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
+	mocks.tokenSrv.On("GenerateRefreshToken", userEntity, expDate).Return("theRefreshToken", nil).Once()
+	mocks.authRepo.On("CreateRefreshTokenIfNotExist", request.Context(), &domain.RefreshTokenEntity{UserID: 1, RefreshToken: "theRefreshToken", ExpirationDate: expDate}).Return(nil).Once()
+
+	recorder := httptest.NewRecorder()
+
+	mocks.authRepo.Wg.Add(1)
+	result := ChangeMyPasswordHandler(recorder, request, h)
+	mocks.authRepo.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+
+	require.Equal(t, 2, len(recorder.Result().Cookies()))
+	assert.Equal(t, "token", recorder.Result().Cookies()[0].Name)
+	assert.Equal(t, "theToken", recorder.Result().Cookies()[0].Value)
+	assert.Equal(t, "refreshToken", recorder.Result().Cookies()[1].Name)
+	assert.Equal(t, "theRefreshToken", recorder.Result().Cookies()[1].Value)
+
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.passGen.AssertExpectations(t)
+	mocks.authRepo.AssertExpectations(t)
+	mocks.cfgSrv.AssertExpectations(t)
+	mocks.tokenSrv.AssertExpectations(t)
+}
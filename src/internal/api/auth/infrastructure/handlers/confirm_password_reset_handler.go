@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func ConfirmPasswordResetHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	input, _ := h.RequestInput.(*infrastructure.PasswordResetConfirmInput)
+
+	if input.Password.String() != input.ConfirmPassword {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Passwords don't match"}}
+	}
+
+	srv := application.NewConfirmPasswordResetService(h.AuthRepository, h.UsersRepository, h.PassGen, h.CfgSrv)
+	if err := srv.ConfirmPasswordReset(r.Context(), input.Token, input.Password); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
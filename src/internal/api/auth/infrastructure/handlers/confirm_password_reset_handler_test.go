@@ -0,0 +1,142 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"gorm.io/gorm"
+)
+
+func confirmPasswordResetHandler(password string, confirmPassword string) (handler.Handler, *repository.MockedAuthRepository, *repository.MockedUsersRepository, *passgen.MockedPasswordGenerator) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
+	pvo, _ := domain.NewUserPasswordValueObject(password)
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
+		RequestInput:    &infrastructure.PasswordResetConfirmInput{Token: "theToken", Password: pvo, ConfirmPassword: confirmPassword},
+	}
+
+	return h, &mockedAuthRepo, &mockedUsersRepo, &mockedPassGen
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Passwords_Do_Not_Match(t *testing.T) {
+	h, _, _, _ := confirmPasswordResetHandler("one", "another")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "Passwords don't match")
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Token_Does_Not_Exist(t *testing.T) {
+	h, mockedAuthRepo, _, _ := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(nil, gorm.ErrRecordNotFound).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The token is not valid")
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Token_Fails(t *testing.T) {
+	h, mockedAuthRepo, _, _ := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the token")
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Token_Was_Already_Used(t *testing.T) {
+	h, mockedAuthRepo, _, _ := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.PasswordResetTokenPurpose, ExpirationDate: time.Now().Add(time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(false, nil).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The token is not valid")
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Token_Has_Expired(t *testing.T) {
+	h, mockedAuthRepo, _, _ := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.PasswordResetTokenPurpose, ExpirationDate: time.Now().Add(-time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(true, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose}).Return(nil).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The token has expired")
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestConfirmPasswordResetHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Update_Fails(t *testing.T) {
+	h, mockedAuthRepo, mockedUsersRepo, mockedPassGen := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.PasswordResetTokenPurpose, ExpirationDate: time.Now().Add(time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(true, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose}).Return(nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "oldHash"}, nil).Once()
+	mockedPassGen.On("GenerateFromPassword", "pass").Return("newHash", nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "newHash"}).Return(fmt.Errorf("some error")).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error updating the user")
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedPassGen.AssertExpectations(t)
+}
+
+func TestConfirmPasswordResetHandler_Updates_The_Password_And_Revokes_The_Sessions(t *testing.T) {
+	h, mockedAuthRepo, mockedUsersRepo, mockedPassGen := confirmPasswordResetHandler("pass", "pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.PasswordResetTokenPurpose, ExpirationDate: time.Now().Add(time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.PasswordResetTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(true, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose}).Return(nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "oldHash"}, nil).Once()
+	mockedPassGen.On("GenerateFromPassword", "pass").Return("newHash", nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "newHash"}).Return(nil).Once()
+	mockedAuthRepo.On("DeleteRefreshTokensByUserID", request.Context(), int32(1)).Return(nil).Once()
+
+	result := ConfirmPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedPassGen.AssertExpectations(t)
+}
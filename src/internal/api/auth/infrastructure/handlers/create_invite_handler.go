@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func CreateInviteHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.CreateInviteInput)
+
+	srv := application.NewCreateInviteService(h.AuthRepository, h.CfgSrv)
+	invite, code, err := srv.CreateInvite(r.Context(), userID, input.IsAdmin, input.MaxUses, input.ExpirationDate)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: infrastructure.NewInviteResponse(invite, code), StatusCode: http.StatusCreated}
+}
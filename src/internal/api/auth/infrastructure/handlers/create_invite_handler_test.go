@@ -0,0 +1,121 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateInviteHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Expiration_Date_Is_In_The_Past(t *testing.T) {
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedCfgSrv.On("GetInviteExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	expDate := time.Now().Add(-time.Minute)
+	h := handler.Handler{
+		CfgSrv:       &mockedCfgSrv,
+		RequestInput: &infrastructure.CreateInviteInput{ExpirationDate: &expDate},
+	}
+
+	result := CreateInviteHandler(httptest.NewRecorder(), meRequest(), h)
+
+	results.CheckBadRequestErrorResult(t, result, "The expiration date must be in the future")
+}
+
+func TestCreateInviteHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Max_Uses_Is_Negative(t *testing.T) {
+	h := handler.Handler{RequestInput: &infrastructure.CreateInviteInput{MaxUses: -1}}
+
+	result := CreateInviteHandler(httptest.NewRecorder(), meRequest(), h)
+
+	results.CheckBadRequestErrorResult(t, result, "The max uses can't be negative")
+}
+
+func TestCreateInviteHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Invite_Fails(t *testing.T) {
+	request := meRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	h := handler.Handler{
+		AuthRepository: &mockedRepo,
+		CfgSrv:         &mockedCfgSrv,
+		RequestInput:   &infrastructure.CreateInviteInput{},
+	}
+
+	mockedCfgSrv.On("GetInviteExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	mockedRepo.On("CreateInvite", request.Context(), mock.AnythingOfType("*domain.InviteEntity")).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateInviteHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the invite")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateInviteHandler_Creates_The_Invite_And_Returns_The_Code(t *testing.T) {
+	request := meRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	expDate := time.Now().Add(24 * time.Hour)
+	h := handler.Handler{
+		AuthRepository: &mockedRepo,
+		CfgSrv:         &mockedCfgSrv,
+		RequestInput:   &infrastructure.CreateInviteInput{IsAdmin: true, MaxUses: 5, ExpirationDate: &expDate},
+	}
+
+	mockedCfgSrv.On("GetInviteExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	var created *domain.InviteEntity
+	mockedRepo.On("CreateInvite", request.Context(), mock.AnythingOfType("*domain.InviteEntity")).Run(func(args mock.Arguments) {
+		created = args.Get(1).(*domain.InviteEntity)
+		created.ID = 3
+	}).Return(nil).Once()
+
+	result := CreateInviteHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*infrastructure.InviteResponse)
+	require.Equal(t, true, isOk, "should be an invite response")
+	assert.Equal(t, int32(3), res.ID)
+	assert.True(t, res.IsAdmin)
+	assert.Equal(t, int32(5), res.MaxUses)
+	assert.Equal(t, expDate, res.ExpirationDate)
+	assert.Equal(t, int32(1), res.CreatedBy)
+	require.NotEmpty(t, res.Code)
+	assert.Equal(t, domain.HashUserToken(res.Code), created.CodeHash)
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateInviteHandler_Uses_The_Default_Expiration_And_A_Single_Use(t *testing.T) {
+	request := meRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	h := handler.Handler{
+		AuthRepository: &mockedRepo,
+		CfgSrv:         &mockedCfgSrv,
+		RequestInput:   &infrastructure.CreateInviteInput{},
+	}
+
+	expDate := time.Now().Add(time.Hour)
+	mockedCfgSrv.On("GetInviteExpirationTime").Return(expDate).Once()
+	mockedRepo.On("CreateInvite", request.Context(), mock.AnythingOfType("*domain.InviteEntity")).Return(nil).Once()
+
+	result := CreateInviteHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, _ := okRes.Content.(*infrastructure.InviteResponse)
+	assert.False(t, res.IsAdmin)
+	assert.Equal(t, int32(1), res.MaxUses)
+	assert.Equal(t, expDate, res.ExpirationDate)
+	mockedRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
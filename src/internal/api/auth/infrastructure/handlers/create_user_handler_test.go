@@ -7,13 +7,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -37,11 +41,14 @@ func TestCreateUserHandler_Validations_Returns_An_ErrorResult_With_A_BadRequestE
 func TestCreateUserHandler_Returns_An_Error_If_The_Query_To_Check_If_The_User_Exists_Fails(t *testing.T) {
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
 	}
 
@@ -57,11 +64,14 @@ func TestCreateUserHandler_Returns_An_Error_If_The_Query_To_Check_If_The_User_Ex
 func TestCreateUserHandler_Returns_A_BadRequest_Error_If_A_User_With_The_Same_Name_Already_Exist(t *testing.T) {
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
 	}
 
@@ -77,11 +87,14 @@ func TestCreateUserHandler_Returns_A_BadRequest_Error_If_A_User_With_The_Same_Na
 func TestCreateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_User_Does_Not_Exist_But_Generating_The_Password_Fails(t *testing.T) {
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
 	}
 
@@ -99,11 +112,14 @@ func TestCreateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The
 func TestCreateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_User_Does_Not_Exist_But_Creating_The_User_Fails(t *testing.T) {
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
 	}
 
@@ -124,12 +140,17 @@ func TestCreateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The
 func TestCreateUserHandler_Creates_The_User(t *testing.T) {
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
-		UsersRepository: &mockedUsersRepo,
-		PassGen:         &mockedPassGen,
-		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
+		UsersRepository:    &mockedUsersRepo,
+		PassGen:            &mockedPassGen,
+		CfgSrv:             &mockedCfgSrv,
+		AuditLogRepository: &mockedAuditLogRepo,
+		RequestInput:       &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass", IsAdmin: true},
 	}
 
 	request, _ := http.NewRequest(http.MethodPost, "/", nil)
@@ -141,6 +162,9 @@ func TestCreateUserHandler_Creates_The_User(t *testing.T) {
 		param := args.Get(1).(*domain.UserRecord)
 		param.ID = 1
 	}).Return(nil).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserCreated && *e.TargetID == 1 && !strings.Contains(e.Diff, hassedPass)
+	})).Return(nil).Once()
 
 	result := CreateUserHandler(httptest.NewRecorder(), request, h)
 
@@ -152,4 +176,37 @@ func TestCreateUserHandler_Creates_The_User(t *testing.T) {
 
 	mockedUsersRepo.AssertExpectations(t)
 	mockedPassGen.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
+
+func TestCreateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Password_Does_Not_Follow_The_Policy(t *testing.T) {
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedCfgSrv.On("GetPasswordMinLength").Return(8).Once()
+	mockedCfgSrv.On("GetPasswordCharacterClasses").Return([]string{}).Once()
+	mockedCfgSrv.On("GetPasswordRejectCommon").Return(true).Once()
+	userName, _ := domain.NewUserNameValueObject("wadus")
+	userPassword, _ := domain.NewUserPasswordValueObject("pass")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		CfgSrv:          &mockedCfgSrv,
+		RequestInput:    &infrastructure.CreateUserInput{Name: userName, Password: userPassword, ConfirmPassword: "pass"},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(false, nil).Once()
+
+	result := CreateUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The password must have at least 8 characters")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+// mockLenientPasswordPolicy sets up a password policy that accepts any password so the tests
+// that don't check the policy can use short passwords
+func mockLenientPasswordPolicy(cfgSrv *application.MockedConfigurationService) {
+	cfgSrv.On("GetPasswordMinLength").Return(1).Maybe()
+	cfgSrv.On("GetPasswordCharacterClasses").Return([]string{}).Maybe()
+	cfgSrv.On("GetPasswordRejectCommon").Return(false).Maybe()
 }
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func DeleteInviteHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	inviteID := h.ParseInt32UrlVar(r, "id")
+
+	srv := application.NewDeleteInviteService(h.AuthRepository)
+	if err := srv.DeleteInvite(r.Context(), inviteID); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
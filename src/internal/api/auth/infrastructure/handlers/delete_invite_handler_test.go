@@ -0,0 +1,64 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+)
+
+func deleteInviteRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodDelete, "/wadus", nil)
+
+	return mux.SetURLVars(request, map[string]string{"id": "3"})
+}
+
+func TestDeleteInviteHandler_Returns_An_Error_If_The_Invite_Does_Not_Exist(t *testing.T) {
+	request := deleteInviteRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("FindInvite", request.Context(), domain.InviteEntity{ID: 3}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DeleteInviteHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteInviteHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := deleteInviteRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("FindInvite", request.Context(), domain.InviteEntity{ID: 3}).Return(&domain.InviteEntity{ID: 3}, nil).Once()
+	mockedRepo.On("DeleteInvite", request.Context(), int32(3)).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteInviteHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the invite")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteInviteHandler_Deletes_The_Invite(t *testing.T) {
+	request := deleteInviteRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("FindInvite", request.Context(), domain.InviteEntity{ID: 3}).Return(&domain.InviteEntity{ID: 3}, nil).Once()
+	mockedRepo.On("DeleteInvite", request.Context(), int32(3)).Return(nil).Once()
+
+	result := DeleteInviteHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func DeleteMySessionHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	sessionID := h.ParseInt32UrlVar(r, "id")
+
+	srv := application.NewDeleteUserSessionService(h.AuthRepository)
+	if err := srv.DeleteUserSession(r.Context(), userID, sessionID); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
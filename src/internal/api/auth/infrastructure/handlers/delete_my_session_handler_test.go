@@ -0,0 +1,88 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+)
+
+func deleteMySessionRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodDelete, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "5",
+	})
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestDeleteMySessionHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_The_Session_Fails(t *testing.T) {
+	request := deleteMySessionRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("ExistsRefreshToken", request.Context(), domain.RefreshTokenEntity{ID: 5, UserID: 1}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := DeleteMySessionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the session")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteMySessionHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Session_Does_Not_Belong_To_The_User(t *testing.T) {
+	request := deleteMySessionRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("ExistsRefreshToken", request.Context(), domain.RefreshTokenEntity{ID: 5, UserID: 1}).Return(false, nil).Once()
+
+	result := DeleteMySessionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The session does not exist")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteMySessionHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := deleteMySessionRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("ExistsRefreshToken", request.Context(), domain.RefreshTokenEntity{ID: 5, UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("DeleteRefreshTokensByID", request.Context(), []int32{5}).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteMySessionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the session")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteMySessionHandler_Deletes_The_Session(t *testing.T) {
+	request := deleteMySessionRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("ExistsRefreshToken", request.Context(), domain.RefreshTokenEntity{ID: 5, UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("DeleteRefreshTokensByID", request.Context(), []int32{5}).Return(nil).Once()
+
+	result := DeleteMySessionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
@@ -11,7 +11,7 @@ import (
 func DeleteRefreshTokensHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	input, _ := h.RequestInput.(*[]int32)
 
-	srv := application.NewDeleteRefreshTokensService(h.AuthRepository)
+	srv := application.NewDeleteRefreshTokensService(h.AuthRepository, h.AuditLogRepository)
 	err := srv.DeleteRefreshTokens(r.Context(), *input)
 	if err != nil {
 		return results.ErrorResult{Err: err}
@@ -7,8 +7,11 @@ import (
 	"testing"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestDeleteRefreshTokensHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Find_The_User_Fails(t *testing.T) {
@@ -30,17 +33,23 @@ func TestDeleteRefreshTokensHandler_Returns_An_ErrorResult_With_An_UnexpectedErr
 
 func TestDeleteRefreshTokensHandler_Returns_An_Ok_Result_If_The_RefreshTokens_Are_Deleted(t *testing.T) {
 	mockedRepo := repository.MockedAuthRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
 	ids := []int32{1, 2}
 	h := handler.Handler{
-		AuthRepository: &mockedRepo,
-		RequestInput:   &ids,
+		AuthRepository:     &mockedRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		RequestInput:       &ids,
 	}
 
 	request, _ := http.NewRequest(http.MethodPost, "/", nil)
 	mockedRepo.On("DeleteRefreshTokensByID", request.Context(), ids).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionRefreshTokensRevoked && e.TargetID == nil && e.Diff == `{"ids":{"old":null,"new":[1,2]}}`
+	})).Return(nil).Once()
 
 	result := DeleteRefreshTokensHandler(httptest.NewRecorder(), request, h)
 
 	results.CheckOkResult(t, result, http.StatusNoContent)
 	mockedRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
 }
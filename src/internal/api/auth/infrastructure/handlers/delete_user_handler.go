@@ -2,8 +2,10 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 )
@@ -11,11 +13,26 @@ import (
 func DeleteUserHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	userID := h.ParseInt32UrlVar(r, "id")
 
-	srv := application.NewDeleteUserService(h.UsersRepository)
-	err := srv.DeleteUser(r.Context(), userID)
+	var transferToUserID *int32
+	if value := r.URL.Query().Get("transferTo"); len(value) > 0 {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Invalid user to transfer the data to"}}
+		}
+
+		id := int32(parsed)
+		transferToUserID = &id
+	}
+
+	srv := application.NewDeleteUserService(h.UsersRepository, h.EventBus, h.AuditLogRepository)
+	job, err := srv.DeleteUser(r.Context(), userID, r.URL.Query().Get("strategy"), transferToUserID)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
 
-	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+	if job == nil {
+		return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+	}
+
+	return results.OkResult{Content: job, StatusCode: http.StatusAccepted}
 }
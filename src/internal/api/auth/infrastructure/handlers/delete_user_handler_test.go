@@ -11,9 +11,14 @@ import (
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestDeleteUserHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *testing.T) {
@@ -89,14 +94,179 @@ func TestDeleteUserHandler_Deletes_The_User(t *testing.T) {
 	}
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
-	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, AuditLogRepository: &mockedAuditLogRepo}
 
 	foundUser := domain.UserRecord{Name: "wadus"}
 	mockedUsersRepo.On("FindUser", request().Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
 	mockedUsersRepo.On("Delete", request().Context(), domain.UserRecord{ID: 1}).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request().Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserDeleted && *e.TargetID == 1
+	})).Return(nil).Once()
 
 	result := DeleteUserHandler(httptest.NewRecorder(), request(), h)
 
 	results.CheckOkResult(t, result, http.StatusNoContent)
 	mockedUsersRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
+
+func deleteUserWithStrategyRequest(query string) *http.Request {
+	request, _ := http.NewRequest(http.MethodDelete, "/wadus?"+query, nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "1",
+	})
+	return request
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_TransferTo_Is_Not_Valid(t *testing.T) {
+	result := DeleteUserHandler(httptest.NewRecorder(), deleteUserWithStrategyRequest("strategy=transfer&transferTo=wadus"), handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid user to transfer the data to")
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_Strategy_Is_Not_Valid(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=wadus")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, `The strategy must be "transfer" or "cascade"`)
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_Cascade_Strategy_Has_A_TransferTo(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=cascade&transferTo=2")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user to transfer the data to can only be set with the transfer strategy")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_Transfer_Strategy_Has_Not_A_TransferTo(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=transfer")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user to transfer the data to is required")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_Transferring_To_The_Same_User(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=transfer&transferTo=1")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The data can't be transferred to the user being deleted")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Checking_The_TransferTo_User_Fails(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=transfer&transferTo=2")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{ID: 2}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the user exists")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_TransferTo_User_Does_Not_Exist(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=transfer&transferTo=2")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{ID: 2}).Return(false, nil).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user to transfer the data to doesn't exist")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Job_Fails(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=cascade")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("CreateUserDeletionJob", request.Context(), &domain.UserDeletionJobRecord{UserID: 1, Strategy: domain.UserDeletionStrategyCascade, Status: domain.UserDeletionJobPending}).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the user deletion job")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDeleteUserHandler_Creates_A_Job_To_Transfer_The_User_Data(t *testing.T) {
+	request := deleteUserWithStrategyRequest("strategy=transfer&transferTo=2")
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, AuditLogRepository: &mockedAuditLogRepo, EventBus: &mockedEventBus}
+
+	transferToUserID := int32(2)
+	foundUser := domain.UserRecord{Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{ID: 2}).Return(true, nil).Once()
+	mockedUsersRepo.On("CreateUserDeletionJob", request.Context(), &domain.UserDeletionJobRecord{UserID: 1, Strategy: domain.UserDeletionStrategyTransfer, TransferToUserID: &transferToUserID, Status: domain.UserDeletionJobPending}).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(1).(*domain.UserDeletionJobRecord)
+		arg.ID = 5
+	})
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserDeletionRequested && *e.TargetID == 1
+	})).Return(nil).Once()
+	mockedEventBus.On("Publish", events.UserDeletionRequested, int32(5)).Once()
+	mockedEventBus.Wg.Add(1)
+
+	result := DeleteUserHandler(httptest.NewRecorder(), request, h)
+
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusAccepted)
+	job, isOk := okRes.Content.(*domain.UserDeletionJobEntity)
+	assert.True(t, isOk, "should be a user deletion job")
+	assert.Equal(t, int32(5), job.ID)
+	assert.Equal(t, domain.UserDeletionStrategyTransfer, job.Strategy)
+	assert.Equal(t, domain.UserDeletionJobPending, job.Status)
+	assert.Equal(t, &transferToUserID, job.TransferToUserID)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
 }
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+)
+
+func DownloadUserExportHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	token := mux.Vars(r)["token"]
+
+	srv := application.NewDownloadUserExportService(h.UsersRepository, h.Storage)
+	content, err := srv.DownloadUserExport(r.Context(), token)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	w.Header().Set("Content-Disposition", `attachment; filename="export.json"`)
+
+	return results.OkResult{Content: json.RawMessage(content), StatusCode: http.StatusOK}
+}
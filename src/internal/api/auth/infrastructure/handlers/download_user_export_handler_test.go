@@ -0,0 +1,133 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func downloadUserExportRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"token": "abc",
+	})
+	return request
+}
+
+func TestDownloadUserExportHandler_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	request := downloadUserExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	mockedUsersRepo.On("FindUserExport", request.Context(), domain.UserExportRecord{TokenHash: domain.HashUserToken("abc")}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DownloadUserExportHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestDownloadUserExportHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_The_Export_Can_Not_Be_Downloaded(t *testing.T) {
+	tests := []struct {
+		name     string
+		export   domain.UserExportRecord
+		errorMsg string
+	}{
+		{"expired", domain.UserExportRecord{Status: domain.UserExportCompleted, ExpirationDate: time.Now().Add(-time.Hour)}, "The export has expired"},
+		{"failed", domain.UserExportRecord{Status: domain.UserExportFailed, ExpirationDate: time.Now().Add(time.Hour)}, "The export failed"},
+		{"pending", domain.UserExportRecord{Status: domain.UserExportPending, ExpirationDate: time.Now().Add(time.Hour)}, "The export isn't ready yet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := downloadUserExportRequest()
+
+			mockedUsersRepo := repository.MockedUsersRepository{}
+			h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+			export := tt.export
+			mockedUsersRepo.On("FindUserExport", request.Context(), domain.UserExportRecord{TokenHash: domain.HashUserToken("abc")}).Return(&export, nil).Once()
+
+			result := DownloadUserExportHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckBadRequestErrorResult(t, result, tt.errorMsg)
+			mockedUsersRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDownloadUserExportHandler_Deletes_The_Archive_Of_An_Expired_Export(t *testing.T) {
+	request := downloadUserExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedStorage := storage.MockedStorage{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, Storage: &mockedStorage}
+
+	export := domain.UserExportRecord{ID: 5, Status: domain.UserExportCompleted, FileName: "user-exports/5.json", ExpirationDate: time.Now().Add(-time.Hour)}
+	mockedUsersRepo.On("FindUserExport", request.Context(), domain.UserExportRecord{TokenHash: domain.HashUserToken("abc")}).Return(&export, nil).Once()
+	mockedStorage.On("Delete", request.Context(), "user-exports/5.json").Return(nil).Once()
+	mockedUsersRepo.On("UpdateUserExport", request.Context(), mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.ID == 5 && r.FileName == ""
+	})).Return(nil).Once()
+
+	result := DownloadUserExportHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The export has expired")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedStorage.AssertExpectations(t)
+}
+
+func TestDownloadUserExportHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Loading_The_Archive_Fails(t *testing.T) {
+	request := downloadUserExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedStorage := storage.MockedStorage{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, Storage: &mockedStorage}
+
+	export := domain.UserExportRecord{Status: domain.UserExportCompleted, FileName: "user-exports/5.json", ExpirationDate: time.Now().Add(time.Hour)}
+	mockedUsersRepo.On("FindUserExport", request.Context(), domain.UserExportRecord{TokenHash: domain.HashUserToken("abc")}).Return(&export, nil).Once()
+	mockedStorage.On("Load", request.Context(), "user-exports/5.json").Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DownloadUserExportHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error loading the export")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedStorage.AssertExpectations(t)
+}
+
+func TestDownloadUserExportHandler_Returns_The_Archive(t *testing.T) {
+	request := downloadUserExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedStorage := storage.MockedStorage{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, Storage: &mockedStorage}
+
+	export := domain.UserExportRecord{Status: domain.UserExportCompleted, FileName: "user-exports/5.json", ExpirationDate: time.Now().Add(time.Hour)}
+	mockedUsersRepo.On("FindUserExport", request.Context(), domain.UserExportRecord{TokenHash: domain.HashUserToken("abc")}).Return(&export, nil).Once()
+	mockedStorage.On("Load", request.Context(), "user-exports/5.json").Return([]byte(`{"profile":{}}`), nil).Once()
+
+	w := httptest.NewRecorder()
+	result := DownloadUserExportHandler(w, request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	assert.Equal(t, json.RawMessage(`{"profile":{}}`), okRes.Content)
+	assert.Equal(t, `attachment; filename="export.json"`, w.Header().Get("Content-Disposition"))
+	mockedUsersRepo.AssertExpectations(t)
+	mockedStorage.AssertExpectations(t)
+}
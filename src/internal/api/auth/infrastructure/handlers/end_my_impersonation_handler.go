@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+// EndMyImpersonationHandler ends the impersonation session of the current token and removes
+// the token cookie
+func EndMyImpersonationHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	impersonationID := h.GetImpersonationIDFromContext(r)
+
+	srv := application.NewEndImpersonationService(h.AuthRepository, h.AuditLogRepository)
+	if err := srv.EndImpersonation(r.Context(), impersonationID); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: tokenCookieName, Value: "", HttpOnly: true, Path: "/", SameSite: http.SameSiteNoneMode, Secure: true, MaxAge: -1})
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
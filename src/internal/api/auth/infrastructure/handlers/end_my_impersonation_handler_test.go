@@ -0,0 +1,71 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func impersonationRequest() *http.Request {
+	request := meRequest()
+	ctx := context.WithValue(request.Context(), consts.ReqContextImpersonationIDKey, int32(5))
+
+	return request.WithContext(ctx)
+}
+
+func TestEndMyImpersonationHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_User_Is_Not_Impersonated(t *testing.T) {
+	result := EndMyImpersonationHandler(httptest.NewRecorder(), meRequest(), handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "There isn't an impersonation session")
+}
+
+func TestEndMyImpersonationHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Ending_The_Impersonation_Fails(t *testing.T) {
+	request := impersonationRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("EndImpersonation", request.Context(), int32(5), mock.AnythingOfType("time.Time")).Return(fmt.Errorf("some error")).Once()
+
+	result := EndMyImpersonationHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error ending the impersonation")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestEndMyImpersonationHandler_Ends_The_Impersonation_And_Removes_The_Token_Cookie(t *testing.T) {
+	request := impersonationRequest()
+	mockedRepo := repository.MockedAuthRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo, AuditLogRepository: &mockedAuditLogRepo}
+
+	mockedRepo.On("EndImpersonation", request.Context(), int32(5), mock.AnythingOfType("time.Time")).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionImpersonationEnded && *e.TargetID == 5 && *e.ActorID == 1
+	})).Return(nil).Once()
+
+	recorder := httptest.NewRecorder()
+	result := EndMyImpersonationHandler(recorder, request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	cookies := recorder.Result().Cookies()
+	require.Equal(t, 1, len(cookies))
+	assert.Equal(t, "token", cookies[0].Name)
+	assert.Equal(t, -1, cookies[0].MaxAge)
+	mockedRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetAllInvitesHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	srv := application.NewGetAllInvitesService(h.AuthRepository)
+	found, err := srv.GetAllInvites(r.Context())
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := make([]*infrastructure.InviteResponse, len(found))
+
+	for i, v := range found {
+		res[i] = infrastructure.NewInviteResponse(v, "")
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
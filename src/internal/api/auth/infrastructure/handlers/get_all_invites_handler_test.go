@@ -0,0 +1,56 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllInvitesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/", nil)
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("GetAllInvites", request.Context()).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAllInvitesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting invites")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllInvitesHandler_Returns_The_Invites_Without_Codes(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/", nil)
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	expDate := time.Now()
+	found := []*domain.InviteEntity{
+		{ID: 1, CodeHash: "hash1", MaxUses: 1, UsesCount: 1, ExpirationDate: expDate, CreatedBy: 1},
+		{ID: 2, CodeHash: "hash2", IsAdmin: true, MaxUses: 3, ExpirationDate: expDate, CreatedBy: 1},
+	}
+	mockedRepo.On("GetAllInvites", request.Context()).Return(found, nil).Once()
+
+	result := GetAllInvitesHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]*infrastructure.InviteResponse)
+	require.Equal(t, true, isOk, "should be an array of invite response")
+	require.Equal(t, 2, len(res))
+	assert.Equal(t, &infrastructure.InviteResponse{ID: 1, MaxUses: 1, UsesCount: 1, ExpirationDate: expDate, CreatedBy: 1}, res[0])
+	assert.Equal(t, &infrastructure.InviteResponse{ID: 2, IsAdmin: true, MaxUses: 3, ExpirationDate: expDate, CreatedBy: 1}, res[1])
+	mockedRepo.AssertExpectations(t)
+}
@@ -20,9 +20,12 @@ func GetAllUsersHandler(w http.ResponseWriter, r *http.Request, h handler.Handle
 
 	for i, v := range foundUsers {
 		res[i] = &infrastructure.UserResponse{
-			ID:      v.ID,
-			Name:    v.Name.String(),
-			IsAdmin: v.IsAdmin,
+			ID:            v.ID,
+			Name:          v.Name.String(),
+			DisplayName:   v.DisplayName.String(),
+			IsAdmin:       v.IsAdmin,
+			Email:         v.Email.String(),
+			EmailVerified: v.EmailVerified,
 		}
 	}
 
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+type AuditLogEntryResponse struct {
+	ID             int32           `json:"id"`
+	ActorID        *int32          `json:"actorId"`
+	ImpersonatorID *int32          `json:"impersonatorId,omitempty"`
+	Action         string          `json:"action"`
+	TargetType     string          `json:"targetType"`
+	TargetID       *int32          `json:"targetId"`
+	RequestID      string          `json:"requestId"`
+	IP             string          `json:"ip"`
+	UserAgent      string          `json:"userAgent"`
+	Diff           json.RawMessage `json:"diff"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+func GetAuditLogHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	filter, err := audit.NewAuditLogFilterFromUrl(r.URL)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	pagInfo := sharedDomain.NewPaginationInfoFromUrl(r.URL)
+
+	srv := sharedApp.NewGetAuditLogService(h.AuditLogRepository)
+	found, err := srv.GetAuditLog(r.Context(), filter, pagInfo)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := make([]AuditLogEntryResponse, len(found))
+
+	for i, v := range found {
+		res[i] = AuditLogEntryResponse{
+			ID:             v.ID,
+			ActorID:        v.ActorID,
+			ImpersonatorID: v.ImpersonatorID,
+			Action:         v.Action,
+			TargetType:     v.TargetType,
+			TargetID:       v.TargetID,
+			RequestID:      v.RequestID,
+			IP:             v.IP,
+			UserAgent:      v.UserAgent,
+			Diff:           json.RawMessage(v.Diff),
+			CreatedAt:      v.CreatedAt,
+		}
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,79 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuditLogHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Filter_Is_Not_Valid(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/tools/audit?from=yesterday", nil)
+
+	result := GetAuditLogHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid from date")
+}
+
+func TestGetAuditLogHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	mockedRepo := sharedRepository.MockedAuditLogRepository{}
+	h := handler.Handler{AuditLogRepository: &mockedRepo}
+
+	request, _ := http.NewRequest(http.MethodGet, "/tools/audit", nil)
+	paginationInfo := sharedDomain.NewPaginationInfo(10, 0, "id", sharedDomain.OrderAsc)
+	mockedRepo.On("GetAll", request.Context(), audit.AuditLogFilter{}, paginationInfo).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAuditLogHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the audit log")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAuditLogHandler_Returns_The_Filtered_Entries(t *testing.T) {
+	mockedRepo := sharedRepository.MockedAuditLogRepository{}
+	h := handler.Handler{AuditLogRepository: &mockedRepo}
+
+	actorID := int32(1)
+	targetID := int32(2)
+	now := time.Now()
+	found := []*audit.AuditLogEntryEntity{
+		{ID: 7, ActorID: &actorID, Action: audit.ActionUserDeleted, TargetType: audit.TargetUser, TargetID: &targetID, RequestID: "reqId", IP: "127.0.0.1", UserAgent: "agent", Diff: `{"name":{"old":"wadus","new":null}}`, CreatedAt: now},
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, "/tools/audit?action=user.deleted&sort=createdAt&order=desc&page=2&page_size=5", nil)
+	paginationInfo := sharedDomain.NewPaginationInfo(5, 5, "createdAt", sharedDomain.OrderDesc)
+	mockedRepo.On("GetAll", request.Context(), audit.AuditLogFilter{Action: audit.ActionUserDeleted}, paginationInfo).Return(found, nil).Once()
+
+	result := GetAuditLogHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]AuditLogEntryResponse)
+	require.Equal(t, true, isOk, "should be an array of audit log entry response")
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, AuditLogEntryResponse{
+		ID:         7,
+		ActorID:    &actorID,
+		Action:     audit.ActionUserDeleted,
+		TargetType: audit.TargetUser,
+		TargetID:   &targetID,
+		RequestID:  "reqId",
+		IP:         "127.0.0.1",
+		UserAgent:  "agent",
+		Diff:       json.RawMessage(`{"name":{"old":"wadus","new":null}}`),
+		CreatedAt:  now,
+	}, res[0])
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+// GetJwksHandler returns the public keys other services can use to verify our tokens
+func GetJwksHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	jwks := h.TokenSrv.GetJsonWebKeySet()
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+
+	return results.OkResult{Content: jwks, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,35 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJwksHandler_Returns_The_Public_Keys(t *testing.T) {
+	mockedTokenSrv := domain.MockedTokenService{}
+	h := handler.Handler{TokenSrv: &mockedTokenSrv}
+	jwks := domain.JsonWebKeySet{Keys: []domain.JsonWebKey{{Kty: "OKP", Kid: "key1", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"}}}
+	mockedTokenSrv.On("GetJsonWebKeySet").Return(jwks).Once()
+
+	request, _ := http.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+	recorder := httptest.NewRecorder()
+
+	result := GetJwksHandler(recorder, request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(domain.JsonWebKeySet)
+	require.Equal(t, true, isOk, "should be a JsonWebKeySet")
+	assert.Equal(t, jwks, res)
+	assert.Equal(t, "public, max-age=300", recorder.Header().Get("Cache-Control"))
+	mockedTokenSrv.AssertExpectations(t)
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetMeHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewGetUserService(h.UsersRepository)
+	user, err := srv.GetUser(r.Context(), userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	entity := user.ToUserEntity()
+	res := infrastructure.UserResponse{
+		ID:            entity.ID,
+		Name:          entity.Name.String(),
+		DisplayName:   entity.DisplayName.String(),
+		IsAdmin:       entity.IsAdmin,
+		Email:         entity.Email.String(),
+		EmailVerified: entity.EmailVerified,
+	}
+
+	return results.OkResult{Content: &res, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,65 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func meRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetMeHandler_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	request := meRequest()
+
+	mockedRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedRepo}
+
+	mockedRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetMeHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetMeHandler_Returns_The_Current_User(t *testing.T) {
+	request := meRequest()
+
+	mockedRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedRepo}
+
+	user := domain.UserRecord{ID: 1, Name: "user1", DisplayName: "User One", IsAdmin: false}
+	mockedRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&user, nil).Once()
+
+	result := GetMeHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	userRes, isOk := okRes.Content.(*infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+
+	assert.Equal(t, int32(1), userRes.ID)
+	assert.Equal(t, "user1", userRes.Name)
+	assert.Equal(t, "User One", userRes.DisplayName)
+	assert.False(t, userRes.IsAdmin)
+	mockedRepo.AssertExpectations(t)
+}
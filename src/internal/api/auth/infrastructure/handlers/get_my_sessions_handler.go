@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetMySessionsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewGetUserSessionsService(h.AuthRepository)
+	found, err := srv.GetUserSessions(r.Context(), userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := make([]RefreshTokenResponse, len(found))
+
+	for i, v := range found {
+		res[i] = RefreshTokenResponse{
+			ID:             v.ID,
+			UserID:         v.UserID,
+			ExpirationDate: v.ExpirationDate,
+		}
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,61 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMySessionsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request := meRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	mockedRepo.On("GetRefreshTokensByUserID", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetMySessionsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the user sessions")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetMySessionsHandler_Returns_The_Sessions(t *testing.T) {
+	request := meRequest()
+
+	mockedRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{AuthRepository: &mockedRepo}
+
+	time1 := time.Now()
+	time2 := time1.Add(-time.Hour)
+	found := []*domain.RefreshTokenEntity{
+		{ID: 2, UserID: 1, ExpirationDate: time1},
+		{ID: 1, UserID: 1, ExpirationDate: time2},
+	}
+	mockedRepo.On("GetRefreshTokensByUserID", request.Context(), int32(1)).Return(found, nil).Once()
+
+	result := GetMySessionsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]RefreshTokenResponse)
+	require.Equal(t, true, isOk, "should be an array of refresh token response")
+	require.Equal(t, 2, len(res))
+	assert.Equal(t, int32(2), res[0].ID)
+	assert.Equal(t, int32(1), res[0].UserID)
+	assert.Equal(t, time1, res[0].ExpirationDate)
+	assert.Equal(t, int32(1), res[1].ID)
+	assert.Equal(t, time2, res[1].ExpirationDate)
+	mockedRepo.AssertExpectations(t)
+}
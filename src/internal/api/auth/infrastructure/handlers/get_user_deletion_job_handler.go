@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetUserDeletionJobHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	jobID := h.ParseInt32UrlVar(r, "id")
+
+	srv := application.NewGetUserDeletionJobService(h.UsersRepository)
+	job, err := srv.GetUserDeletionJob(r.Context(), jobID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: job, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,56 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetUserDeletionJobHandler_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "5",
+	})
+
+	mockedRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedRepo}
+
+	mockedRepo.On("FindUserDeletionJob", request.Context(), domain.UserDeletionJobRecord{ID: 5}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetUserDeletionJobHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetUserDeletionJobHandler_Returns_The_Job(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "5",
+	})
+
+	mockedRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedRepo}
+
+	found := domain.UserDeletionJobRecord{ID: 5, UserID: 1, Strategy: domain.UserDeletionStrategyCascade, Status: domain.UserDeletionJobRunning, Step: "lists", Progress: 20}
+	mockedRepo.On("FindUserDeletionJob", request.Context(), domain.UserDeletionJobRecord{ID: 5}).Return(&found, nil).Once()
+
+	result := GetUserDeletionJobHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	job, isOk := okRes.Content.(*domain.UserDeletionJobEntity)
+	assert.True(t, isOk, "should be a user deletion job")
+	assert.Equal(t, found.ToUserDeletionJobEntity(), job)
+	mockedRepo.AssertExpectations(t)
+}
@@ -18,10 +18,14 @@ func GetUserHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) h
 		return results.ErrorResult{Err: err}
 	}
 
+	entity := user.ToUserEntity()
 	res := infrastructure.UserResponse{
-		ID:      user.ID,
-		Name:    string(user.Name),
-		IsAdmin: user.IsAdmin,
+		ID:            entity.ID,
+		Name:          entity.Name.String(),
+		DisplayName:   entity.DisplayName.String(),
+		IsAdmin:       entity.IsAdmin,
+		Email:         entity.Email.String(),
+		EmailVerified: entity.EmailVerified,
 	}
 
 	return results.OkResult{Content: &res, StatusCode: http.StatusOK}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+// ImpersonateUserHandler replaces the token cookie of the admin with one of the user. The
+// refresh token cookie is kept, so the admin gets its own token back when refreshing it
+func ImpersonateUserHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	adminID := h.GetUserIDFromContext(r)
+	userID := h.ParseInt32UrlVar(r, "id")
+
+	srv := application.NewStartImpersonationService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.TokenSrv, h.AuditLogRepository)
+	token, impersonation, err := srv.StartImpersonation(r.Context(), adminID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	addTokenCookie(w, token)
+
+	res := infrastructure.ImpersonationResponse{
+		ID:             impersonation.ID,
+		AdminID:        impersonation.AdminID,
+		UserID:         impersonation.UserID,
+		StartedAt:      impersonation.StartedAt,
+		ExpirationDate: impersonation.ExpirationDate,
+	}
+
+	return results.OkResult{Content: &res, StatusCode: http.StatusCreated}
+}
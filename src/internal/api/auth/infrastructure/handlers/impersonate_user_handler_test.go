@@ -0,0 +1,133 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func impersonateRequest(userID string) *http.Request {
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{"id": userID})
+	ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestImpersonateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_Impersonating_Yourself(t *testing.T) {
+	result := ImpersonateUserHandler(httptest.NewRecorder(), impersonateRequest("1"), handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "It is not possible to impersonate yourself")
+}
+
+func TestImpersonateUserHandler_Returns_An_Error_If_The_User_Does_Not_Exist(t *testing.T) {
+	request := impersonateRequest("2")
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ImpersonateUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestImpersonateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_When_Impersonating_An_Admin(t *testing.T) {
+	request := impersonateRequest("2")
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 2}).Return(&domain.UserRecord{ID: 2, Name: "other", IsAdmin: true}, nil).Once()
+
+	result := ImpersonateUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "It is not possible to impersonate an admin user")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestImpersonateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Impersonation_Fails(t *testing.T) {
+	request := impersonateRequest("2")
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, AuthRepository: &mockedAuthRepo, CfgSrv: &mockedCfgSrv}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 2}).Return(&domain.UserRecord{ID: 2, Name: "wadus"}, nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "admin", IsAdmin: true}, nil).Once()
+	mockedCfgSrv.On("GetImpersonationExpirationTime").Return(time.Now().Add(time.Minute)).Once()
+	mockedAuthRepo.On("CreateImpersonation", request.Context(), mock.AnythingOfType("*domain.ImpersonationEntity")).Return(fmt.Errorf("some error")).Once()
+
+	result := ImpersonateUserHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the impersonation")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestImpersonateUserHandler_Starts_The_Impersonation_And_Sets_The_Token_Cookie(t *testing.T) {
+	request := impersonateRequest("2")
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedTokenSrv := domain.MockedTokenService{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, AuthRepository: &mockedAuthRepo, CfgSrv: &mockedCfgSrv, TokenSrv: &mockedTokenSrv, AuditLogRepository: &mockedAuditLogRepo}
+
+	expDate := time.Now().Add(time.Minute)
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 2}).Return(&domain.UserRecord{ID: 2, Name: "wadus"}, nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "admin", IsAdmin: true}, nil).Once()
+	mockedCfgSrv.On("GetImpersonationExpirationTime").Return(expDate).Once()
+	mockedAuthRepo.On("CreateImpersonation", request.Context(), mock.AnythingOfType("*domain.ImpersonationEntity")).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.ImpersonationEntity).ID = 5
+	}).Return(nil).Once()
+	mockedTokenSrv.On("GenerateImpersonationToken", mock.AnythingOfType("*domain.UserEntity"), mock.AnythingOfType("*domain.UserEntity"), mock.AnythingOfType("*domain.ImpersonationEntity")).Return("theToken", nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionImpersonationStarted && *e.TargetID == 5 && *e.ActorID == 1
+	})).Return(nil).Once()
+
+	recorder := httptest.NewRecorder()
+	result := ImpersonateUserHandler(recorder, request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*infrastructure.ImpersonationResponse)
+	require.Equal(t, true, isOk, "should be an impersonation response")
+	assert.Equal(t, int32(5), res.ID)
+	assert.Equal(t, int32(1), res.AdminID)
+	assert.Equal(t, int32(2), res.UserID)
+	assert.Equal(t, expDate, res.ExpirationDate)
+
+	cookies := recorder.Result().Cookies()
+	require.Equal(t, 1, len(cookies))
+	assert.Equal(t, "token", cookies[0].Name)
+	assert.Equal(t, "theToken", cookies[0].Value)
+
+	admin, _ := mockedTokenSrv.Calls[0].Arguments.Get(0).(*domain.UserEntity)
+	user, _ := mockedTokenSrv.Calls[0].Arguments.Get(1).(*domain.UserEntity)
+	assert.Equal(t, int32(1), admin.ID)
+	assert.Equal(t, int32(2), user.ID)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedTokenSrv.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
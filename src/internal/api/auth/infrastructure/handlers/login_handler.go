@@ -18,7 +18,7 @@ const (
 func LoginHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	input, _ := h.RequestInput.(*infrastructure.LoginInput)
 
-	srv := application.NewLoginService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.TokenSrv)
+	srv := application.NewLoginService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.TokenSrv, h.PassGen)
 	t, rt, u, err := srv.Login(r.Context(), input.UserName, input.Password)
 	if err != nil {
 		return results.ErrorResult{Err: err}
@@ -27,7 +27,7 @@ func LoginHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) han
 	addTokenCookie(w, t)
 	addRefreshTokenCookie(w, rt)
 
-	res := infrastructure.UserResponse{ID: u.ID, Name: u.Name.String(), IsAdmin: u.IsAdmin}
+	res := infrastructure.UserResponse{ID: u.ID, Name: u.Name.String(), DisplayName: u.DisplayName.String(), IsAdmin: u.IsAdmin, Email: u.Email.String(), EmailVerified: u.EmailVerified}
 
 	return results.OkResult{Content: res, StatusCode: http.StatusOK}
 }
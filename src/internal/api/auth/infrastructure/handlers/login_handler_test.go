@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
@@ -28,6 +29,7 @@ func TestLoginHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *te
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -35,6 +37,7 @@ func TestLoginHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *te
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -52,6 +55,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Passw
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -59,6 +63,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Passw
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -77,6 +82,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -84,6 +90,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -92,6 +99,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 	hashedPass := string(hashedBytes)
 	foundUser := domain.UserRecord{ID: 1, PasswordHash: hashedPass}
 	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(&foundUser, nil).Once()
+	mockedPassGen.On("NeedsRehash", hashedPass).Return(false).Once()
 	mockedTokenSrv.On("GenerateToken", foundUser.ToUserEntity()).Return("", fmt.Errorf("some error")).Once()
 
 	result := LoginHandler(httptest.NewRecorder(), request, h)
@@ -106,6 +114,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -113,6 +122,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -121,6 +131,7 @@ func TestLoginHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Generati
 	hashedPass := string(hashedBytes)
 	foundUser := domain.UserRecord{ID: 1, PasswordHash: hashedPass}
 	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(&foundUser, nil).Once()
+	mockedPassGen.On("NeedsRehash", hashedPass).Return(false).Once()
 	mockedTokenSrv.On("GenerateToken", foundUser.ToUserEntity()).Return("token", nil).Once()
 	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
 	mockedCfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
@@ -139,6 +150,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -146,6 +158,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -154,6 +167,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 	hashedPass := string(hashedBytes)
 	foundUser := domain.UserRecord{ID: 1, Name: "user", IsAdmin: true, PasswordHash: hashedPass}
 	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(&foundUser, nil).Once()
+	mockedPassGen.On("NeedsRehash", hashedPass).Return(false).Once()
 	mockedTokenSrv.On("GenerateToken", foundUser.ToUserEntity()).Return("theToken", nil).Once()
 	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
 	mockedCfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
@@ -191,6 +205,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedCfgSrv := application.MockedConfigurationService{}
 	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	userPassword, _ := domain.NewUserPasswordValueObject("pass")
 	h := handler.Handler{
@@ -198,6 +213,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 		UsersRepository: &mockedUsersRepo,
 		CfgSrv:          &mockedCfgSrv,
 		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
 		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
 	}
 
@@ -206,6 +222,7 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 	hashedPass := string(hashedBytes)
 	foundUser := domain.UserRecord{ID: 1, Name: "user", IsAdmin: true, PasswordHash: hashedPass}
 	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(&foundUser, nil).Once()
+	mockedPassGen.On("NeedsRehash", hashedPass).Return(false).Once()
 	mockedTokenSrv.On("GenerateToken", foundUser.ToUserEntity()).Return("theToken", nil).Once()
 	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
 	mockedCfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
@@ -237,3 +254,46 @@ func TestLoginHandler_Returns_An_OkResult_With_A_LoginResponse_And_Creates_The_C
 	mockedCfgSrv.AssertExpectations(t)
 	mockedTokenSrv.AssertExpectations(t)
 }
+
+func TestLoginHandler_Rehashes_The_Password_If_The_Stored_Hash_Is_Outdated(t *testing.T) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedTokenSrv := domain.MockedTokenService{}
+	mockedPassGen := passgen.MockedPasswordGenerator{}
+	userName, _ := domain.NewUserNameValueObject("wadus")
+	userPassword, _ := domain.NewUserPasswordValueObject("pass")
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		CfgSrv:          &mockedCfgSrv,
+		TokenSrv:        &mockedTokenSrv,
+		PassGen:         &mockedPassGen,
+		RequestInput:    &infrastructure.LoginInput{UserName: userName, Password: userPassword},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	hashedBytes, _ := bcrypt.GenerateFromPassword([]byte("pass"), 4)
+	hashedPass := string(hashedBytes)
+	foundUser := domain.UserRecord{ID: 1, Name: "user", IsAdmin: true, PasswordHash: hashedPass}
+	entity := foundUser.ToUserEntity()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(&foundUser, nil).Once()
+	mockedPassGen.On("NeedsRehash", hashedPass).Return(true).Once()
+	mockedPassGen.On("GenerateFromPassword", "pass").Return("newHash", nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "user", IsAdmin: true, PasswordHash: "newHash"}).Return(fmt.Errorf("some error")).Once()
+	mockedTokenSrv.On("GenerateToken", entity).Return("theToken", nil).Once()
+	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
+	mockedCfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
+	mockedTokenSrv.On("GenerateRefreshToken", entity, expDate).Return("theRefreshToken", nil).Once()
+	ctx := newrelic.NewContext(context.Background(), nil)
+	mockedAuthRepo.On("CreateRefreshTokenIfNotExist", ctx, &domain.RefreshTokenEntity{UserID: foundUser.ID, RefreshToken: "theRefreshToken", ExpirationDate: expDate}).Return(nil).Once()
+
+	mockedAuthRepo.Wg.Add(1)
+	result := LoginHandler(httptest.NewRecorder(), request, h)
+	mockedAuthRepo.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusOK)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedPassGen.AssertExpectations(t)
+	mockedTokenSrv.AssertExpectations(t)
+}
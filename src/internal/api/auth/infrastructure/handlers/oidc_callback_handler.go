@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/oidc"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+// OidcCallbackHandler is the handler for the /auth/oidc/{provider}/callback endpoint. It ends the
+// authorization code flow creating the same cookies as the login and redirecting to the frontend
+func OidcCallbackHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	provider := mux.Vars(r)["provider"]
+
+	cfg, found := h.CfgSrv.GetOidcProvider(provider)
+	if !found {
+		return results.ErrorResult{Err: gorm.ErrRecordNotFound}
+	}
+
+	if providerErr := r.URL.Query().Get("error"); len(providerErr) > 0 {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "The identity provider returned an error: " + providerErr}}
+	}
+
+	cookie, err := r.Cookie(oidcFlowCookieName)
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "The login flow has expired", InternalError: err}}
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: oidcFlowCookieName, Value: "", HttpOnly: true, Path: "/auth/oidc/" + provider, MaxAge: -1, SameSite: http.SameSiteLaxMode, Secure: true})
+
+	values := strings.Split(cookie.Value, ".")
+	state := r.URL.Query().Get("state")
+	if len(values) != 3 || len(state) == 0 || subtle.ConstantTimeCompare([]byte(values[0]), []byte(state)) != 1 {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Invalid state"}}
+	}
+
+	identity, err := oidc.NewOidcClient(cfg).Exchange(r.Context(), r.URL.Query().Get("code"), values[2], values[1])
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.UnauthorizedError{Msg: "Error validating the identity provider response", InternalError: err}}
+	}
+
+	srv := application.NewOidcLoginService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.TokenSrv)
+	t, rt, _, err := srv.Login(r.Context(), identity)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	addTokenCookie(w, t)
+	addRefreshTokenCookie(w, rt)
+
+	w.Header().Set("Location", h.CfgSrv.GetFrontendUrl())
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusFound}
+}
@@ -0,0 +1,203 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/oidc"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type oidcCallbackMocks struct {
+	authRepo  *repository.MockedAuthRepository
+	usersRepo *repository.MockedUsersRepository
+	cfgSrv    *application.MockedConfigurationService
+	tokenSrv  *domain.MockedTokenService
+}
+
+func newOidcCallbackHandler(idp *stubIdp) (handler.Handler, oidcCallbackMocks) {
+	mocks := oidcCallbackMocks{
+		authRepo:  &repository.MockedAuthRepository{},
+		usersRepo: &repository.MockedUsersRepository{},
+		cfgSrv:    &application.MockedConfigurationService{},
+		tokenSrv:  &domain.MockedTokenService{},
+	}
+	h := handler.Handler{
+		AuthRepository:  mocks.authRepo,
+		UsersRepository: mocks.usersRepo,
+		CfgSrv:          mocks.cfgSrv,
+		TokenSrv:        mocks.tokenSrv,
+	}
+	mocks.cfgSrv.On("GetOidcProvider", "company").Return(idp.providerConfig(), true).Once()
+
+	return h, mocks
+}
+
+// callbackRequest returns the request the provider redirects to, with the flow cookie the
+// login handler would have set
+func callbackRequest(idp *stubIdp, state string) *http.Request {
+	idp.codeChallenge = oidc.CodeChallenge("theVerifier")
+	request := oidcRequest("/auth/oidc/company/callback?code=theCode&state=" + state)
+	request.AddCookie(&http.Cookie{Name: "oidcFlow", Value: "theState.theNonce.theVerifier"})
+
+	return request
+}
+
+func mockOidcSessionTokens(mocks oidcCallbackMocks, user *domain.UserRecord) {
+	expDate, _ := time.Parse(time.RFC3339, "2021-04-03T19:00:00+00:00")
+	mocks.tokenSrv.On("GenerateToken", mock.AnythingOfType("*domain.UserEntity")).Return("theToken", nil).Once()
+	mocks.cfgSrv.On("GetRefreshTokenExpirationTime").Return(expDate).Once()
+	mocks.tokenSrv.On("GenerateRefreshToken", mock.AnythingOfType("*domain.UserEntity"), expDate).Return("theRefreshToken", nil).Once()
+	mocks.authRepo.On("CreateRefreshTokenIfNotExist", mock.Anything, &domain.RefreshTokenEntity{UserID: user.ID, RefreshToken: "theRefreshToken", ExpirationDate: expDate}).Return(nil).Once()
+	mocks.authRepo.Wg.Add(1)
+	mocks.cfgSrv.On("GetFrontendUrl").Return("https://frontend").Once()
+}
+
+func TestOidcCallbackHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Flow_Cookie_Does_Not_Exist(t *testing.T) {
+	idp := newStubIdp(t)
+	h, _ := newOidcCallbackHandler(idp)
+
+	result := OidcCallbackHandler(httptest.NewRecorder(), oidcRequest("/auth/oidc/company/callback?code=theCode&state=theState"), h)
+
+	results.CheckBadRequestErrorResult(t, result, "The login flow has expired")
+}
+
+func TestOidcCallbackHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Provider_Returns_An_Error(t *testing.T) {
+	idp := newStubIdp(t)
+	h, _ := newOidcCallbackHandler(idp)
+
+	result := OidcCallbackHandler(httptest.NewRecorder(), oidcRequest("/auth/oidc/company/callback?error=access_denied"), h)
+
+	results.CheckBadRequestErrorResult(t, result, "The identity provider returned an error: access_denied")
+}
+
+func TestOidcCallbackHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_State_Does_Not_Match(t *testing.T) {
+	idp := newStubIdp(t)
+	h, _ := newOidcCallbackHandler(idp)
+
+	result := OidcCallbackHandler(httptest.NewRecorder(), callbackRequest(idp, "anotherState"), h)
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid state")
+}
+
+func TestOidcCallbackHandler_Returns_An_ErrorResult_With_An_UnauthorizedError_If_The_IdToken_Is_Not_Valid(t *testing.T) {
+	idp := newStubIdp(t)
+
+	tests := map[string]func(claims map[string]interface{}){
+		"wrong nonce":    func(claims map[string]interface{}) { claims["nonce"] = "anotherNonce" },
+		"wrong audience": func(claims map[string]interface{}) { claims["aud"] = "anotherClient" },
+		"wrong issuer":   func(claims map[string]interface{}) { claims["iss"] = "https://another" },
+		"expired":        func(claims map[string]interface{}) { claims["exp"] = time.Now().Add(-time.Minute).Unix() },
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, _ := newOidcCallbackHandler(idp)
+			idp.claims = idp.validClaims("theNonce")
+			modify(idp.claims)
+
+			result := OidcCallbackHandler(httptest.NewRecorder(), callbackRequest(idp, "theState"), h)
+
+			results.CheckUnauthorizedErrorErrorResult(t, result, "Error validating the identity provider response")
+		})
+	}
+}
+
+func TestOidcCallbackHandler_Logs_In_The_Linked_User_And_Creates_The_Cookies(t *testing.T) {
+	idp := newStubIdp(t)
+	idp.claims = idp.validClaims("theNonce")
+	h, mocks := newOidcCallbackHandler(idp)
+	request := callbackRequest(idp, "theState")
+
+	provider, subject := "company", "subject1"
+	foundUser := domain.UserRecord{ID: 5, Name: "jdoe"}
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{OidcProvider: &provider, OidcSubject: &subject}).Return(&foundUser, nil).Once()
+	mockOidcSessionTokens(mocks, &foundUser)
+
+	recorder := httptest.NewRecorder()
+	result := OidcCallbackHandler(recorder, request, h)
+	mocks.authRepo.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusFound)
+	assert.Equal(t, "https://frontend", recorder.Header().Get("Location"))
+
+	cookies := recorder.Result().Cookies()
+	require.Equal(t, 3, len(cookies))
+	assert.Equal(t, "oidcFlow", cookies[0].Name)
+	assert.Equal(t, -1, cookies[0].MaxAge)
+	assert.Equal(t, "token", cookies[1].Name)
+	assert.Equal(t, "theToken", cookies[1].Value)
+	assert.Equal(t, "refreshToken", cookies[2].Name)
+	assert.Equal(t, "theRefreshToken", cookies[2].Value)
+
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.authRepo.AssertExpectations(t)
+	mocks.tokenSrv.AssertExpectations(t)
+	mocks.cfgSrv.AssertExpectations(t)
+}
+
+func TestOidcCallbackHandler_Provisions_A_New_User_If_The_Identity_Is_Not_Linked(t *testing.T) {
+	idp := newStubIdp(t)
+	idp.claims = idp.validClaims("theNonce")
+	h, mocks := newOidcCallbackHandler(idp)
+	request := callbackRequest(idp, "theState")
+
+	provider, subject, email := "company", "subject1", "john.doe@company.com"
+	mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{OidcProvider: &provider, OidcSubject: &subject}).Return(nil, gorm.ErrRecordNotFound).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "johndoe"}).Return(true, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "johndoe1"}).Return(false, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &email}).Return(false, nil).Once()
+	newUser := domain.UserRecord{Name: "johndoe1", DisplayName: "John Doe", Email: &email, EmailVerified: true, OidcProvider: &provider, OidcSubject: &subject}
+	mocks.usersRepo.On("Create", request.Context(), &newUser).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.UserRecord).ID = 7
+	}).Return(nil).Once()
+	mockOidcSessionTokens(mocks, &domain.UserRecord{ID: 7})
+
+	recorder := httptest.NewRecorder()
+	result := OidcCallbackHandler(recorder, request, h)
+	mocks.authRepo.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusFound)
+	assert.Equal(t, "https://frontend", recorder.Header().Get("Location"))
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.authRepo.AssertExpectations(t)
+}
+
+func TestOidcCallbackHandler_Caches_The_Provider_Metadata_And_Gets_The_Keys_Again_When_They_Are_Rotated(t *testing.T) {
+	idp := newStubIdp(t)
+	provider, subject := "company", "subject1"
+	foundUser := domain.UserRecord{ID: 5, Name: "jdoe"}
+
+	for _, kid := range []string{"idpKey", "idpKey", "rotatedKey"} {
+		if kid != idp.kid {
+			idp.rotateKey(t, kid)
+		}
+		idp.claims = idp.validClaims("theNonce")
+		h, mocks := newOidcCallbackHandler(idp)
+		request := callbackRequest(idp, "theState")
+
+		mocks.usersRepo.On("FindUser", request.Context(), domain.UserRecord{OidcProvider: &provider, OidcSubject: &subject}).Return(&foundUser, nil).Once()
+		mockOidcSessionTokens(mocks, &foundUser)
+
+		result := OidcCallbackHandler(httptest.NewRecorder(), request, h)
+		mocks.authRepo.Wg.Wait()
+
+		results.CheckOkResult(t, result, http.StatusFound)
+	}
+
+	assert.Equal(t, 1, idp.metadataRequests)
+	assert.Equal(t, 2, idp.jwksRequests)
+}
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/oidc"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"gorm.io/gorm"
+)
+
+const oidcFlowCookieName = "oidcFlow"
+
+// OidcLoginHandler is the handler for the /auth/oidc/{provider}/login endpoint. It starts the
+// authorization code flow redirecting the user to the provider
+func OidcLoginHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	provider := mux.Vars(r)["provider"]
+
+	cfg, found := h.CfgSrv.GetOidcProvider(provider)
+	if !found {
+		return results.ErrorResult{Err: gorm.ErrRecordNotFound}
+	}
+
+	values := make([]string, 3)
+	for i := range values {
+		value, err := oidc.NewRandomValue()
+		if err != nil {
+			return results.ErrorResult{Err: &appErrors.UnexpectedError{Msg: "Error generating the oidc flow values", InternalError: err}}
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	authUrl, err := oidc.NewOidcClient(cfg).AuthCodeURL(r.Context(), state, nonce, codeVerifier)
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.UnexpectedError{Msg: "Error getting the provider authorization url", InternalError: err}}
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcFlowCookieName,
+		Value:    strings.Join(values, "."),
+		HttpOnly: true,
+		Path:     "/auth/oidc/" + provider,
+		MaxAge:   600,
+		SameSite: http.SameSiteLaxMode,
+		Secure:   true,
+	})
+
+	w.Header().Set("Location", authUrl)
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusFound}
+}
@@ -0,0 +1,166 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/oidc"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/golang-jwt/jwt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubIdp is a minimal OpenID Connect provider that issues an id token with the configured
+// claims for the authorization code "theCode"
+type stubIdp struct {
+	server           *httptest.Server
+	key              ed25519.PrivateKey
+	kid              string
+	claims           jwt.MapClaims
+	codeChallenge    string
+	metadataRequests int
+	jwksRequests     int
+}
+
+func newStubIdp(t *testing.T) *stubIdp {
+	idp := &stubIdp{}
+	idp.rotateKey(t, "idpKey")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		idp.metadataRequests++
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.server.URL,
+			"authorization_endpoint": idp.server.URL + "/authorize",
+			"token_endpoint":         idp.server.URL + "/token",
+			"jwks_uri":               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.jwksRequests++
+		pub := idp.key.Public().(ed25519.PublicKey)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{"kty": "OKP", "crv": "Ed25519", "kid": idp.kid, "alg": "EdDSA", "use": "sig", "x": base64.RawURLEncoding.EncodeToString(pub)}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		if r.Form.Get("code") != "theCode" || r.Form.Get("client_id") != "clientId" || r.Form.Get("client_secret") != "clientSecret" ||
+			oidc.CodeChallenge(r.Form.Get("code_verifier")) != idp.codeChallenge {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, idp.claims)
+		token.Header["kid"] = idp.kid
+		idToken, _ := token.SignedString(idp.key)
+
+		json.NewEncoder(w).Encode(map[string]string{"access_token": "accessToken", "token_type": "Bearer", "id_token": idToken})
+	})
+
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+
+	return idp
+}
+
+// rotateKey replaces the signing key of the provider with a new one
+func (idp *stubIdp) rotateKey(t *testing.T, kid string) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	idp.key = priv
+	idp.kid = kid
+}
+
+func (idp *stubIdp) providerConfig() *application.OidcProviderConfig {
+	return &application.OidcProviderConfig{
+		Name:         "company",
+		IssuerUrl:    idp.server.URL,
+		ClientID:     "clientId",
+		ClientSecret: "clientSecret",
+		RedirectUrl:  "https://api/auth/oidc/company/callback",
+		Scopes:       []string{"openid", "email"},
+	}
+}
+
+func (idp *stubIdp) validClaims(nonce string) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                idp.server.URL,
+		"aud":                "clientId",
+		"sub":                "subject1",
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"iat":                time.Now().Unix(),
+		"nonce":              nonce,
+		"email":              "john.doe@company.com",
+		"email_verified":     true,
+		"name":               "John Doe",
+		"preferred_username": "johndoe",
+	}
+}
+
+func oidcRequest(target string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, target, nil)
+
+	return mux.SetURLVars(request, map[string]string{"provider": "company"})
+}
+
+func TestOidcLoginHandler_Returns_An_Error_If_The_Provider_Does_Not_Exist(t *testing.T) {
+	mockedCfgSrv := application.MockedConfigurationService{}
+	h := handler.Handler{CfgSrv: &mockedCfgSrv}
+	mockedCfgSrv.On("GetOidcProvider", "company").Return(nil, false).Once()
+
+	result := OidcLoginHandler(httptest.NewRecorder(), oidcRequest("/auth/oidc/company/login"), h)
+
+	results.CheckError(t, result, "record not found")
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+func TestOidcLoginHandler_Redirects_To_The_Provider_With_A_Pkce_Challenge(t *testing.T) {
+	idp := newStubIdp(t)
+	mockedCfgSrv := application.MockedConfigurationService{}
+	h := handler.Handler{CfgSrv: &mockedCfgSrv}
+	mockedCfgSrv.On("GetOidcProvider", "company").Return(idp.providerConfig(), true).Once()
+
+	recorder := httptest.NewRecorder()
+	result := OidcLoginHandler(recorder, oidcRequest("/auth/oidc/company/login"), h)
+
+	results.CheckOkResult(t, result, http.StatusFound)
+
+	require.Equal(t, 1, len(recorder.Result().Cookies()))
+	cookie := recorder.Result().Cookies()[0]
+	assert.Equal(t, "oidcFlow", cookie.Name)
+	assert.Equal(t, "/auth/oidc/company", cookie.Path)
+	assert.True(t, cookie.HttpOnly)
+	values := strings.Split(cookie.Value, ".")
+	require.Equal(t, 3, len(values))
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	require.Nil(t, err)
+	assert.Equal(t, idp.server.URL+"/authorize", location.Scheme+"://"+location.Host+location.Path)
+	query := location.Query()
+	assert.Equal(t, "code", query.Get("response_type"))
+	assert.Equal(t, "clientId", query.Get("client_id"))
+	assert.Equal(t, "https://api/auth/oidc/company/callback", query.Get("redirect_uri"))
+	assert.Equal(t, "openid email", query.Get("scope"))
+	assert.Equal(t, values[0], query.Get("state"))
+	assert.Equal(t, values[1], query.Get("nonce"))
+	assert.Equal(t, oidc.CodeChallenge(values[2]), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	mockedCfgSrv.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func RegisterHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	input, _ := h.RequestInput.(*infrastructure.RegisterInput)
+
+	srv := application.NewRegisterUserService(h.AuthRepository, h.UsersRepository, h.PassGen, h.CfgSrv, h.AuditLogRepository)
+	user, err := srv.RegisterUser(r.Context(), input.Code, input.Name, input.Password.String(), input.ConfirmPassword)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := infrastructure.UserResponse{
+		ID:            user.ID,
+		Name:          user.Name.String(),
+		DisplayName:   user.DisplayName.String(),
+		IsAdmin:       user.IsAdmin,
+		Email:         user.Email.String(),
+		EmailVerified: user.EmailVerified,
+	}
+
+	return results.OkResult{Content: &res, StatusCode: http.StatusCreated}
+}
@@ -0,0 +1,150 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type registerMocks struct {
+	authRepo     *repository.MockedAuthRepository
+	usersRepo    *repository.MockedUsersRepository
+	passGen      *passgen.MockedPasswordGenerator
+	cfgSrv       *application.MockedConfigurationService
+	auditLogRepo *sharedRepository.MockedAuditLogRepository
+}
+
+func newRegisterHandler(confirmPassword string) (handler.Handler, registerMocks) {
+	mocks := registerMocks{
+		authRepo:     &repository.MockedAuthRepository{},
+		usersRepo:    &repository.MockedUsersRepository{},
+		passGen:      &passgen.MockedPasswordGenerator{},
+		cfgSrv:       &application.MockedConfigurationService{},
+		auditLogRepo: &sharedRepository.MockedAuditLogRepository{},
+	}
+	mockLenientPasswordPolicy(mocks.cfgSrv)
+	userName, _ := domain.NewUserNameValueObject("wadus")
+	userPassword, _ := domain.NewUserPasswordValueObject("pass")
+	h := handler.Handler{
+		AuthRepository:     mocks.authRepo,
+		UsersRepository:    mocks.usersRepo,
+		PassGen:            mocks.passGen,
+		CfgSrv:             mocks.cfgSrv,
+		AuditLogRepository: mocks.auditLogRepo,
+		RequestInput:       &infrastructure.RegisterInput{Code: "theCode", Name: userName, Password: userPassword, ConfirmPassword: confirmPassword},
+	}
+
+	return h, mocks
+}
+
+func TestRegisterHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Invite_Is_Not_Valid(t *testing.T) {
+	tests := map[string]struct {
+		invite *domain.InviteEntity
+		err    error
+	}{
+		"not found": {nil, gorm.ErrRecordNotFound},
+		"expired":   {&domain.InviteEntity{ID: 3, MaxUses: 1, ExpirationDate: time.Now().Add(-time.Minute)}, nil},
+		"exhausted": {&domain.InviteEntity{ID: 3, MaxUses: 2, UsesCount: 2, ExpirationDate: time.Now().Add(time.Hour)}, nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			h, mocks := newRegisterHandler("pass")
+			request, _ := http.NewRequest(http.MethodPost, "/", nil)
+			mocks.authRepo.On("FindInvite", request.Context(), domain.InviteEntity{CodeHash: domain.HashUserToken("theCode")}).Return(tt.invite, tt.err).Once()
+
+			result := RegisterHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckBadRequestErrorResult(t, result, "The invite code is not valid")
+			mocks.authRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRegisterHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Last_Use_Was_Taken_Concurrently(t *testing.T) {
+	h, mocks := newRegisterHandler("pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	invite := domain.InviteEntity{ID: 3, MaxUses: 1, ExpirationDate: time.Now().Add(time.Hour)}
+	mocks.authRepo.On("FindInvite", request.Context(), domain.InviteEntity{CodeHash: domain.HashUserToken("theCode")}).Return(&invite, nil).Once()
+	mocks.authRepo.On("UseInvite", request.Context(), int32(3), mock.AnythingOfType("time.Time")).Return(false, nil).Once()
+
+	result := RegisterHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The invite code is not valid")
+	mocks.authRepo.AssertExpectations(t)
+}
+
+func TestRegisterHandler_Releases_The_Invite_If_The_User_Can_Not_Be_Created(t *testing.T) {
+	h, mocks := newRegisterHandler("anotherPass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	invite := domain.InviteEntity{ID: 3, MaxUses: 1, ExpirationDate: time.Now().Add(time.Hour)}
+	mocks.authRepo.On("FindInvite", request.Context(), domain.InviteEntity{CodeHash: domain.HashUserToken("theCode")}).Return(&invite, nil).Once()
+	mocks.authRepo.On("UseInvite", request.Context(), int32(3), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mocks.authRepo.On("ReleaseInvite", request.Context(), int32(3)).Return(nil).Once()
+
+	result := RegisterHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "Passwords don't match")
+	mocks.authRepo.AssertExpectations(t)
+}
+
+func TestRegisterHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Using_The_Invite_Fails(t *testing.T) {
+	h, mocks := newRegisterHandler("pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	invite := domain.InviteEntity{ID: 3, MaxUses: 1, ExpirationDate: time.Now().Add(time.Hour)}
+	mocks.authRepo.On("FindInvite", request.Context(), domain.InviteEntity{CodeHash: domain.HashUserToken("theCode")}).Return(&invite, nil).Once()
+	mocks.authRepo.On("UseInvite", request.Context(), int32(3), mock.AnythingOfType("time.Time")).Return(false, fmt.Errorf("some error")).Once()
+
+	result := RegisterHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error using the invite")
+	mocks.authRepo.AssertExpectations(t)
+}
+
+func TestRegisterHandler_Creates_The_User_With_The_Role_Of_The_Invite(t *testing.T) {
+	h, mocks := newRegisterHandler("pass")
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	invite := domain.InviteEntity{ID: 3, IsAdmin: true, MaxUses: 1, ExpirationDate: time.Now().Add(time.Hour)}
+	mocks.authRepo.On("FindInvite", request.Context(), domain.InviteEntity{CodeHash: domain.HashUserToken("theCode")}).Return(&invite, nil).Once()
+	mocks.authRepo.On("UseInvite", request.Context(), int32(3), mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+	mocks.usersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "wadus"}).Return(false, nil).Once()
+	mocks.passGen.On("GenerateFromPassword", "pass").Return("hashed", nil).Once()
+	mocks.usersRepo.On("Create", request.Context(), &domain.UserRecord{Name: "wadus", PasswordHash: "hashed", IsAdmin: true}).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.UserRecord).ID = 7
+	}).Return(nil).Once()
+	mocks.auditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserCreated && *e.TargetID == 7
+	})).Return(nil).Once()
+
+	result := RegisterHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+	assert.Equal(t, int32(7), res.ID)
+	assert.Equal(t, "wadus", res.Name)
+	assert.True(t, res.IsAdmin)
+	mocks.authRepo.AssertExpectations(t)
+	mocks.usersRepo.AssertExpectations(t)
+	mocks.passGen.AssertExpectations(t)
+	mocks.auditLogRepo.AssertExpectations(t)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func RequestMyExportHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+
+	return requestUserExport(r, h, userID)
+}
+
+func requestUserExport(r *http.Request, h handler.Handler, userID int32) handler.HandlerResult {
+	srv := application.NewRequestUserExportService(h.UsersRepository, h.CfgSrv, h.EventBus, h.AuditLogRepository)
+	export, token, err := srv.RequestUserExport(r.Context(), userID, h.GetUserIDFromContext(r))
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: infrastructure.NewUserExportResponse(export, token), StatusCode: http.StatusAccepted}
+}
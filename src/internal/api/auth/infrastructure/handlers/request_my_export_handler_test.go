@@ -0,0 +1,109 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func myExportRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestRequestMyExportHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *testing.T) {
+	request := myExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := RequestMyExportHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestRequestMyExportHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Export_Fails(t *testing.T) {
+	request := myExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, CfgSrv: mockedCfgSrv}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1}, nil).Once()
+	mockedCfgSrv.On("GetUserExportExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	mockedUsersRepo.On("CreateUserExport", request.Context(), mock.AnythingOfType("*domain.UserExportRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := RequestMyExportHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the user export")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+func TestRequestMyExportHandler_Creates_The_Export(t *testing.T) {
+	request := myExportRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, CfgSrv: mockedCfgSrv, AuditLogRepository: &mockedAuditLogRepo, EventBus: &mockedEventBus}
+
+	expDate := time.Now().Add(time.Hour)
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1}, nil).Once()
+	mockedCfgSrv.On("GetUserExportExpirationTime").Return(expDate).Once()
+	mockedUsersRepo.On("CreateUserExport", request.Context(), mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.UserID == 1 && r.RequestedBy == 1 && r.Status == domain.UserExportPending && len(r.TokenHash) == 64 && r.ExpirationDate == expDate
+	})).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(1).(*domain.UserExportRecord)
+		arg.ID = 5
+	})
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserExportRequested && *e.TargetID == 1
+	})).Return(nil).Once()
+	mockedEventBus.On("Publish", events.UserExportRequested, int32(5)).Once()
+	mockedEventBus.Wg.Add(1)
+
+	result := RequestMyExportHandler(httptest.NewRecorder(), request, h)
+
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusAccepted)
+	res, isOk := okRes.Content.(*infrastructure.UserExportResponse)
+	require.True(t, isOk, "should be a user export response")
+	assert.Equal(t, int32(5), res.ID)
+	assert.Equal(t, domain.UserExportPending, res.Status)
+	assert.Equal(t, expDate, res.ExpirationDate)
+	assert.True(t, strings.HasPrefix(res.DownloadUrl, "/exports/"))
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
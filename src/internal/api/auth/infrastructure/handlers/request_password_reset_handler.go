@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func RequestPasswordResetHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	input, _ := h.RequestInput.(*infrastructure.PasswordResetRequestInput)
+
+	srv := application.NewRequestPasswordResetService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.Mailer)
+	if err := srv.RequestPasswordReset(r.Context(), input.Email); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
@@ -0,0 +1,148 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestPasswordResetHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_The_User_Fails(t *testing.T) {
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	email, _ := domain.NewUserEmailValueObject("wadus@wadus.com")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.PasswordResetRequestInput{Email: email},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	emailValue := "wadus@wadus.com"
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &emailValue, EmailVerified: true}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := RequestPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the user exists")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestRequestPasswordResetHandler_Does_Not_Send_Anything_If_There_Is_No_User_With_The_Email_Verified(t *testing.T) {
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedMailer := mailer.MockedMailer{}
+	email, _ := domain.NewUserEmailValueObject("wadus@wadus.com")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		Mailer:          &mockedMailer,
+		RequestInput:    &infrastructure.PasswordResetRequestInput{Email: email},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	emailValue := "wadus@wadus.com"
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &emailValue, EmailVerified: true}).Return(false, nil).Once()
+
+	result := RequestPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedMailer.AssertExpectations(t)
+}
+
+func TestRequestPasswordResetHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Sending_The_Email_Fails(t *testing.T) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedMailer := mailer.MockedMailer{}
+	email, _ := domain.NewUserEmailValueObject("wadus@wadus.com")
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		CfgSrv:          &mockedCfgSrv,
+		Mailer:          &mockedMailer,
+		RequestInput:    &infrastructure.PasswordResetRequestInput{Email: email},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	emailValue := "wadus@wadus.com"
+	query := domain.UserRecord{Email: &emailValue, EmailVerified: true}
+	mockedUsersRepo.On("ExistsUser", request.Context(), query).Return(true, nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), query).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &emailValue, EmailVerified: true}, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose}).Return(nil).Once()
+	expDate := time.Now().Add(time.Hour)
+	mockedCfgSrv.On("GetPasswordResetTokenExpirationTime").Return(expDate).Once()
+	mockedAuthRepo.On("CreateUserToken", request.Context(), mock.AnythingOfType("*domain.UserTokenEntity")).Return(nil).Once()
+	mockedCfgSrv.On("GetFrontendUrl").Return("http://front").Once()
+	mockedMailer.On("Send", mock.AnythingOfType("*mailer.Message")).Return(fmt.Errorf("some error")).Once()
+
+	result := RequestPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error sending the password reset email")
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+	mockedMailer.AssertExpectations(t)
+}
+
+func TestRequestPasswordResetHandler_Saves_The_Hashed_Token_And_Sends_It_By_Email(t *testing.T) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedMailer := mailer.MockedMailer{}
+	email, _ := domain.NewUserEmailValueObject("wadus@wadus.com")
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		CfgSrv:          &mockedCfgSrv,
+		Mailer:          &mockedMailer,
+		RequestInput:    &infrastructure.PasswordResetRequestInput{Email: email},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	emailValue := "wadus@wadus.com"
+	query := domain.UserRecord{Email: &emailValue, EmailVerified: true}
+	mockedUsersRepo.On("ExistsUser", request.Context(), query).Return(true, nil).Once()
+	mockedUsersRepo.On("FindUser", request.Context(), query).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &emailValue, EmailVerified: true}, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose}).Return(nil).Once()
+	expDate := time.Now().Add(time.Hour)
+	mockedCfgSrv.On("GetPasswordResetTokenExpirationTime").Return(expDate).Once()
+	var savedToken *domain.UserTokenEntity
+	mockedAuthRepo.On("CreateUserToken", request.Context(), mock.AnythingOfType("*domain.UserTokenEntity")).Run(func(args mock.Arguments) {
+		savedToken = args.Get(1).(*domain.UserTokenEntity)
+	}).Return(nil).Once()
+	mockedCfgSrv.On("GetFrontendUrl").Return("http://front").Once()
+	var sentMessage *mailer.Message
+	mockedMailer.On("Send", mock.AnythingOfType("*mailer.Message")).Run(func(args mock.Arguments) {
+		sentMessage = args.Get(0).(*mailer.Message)
+	}).Return(nil).Once()
+
+	result := RequestPasswordResetHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	assert.Equal(t, int32(1), savedToken.UserID)
+	assert.Equal(t, domain.PasswordResetTokenPurpose, savedToken.Purpose)
+	assert.Equal(t, expDate, savedToken.ExpirationDate)
+	assert.Equal(t, "wadus@wadus.com", sentMessage.To)
+	prefix := "http://front/password-reset?token="
+	start := strings.Index(sentMessage.Body, prefix)
+	assert.True(t, start >= 0)
+	token := strings.TrimSpace(sentMessage.Body[start+len(prefix):])
+	assert.Equal(t, savedToken.TokenHash, domain.HashUserToken(token))
+	assert.NotEqual(t, savedToken.TokenHash, token)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+	mockedMailer.AssertExpectations(t)
+}
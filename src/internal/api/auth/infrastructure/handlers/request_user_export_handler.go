@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+)
+
+func RequestUserExportHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.ParseInt32UrlVar(r, "id")
+
+	return requestUserExport(r, h, userID)
+}
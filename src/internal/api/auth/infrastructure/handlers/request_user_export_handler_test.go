@@ -0,0 +1,61 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestUserExportHandler_Creates_The_Export_Of_The_User_Requested_By_The_Admin(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "2",
+	})
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	request = request.WithContext(ctx)
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, CfgSrv: mockedCfgSrv, AuditLogRepository: &mockedAuditLogRepo, EventBus: &mockedEventBus}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 2}).Return(&domain.UserRecord{ID: 2}, nil).Once()
+	mockedCfgSrv.On("GetUserExportExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	mockedUsersRepo.On("CreateUserExport", request.Context(), mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.UserID == 2 && r.RequestedBy == 1
+	})).Return(nil).Once().Run(func(args mock.Arguments) {
+		arg := args.Get(1).(*domain.UserExportRecord)
+		arg.ID = 5
+	})
+	mockedAuditLogRepo.On("Create", request.Context(), mock.AnythingOfType("*audit.AuditLogEntryEntity")).Return(nil).Once()
+	mockedEventBus.On("Publish", events.UserExportRequested, int32(5)).Once()
+	mockedEventBus.Wg.Add(1)
+
+	result := RequestUserExportHandler(httptest.NewRecorder(), request, h)
+
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusAccepted)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func SendMyEmailVerificationHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewSendEmailVerificationService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.Mailer)
+	if err := srv.SendEmailVerification(r.Context(), userID); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
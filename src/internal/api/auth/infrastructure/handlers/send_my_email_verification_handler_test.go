@@ -0,0 +1,43 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestSendMyEmailVerificationHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_User_Does_Not_Have_An_Email(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus"}, nil).Once()
+
+	result := SendMyEmailVerificationHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user doesn't have an email")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestSendMyEmailVerificationHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Email_Is_Already_Verified(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo}
+
+	email := "wadus@wadus.com"
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &email, EmailVerified: true}, nil).Once()
+
+	result := SendMyEmailVerificationHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The email is already verified")
+	mockedUsersRepo.AssertExpectations(t)
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func UpdateMeHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.UpdateMeInput)
+
+	srv := application.NewUpdateUserService(h.UsersRepository, h.PassGen, h.CfgSrv, h.AuditLogRepository)
+	user, err := srv.UpdateProfile(r.Context(), userID, input.Name, input.DisplayName)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := infrastructure.UserResponse{
+		ID:            user.ID,
+		Name:          user.Name.String(),
+		DisplayName:   user.DisplayName.String(),
+		IsAdmin:       user.IsAdmin,
+		Email:         user.Email.String(),
+		EmailVerified: user.EmailVerified,
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,134 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMeHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	userName, _ := domain.NewUserNameValueObject("wadus")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateMeInput{Name: userName},
+	}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := UpdateMeHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateMeHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_Tries_To_Update_The_Admin_User_UserName(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	userName, _ := domain.NewUserNameValueObject("newAdmin")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateMeInput{Name: userName},
+	}
+
+	foundUser := domain.UserRecord{ID: 1, Name: "admin", IsAdmin: true}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+
+	result := UpdateMeHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "It is not possible to change the admin user name")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateMeHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_New_UserName_Is_Already_Used(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	userName, _ := domain.NewUserNameValueObject("taken")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateMeInput{Name: userName},
+	}
+
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "taken"}).Return(true, nil).Once()
+
+	result := UpdateMeHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A user with the same user name already exists")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateMeHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Update_Fails(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	userName, _ := domain.NewUserNameValueObject("wadus")
+	displayName, _ := domain.NewUserDisplayNameValueObject("Wadus")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateMeInput{Name: userName, DisplayName: displayName},
+	}
+
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", DisplayName: "Wadus"}).Return(fmt.Errorf("some error")).Once()
+
+	result := UpdateMeHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error updating the user")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateMeHandler_Updates_The_Current_User(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	userName, _ := domain.NewUserNameValueObject("newName")
+	displayName, _ := domain.NewUserDisplayNameValueObject("New Name")
+	h := handler.Handler{
+		UsersRepository:    &mockedUsersRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		RequestInput:       &infrastructure.UpdateMeInput{Name: userName, DisplayName: displayName},
+	}
+
+	foundUser := domain.UserRecord{ID: 1, Name: "wadus", PasswordHash: "hash"}
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Name: "newName"}).Return(false, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "newName", DisplayName: "New Name", PasswordHash: "hash"}).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserUpdated && *e.TargetID == 1 && e.Diff == `{"displayName":{"old":"","new":"New Name"},"name":{"old":"wadus","new":"newName"}}`
+	})).Return(nil).Once()
+
+	result := UpdateMeHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	userRes, isOk := okRes.Content.(infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+	assert.Equal(t, int32(1), userRes.ID)
+	assert.Equal(t, "newName", userRes.Name)
+	assert.Equal(t, "New Name", userRes.DisplayName)
+	assert.False(t, userRes.IsAdmin)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func UpdateMyEmailHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.UpdateEmailInput)
+
+	srv := application.NewUpdateUserEmailService(h.AuthRepository, h.UsersRepository)
+	user, err := srv.UpdateEmail(r.Context(), userID, input.Email)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	if !user.Email.IsEmpty() && !user.EmailVerified {
+		sendSrv := application.NewSendEmailVerificationService(h.AuthRepository, h.UsersRepository, h.CfgSrv, h.Mailer)
+		if err := sendSrv.SendEmailVerification(r.Context(), userID); err != nil {
+			return results.ErrorResult{Err: err}
+		}
+	}
+
+	res := infrastructure.UserResponse{
+		ID:            user.ID,
+		Name:          user.Name.String(),
+		DisplayName:   user.DisplayName.String(),
+		IsAdmin:       user.IsAdmin,
+		Email:         user.Email.String(),
+		EmailVerified: user.EmailVerified,
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
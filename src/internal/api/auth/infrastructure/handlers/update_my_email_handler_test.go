@@ -0,0 +1,134 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMyEmailHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Email_Is_Already_Used(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	email, _ := domain.NewUserEmailValueObject("taken@wadus.com")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateEmailInput{Email: email},
+	}
+
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus"}, nil).Once()
+	emailValue := "taken@wadus.com"
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &emailValue}).Return(true, nil).Once()
+
+	result := UpdateMyEmailHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A user with the same email already exists")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateMyEmailHandler_Removes_The_Email_Without_Sending_A_Verification(t *testing.T) {
+	request := meRequest()
+
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedMailer := mailer.MockedMailer{}
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		Mailer:          &mockedMailer,
+		RequestInput:    &infrastructure.UpdateEmailInput{},
+	}
+
+	oldEmail := "old@wadus.com"
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &oldEmail, EmailVerified: true}, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus"}).Return(nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.EmailVerificationTokenPurpose}).Return(nil).Once()
+
+	result := UpdateMyEmailHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+	assert.Equal(t, "", res.Email)
+	assert.False(t, res.EmailVerified)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedMailer.AssertExpectations(t)
+}
+
+func TestUpdateMyEmailHandler_Updates_The_Email_And_Sends_A_Verification(t *testing.T) {
+	request := meRequest()
+
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockedMailer := mailer.MockedMailer{}
+	email, _ := domain.NewUserEmailValueObject("new@wadus.com")
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		CfgSrv:          &mockedCfgSrv,
+		Mailer:          &mockedMailer,
+		RequestInput:    &infrastructure.UpdateEmailInput{Email: email},
+	}
+
+	emailValue := "new@wadus.com"
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus"}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &emailValue}).Return(false, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", Email: &emailValue}).Return(nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.EmailVerificationTokenPurpose}).Return(nil).Twice()
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &emailValue}, nil).Once()
+	mockedCfgSrv.On("GetEmailVerificationTokenExpirationTime").Return(time.Now().Add(time.Hour)).Once()
+	mockedAuthRepo.On("CreateUserToken", request.Context(), mock.AnythingOfType("*domain.UserTokenEntity")).Return(nil).Once()
+	mockedCfgSrv.On("GetFrontendUrl").Return("http://front").Once()
+	mockedMailer.On("Send", mock.MatchedBy(func(m *mailer.Message) bool { return m.To == "new@wadus.com" })).Return(nil).Once()
+
+	result := UpdateMyEmailHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(infrastructure.UserResponse)
+	require.Equal(t, true, isOk, "should be a user response")
+	assert.Equal(t, "new@wadus.com", res.Email)
+	assert.False(t, res.EmailVerified)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+	mockedMailer.AssertExpectations(t)
+}
+
+func TestUpdateMyEmailHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Update_Fails(t *testing.T) {
+	request := meRequest()
+
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	email, _ := domain.NewUserEmailValueObject("new@wadus.com")
+	h := handler.Handler{
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.UpdateEmailInput{Email: email},
+	}
+
+	emailValue := "new@wadus.com"
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus"}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), domain.UserRecord{Email: &emailValue}).Return(false, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", Email: &emailValue}).Return(fmt.Errorf("some error")).Once()
+
+	result := UpdateMyEmailHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error updating the user")
+	mockedUsersRepo.AssertExpectations(t)
+}
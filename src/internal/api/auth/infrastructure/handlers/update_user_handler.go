@@ -19,16 +19,19 @@ func UpdateUserHandler(w http.ResponseWriter, r *http.Request, h handler.Handler
 		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Passwords don't match"}}
 	}
 
-	srv := application.NewUpdateUserService(h.UsersRepository, h.PassGen)
+	srv := application.NewUpdateUserService(h.UsersRepository, h.PassGen, h.CfgSrv, h.AuditLogRepository)
 	user, err := srv.UpdateUser(r.Context(), userID, input.Name, input.Password, input.IsAdmin)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
 
 	res := infrastructure.UserResponse{
-		ID:      user.ID,
-		Name:    user.Name.String(),
-		IsAdmin: user.IsAdmin,
+		ID:            user.ID,
+		Name:          user.Name.String(),
+		DisplayName:   user.DisplayName.String(),
+		IsAdmin:       user.IsAdmin,
+		Email:         user.Email.String(),
+		EmailVerified: user.EmailVerified,
 	}
 
 	return results.OkResult{Content: res, StatusCode: http.StatusOK}
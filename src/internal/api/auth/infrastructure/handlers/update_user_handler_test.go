@@ -13,10 +13,14 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,10 +48,13 @@ func TestUpdateUserHandler_Returns_An_Error_If_The_Query_To_Find_The_User_Fails(
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName},
 	}
 
@@ -71,10 +78,13 @@ func TestUpdateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_Trie
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("newAdmin")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName},
 	}
 
@@ -99,10 +109,13 @@ func TestUpdateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_Trie
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("admin")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, IsAdmin: false},
 	}
 
@@ -127,10 +140,13 @@ func TestUpdateUserHandler_Returns_An_Error_If_The_Query_To_Check_If_A_User_With
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadusR")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, Password: "newPass", ConfirmPassword: "newPass"},
 	}
 
@@ -157,10 +173,13 @@ func TestUpdateUserHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadusR")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, Password: "newPass", ConfirmPassword: "newPass"},
 	}
 
@@ -187,10 +206,13 @@ func TestUpdateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Gen
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, Password: "newPass", ConfirmPassword: "newPass"},
 	}
 
@@ -217,10 +239,13 @@ func TestUpdateUserHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	updatedUserName, _ := domain.NewUserNameValueObject("updated")
 	h := handler.Handler{
 		UsersRepository: &mockedUsersRepo,
 		PassGen:         &mockedPassGen,
+		CfgSrv:          &mockedCfgSrv,
 		RequestInput:    &infrastructure.UpdateUserInput{Name: updatedUserName},
 	}
 
@@ -247,12 +272,17 @@ func TestUpdateUserHandler_Updates_The_UserName(t *testing.T) {
 	}
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	updatedUserName, _ := domain.NewUserNameValueObject("updated")
 	h := handler.Handler{
-		UsersRepository: &mockedUsersRepo,
-		PassGen:         &mockedPassGen,
-		RequestInput:    &infrastructure.UpdateUserInput{Name: updatedUserName},
+		UsersRepository:    &mockedUsersRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		PassGen:            &mockedPassGen,
+		CfgSrv:             &mockedCfgSrv,
+		RequestInput:       &infrastructure.UpdateUserInput{Name: updatedUserName},
 	}
 
 	req := request()
@@ -260,6 +290,9 @@ func TestUpdateUserHandler_Updates_The_UserName(t *testing.T) {
 	mockedUsersRepo.On("FindUser", req.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
 	mockedUsersRepo.On("ExistsUser", req.Context(), domain.UserRecord{Name: "updated"}).Return(false, nil).Once()
 	mockedUsersRepo.On("Update", req.Context(), &foundUser).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", req.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserUpdated && *e.TargetID == 1 && e.Diff == `{"name":{"old":"wadus","new":"updated"}}`
+	})).Return(nil).Once()
 
 	result := UpdateUserHandler(httptest.NewRecorder(), req, h)
 
@@ -273,6 +306,7 @@ func TestUpdateUserHandler_Updates_The_UserName(t *testing.T) {
 
 	mockedUsersRepo.AssertExpectations(t)
 	mockedPassGen.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
 }
 
 func TestUpdateUserHandler_Updates_The_Password(t *testing.T) {
@@ -285,12 +319,17 @@ func TestUpdateUserHandler_Updates_The_Password(t *testing.T) {
 	}
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	h := handler.Handler{
-		UsersRepository: &mockedUsersRepo,
-		PassGen:         &mockedPassGen,
-		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, Password: "newPass", ConfirmPassword: "newPass"},
+		UsersRepository:    &mockedUsersRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		PassGen:            &mockedPassGen,
+		CfgSrv:             &mockedCfgSrv,
+		RequestInput:       &infrastructure.UpdateUserInput{Name: userName, Password: "newPass", ConfirmPassword: "newPass"},
 	}
 
 	req := request()
@@ -298,6 +337,9 @@ func TestUpdateUserHandler_Updates_The_Password(t *testing.T) {
 	mockedUsersRepo.On("FindUser", req.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
 	mockedPassGen.On("GenerateFromPassword", "newPass").Return("hassedPass", nil).Once()
 	mockedUsersRepo.On("Update", req.Context(), &foundUser).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", req.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserUpdated && *e.TargetID == 1 && e.Diff == `{"passwordChanged":{"old":null,"new":true}}`
+	})).Return(nil).Once()
 
 	result := UpdateUserHandler(httptest.NewRecorder(), req, h)
 
@@ -311,6 +353,7 @@ func TestUpdateUserHandler_Updates_The_Password(t *testing.T) {
 
 	mockedUsersRepo.AssertExpectations(t)
 	mockedPassGen.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
 }
 
 func TestUpdateUserHandler_Updates_The_IsAmin(t *testing.T) {
@@ -323,18 +366,26 @@ func TestUpdateUserHandler_Updates_The_IsAmin(t *testing.T) {
 	}
 
 	mockedUsersRepo := repository.MockedUsersRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
 	mockedPassGen := passgen.MockedPasswordGenerator{}
+	mockedCfgSrv := application.MockedConfigurationService{}
+	mockLenientPasswordPolicy(&mockedCfgSrv)
 	userName, _ := domain.NewUserNameValueObject("wadus")
 	h := handler.Handler{
-		UsersRepository: &mockedUsersRepo,
-		PassGen:         &mockedPassGen,
-		RequestInput:    &infrastructure.UpdateUserInput{Name: userName, IsAdmin: true},
+		UsersRepository:    &mockedUsersRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		PassGen:            &mockedPassGen,
+		CfgSrv:             &mockedCfgSrv,
+		RequestInput:       &infrastructure.UpdateUserInput{Name: userName, IsAdmin: true},
 	}
 
 	req := request()
 	foundUser := domain.UserRecord{ID: 1, Name: "wadus", IsAdmin: false}
 	mockedUsersRepo.On("FindUser", req.Context(), domain.UserRecord{ID: 1}).Return(&foundUser, nil).Once()
 	mockedUsersRepo.On("Update", req.Context(), &foundUser).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", req.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserUpdated && *e.TargetID == 1 && e.Diff == `{"isAdmin":{"old":false,"new":true}}`
+	})).Return(nil).Once()
 
 	result := UpdateUserHandler(httptest.NewRecorder(), req, h)
 
@@ -348,4 +399,5 @@ func TestUpdateUserHandler_Updates_The_IsAmin(t *testing.T) {
 
 	mockedUsersRepo.AssertExpectations(t)
 	mockedPassGen.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
 }
@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func VerifyEmailHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	input, _ := h.RequestInput.(*infrastructure.VerifyEmailInput)
+
+	srv := application.NewVerifyEmailService(h.AuthRepository, h.UsersRepository)
+	if err := srv.VerifyEmail(r.Context(), input.Token); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
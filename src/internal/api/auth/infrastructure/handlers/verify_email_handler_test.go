@@ -0,0 +1,61 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestVerifyEmailHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Token_Has_Expired(t *testing.T) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	h := handler.Handler{
+		AuthRepository: &mockedAuthRepo,
+		RequestInput:   &infrastructure.VerifyEmailInput{Token: "theToken"},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.EmailVerificationTokenPurpose, ExpirationDate: time.Now().Add(-time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.EmailVerificationTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(true, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.EmailVerificationTokenPurpose}).Return(nil).Once()
+
+	result := VerifyEmailHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The token has expired")
+	mockedAuthRepo.AssertExpectations(t)
+}
+
+func TestVerifyEmailHandler_Marks_The_Email_As_Verified(t *testing.T) {
+	mockedAuthRepo := repository.MockedAuthRepository{}
+	mockedUsersRepo := repository.MockedUsersRepository{}
+	h := handler.Handler{
+		AuthRepository:  &mockedAuthRepo,
+		UsersRepository: &mockedUsersRepo,
+		RequestInput:    &infrastructure.VerifyEmailInput{Token: "theToken"},
+	}
+
+	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	foundToken := domain.UserTokenEntity{ID: 5, UserID: 1, Purpose: domain.EmailVerificationTokenPurpose, ExpirationDate: time.Now().Add(time.Minute)}
+	mockedAuthRepo.On("FindUserToken", request.Context(), domain.UserTokenEntity{TokenHash: domain.HashUserToken("theToken"), Purpose: domain.EmailVerificationTokenPurpose}).Return(&foundToken, nil).Once()
+	mockedAuthRepo.On("ConsumeUserToken", request.Context(), int32(5)).Return(true, nil).Once()
+	mockedAuthRepo.On("DeleteUserTokens", request.Context(), domain.UserTokenEntity{UserID: 1, Purpose: domain.EmailVerificationTokenPurpose}).Return(nil).Once()
+	email := "wadus@wadus.com"
+	mockedUsersRepo.On("FindUser", request.Context(), domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "wadus", Email: &email}, nil).Once()
+	mockedUsersRepo.On("Update", request.Context(), &domain.UserRecord{ID: 1, Name: "wadus", Email: &email, EmailVerified: true}).Return(nil).Once()
+
+	result := VerifyEmailHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedAuthRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+}
@@ -0,0 +1,12 @@
+package infrastructure
+
+import "time"
+
+// ImpersonationResponse is the struct used to send the info of an impersonation session
+type ImpersonationResponse struct {
+	ID             int32     `json:"id"`
+	AdminID        int32     `json:"adminId"`
+	UserID         int32     `json:"userId"`
+	StartedAt      time.Time `json:"startedAt"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
@@ -0,0 +1,31 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+// InviteResponse is the struct used to send invite info. The code is only sent when the
+// invite is created because it isn't stored
+type InviteResponse struct {
+	ID             int32     `json:"id"`
+	Code           string    `json:"code,omitempty"`
+	IsAdmin        bool      `json:"isAdmin"`
+	MaxUses        int32     `json:"maxUses"`
+	UsesCount      int32     `json:"usesCount"`
+	ExpirationDate time.Time `json:"expirationDate"`
+	CreatedBy      int32     `json:"createdBy"`
+}
+
+func NewInviteResponse(invite *domain.InviteEntity, code string) *InviteResponse {
+	return &InviteResponse{
+		ID:             invite.ID,
+		Code:           code,
+		IsAdmin:        invite.IsAdmin,
+		MaxUses:        invite.MaxUses,
+		UsesCount:      invite.UsesCount,
+		ExpirationDate: invite.ExpirationDate,
+		CreatedBy:      invite.CreatedBy,
+	}
+}
@@ -0,0 +1,96 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+)
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// find returns the public key with the given kid that can verify the given algorithm. When the
+// token doesn't have a kid the only key of the set is used
+func (s *jsonWebKeySet) find(kid string, alg string) (interface{}, error) {
+	for _, key := range s.Keys {
+		if key.Kid != kid && (len(kid) > 0 || len(s.Keys) > 1) {
+			continue
+		}
+
+		if key.Use == "enc" || (len(key.Alg) > 0 && key.Alg != alg) {
+			continue
+		}
+
+		return key.publicKey(alg)
+	}
+
+	return nil, fmt.Errorf("there isn't any key for kid %q and algorithm %v", kid, alg)
+}
+
+func (k *jsonWebKey) publicKey(alg string) (interface{}, error) {
+	switch {
+	case k.Kty == "RSA" && alg == "RS256":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case k.Kty == "EC" && k.Crv == "P-256" && alg == "ES256":
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case k.Kty == "OKP" && k.Crv == "Ed25519" && alg == "EdDSA":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size")
+		}
+
+		return ed25519.PublicKey(x), nil
+	}
+
+	return nil, fmt.Errorf("the key %q can't be used with the %v algorithm", k.Kid, alg)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(b), nil
+}
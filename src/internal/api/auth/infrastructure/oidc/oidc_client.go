@@ -0,0 +1,240 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/golang-jwt/jwt"
+)
+
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+// OidcClient implements the authorization code flow with PKCE against an OpenID Connect provider
+type OidcClient struct {
+	cfg        *sharedApp.OidcProviderConfig
+	httpClient *http.Client
+}
+
+func NewOidcClient(cfg *sharedApp.OidcProviderConfig) *OidcClient {
+	return &OidcClient{cfg, &http.Client{Timeout: 10 * time.Second}}
+}
+
+// NewRandomValue returns a random url safe string to be used as state, nonce or code verifier
+func NewRandomValue() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge returns the S256 PKCE code challenge for the given verifier
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// AuthCodeURL returns the url of the provider the user has to be redirected to
+func (c *OidcClient) AuthCodeURL(ctx context.Context, state string, nonce string, codeVerifier string) (string, error) {
+	metadata, err := c.getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.cfg.ClientID)
+	params.Set("redirect_uri", c.cfg.RedirectUrl)
+	params.Set("scope", strings.Join(c.cfg.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", CodeChallenge(codeVerifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(metadata.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return metadata.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Exchange exchanges the authorization code for the tokens and returns the identity contained
+// in the verified id token
+func (c *OidcClient) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*domain.OidcIdentity, error) {
+	metadata, err := c.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("grant_type", "authorization_code")
+	params.Set("code", code)
+	params.Set("redirect_uri", c.cfg.RedirectUrl)
+	params.Set("client_id", c.cfg.ClientID)
+	params.Set("client_secret", c.cfg.ClientSecret)
+	params.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	tokens := tokenResponse{}
+	if err := c.doJsonRequest(req, &tokens); err != nil {
+		return nil, fmt.Errorf("error exchanging the authorization code: %v", err)
+	}
+
+	if len(tokens.IDToken) == 0 {
+		return nil, fmt.Errorf("the token response doesn't have an id token")
+	}
+
+	return c.verifyIDToken(ctx, metadata, tokens.IDToken, nonce)
+}
+
+func (c *OidcClient) verifyIDToken(ctx context.Context, metadata *providerMetadata, rawIDToken string, nonce string) (*domain.OidcIdentity, error) {
+	keys, cached, err := c.getKeys(ctx, metadata.JwksUri, false)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := jwt.Parser{ValidMethods: []string{"RS256", "ES256", "EdDSA"}}
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+
+		key, err := keys.find(kid, token.Method.Alg())
+		if err == nil || !cached {
+			return key, err
+		}
+
+		// the provider may have rotated its keys since they were cached
+		if keys, _, err = c.getKeys(ctx, metadata.JwksUri, true); err != nil {
+			return nil, err
+		}
+
+		return keys.find(kid, token.Method.Alg())
+	}
+
+	token, err := parser.Parse(rawIDToken, keyFunc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id token: %v", err)
+	}
+
+	claims := token.Claims.(jwt.MapClaims)
+
+	if !claims.VerifyIssuer(metadata.Issuer, true) {
+		return nil, fmt.Errorf("invalid id token issuer")
+	}
+
+	if !claims.VerifyAudience(c.cfg.ClientID, true) {
+		return nil, fmt.Errorf("invalid id token audience")
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, fmt.Errorf("the id token has expired")
+	}
+
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, fmt.Errorf("invalid id token nonce")
+	}
+
+	identity := domain.OidcIdentity{Provider: c.cfg.Name}
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.EmailVerified, _ = claims["email_verified"].(bool)
+	identity.Name, _ = claims["name"].(string)
+	identity.PreferredUsername, _ = claims["preferred_username"].(string)
+
+	if len(identity.Subject) == 0 {
+		return nil, fmt.Errorf("the id token doesn't have a subject")
+	}
+
+	return &identity, nil
+}
+
+func (c *OidcClient) getMetadata(ctx context.Context) (*providerMetadata, error) {
+	issuer := strings.TrimSuffix(c.cfg.IssuerUrl, "/")
+
+	cacheKey := "metadata:" + issuer
+	if cached, found := cache.get(cacheKey); found {
+		return cached.(*providerMetadata), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := providerMetadata{}
+	if err := c.doJsonRequest(req, &metadata); err != nil {
+		return nil, fmt.Errorf("error getting the provider configuration: %v", err)
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("the provider issuer %q doesn't match the configured one", metadata.Issuer)
+	}
+
+	cache.set(cacheKey, &metadata)
+
+	return &metadata, nil
+}
+
+// getKeys returns the keys of the provider and whether they come from the cache. The cache
+// isn't used when refresh is true
+func (c *OidcClient) getKeys(ctx context.Context, jwksUri string, refresh bool) (*jsonWebKeySet, bool, error) {
+	cacheKey := "keys:" + jwksUri
+	if cached, found := cache.get(cacheKey); found && !refresh {
+		return cached.(*jsonWebKeySet), true, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksUri, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	keys := jsonWebKeySet{}
+	if err := c.doJsonRequest(req, &keys); err != nil {
+		return nil, false, fmt.Errorf("error getting the provider keys: %v", err)
+	}
+
+	cache.set(cacheKey, &keys)
+
+	return &keys, false, nil
+}
+
+func (c *OidcClient) doJsonRequest(req *http.Request, result interface{}) error {
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %v", res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(result)
+}
@@ -0,0 +1,43 @@
+package oidc
+
+import (
+	"sync"
+	"time"
+)
+
+// providerCacheTTL is how long the metadata and the keys of a provider are used before
+// getting them again
+const providerCacheTTL = time.Hour
+
+type providerCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// providerCache keeps the metadata and the keys of the providers between requests, because a
+// new client is created for each one
+type providerCache struct {
+	mu      sync.Mutex
+	entries map[string]providerCacheEntry
+}
+
+var cache = &providerCache{entries: map[string]providerCacheEntry{}}
+
+func (c *providerCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.entries[key]
+	if !found || !time.Now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (c *providerCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = providerCacheEntry{value: value, expiresAt: time.Now().Add(providerCacheTTL)}
+}
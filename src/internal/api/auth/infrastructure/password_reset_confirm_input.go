@@ -0,0 +1,43 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type PasswordResetConfirmInput struct {
+	Token           string                         `json:"token"`
+	Password        domain.UserPasswordValueObject `json:"password"`
+	ConfirmPassword string                         `json:"confirmPassword"`
+}
+
+func (i *PasswordResetConfirmInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Token           string `json:"token"`
+		Password        string `json:"password"`
+		ConfirmPassword string `json:"confirmPassword"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	if len(realInput.Token) == 0 {
+		return &appErrors.BadRequestError{Msg: "The token can not be empty"}
+	}
+
+	pvo, err := domain.NewUserPasswordValueObject(realInput.Password)
+	if err != nil {
+		return err
+	}
+
+	*i = PasswordResetConfirmInput{
+		Token:           realInput.Token,
+		Password:        pvo,
+		ConfirmPassword: realInput.ConfirmPassword,
+	}
+
+	return nil
+}
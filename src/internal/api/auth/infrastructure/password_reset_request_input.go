@@ -0,0 +1,37 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type PasswordResetRequestInput struct {
+	Email domain.UserEmailValueObject `json:"email"`
+}
+
+func (i *PasswordResetRequestInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Email string `json:"email"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	evo, err := domain.NewUserEmailValueObject(realInput.Email)
+	if err != nil {
+		return err
+	}
+
+	if evo.IsEmpty() {
+		return &appErrors.BadRequestError{Msg: "The email can not be empty"}
+	}
+
+	*i = PasswordResetRequestInput{
+		Email: evo,
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type RegisterInput struct {
+	Code            string                         `json:"code"`
+	Name            domain.UserNameValueObject     `json:"name"`
+	Password        domain.UserPasswordValueObject `json:"password"`
+	ConfirmPassword string                         `json:"confirmPassword"`
+}
+
+func (i *RegisterInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Code            string `json:"code"`
+		Name            string `json:"name"`
+		Password        string `json:"password"`
+		ConfirmPassword string `json:"confirmPassword"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	if len(realInput.Code) == 0 {
+		return &appErrors.BadRequestError{Msg: "The invite code can not be empty"}
+	}
+
+	nvo, err := domain.NewUserNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	pvo, err := domain.NewUserPasswordValueObject(realInput.Password)
+	if err != nil {
+		return err
+	}
+
+	*i = RegisterInput{
+		Code:            realInput.Code,
+		Name:            nvo,
+		Password:        pvo,
+		ConfirmPassword: realInput.ConfirmPassword,
+	}
+
+	return nil
+}
@@ -57,3 +57,147 @@ func (m *MockedAuthRepository) DeleteRefreshTokensByID(ctx context.Context, ids
 
 	return args.Error(0)
 }
+
+func (m *MockedAuthRepository) GetRefreshTokensByUserID(ctx context.Context, userID int32) ([]*domain.RefreshTokenEntity, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.RefreshTokenEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) DeleteRefreshTokensByUserID(ctx context.Context, userID int32) error {
+	args := m.Called(ctx, userID)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) CreateUserToken(ctx context.Context, userToken *domain.UserTokenEntity) error {
+	args := m.Called(ctx, userToken)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) FindUserToken(ctx context.Context, query domain.UserTokenEntity) (*domain.UserTokenEntity, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.UserTokenEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) ConsumeUserToken(ctx context.Context, id int32) (bool, error) {
+	args := m.Called(ctx, id)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedAuthRepository) DeleteUserTokens(ctx context.Context, query domain.UserTokenEntity) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) DeleteExpiredUserTokens(ctx context.Context, expTime time.Time) error {
+	args := m.Called(ctx, expTime)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) CreateInvite(ctx context.Context, invite *domain.InviteEntity) error {
+	args := m.Called(ctx, invite)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) FindInvite(ctx context.Context, query domain.InviteEntity) (*domain.InviteEntity, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.InviteEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) GetAllInvites(ctx context.Context) ([]*domain.InviteEntity, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.InviteEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) DeleteInvite(ctx context.Context, id int32) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) UseInvite(ctx context.Context, id int32, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, now)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedAuthRepository) ReleaseInvite(ctx context.Context, id int32) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) GetAdminBootstrap(ctx context.Context) (*domain.AdminBootstrapEntity, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.AdminBootstrapEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) OpenAdminBootstrap(ctx context.Context) error {
+	args := m.Called(ctx)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) SetAdminSetupTokenHash(ctx context.Context, setupTokenHash string, replace bool) (bool, error) {
+	args := m.Called(ctx, setupTokenHash, replace)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedAuthRepository) ClaimAdminBootstrap(ctx context.Context, now time.Time) (bool, error) {
+	args := m.Called(ctx, now)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedAuthRepository) ReleaseAdminBootstrap(ctx context.Context) error {
+	args := m.Called(ctx)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) CreateImpersonation(ctx context.Context, impersonation *domain.ImpersonationEntity) error {
+	args := m.Called(ctx, impersonation)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuthRepository) FindImpersonation(ctx context.Context, query domain.ImpersonationEntity) (*domain.ImpersonationEntity, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.ImpersonationEntity), args.Error(1)
+}
+
+func (m *MockedAuthRepository) EndImpersonation(ctx context.Context, id int32, now time.Time) error {
+	args := m.Called(ctx, id, now)
+
+	return args.Error(0)
+}
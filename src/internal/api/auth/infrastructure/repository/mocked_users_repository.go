@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/stretchr/testify/mock"
@@ -55,3 +56,63 @@ func (m *MockedUsersRepository) Update(ctx context.Context, record *domain.UserR
 
 	return args.Error(0)
 }
+
+func (m *MockedUsersRepository) CreateUserDeletionJob(ctx context.Context, record *domain.UserDeletionJobRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedUsersRepository) FindUserDeletionJob(ctx context.Context, query domain.UserDeletionJobRecord) (*domain.UserDeletionJobRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.UserDeletionJobRecord), args.Error(1)
+}
+
+func (m *MockedUsersRepository) UpdateUserDeletionJob(ctx context.Context, record *domain.UserDeletionJobRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedUsersRepository) GetUnfinishedUserDeletionJobs(ctx context.Context) ([]*domain.UserDeletionJobRecord, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.UserDeletionJobRecord), args.Error(1)
+}
+
+func (m *MockedUsersRepository) CreateUserExport(ctx context.Context, record *domain.UserExportRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedUsersRepository) FindUserExport(ctx context.Context, query domain.UserExportRecord) (*domain.UserExportRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.UserExportRecord), args.Error(1)
+}
+
+func (m *MockedUsersRepository) UpdateUserExport(ctx context.Context, record *domain.UserExportRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedUsersRepository) GetExpiredUserExports(ctx context.Context, now time.Time) ([]*domain.UserExportRecord, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.UserExportRecord), args.Error(1)
+}
@@ -70,3 +70,205 @@ func (r *MySqlAuthRepository) DeleteRefreshTokensByID(ctx context.Context, ids [
 
 	return nil
 }
+
+func (r *MySqlAuthRepository) GetRefreshTokensByUserID(ctx context.Context, userID int32) ([]*domain.RefreshTokenEntity, error) {
+	foundRts := []domain.RefreshTokenRecord{}
+	if err := r.db.WithContext(ctx).
+		Select("id,userId,expirationDate").
+		Where(domain.RefreshTokenRecord{UserID: userID}).
+		Order("expirationDate desc").
+		Find(&foundRts).
+		Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.RefreshTokenEntity, len(foundRts))
+
+	for i, u := range foundRts {
+		res[i] = u.ToRefreshTokenEntity()
+	}
+
+	return res, nil
+}
+
+func (r *MySqlAuthRepository) DeleteRefreshTokensByUserID(ctx context.Context, userID int32) error {
+	return r.db.WithContext(ctx).Delete(domain.RefreshTokenRecord{}, "userId = ?", userID).Error
+}
+
+func (r *MySqlAuthRepository) CreateUserToken(ctx context.Context, userToken *domain.UserTokenEntity) error {
+	record := userToken.ToUserTokenRecord()
+	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+		return err
+	}
+
+	userToken.ID = record.ID
+
+	return nil
+}
+
+func (r *MySqlAuthRepository) FindUserToken(ctx context.Context, query domain.UserTokenEntity) (*domain.UserTokenEntity, error) {
+	foundToken := domain.UserTokenRecord{}
+	if err := r.db.WithContext(ctx).Where(query.ToUserTokenRecord()).Take(&foundToken).Error; err != nil {
+		return nil, err
+	}
+
+	return foundToken.ToUserTokenEntity(), nil
+}
+
+// ConsumeUserToken deletes the token in one statement, so when two requests use the same token
+// only one of them deletes it
+func (r *MySqlAuthRepository) ConsumeUserToken(ctx context.Context, id int32) (bool, error) {
+	result := r.db.WithContext(ctx).Delete(&domain.UserTokenRecord{}, "id = ?", id)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *MySqlAuthRepository) DeleteUserTokens(ctx context.Context, query domain.UserTokenEntity) error {
+	return r.db.WithContext(ctx).Where(query.ToUserTokenRecord()).Delete(&domain.UserTokenRecord{}).Error
+}
+
+func (r *MySqlAuthRepository) DeleteExpiredUserTokens(ctx context.Context, expTime time.Time) error {
+	return r.db.WithContext(ctx).Delete(domain.UserTokenRecord{}, "expirationDate <= ?", expTime).Error
+}
+
+func (r *MySqlAuthRepository) CreateInvite(ctx context.Context, invite *domain.InviteEntity) error {
+	record := invite.ToInviteRecord()
+	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+		return err
+	}
+
+	invite.ID = record.ID
+
+	return nil
+}
+
+func (r *MySqlAuthRepository) FindInvite(ctx context.Context, query domain.InviteEntity) (*domain.InviteEntity, error) {
+	foundInvite := domain.InviteRecord{}
+	if err := r.db.WithContext(ctx).Where(query.ToInviteRecord()).Take(&foundInvite).Error; err != nil {
+		return nil, err
+	}
+
+	return foundInvite.ToInviteEntity(), nil
+}
+
+func (r *MySqlAuthRepository) GetAllInvites(ctx context.Context) ([]*domain.InviteEntity, error) {
+	foundInvites := []domain.InviteRecord{}
+	if err := r.db.WithContext(ctx).Order("id").Find(&foundInvites).Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]*domain.InviteEntity, len(foundInvites))
+
+	for i, inv := range foundInvites {
+		res[i] = inv.ToInviteEntity()
+	}
+
+	return res, nil
+}
+
+func (r *MySqlAuthRepository) DeleteInvite(ctx context.Context, id int32) error {
+	return r.db.WithContext(ctx).Delete(&domain.InviteRecord{}, id).Error
+}
+
+// UseInvite increments the uses of the invite only if it hasn't expired and it has uses left,
+// doing the check in the same statement so two registrations can't use the last one
+func (r *MySqlAuthRepository) UseInvite(ctx context.Context, id int32, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.InviteRecord{}).
+		Where("id = ? AND usesCount < maxUses AND expirationDate > ?", id, now).
+		Update("usesCount", gorm.Expr("usesCount + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseInvite gives back a use of the invite when the registration that used it fails
+func (r *MySqlAuthRepository) ReleaseInvite(ctx context.Context, id int32) error {
+	return r.db.WithContext(ctx).Model(&domain.InviteRecord{}).
+		Where("id = ? AND usesCount > 0", id).
+		Update("usesCount", gorm.Expr("usesCount - 1")).Error
+}
+
+func (r *MySqlAuthRepository) GetAdminBootstrap(ctx context.Context) (*domain.AdminBootstrapEntity, error) {
+	found := domain.AdminBootstrapRecord{}
+	if err := r.db.WithContext(ctx).Where(domain.AdminBootstrapRecord{ID: domain.AdminBootstrapID}).Take(&found).Error; err != nil {
+		return nil, err
+	}
+
+	return found.ToAdminBootstrapEntity(), nil
+}
+
+// OpenAdminBootstrap creates the admin bootstrap row when it doesn't exist yet, keeping the
+// setup token hash of the existing one
+func (r *MySqlAuthRepository) OpenAdminBootstrap(ctx context.Context) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.AdminBootstrapRecord{ID: domain.AdminBootstrapID}).Error
+}
+
+// SetAdminSetupTokenHash stores the hash of a generated setup token while the bootstrap isn't
+// completed. Unless replace is set, it's only stored when there isn't a pending one, so only one
+// of several instances starting at the same time stores its token
+func (r *MySqlAuthRepository) SetAdminSetupTokenHash(ctx context.Context, setupTokenHash string, replace bool) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&domain.AdminBootstrapRecord{}).
+		Where("id = ? AND completedAt IS NULL", domain.AdminBootstrapID)
+	if !replace {
+		query = query.Where("setupTokenHash IS NULL")
+	}
+
+	result := query.Update("setupTokenHash", setupTokenHash)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ClaimAdminBootstrap marks the admin bootstrap as completed only if it wasn't already, so only
+// one of several concurrent attempts can create the first admin
+func (r *MySqlAuthRepository) ClaimAdminBootstrap(ctx context.Context, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.AdminBootstrapRecord{}).
+		Where("id = ? AND completedAt IS NULL", domain.AdminBootstrapID).
+		Update("completedAt", now)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// ReleaseAdminBootstrap opens again the admin bootstrap when the creation of the admin fails
+func (r *MySqlAuthRepository) ReleaseAdminBootstrap(ctx context.Context) error {
+	return r.db.WithContext(ctx).Model(&domain.AdminBootstrapRecord{}).
+		Where("id = ?", domain.AdminBootstrapID).
+		Update("completedAt", nil).Error
+}
+
+func (r *MySqlAuthRepository) CreateImpersonation(ctx context.Context, impersonation *domain.ImpersonationEntity) error {
+	record := impersonation.ToImpersonationRecord()
+	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+		return err
+	}
+
+	impersonation.ID = record.ID
+
+	return nil
+}
+
+func (r *MySqlAuthRepository) FindImpersonation(ctx context.Context, query domain.ImpersonationEntity) (*domain.ImpersonationEntity, error) {
+	found := domain.ImpersonationRecord{}
+	if err := r.db.WithContext(ctx).Where(query.ToImpersonationRecord()).Take(&found).Error; err != nil {
+		return nil, err
+	}
+
+	return found.ToImpersonationEntity(), nil
+}
+
+func (r *MySqlAuthRepository) EndImpersonation(ctx context.Context, id int32, now time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.ImpersonationRecord{}).
+		Where("id = ? AND endedAt IS NULL", id).
+		Update("endedAt", now).Error
+}
@@ -211,3 +211,473 @@ func TestMySqlAuthRepository_DeleteRefreshTokensByID_Deletes_The_RefreshTokens(t
 	assert.Nil(t, err)
 	helpers.CheckSqlMockExpectations(mock, t)
 }
+
+func TestMySqlAuthRepository_GetRefreshTokensByUserID_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id,userId,expirationDate FROM `refresh_tokens` WHERE `refresh_tokens`.`userId` = ? ORDER BY expirationDate desc")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetRefreshTokensByUserID(context.Background(), 1)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_GetRefreshTokensByUserID_Returns_The_User_RefreshTokens(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	columns := []string{"id", "userId", "expirationDate"}
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id,userId,expirationDate FROM `refresh_tokens` WHERE `refresh_tokens`.`userId` = ? ORDER BY expirationDate desc")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(11, 1, now))
+
+	res, err := repo.GetRefreshTokensByUserID(context.Background(), 1)
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, int32(11), res[0].ID)
+	assert.Equal(t, int32(1), res[0].UserID)
+	assert.Equal(t, now, res[0].ExpirationDate)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_DeleteRefreshTokensByUserID_Returns_An_Error_If_The_Delete_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `refresh_tokens` WHERE userId = ?")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.DeleteRefreshTokensByUserID(context.Background(), 1)
+
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_DeleteRefreshTokensByUserID_Deletes_The_User_RefreshTokens(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `refresh_tokens` WHERE userId = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.DeleteRefreshTokensByUserID(context.Background(), 1)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_CreateUserToken_Returns_An_Error_If_The_Insert_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	expDate := time.Now()
+	ut := domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose, TokenHash: "hash", ExpirationDate: expDate}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_tokens` (`userId`,`purpose`,`tokenHash`,`expirationDate`) VALUES (?,?,?,?)")).
+		WithArgs(ut.UserID, ut.Purpose, ut.TokenHash, ut.ExpirationDate).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.CreateUserToken(context.Background(), &ut)
+
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_CreateUserToken_Creates_The_UserToken(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	expDate := time.Now()
+	ut := domain.UserTokenEntity{UserID: 1, Purpose: domain.PasswordResetTokenPurpose, TokenHash: "hash", ExpirationDate: expDate}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `user_tokens` (`userId`,`purpose`,`tokenHash`,`expirationDate`) VALUES (?,?,?,?)")).
+		WithArgs(ut.UserID, ut.Purpose, ut.TokenHash, ut.ExpirationDate).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateUserToken(context.Background(), &ut)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(12), ut.ID)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_FindUserToken_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_tokens` WHERE `user_tokens`.`purpose` = ? AND `user_tokens`.`tokenHash` = ? LIMIT 1")).
+		WithArgs(domain.PasswordResetTokenPurpose, "hash").
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.FindUserToken(context.Background(), domain.UserTokenEntity{Purpose: domain.PasswordResetTokenPurpose, TokenHash: "hash"})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_FindUserToken_Returns_The_UserToken(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	columns := []string{"id", "userId", "purpose", "tokenHash", "expirationDate"}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `user_tokens` WHERE `user_tokens`.`purpose` = ? AND `user_tokens`.`tokenHash` = ? LIMIT 1")).
+		WithArgs(domain.PasswordResetTokenPurpose, "hash").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(12, 1, domain.PasswordResetTokenPurpose, "hash", now))
+
+	res, err := repo.FindUserToken(context.Background(), domain.UserTokenEntity{Purpose: domain.PasswordResetTokenPurpose, TokenHash: "hash"})
+
+	assert.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, int32(12), res.ID)
+	assert.Equal(t, int32(1), res.UserID)
+	assert.Equal(t, now, res.ExpirationDate)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_ConsumeUserToken_Returns_False_If_The_Token_Was_Already_Deleted(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_tokens` WHERE id = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	consumed, err := repo.ConsumeUserToken(context.Background(), 5)
+
+	assert.Nil(t, err)
+	assert.False(t, consumed)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_ConsumeUserToken_Deletes_The_Token(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_tokens` WHERE id = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	consumed, err := repo.ConsumeUserToken(context.Background(), 5)
+
+	assert.Nil(t, err)
+	assert.True(t, consumed)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_DeleteUserTokens_Deletes_The_UserTokens(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_tokens` WHERE `user_tokens`.`userId` = ? AND `user_tokens`.`purpose` = ?")).
+		WithArgs(1, domain.EmailVerificationTokenPurpose).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteUserTokens(context.Background(), domain.UserTokenEntity{UserID: 1, Purpose: domain.EmailVerificationTokenPurpose})
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuth_DeleteExpiredUserTokens_Deletes_The_Expired_UserTokens(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `user_tokens` WHERE expirationDate <= ?")).
+		WithArgs(now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	err := repo.DeleteExpiredUserTokens(context.Background(), now)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_CreateInvite_Creates_The_Invite(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	expDate := time.Now()
+	inv := domain.InviteEntity{CodeHash: "hash", IsAdmin: true, MaxUses: 3, ExpirationDate: expDate, CreatedBy: 1}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `invites` (`codeHash`,`isAdmin`,`maxUses`,`usesCount`,`expirationDate`,`createdBy`) VALUES (?,?,?,?,?,?)")).
+		WithArgs(inv.CodeHash, inv.IsAdmin, inv.MaxUses, inv.UsesCount, inv.ExpirationDate, inv.CreatedBy).
+		WillReturnResult(sqlmock.NewResult(12, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateInvite(context.Background(), &inv)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(12), inv.ID)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_FindInvite_Returns_The_Invite(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	columns := []string{"id", "codeHash", "isAdmin", "maxUses", "usesCount", "expirationDate", "createdBy"}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `invites` WHERE `invites`.`codeHash` = ? LIMIT 1")).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(12, "hash", true, 3, 1, now, 1))
+
+	res, err := repo.FindInvite(context.Background(), domain.InviteEntity{CodeHash: "hash"})
+
+	assert.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, &domain.InviteEntity{ID: 12, CodeHash: "hash", IsAdmin: true, MaxUses: 3, UsesCount: 1, ExpirationDate: now, CreatedBy: 1}, res)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_GetAllInvites_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `invites` ORDER BY id")).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetAllInvites(context.Background())
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_DeleteInvite_Deletes_The_Invite(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `invites` WHERE `invites`.`id` = ?")).
+		WithArgs(12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteInvite(context.Background(), 12)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_UseInvite(t *testing.T) {
+	now := time.Now()
+
+	for affectedRows, expected := range map[int64]bool{0: false, 1: true} {
+		mock, db := helpers.GetMockedDb(t)
+		repo := NewMySqlAuthRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE `invites` SET `usesCount`=usesCount + 1 WHERE id = ? AND usesCount < maxUses AND expirationDate > ?")).
+			WithArgs(12, now).
+			WillReturnResult(sqlmock.NewResult(0, affectedRows))
+		mock.ExpectCommit()
+
+		used, err := repo.UseInvite(context.Background(), 12, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, used)
+		helpers.CheckSqlMockExpectations(mock, t)
+	}
+}
+
+func TestMySqlAuthRepository_ReleaseInvite_Decrements_The_Uses(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `invites` SET `usesCount`=usesCount - 1 WHERE id = ? AND usesCount > 0")).
+		WithArgs(12).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.ReleaseInvite(context.Background(), 12)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_GetAdminBootstrap_Returns_The_Bootstrap_State(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `admin_bootstrap` WHERE `admin_bootstrap`.`id` = ? LIMIT 1")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "setupTokenHash", "completedAt"}).AddRow(1, "hash", now))
+
+	res, err := repo.GetAdminBootstrap(context.Background())
+
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.AdminBootstrapEntity{SetupTokenHash: "hash", CompletedAt: &now}, res)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_OpenAdminBootstrap_Creates_The_Row_If_It_Does_Not_Exist(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `admin_bootstrap` (`setupTokenHash`,`completedAt`,`id`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `id`=`id`")).
+		WithArgs(nil, nil, 1).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := repo.OpenAdminBootstrap(context.Background())
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_SetAdminSetupTokenHash_Only_Replaces_A_Pending_Hash_When_Asked(t *testing.T) {
+	tests := map[string]struct {
+		replace      bool
+		sql          string
+		affectedRows int64
+	}{
+		"without pending hash": {false, "UPDATE `admin_bootstrap` SET `setupTokenHash`=? WHERE (id = ? AND completedAt IS NULL) AND setupTokenHash IS NULL", 1},
+		"with pending hash":    {false, "UPDATE `admin_bootstrap` SET `setupTokenHash`=? WHERE (id = ? AND completedAt IS NULL) AND setupTokenHash IS NULL", 0},
+		"replacing":            {true, "UPDATE `admin_bootstrap` SET `setupTokenHash`=? WHERE id = ? AND completedAt IS NULL", 1},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mock, db := helpers.GetMockedDb(t)
+			repo := NewMySqlAuthRepository(db)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(tt.sql)).
+				WithArgs("hash", 1).
+				WillReturnResult(sqlmock.NewResult(0, tt.affectedRows))
+			mock.ExpectCommit()
+
+			stored, err := repo.SetAdminSetupTokenHash(context.Background(), "hash", tt.replace)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.affectedRows == 1, stored)
+			helpers.CheckSqlMockExpectations(mock, t)
+		})
+	}
+}
+
+func TestMySqlAuthRepository_ClaimAdminBootstrap(t *testing.T) {
+	now := time.Now()
+
+	for affectedRows, expected := range map[int64]bool{0: false, 1: true} {
+		mock, db := helpers.GetMockedDb(t)
+		repo := NewMySqlAuthRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE `admin_bootstrap` SET `completedAt`=? WHERE id = ? AND completedAt IS NULL")).
+			WithArgs(now, 1).
+			WillReturnResult(sqlmock.NewResult(0, affectedRows))
+		mock.ExpectCommit()
+
+		claimed, err := repo.ClaimAdminBootstrap(context.Background(), now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, claimed)
+		helpers.CheckSqlMockExpectations(mock, t)
+	}
+}
+
+func TestMySqlAuthRepository_ReleaseAdminBootstrap_Clears_The_Completion_Date(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `admin_bootstrap` SET `completedAt`=? WHERE id = ?")).
+		WithArgs(nil, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.ReleaseAdminBootstrap(context.Background())
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_CreateImpersonation_Creates_The_Impersonation(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	imp := domain.ImpersonationEntity{AdminID: 1, UserID: 2, StartedAt: now, ExpirationDate: now.Add(time.Minute)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `impersonations` (`adminId`,`userId`,`startedAt`,`expirationDate`,`endedAt`) VALUES (?,?,?,?,?)")).
+		WithArgs(imp.AdminID, imp.UserID, imp.StartedAt, imp.ExpirationDate, nil).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateImpersonation(context.Background(), &imp)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), imp.ID)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_FindImpersonation_Returns_The_Impersonation(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	columns := []string{"id", "adminId", "userId", "startedAt", "expirationDate", "endedAt"}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `impersonations` WHERE `impersonations`.`id` = ? LIMIT 1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(5, 1, 2, now, now, nil))
+
+	res, err := repo.FindImpersonation(context.Background(), domain.ImpersonationEntity{ID: 5})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.ImpersonationEntity{ID: 5, AdminID: 1, UserID: 2, StartedAt: now, ExpirationDate: now}, res)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuthRepository_EndImpersonation_Sets_The_End_Date(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuthRepository(db)
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `impersonations` SET `endedAt`=? WHERE id = ? AND endedAt IS NULL")).
+		WithArgs(now, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.EndImpersonation(context.Background(), 5, now)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"gorm.io/gorm"
@@ -59,3 +60,57 @@ func (r *MySqlUsersRepository) Delete(ctx context.Context, query domain.UserReco
 func (r *MySqlUsersRepository) Update(ctx context.Context, record *domain.UserRecord) error {
 	return r.db.WithContext(ctx).Save(record).Error
 }
+
+func (r *MySqlUsersRepository) CreateUserDeletionJob(ctx context.Context, record *domain.UserDeletionJobRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlUsersRepository) FindUserDeletionJob(ctx context.Context, query domain.UserDeletionJobRecord) (*domain.UserDeletionJobRecord, error) {
+	foundJob := domain.UserDeletionJobRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundJob).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundJob, nil
+}
+
+func (r *MySqlUsersRepository) UpdateUserDeletionJob(ctx context.Context, record *domain.UserDeletionJobRecord) error {
+	return r.db.WithContext(ctx).Save(record).Error
+}
+
+// GetUnfinishedUserDeletionJobs returns the pending and the running jobs sorted by id
+func (r *MySqlUsersRepository) GetUnfinishedUserDeletionJobs(ctx context.Context) ([]*domain.UserDeletionJobRecord, error) {
+	foundJobs := []*domain.UserDeletionJobRecord{}
+	if err := r.db.WithContext(ctx).Where("status IN ?", []string{domain.UserDeletionJobPending, domain.UserDeletionJobRunning}).Order("id").Find(&foundJobs).Error; err != nil {
+		return nil, err
+	}
+
+	return foundJobs, nil
+}
+
+func (r *MySqlUsersRepository) CreateUserExport(ctx context.Context, record *domain.UserExportRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlUsersRepository) FindUserExport(ctx context.Context, query domain.UserExportRecord) (*domain.UserExportRecord, error) {
+	foundExport := domain.UserExportRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundExport).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundExport, nil
+}
+
+func (r *MySqlUsersRepository) UpdateUserExport(ctx context.Context, record *domain.UserExportRecord) error {
+	return r.db.WithContext(ctx).Save(record).Error
+}
+
+// GetExpiredUserExports returns the expired exports whose archive is still in the storage
+func (r *MySqlUsersRepository) GetExpiredUserExports(ctx context.Context, now time.Time) ([]*domain.UserExportRecord, error) {
+	foundExports := []*domain.UserExportRecord{}
+	if err := r.db.WithContext(ctx).Where("expirationDate <= ? AND fileName <> ''", now).Find(&foundExports).Error; err != nil {
+		return nil, err
+	}
+
+	return foundExports, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
@@ -17,7 +18,7 @@ import (
 )
 
 var (
-	userColumns = []string{"id", "name", "passwordHash", "isAdmin"}
+	userColumns = []string{"id", "name", "passwordHash", "isAdmin", "displayName", "email", "emailVerified"}
 )
 
 func TestMySqlUsersRepository_FindUser_WhenTheQueryFails(t *testing.T) {
@@ -42,7 +43,7 @@ func TestMySqlUsersRepository_FindUser_WhenTheQueryDoesNotFail(t *testing.T) {
 
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE `users`.`name` = ? LIMIT 1")).
 		WithArgs("userName").
-		WillReturnRows(sqlmock.NewRows(userColumns).AddRow(1, "userName", "hash", true))
+		WillReturnRows(sqlmock.NewRows(userColumns).AddRow(1, "userName", "hash", true, "User Name", "user@name.com", true))
 
 	repo := NewMySqlUsersRepository(db)
 
@@ -51,6 +52,10 @@ func TestMySqlUsersRepository_FindUser_WhenTheQueryDoesNotFail(t *testing.T) {
 	require.NotNil(t, res)
 	assert.Equal(t, "userName", res.Name)
 	assert.True(t, res.IsAdmin)
+	assert.Equal(t, "User Name", res.DisplayName)
+	require.NotNil(t, res.Email)
+	assert.Equal(t, "user@name.com", *res.Email)
+	assert.True(t, res.EmailVerified)
 	assert.Equal(t, int32(1), res.ID)
 	assert.Nil(t, err)
 
@@ -110,8 +115,8 @@ func TestMySqlUsersRepository_GetAll_WhenTheQueryDoesNotFail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users`")).
 		WillReturnRows(sqlmock.NewRows(userColumns).
-			AddRow(11, "user1", "pass1", true).
-			AddRow(12, "user2", "pass2", false))
+			AddRow(11, "user1", "pass1", true, "User 1", "user1@wadus.com", false).
+			AddRow(12, "user2", "pass2", false, "", nil, false))
 
 	repo := NewMySqlUsersRepository(db)
 
@@ -124,6 +129,9 @@ func TestMySqlUsersRepository_GetAll_WhenTheQueryDoesNotFail(t *testing.T) {
 	assert.Equal(t, nvo, res[0].Name)
 	assert.Equal(t, "pass1", res[0].PasswordHash)
 	assert.True(t, res[0].IsAdmin)
+	assert.Equal(t, "User 1", res[0].DisplayName.String())
+	assert.Equal(t, "user1@wadus.com", res[0].Email.String())
+	assert.True(t, res[1].Email.IsEmpty())
 	assert.Equal(t, int32(12), res[1].ID)
 	nvo, _ = domain.NewUserNameValueObject("user2")
 	assert.Equal(t, nvo, res[1].Name)
@@ -137,8 +145,8 @@ func TestMySqlUsersRepository_Create_WhenItFails(t *testing.T) {
 	user := domain.UserRecord{Name: "userName", PasswordHash: "hash", IsAdmin: false}
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`) VALUES (?,?,?)")).
-		WithArgs(user.Name, user.PasswordHash, user.IsAdmin).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`,`displayName`,`email`,`emailVerified`,`oidcProvider`,`oidcSubject`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(user.Name, user.PasswordHash, user.IsAdmin, user.DisplayName, user.Email, user.EmailVerified, user.OidcProvider, user.OidcSubject).
 		WillReturnError(fmt.Errorf("some error"))
 	mock.ExpectRollback()
 
@@ -155,8 +163,8 @@ func TestMySqlUsersRepository_Create_WhenItDoesNotFail(t *testing.T) {
 	user := domain.UserRecord{Name: "userName", PasswordHash: "hash", IsAdmin: false}
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`) VALUES (?,?,?)")).
-		WithArgs(user.Name, user.PasswordHash, user.IsAdmin).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`,`displayName`,`email`,`emailVerified`,`oidcProvider`,`oidcSubject`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(user.Name, user.PasswordHash, user.IsAdmin, user.DisplayName, user.Email, user.EmailVerified, user.OidcProvider, user.OidcSubject).
 		WillReturnResult(sqlmock.NewResult(12, 1))
 	mock.ExpectCommit()
 
@@ -206,8 +214,8 @@ func TestMySqlUsersRepository_Delete_WhenItDoesNotFail(t *testing.T) {
 func TestMySqlUsersRepository_Update_WhenItFails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `name`=?,`passwordHash`=?,`isAdmin`=? WHERE `id` = ?")).
-		WithArgs("userName", "hash", false, 11).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `name`=?,`passwordHash`=?,`isAdmin`=?,`displayName`=?,`email`=?,`emailVerified`=?,`oidcProvider`=?,`oidcSubject`=? WHERE `id` = ?")).
+		WithArgs("userName", "hash", false, "", nil, false, nil, nil, 11).
 		WillReturnError(fmt.Errorf("some error"))
 	mock.ExpectRollback()
 
@@ -223,13 +231,13 @@ func TestMySqlUsersRepository_Update_WhenItFails(t *testing.T) {
 func TestMySqlUsersRepository_Update_WhenItDoesNotFail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `name`=?,`passwordHash`=?,`isAdmin`=? WHERE `id` = ?")).
-		WithArgs("userName", "hash", false, 11).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `name`=?,`passwordHash`=?,`isAdmin`=?,`displayName`=?,`email`=?,`emailVerified`=?,`oidcProvider`=?,`oidcSubject`=? WHERE `id` = ?")).
+		WithArgs("userName", "hash", false, "", nil, false, nil, nil, 11).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`,`id`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`),`passwordHash`=VALUES(`passwordHash`),`isAdmin`=VALUES(`isAdmin`)")).
-		WithArgs("userName", "hash", false, 11).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`,`passwordHash`,`isAdmin`,`displayName`,`email`,`emailVerified`,`oidcProvider`,`oidcSubject`,`id`) VALUES (?,?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `name`=VALUES(`name`),`passwordHash`=VALUES(`passwordHash`),`isAdmin`=VALUES(`isAdmin`),`displayName`=VALUES(`displayName`),`email`=VALUES(`email`),`emailVerified`=VALUES(`emailVerified`),`oidcProvider`=VALUES(`oidcProvider`),`oidcSubject`=VALUES(`oidcSubject`)")).
+		WithArgs("userName", "hash", false, "", nil, false, nil, nil, 11).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
@@ -241,3 +249,185 @@ func TestMySqlUsersRepository_Update_WhenItDoesNotFail(t *testing.T) {
 	assert.Nil(t, err)
 	helpers.CheckSqlMockExpectations(mock, t)
 }
+
+func TestMySqlUsersRepository_FindUserDeletionJob_WhenTheQueryFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userDeletionJobs` WHERE `userDeletionJobs`.`id` = ? LIMIT 1")).
+		WithArgs(5).
+		WillReturnError(fmt.Errorf("some error"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.FindUserDeletionJob(context.Background(), domain.UserDeletionJobRecord{ID: 5})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_FindUserDeletionJob_WhenTheQueryDoesNotFail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userDeletionJobs` WHERE `userDeletionJobs`.`id` = ? LIMIT 1")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "strategy", "status", "step", "progress"}).AddRow(5, 11, "cascade", "running", "Deleting the lists", 40))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.FindUserDeletionJob(context.Background(), domain.UserDeletionJobRecord{ID: 5})
+
+	assert.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, int32(11), res.UserID)
+	assert.Equal(t, domain.UserDeletionStrategyCascade, res.Strategy)
+	assert.Equal(t, domain.UserDeletionJobRunning, res.Status)
+	assert.Equal(t, int32(40), res.Progress)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_CreateUserDeletionJob(t *testing.T) {
+	now := time.Now()
+	job := domain.UserDeletionJobRecord{UserID: 11, Strategy: domain.UserDeletionStrategyCascade, Status: domain.UserDeletionJobPending, CreatedAt: now, UpdatedAt: now}
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `userDeletionJobs` (`userId`,`strategy`,`transferToUserId`,`status`,`step`,`progress`,`error`,`createdAt`,`updatedAt`) VALUES (?,?,?,?,?,?,?,?,?)")).
+		WithArgs(11, "cascade", nil, "pending", "", 0, "", now, now).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlUsersRepository(db)
+
+	err := repo.CreateUserDeletionJob(context.Background(), &job)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), job.ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_GetUnfinishedUserDeletionJobs_WhenTheQueryFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userDeletionJobs` WHERE status IN (?,?) ORDER BY id")).
+		WithArgs("pending", "running").
+		WillReturnError(fmt.Errorf("some error"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.GetUnfinishedUserDeletionJobs(context.Background())
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_GetUnfinishedUserDeletionJobs_WhenTheQueryDoesNotFail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userDeletionJobs` WHERE status IN (?,?) ORDER BY id")).
+		WithArgs("pending", "running").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "status"}).AddRow(5, 11, "pending").AddRow(6, 12, "running"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.GetUnfinishedUserDeletionJobs(context.Background())
+
+	assert.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(5), res[0].ID)
+	assert.Equal(t, domain.UserDeletionJobRunning, res[1].Status)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_FindUserExport_WhenTheQueryFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userExports` WHERE `userExports`.`tokenHash` = ? LIMIT 1")).
+		WithArgs("hash").
+		WillReturnError(fmt.Errorf("some error"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.FindUserExport(context.Background(), domain.UserExportRecord{TokenHash: "hash"})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_FindUserExport_WhenTheQueryDoesNotFail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userExports` WHERE `userExports`.`tokenHash` = ? LIMIT 1")).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "requestedBy", "status", "tokenHash", "fileName"}).AddRow(5, 11, 11, "completed", "hash", "user-exports/5.json"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.FindUserExport(context.Background(), domain.UserExportRecord{TokenHash: "hash"})
+
+	assert.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, int32(5), res.ID)
+	assert.Equal(t, int32(11), res.UserID)
+	assert.Equal(t, domain.UserExportCompleted, res.Status)
+	assert.Equal(t, "user-exports/5.json", res.FileName)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_CreateUserExport(t *testing.T) {
+	now := time.Now()
+	export := domain.UserExportRecord{UserID: 11, RequestedBy: 1, Status: domain.UserExportPending, TokenHash: "hash", ExpirationDate: now, CreatedAt: now, UpdatedAt: now}
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `userExports` (`userId`,`requestedBy`,`status`,`tokenHash`,`fileName`,`error`,`expirationDate`,`createdAt`,`updatedAt`) VALUES (?,?,?,?,?,?,?,?,?)")).
+		WithArgs(11, 1, "pending", "hash", "", "", now, now, now).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlUsersRepository(db)
+
+	err := repo.CreateUserExport(context.Background(), &export)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), export.ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_GetExpiredUserExports_WhenTheQueryFails(t *testing.T) {
+	now := time.Now()
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userExports` WHERE expirationDate <= ? AND fileName <> ''")).
+		WithArgs(now).
+		WillReturnError(fmt.Errorf("some error"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.GetExpiredUserExports(context.Background(), now)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlUsersRepository_GetExpiredUserExports_WhenTheQueryDoesNotFail(t *testing.T) {
+	now := time.Now()
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userExports` WHERE expirationDate <= ? AND fileName <> ''")).
+		WithArgs(now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "fileName"}).AddRow(5, "user-exports/5.json"))
+
+	repo := NewMySqlUsersRepository(db)
+
+	res, err := repo.GetExpiredUserExports(context.Background(), now)
+
+	assert.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, int32(5), res[0].ID)
+	assert.Equal(t, "user-exports/5.json", res[0].FileName)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
@@ -0,0 +1,76 @@
+package subscribers
+
+import (
+	"context"
+	"log"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/honeybadger-io/honeybadger-go"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type UserDeletionProcessor struct {
+	eventName         string
+	eventBus          events.EventBus
+	channel           chan events.DataEvent
+	usersRepo         domain.UsersRepository
+	authRepo          domain.AuthRepository
+	listsRepo         listsDomain.ListsRepository
+	categoriesRepo    listsDomain.CategoriesRepository
+	workspacesRepo    workspacesDomain.WorkspacesRepository
+	tagsRepo          listsDomain.TagsRepository
+	listTemplatesRepo listsDomain.ListTemplatesRepository
+	listsSearchClient search.SearchIndexClient
+	doneFunc          func(err error)
+	newRelicApp       *newrelic.Application
+}
+
+func NewUserDeletionProcessor(eventName string, eventBus events.EventBus, usersRepo domain.UsersRepository, authRepo domain.AuthRepository, listsRepo listsDomain.ListsRepository, categoriesRepo listsDomain.CategoriesRepository, workspacesRepo workspacesDomain.WorkspacesRepository, tagsRepo listsDomain.TagsRepository, listTemplatesRepo listsDomain.ListTemplatesRepository, listsSearchClient search.SearchIndexClient, newRelicApp *newrelic.Application) *UserDeletionProcessor {
+	doneFunc := func(err error) {
+		if err != nil {
+			log.Printf("User deletion failed with error %v", err)
+			honeybadger.Notify(err)
+		}
+	}
+
+	return &UserDeletionProcessor{
+		eventName:         eventName,
+		eventBus:          eventBus,
+		channel:           make(chan events.DataEvent),
+		usersRepo:         usersRepo,
+		authRepo:          authRepo,
+		listsRepo:         listsRepo,
+		categoriesRepo:    categoriesRepo,
+		workspacesRepo:    workspacesRepo,
+		tagsRepo:          tagsRepo,
+		listTemplatesRepo: listTemplatesRepo,
+		listsSearchClient: listsSearchClient,
+		doneFunc:          doneFunc,
+		newRelicApp:       newRelicApp,
+	}
+}
+
+func (s *UserDeletionProcessor) Subscribe() {
+	s.eventBus.Subscribe(s.eventName, s.channel)
+}
+
+func (s *UserDeletionProcessor) Start() {
+	for d := range s.channel {
+		jobID, _ := d.Data.(int32)
+
+		txn := s.newRelicApp.StartTransaction(s.eventName)
+		ctx := newrelic.NewContext(context.Background(), txn)
+
+		srv := application.NewRunUserDeletionJobService(s.usersRepo, s.authRepo, s.listsRepo, s.categoriesRepo, s.workspacesRepo, s.tagsRepo, s.listTemplatesRepo, s.listsSearchClient)
+		err := srv.RunUserDeletionJob(ctx, jobID)
+
+		s.doneFunc(err)
+
+		txn.End()
+	}
+}
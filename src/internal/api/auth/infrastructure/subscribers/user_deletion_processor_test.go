@@ -0,0 +1,181 @@
+package subscribers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	authRepository "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type userDeletionProcessorMocks struct {
+	usersRepo         authRepository.MockedUsersRepository
+	authRepo          authRepository.MockedAuthRepository
+	listsRepo         listsRepository.MockedListsRepository
+	categoriesRepo    listsRepository.MockedCategoriesRepository
+	workspacesRepo    workspacesRepository.MockedWorkspacesRepository
+	tagsRepo          listsRepository.MockedTagsRepository
+	listTemplatesRepo listsRepository.MockedListTemplatesRepository
+	listsSearchClient search.MockedSearchIndexClient
+}
+
+func (m *userDeletionProcessorMocks) assertExpectations(t *testing.T) {
+	m.usersRepo.AssertExpectations(t)
+	m.authRepo.AssertExpectations(t)
+	m.listsRepo.AssertExpectations(t)
+	m.categoriesRepo.AssertExpectations(t)
+	m.workspacesRepo.AssertExpectations(t)
+	m.tagsRepo.AssertExpectations(t)
+	m.listTemplatesRepo.AssertExpectations(t)
+	m.listsSearchClient.AssertExpectations(t)
+}
+
+func (m *userDeletionProcessorMocks) expectJobUpdate(ctx context.Context, status string, step string, progress int32) {
+	m.usersRepo.On("UpdateUserDeletionJob", ctx, mock.MatchedBy(func(r *domain.UserDeletionJobRecord) bool {
+		return r.Status == status && r.Step == step && r.Progress == progress
+	})).Return(nil).Once()
+}
+
+func runUserDeletionProcessor(m *userDeletionProcessorMocks, jobID int32) error {
+	ch := make(chan events.DataEvent)
+	doneChan := make(chan error)
+	f := func(err error) {
+		doneChan <- err
+	}
+	subscriber := &UserDeletionProcessor{
+		channel:           ch,
+		usersRepo:         &m.usersRepo,
+		authRepo:          &m.authRepo,
+		listsRepo:         &m.listsRepo,
+		categoriesRepo:    &m.categoriesRepo,
+		workspacesRepo:    &m.workspacesRepo,
+		tagsRepo:          &m.tagsRepo,
+		listTemplatesRepo: &m.listTemplatesRepo,
+		listsSearchClient: &m.listsSearchClient,
+		doneFunc:          f,
+	}
+
+	go subscriber.Start()
+
+	ch <- events.DataEvent{Data: jobID}
+
+	return <-doneChan
+}
+
+func TestUserDeletionProcessor_Cascade(t *testing.T) {
+	m := userDeletionProcessorMocks{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	job := domain.UserDeletionJobRecord{ID: 5, UserID: 1, Strategy: domain.UserDeletionStrategyCascade, Status: domain.UserDeletionJobPending}
+	m.usersRepo.On("FindUserDeletionJob", ctx, domain.UserDeletionJobRecord{ID: 5}).Return(&job, nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "workspaces", 0)
+	foundWorkspaces := workspacesDomain.UserWorkspaceRecords{
+		{WorkspaceRecord: workspacesDomain.WorkspaceRecord{ID: 10}, Role: workspacesDomain.WorkspaceRoleOwner},
+		{WorkspaceRecord: workspacesDomain.WorkspaceRecord{ID: 11}, Role: workspacesDomain.WorkspaceRoleMember},
+	}
+	m.workspacesRepo.On("GetUserWorkspaces", ctx, int32(1)).Return(foundWorkspaces, nil).Once()
+	m.listsRepo.On("GetLists", ctx, listsDomain.ListRecord{WorkspaceID: 10}).Return(listsDomain.ListRecords{{ID: 20}}, nil).Once()
+	m.listsRepo.On("DeleteList", ctx, listsDomain.ListRecord{ID: 20}).Return(nil).Once()
+	m.listsSearchClient.On("DeleteObject", "20").Return(nil).Once()
+	m.listsRepo.On("DeleteTrashedLists", ctx, listsDomain.ListRecord{WorkspaceID: 10}).Return(nil).Once()
+	m.categoriesRepo.On("DeleteCategories", ctx, listsDomain.CategoryRecord{WorkspaceID: 10}).Return(nil).Once()
+	m.workspacesRepo.On("DeleteWorkspace", ctx, int32(10)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "lists", 20)
+	m.listsRepo.On("GetLists", ctx, listsDomain.ListRecord{UserID: 1}).Return(listsDomain.ListRecords{{ID: 21}}, nil).Once()
+	m.listsRepo.On("DeleteList", ctx, listsDomain.ListRecord{ID: 21}).Return(nil).Once()
+	m.listsSearchClient.On("DeleteObject", "21").Return(nil).Once()
+	m.listsRepo.On("DeleteTrashedLists", ctx, listsDomain.ListRecord{UserID: 1}).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "categories", 40)
+	m.categoriesRepo.On("DeleteCategories", ctx, listsDomain.CategoryRecord{UserID: 1}).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "refreshTokens", 60)
+	m.authRepo.On("DeleteRefreshTokensByUserID", ctx, int32(1)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "user", 80)
+	m.usersRepo.On("Delete", ctx, domain.UserRecord{ID: 1}).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobCompleted, "", 100)
+
+	err := runUserDeletionProcessor(&m, 5)
+
+	assert.Nil(t, err)
+	m.assertExpectations(t)
+}
+
+func TestUserDeletionProcessor_Transfer(t *testing.T) {
+	m := userDeletionProcessorMocks{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	transferToUserID := int32(2)
+	job := domain.UserDeletionJobRecord{ID: 5, UserID: 1, Strategy: domain.UserDeletionStrategyTransfer, TransferToUserID: &transferToUserID, Status: domain.UserDeletionJobPending}
+	m.usersRepo.On("FindUserDeletionJob", ctx, domain.UserDeletionJobRecord{ID: 5}).Return(&job, nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "workspaces", 0)
+	m.usersRepo.On("FindUser", ctx, domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "user1"}, nil).Once()
+	m.workspacesRepo.On("TransferOwnedWorkspaces", ctx, int32(1), int32(2), "Workspace of user1").Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "tags", 14)
+	m.tagsRepo.On("TransferTags", ctx, int32(1), int32(2)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "lists", 28)
+	m.listsRepo.On("GetLists", ctx, listsDomain.ListRecord{UserID: 1}).Return(listsDomain.ListRecords{{ID: 21}}, nil).Once()
+	m.listsRepo.On("TransferLists", ctx, int32(1), int32(2)).Return(nil).Once()
+	m.tagsRepo.On("GetListsOwnerTags", ctx, []int32{21}).Return(listsDomain.TaggedRecords{{ID: 21, Name: "tag1"}}, nil).Once()
+	transferredList := listsDomain.ListRecord{ID: 21, UserID: 2, Name: "list1", Items: []listsDomain.ListItemRecord{{ID: 31, Title: "title1", Description: "desc1"}}}
+	m.listsRepo.On("FindList", ctx, listsDomain.ListRecord{ID: 21}).Return(&transferredList, nil).Once()
+	listDocuments := []listsDomain.ListSearchDocument{
+		{ObjectID: "21", UserID: 2, Name: "list1", ItemsTitles: []string{"title1"}, ItemsDescriptions: []string{"desc1"}, Tags: []string{"tag1"}},
+	}
+	m.listsSearchClient.On("SaveObjects", listDocuments).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "categories", 42)
+	m.categoriesRepo.On("TransferCategories", ctx, int32(1), int32(2)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "templates", 57)
+	m.listTemplatesRepo.On("TransferListTemplates", ctx, int32(1), int32(2)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "refreshTokens", 71)
+	m.authRepo.On("DeleteRefreshTokensByUserID", ctx, int32(1)).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "user", 85)
+	m.usersRepo.On("Delete", ctx, domain.UserRecord{ID: 1}).Return(nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobCompleted, "", 100)
+
+	err := runUserDeletionProcessor(&m, 5)
+
+	assert.Nil(t, err)
+	m.assertExpectations(t)
+}
+
+func TestUserDeletionProcessor_Marks_The_Job_As_Failed_When_A_Step_Fails(t *testing.T) {
+	m := userDeletionProcessorMocks{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	job := domain.UserDeletionJobRecord{ID: 5, UserID: 1, Strategy: domain.UserDeletionStrategyCascade, Status: domain.UserDeletionJobPending}
+	m.usersRepo.On("FindUserDeletionJob", ctx, domain.UserDeletionJobRecord{ID: 5}).Return(&job, nil).Once()
+
+	m.expectJobUpdate(ctx, domain.UserDeletionJobRunning, "workspaces", 0)
+	m.workspacesRepo.On("GetUserWorkspaces", ctx, int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+	m.usersRepo.On("UpdateUserDeletionJob", ctx, mock.MatchedBy(func(r *domain.UserDeletionJobRecord) bool {
+		return r.Status == domain.UserDeletionJobFailed && r.Step == "workspaces" && r.Error == "error getting the user workspaces: some error"
+	})).Return(nil).Once()
+
+	err := runUserDeletionProcessor(&m, 5)
+
+	assert.EqualError(t, err, "error getting the user workspaces: some error")
+	m.assertExpectations(t)
+}
@@ -0,0 +1,72 @@
+package subscribers
+
+import (
+	"context"
+	"log"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	"github.com/honeybadger-io/honeybadger-go"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type UserExportProcessor struct {
+	eventName      string
+	eventBus       events.EventBus
+	channel        chan events.DataEvent
+	usersRepo      domain.UsersRepository
+	authRepo       domain.AuthRepository
+	listsRepo      listsDomain.ListsRepository
+	categoriesRepo listsDomain.CategoriesRepository
+	auditRepo      audit.AuditLogRepository
+	storage        storage.Storage
+	doneFunc       func(err error)
+	newRelicApp    *newrelic.Application
+}
+
+func NewUserExportProcessor(eventName string, eventBus events.EventBus, usersRepo domain.UsersRepository, authRepo domain.AuthRepository, listsRepo listsDomain.ListsRepository, categoriesRepo listsDomain.CategoriesRepository, auditRepo audit.AuditLogRepository, storage storage.Storage, newRelicApp *newrelic.Application) *UserExportProcessor {
+	doneFunc := func(err error) {
+		if err != nil {
+			log.Printf("User export failed with error %v", err)
+			honeybadger.Notify(err)
+		}
+	}
+
+	return &UserExportProcessor{
+		eventName:      eventName,
+		eventBus:       eventBus,
+		channel:        make(chan events.DataEvent),
+		usersRepo:      usersRepo,
+		authRepo:       authRepo,
+		listsRepo:      listsRepo,
+		categoriesRepo: categoriesRepo,
+		auditRepo:      auditRepo,
+		storage:        storage,
+		doneFunc:       doneFunc,
+		newRelicApp:    newRelicApp,
+	}
+}
+
+func (s *UserExportProcessor) Subscribe() {
+	s.eventBus.Subscribe(s.eventName, s.channel)
+}
+
+func (s *UserExportProcessor) Start() {
+	for d := range s.channel {
+		exportID, _ := d.Data.(int32)
+
+		txn := s.newRelicApp.StartTransaction(s.eventName)
+		ctx := newrelic.NewContext(context.Background(), txn)
+
+		srv := application.NewRunUserExportService(s.usersRepo, s.authRepo, s.listsRepo, s.categoriesRepo, s.auditRepo, s.storage)
+		err := srv.RunUserExport(ctx, exportID)
+
+		s.doneFunc(err)
+
+		txn.End()
+	}
+}
@@ -0,0 +1,143 @@
+package subscribers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	authRepository "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type userExportProcessorMocks struct {
+	usersRepo      authRepository.MockedUsersRepository
+	authRepo       authRepository.MockedAuthRepository
+	listsRepo      listsRepository.MockedListsRepository
+	categoriesRepo listsRepository.MockedCategoriesRepository
+	auditRepo      sharedRepository.MockedAuditLogRepository
+	storage        storage.MockedStorage
+}
+
+func (m *userExportProcessorMocks) assertExpectations(t *testing.T) {
+	m.usersRepo.AssertExpectations(t)
+	m.authRepo.AssertExpectations(t)
+	m.listsRepo.AssertExpectations(t)
+	m.categoriesRepo.AssertExpectations(t)
+	m.auditRepo.AssertExpectations(t)
+	m.storage.AssertExpectations(t)
+}
+
+func (m *userExportProcessorMocks) expectExportUpdate(ctx context.Context, status string) {
+	m.usersRepo.On("UpdateUserExport", ctx, mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.Status == status
+	})).Return(nil).Once()
+}
+
+func runUserExportProcessor(m *userExportProcessorMocks, exportID int32) error {
+	ch := make(chan events.DataEvent)
+	doneChan := make(chan error)
+	f := func(err error) {
+		doneChan <- err
+	}
+	subscriber := &UserExportProcessor{
+		channel:        ch,
+		usersRepo:      &m.usersRepo,
+		authRepo:       &m.authRepo,
+		listsRepo:      &m.listsRepo,
+		categoriesRepo: &m.categoriesRepo,
+		auditRepo:      &m.auditRepo,
+		storage:        &m.storage,
+		doneFunc:       f,
+	}
+
+	go subscriber.Start()
+
+	ch <- events.DataEvent{Data: exportID}
+
+	return <-doneChan
+}
+
+func TestUserExportProcessor_Saves_The_Archive(t *testing.T) {
+	m := userExportProcessorMocks{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	expiredExport := domain.UserExportRecord{ID: 4, UserID: 2, Status: domain.UserExportCompleted, FileName: "user-exports/4.json"}
+	m.usersRepo.On("GetExpiredUserExports", ctx, mock.AnythingOfType("time.Time")).Return([]*domain.UserExportRecord{&expiredExport}, nil).Once()
+	m.storage.On("Delete", ctx, "user-exports/4.json").Return(nil).Once()
+	m.usersRepo.On("UpdateUserExport", ctx, mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.ID == 4 && r.FileName == ""
+	})).Return(nil).Once()
+
+	export := domain.UserExportRecord{ID: 5, UserID: 1, Status: domain.UserExportPending}
+	m.usersRepo.On("FindUserExport", ctx, domain.UserExportRecord{ID: 5}).Return(&export, nil).Once()
+	m.expectExportUpdate(ctx, domain.UserExportRunning)
+
+	m.usersRepo.On("FindUser", ctx, domain.UserRecord{ID: 1}).Return(&domain.UserRecord{ID: 1, Name: "user1", PasswordHash: "hash"}, nil).Once()
+	m.listsRepo.On("GetLists", ctx, listsDomain.ListRecord{UserID: 1}).Return(listsDomain.ListRecords{{ID: 21}}, nil).Once()
+	m.listsRepo.On("FindList", ctx, listsDomain.ListRecord{ID: 21}).Return(&listsDomain.ListRecord{ID: 21, UserID: 1, Name: "list1", Items: []listsDomain.ListItemRecord{{ID: 31, Title: "title1"}}}, nil).Once()
+	m.categoriesRepo.On("GetCategories", ctx, listsDomain.CategoryRecord{UserID: 1}).Return(listsDomain.CategoryRecords{{ID: 41, Name: "category1"}}, nil).Once()
+	m.authRepo.On("GetRefreshTokensByUserID", ctx, int32(1)).Return([]*domain.RefreshTokenEntity{{ID: 51, RefreshToken: "token"}}, nil).Once()
+
+	userID := int32(1)
+	pagInfo := sharedDomain.NewPaginationInfo(-1, 0, "id", sharedDomain.OrderAsc)
+	m.auditRepo.On("GetAll", ctx, audit.AuditLogFilter{ActorID: &userID}, pagInfo).Return([]*audit.AuditLogEntryEntity{{ID: 62, Action: audit.ActionUserUpdated, Diff: "{}"}, {ID: 61, Action: audit.ActionUserCreated, Diff: "{}"}}, nil).Once()
+	m.auditRepo.On("GetAll", ctx, audit.AuditLogFilter{TargetType: audit.TargetUser, TargetID: &userID}, pagInfo).Return([]*audit.AuditLogEntryEntity{{ID: 62, Action: audit.ActionUserUpdated, Diff: "{}"}}, nil).Once()
+
+	var saved []byte
+	m.storage.On("Save", ctx, "user-exports/5.json", mock.AnythingOfType("[]uint8")).Return(nil).Once().Run(func(args mock.Arguments) {
+		saved = args.Get(2).([]byte)
+	})
+	m.expectExportUpdate(ctx, domain.UserExportCompleted)
+
+	err := runUserExportProcessor(&m, 5)
+
+	assert.Nil(t, err)
+	m.assertExpectations(t)
+
+	archive := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(saved, &archive))
+	assert.Equal(t, "user1", archive["profile"].(map[string]interface{})["name"])
+	assert.NotContains(t, string(saved), "hash")
+	assert.NotContains(t, string(saved), `"token"`)
+	assert.Len(t, archive["lists"], 1)
+	assert.Len(t, archive["lists"].([]interface{})[0].(map[string]interface{})["items"], 1)
+	assert.Len(t, archive["categories"], 1)
+	assert.Len(t, archive["sessions"], 1)
+	auditEntries := archive["auditEntries"].([]interface{})
+	require.Len(t, auditEntries, 2)
+	assert.Equal(t, float64(61), auditEntries[0].(map[string]interface{})["id"])
+	assert.Equal(t, float64(62), auditEntries[1].(map[string]interface{})["id"])
+}
+
+func TestUserExportProcessor_Marks_The_Export_As_Failed_When_Building_The_Archive_Fails(t *testing.T) {
+	m := userExportProcessorMocks{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	m.usersRepo.On("GetExpiredUserExports", ctx, mock.AnythingOfType("time.Time")).Return(nil, fmt.Errorf("some error")).Once()
+
+	export := domain.UserExportRecord{ID: 5, UserID: 1, Status: domain.UserExportPending, ExpirationDate: time.Now()}
+	m.usersRepo.On("FindUserExport", ctx, domain.UserExportRecord{ID: 5}).Return(&export, nil).Once()
+	m.expectExportUpdate(ctx, domain.UserExportRunning)
+	m.usersRepo.On("FindUser", ctx, domain.UserRecord{ID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	m.usersRepo.On("UpdateUserExport", ctx, mock.MatchedBy(func(r *domain.UserExportRecord) bool {
+		return r.Status == domain.UserExportFailed && r.Error == "error getting the user: some error"
+	})).Return(nil).Once()
+
+	err := runUserExportProcessor(&m, 5)
+
+	assert.EqualError(t, err, "error getting the user: some error")
+	m.assertExpectations(t)
+}
@@ -0,0 +1,32 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+type UpdateEmailInput struct {
+	Email domain.UserEmailValueObject `json:"email"`
+}
+
+func (i *UpdateEmailInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Email string `json:"email"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	evo, err := domain.NewUserEmailValueObject(realInput.Email)
+	if err != nil {
+		return err
+	}
+
+	*i = UpdateEmailInput{
+		Email: evo,
+	}
+
+	return nil
+}
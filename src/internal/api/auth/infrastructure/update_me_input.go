@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+type UpdateMeInput struct {
+	Name        domain.UserNameValueObject        `json:"name"`
+	DisplayName domain.UserDisplayNameValueObject `json:"displayName"`
+}
+
+func (i *UpdateMeInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	nvo, err := domain.NewUserNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	dnvo, err := domain.NewUserDisplayNameValueObject(realInput.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	*i = UpdateMeInput{
+		Name:        nvo,
+		DisplayName: dnvo,
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package infrastructure
+
+import (
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+)
+
+// UserExportResponse is the struct used to send the export info. The download url is only
+// sent when the export is requested because the token isn't stored
+type UserExportResponse struct {
+	ID             int32     `json:"id"`
+	UserID         int32     `json:"userId"`
+	Status         string    `json:"status"`
+	DownloadUrl    string    `json:"downloadUrl"`
+	ExpirationDate time.Time `json:"expirationDate"`
+}
+
+func NewUserExportResponse(export *domain.UserExportEntity, token string) *UserExportResponse {
+	return &UserExportResponse{
+		ID:             export.ID,
+		UserID:         export.UserID,
+		Status:         export.Status,
+		DownloadUrl:    "/exports/" + token,
+		ExpirationDate: export.ExpirationDate,
+	}
+}
@@ -2,7 +2,10 @@ package infrastructure
 
 // UserResponse is the struct used to send user info
 type UserResponse struct {
-	ID      int32  `json:"id"`
-	Name    string `json:"name"`
-	IsAdmin bool   `json:"isAdmin"`
+	ID            int32  `json:"id"`
+	Name          string `json:"name"`
+	DisplayName   string `json:"displayName"`
+	IsAdmin       bool   `json:"isAdmin"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"emailVerified"`
 }
@@ -0,0 +1,31 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type VerifyEmailInput struct {
+	Token string `json:"token"`
+}
+
+func (i *VerifyEmailInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Token string `json:"token"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	if len(realInput.Token) == 0 {
+		return &appErrors.BadRequestError{Msg: "The token can not be empty"}
+	}
+
+	*i = VerifyEmailInput{
+		Token: realInput.Token,
+	}
+
+	return nil
+}
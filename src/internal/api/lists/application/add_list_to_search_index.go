@@ -10,11 +10,12 @@ import (
 
 type AddListToSearchIndexService struct {
 	repo         domain.ListsRepository
+	tagsRepo     domain.TagsRepository
 	searchClient search.SearchIndexClient
 }
 
-func NewAddListToSearchIndexService(repo domain.ListsRepository, searchClient search.SearchIndexClient) *AddListToSearchIndexService {
-	return &AddListToSearchIndexService{repo, searchClient}
+func NewAddListToSearchIndexService(repo domain.ListsRepository, tagsRepo domain.TagsRepository, searchClient search.SearchIndexClient) *AddListToSearchIndexService {
+	return &AddListToSearchIndexService{repo, tagsRepo, searchClient}
 }
 
 func (s *AddListToSearchIndexService) AddListToSearchIndexService(ctx context.Context, listID int32) error {
@@ -23,5 +24,10 @@ func (s *AddListToSearchIndexService) AddListToSearchIndexService(ctx context.Co
 		return &errors.UnexpectedError{Msg: "Error getting the list", InternalError: err}
 	}
 
-	return s.searchClient.SaveObjects(foundList.ToListSearchDocument())
+	documents, err := toListSearchDocuments(ctx, s.tagsRepo, domain.ListRecords{*foundList})
+	if err != nil {
+		return err
+	}
+
+	return s.searchClient.SaveObjects(documents[0])
 }
@@ -0,0 +1,18 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// getCategoryTree returns the hierarchy of all the categories of the workspace
+func getCategoryTree(ctx context.Context, repo domain.CategoriesRepository, workspaceID int32) (*domain.CategoryTree, error) {
+	foundCategories, err := repo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user categories", InternalError: err}
+	}
+
+	return domain.NewCategoryTree(foundCategories), nil
+}
@@ -0,0 +1,77 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CreateCalendarFeedService struct {
+	repo           domain.CalendarFeedsRepository
+	listsRepo      domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+}
+
+func NewCreateCalendarFeedService(repo domain.CalendarFeedsRepository, listsRepo domain.ListsRepository, categoriesRepo domain.CategoriesRepository) *CreateCalendarFeedService {
+	return &CreateCalendarFeedService{repo, listsRepo, categoriesRepo}
+}
+
+// CreateCalendarFeed creates the feed with a new token, which is returned in the feed together
+// with its url. The feed can be filtered by a category, including its subcategories, or by a list
+func (s *CreateCalendarFeedService) CreateCalendarFeed(ctx context.Context, feedToCreate *domain.CalendarFeedEntity) error {
+	if err := s.checkFilters(ctx, feedToCreate); err != nil {
+		return err
+	}
+
+	token, tokenHash, err := domain.NewCalendarFeedToken()
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error generating the calendar feed token", InternalError: err}
+	}
+
+	record := feedToCreate.ToCalendarFeedRecord()
+	record.TokenHash = tokenHash
+	record.CreatedAt = time.Now()
+
+	if err := s.repo.CreateCalendarFeed(ctx, record); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error creating the calendar feed", InternalError: err}
+	}
+
+	feedToCreate.ID = record.ID
+	feedToCreate.CreatedAt = record.CreatedAt
+	feedToCreate.Token = token
+	feedToCreate.Url = domain.CalendarFeedUrl(token)
+
+	return nil
+}
+
+func (s *CreateCalendarFeedService) checkFilters(ctx context.Context, feed *domain.CalendarFeedEntity) error {
+	if feed.CategoryID != nil && feed.ListID != nil {
+		return &appErrors.BadRequestError{Msg: "A calendar feed can be filtered by a category or by a list, but not by both"}
+	}
+
+	if feed.CategoryID != nil {
+		exists, err := s.categoriesRepo.ExistsCategory(ctx, domain.CategoryRecord{ID: *feed.CategoryID, WorkspaceID: feed.WorkspaceID})
+		if err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error checking if the category exists", InternalError: err}
+		}
+
+		if !exists {
+			return &appErrors.BadRequestError{Msg: "The category doesn't exist"}
+		}
+	}
+
+	if feed.ListID != nil {
+		exists, err := s.listsRepo.ExistsList(ctx, domain.ListRecord{ID: *feed.ListID, WorkspaceID: feed.WorkspaceID})
+		if err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error checking if the list exists", InternalError: err}
+		}
+
+		if !exists {
+			return &appErrors.BadRequestError{Msg: "The list doesn't exist"}
+		}
+	}
+
+	return nil
+}
@@ -4,32 +4,63 @@ import (
 	"context"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
 type CreateCategoryService struct {
-	repo domain.CategoriesRepository
+	repo       domain.CategoriesRepository
+	quotasRepo domain.QuotasRepository
+	cfgSrv     sharedApp.ConfigurationService
 }
 
-func NewCreateCategoryService(repo domain.CategoriesRepository) *CreateCategoryService {
-	return &CreateCategoryService{repo}
+func NewCreateCategoryService(repo domain.CategoriesRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService) *CreateCategoryService {
+	return &CreateCategoryService{repo, quotasRepo, cfgSrv}
 }
 
 func (s *CreateCategoryService) CreateCategory(ctx context.Context, categoryToCreate *domain.CategoryEntity) error {
-	if existsCategory, err := s.repo.ExistsCategory(ctx, domain.CategoryRecord{Name: categoryToCreate.Name.String(), UserID: categoryToCreate.UserID}); err != nil {
-		return &appErrors.UnexpectedError{Msg: "Error checking if a category with the same name already exists", InternalError: err}
-	} else if existsCategory {
+	tree, err := getCategoryTree(ctx, s.repo, categoryToCreate.WorkspaceID)
+	if err != nil {
+		return err
+	}
+
+	if err := tree.CheckParent(0, categoryToCreate.ParentID); err != nil {
+		return err
+	}
+
+	if tree.HasSiblingNamed(categoryToCreate.Name.String(), categoryToCreate.ParentID, 0) {
 		return &appErrors.BadRequestError{Msg: "A category with the same name already exists", InternalError: nil}
 	}
 
+	limits, err := getQuotaLimits(ctx, s.quotasRepo, s.cfgSrv, categoryToCreate.UserID)
+	if err != nil {
+		return err
+	}
+
+	categoriesCount, err := s.repo.CountCategories(ctx, domain.CategoryRecord{UserID: categoryToCreate.UserID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error counting the user categories", InternalError: err}
+	}
+
+	if err := limits.CheckNewCategory(categoriesCount); err != nil {
+		return err
+	}
+
+	categoryToCreate.Position = tree.NextPosition()
 	record := categoryToCreate.ToCategoryRecord()
 
-	err := s.repo.CreateCategory(ctx, record)
+	err = s.repo.CreateCategory(ctx, record)
 	if err != nil {
 		return &appErrors.UnexpectedError{Msg: "Error creating the user category", InternalError: err}
 	}
 
 	categoryToCreate.ID = record.ID
 
+	if categoryToCreate.IsDefault {
+		if err := s.repo.SetDefaultCategory(ctx, categoryToCreate.UserID, categoryToCreate.WorkspaceID, &record.ID); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error setting the default category", InternalError: err}
+		}
+	}
+
 	return nil
 }
@@ -5,26 +5,51 @@ import (
 	"log"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 )
 
 type CreateListService struct {
-	repo     domain.ListsRepository
-	eventBus events.EventBus
+	repo           domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	tagsRepo       domain.TagsRepository
+	quotasRepo     domain.QuotasRepository
+	cfgSrv         sharedApp.ConfigurationService
+	eventBus       events.EventBus
 }
 
-func NewCreateListService(repo domain.ListsRepository, eventBus events.EventBus) *CreateListService {
-	return &CreateListService{repo, eventBus}
+func NewCreateListService(repo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, tagsRepo domain.TagsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *CreateListService {
+	return &CreateListService{repo, categoriesRepo, tagsRepo, quotasRepo, cfgSrv, eventBus}
+}
+
+// NewListError is the reason why the list in the Index position of a batch can't be created
+type NewListError struct {
+	Index int
+	Err   error
 }
 
 func (s *CreateListService) CreateList(ctx context.Context, listToCreate *domain.ListEntity) error {
-	if existsList, err := s.repo.ExistsList(ctx, domain.ListRecord{Name: listToCreate.Name.String(), UserID: listToCreate.UserID}); err != nil {
-		return &appErrors.UnexpectedError{Msg: "Error checking if a list with the same name already exists", InternalError: err}
+	if existsList, err := s.existsList(ctx, listToCreate); err != nil {
+		return err
 	} else if existsList {
 		return &appErrors.BadRequestError{Msg: "A list with the same name already exists", InternalError: nil}
 	}
 
+	if err := s.checkQuota(ctx, listToCreate); err != nil {
+		return err
+	}
+
+	// The list gets the default category of the user when it doesn't have one
+	if listToCreate.CategoryID == nil {
+		defaultCategoryID, err := s.findDefaultCategoryID(ctx, listToCreate)
+		if err != nil {
+			return err
+		}
+
+		listToCreate.CategoryID = defaultCategoryID
+	}
+
 	record := listToCreate.ToListRecord()
 
 	err := s.repo.CreateList(ctx, record)
@@ -34,13 +59,142 @@ func (s *CreateListService) CreateList(ctx context.Context, listToCreate *domain
 
 	log.Println(record)
 
+	return s.listCreated(ctx, listToCreate, record)
+}
+
+// CreateLists checks the lists of a batch, which belong to the same user and workspace, like
+// CreateList does, considering that the lists before each one are created too. When there
+// aren't errors and it isn't a dry run, all the lists are created in one transaction and their
+// events are published after it, so there aren't events of lists that haven't been created
+func (s *CreateListService) CreateLists(ctx context.Context, listsToCreate []*domain.ListEntity, dryRun bool) ([]NewListError, error) {
+	listErrors, err := s.checkLists(ctx, listsToCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun || len(listErrors) > 0 || len(listsToCreate) == 0 {
+		return listErrors, nil
+	}
+
+	// The default category is the same for all the lists because they are of the same user and workspace
+	defaultCategoryID, err := s.findDefaultCategoryID(ctx, listsToCreate[0])
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*domain.ListRecord, len(listsToCreate))
+	for i, v := range listsToCreate {
+		if v.CategoryID == nil {
+			v.CategoryID = defaultCategoryID
+		}
+
+		records[i] = v.ToListRecord()
+	}
+
+	if err := s.repo.CreateLists(ctx, records); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error creating the user lists", InternalError: err}
+	}
+
+	for i, v := range listsToCreate {
+		if err := s.listCreated(ctx, v, records[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return listErrors, nil
+}
+
+func (s *CreateListService) checkLists(ctx context.Context, listsToCreate []*domain.ListEntity) ([]NewListError, error) {
+	listErrors := []NewListError{}
+	if len(listsToCreate) == 0 {
+		return listErrors, nil
+	}
+
+	limits, listsCount, err := s.getQuotaUsage(ctx, listsToCreate[0].UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, v := range listsToCreate {
+		existsList, err := s.existsList(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if existsList {
+			listErrors = append(listErrors, NewListError{i, &appErrors.BadRequestError{Msg: "A list with the same name already exists", InternalError: nil}})
+		}
+
+		if err := limits.CheckNewList(listsCount + int64(i)); err != nil {
+			listErrors = append(listErrors, NewListError{i, err})
+		}
+
+		if err := limits.CheckItems(len(v.Items)); err != nil {
+			listErrors = append(listErrors, NewListError{i, err})
+		}
+	}
+
+	return listErrors, nil
+}
+
+func (s *CreateListService) existsList(ctx context.Context, listToCreate *domain.ListEntity) (bool, error) {
+	existsList, err := s.repo.ExistsList(ctx, domain.ListRecord{Name: listToCreate.Name.String(), WorkspaceID: listToCreate.WorkspaceID})
+	if err != nil {
+		return false, &appErrors.UnexpectedError{Msg: "Error checking if a list with the same name already exists", InternalError: err}
+	}
+
+	return existsList, nil
+}
+
+func (s *CreateListService) findDefaultCategoryID(ctx context.Context, listToCreate *domain.ListEntity) (*int32, error) {
+	defaultCategoryID, err := s.categoriesRepo.FindDefaultCategoryID(ctx, listToCreate.UserID, listToCreate.WorkspaceID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the default category", InternalError: err}
+	}
+
+	return defaultCategoryID, nil
+}
+
+// listCreated sets the ids of the created record in the list, saves its tags and publishes its event
+func (s *CreateListService) listCreated(ctx context.Context, listToCreate *domain.ListEntity, record *domain.ListRecord) error {
 	listToCreate.ID = record.ID
 
 	for i, v := range listToCreate.Items {
 		v.ID = record.Items[i].ID
 	}
 
-	go s.eventBus.Publish(events.ListCreated, record.ID)
+	if err := saveListTags(ctx, s.tagsRepo, listToCreate.UserID, listToCreate); err != nil {
+		return err
+	}
+
+	go s.eventBus.Publish(events.ListCreated, domain.NewListEvent(record))
 
 	return nil
 }
+
+func (s *CreateListService) checkQuota(ctx context.Context, listToCreate *domain.ListEntity) error {
+	limits, listsCount, err := s.getQuotaUsage(ctx, listToCreate.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := limits.CheckNewList(listsCount); err != nil {
+		return err
+	}
+
+	return limits.CheckItems(len(listToCreate.Items))
+}
+
+func (s *CreateListService) getQuotaUsage(ctx context.Context, userID int32) (domain.QuotaLimits, int64, error) {
+	limits, err := getQuotaLimits(ctx, s.quotasRepo, s.cfgSrv, userID)
+	if err != nil {
+		return domain.QuotaLimits{}, 0, err
+	}
+
+	listsCount, err := s.repo.CountLists(ctx, domain.ListRecord{UserID: userID})
+	if err != nil {
+		return domain.QuotaLimits{}, 0, &appErrors.UnexpectedError{Msg: "Error counting the user lists", InternalError: err}
+	}
+
+	return limits, listsCount, nil
+}
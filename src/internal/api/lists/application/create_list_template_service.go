@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CreateListTemplateService struct {
+	repo domain.ListTemplatesRepository
+}
+
+func NewCreateListTemplateService(repo domain.ListTemplatesRepository) *CreateListTemplateService {
+	return &CreateListTemplateService{repo}
+}
+
+func (s *CreateListTemplateService) CreateListTemplate(ctx context.Context, templateToCreate *domain.ListTemplateEntity) error {
+	if existsTemplate, err := s.repo.ExistsListTemplate(ctx, domain.ListTemplateRecord{UserID: templateToCreate.UserID, Name: templateToCreate.Name.String()}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if a template with the same name already exists", InternalError: err}
+	} else if existsTemplate {
+		return &appErrors.BadRequestError{Msg: "A template with the same name already exists"}
+	}
+
+	templateToCreate.CreatedAt = time.Now()
+
+	record, err := templateToCreate.ToListTemplateRecord()
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error encoding the template items", InternalError: err}
+	}
+
+	if err := s.repo.CreateListTemplate(ctx, record); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error creating the template", InternalError: err}
+	}
+
+	templateToCreate.ID = record.ID
+	templateToCreate.Variables = domain.TemplateVariables(templateToCreate.Items)
+
+	return nil
+}
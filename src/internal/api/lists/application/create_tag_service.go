@@ -0,0 +1,38 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CreateTagService struct {
+	repo domain.TagsRepository
+}
+
+func NewCreateTagService(repo domain.TagsRepository) *CreateTagService {
+	return &CreateTagService{repo}
+}
+
+func (s *CreateTagService) CreateTag(ctx context.Context, tagToCreate *domain.TagEntity) error {
+	exists, err := s.repo.ExistsTag(ctx, domain.TagRecord{UserID: tagToCreate.UserID, Name: tagToCreate.Name.String()})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if a tag with the same name already exists", InternalError: err}
+	}
+
+	if exists {
+		return &appErrors.BadRequestError{Msg: "A tag with the same name already exists", InternalError: nil}
+	}
+
+	record := tagToCreate.ToTagRecord()
+
+	err = s.repo.CreateTag(ctx, record)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error creating the user tag", InternalError: err}
+	}
+
+	tagToCreate.ID = record.ID
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type DeleteCalendarFeedService struct {
+	repo domain.CalendarFeedsRepository
+}
+
+func NewDeleteCalendarFeedService(repo domain.CalendarFeedsRepository) *DeleteCalendarFeedService {
+	return &DeleteCalendarFeedService{repo}
+}
+
+// DeleteCalendarFeed revokes the feed, so its url stops working
+func (s *DeleteCalendarFeedService) DeleteCalendarFeed(ctx context.Context, feedID int32, userID int32, workspaceID int32) error {
+	query := domain.CalendarFeedRecord{ID: feedID, UserID: userID, WorkspaceID: workspaceID}
+
+	if _, err := s.repo.FindCalendarFeed(ctx, query); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteCalendarFeed(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the calendar feed", InternalError: err}
+	}
+
+	return nil
+}
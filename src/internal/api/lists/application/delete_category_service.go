@@ -2,28 +2,127 @@ package application
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 )
 
 type DeleteCategoryService struct {
-	repo domain.CategoriesRepository
+	repo      domain.CategoriesRepository
+	listsRepo domain.ListsRepository
+	eventBus  events.EventBus
 }
 
-func NewDeleteCategoryService(repo domain.CategoriesRepository) *DeleteCategoryService {
-	return &DeleteCategoryService{repo}
+func NewDeleteCategoryService(repo domain.CategoriesRepository, listsRepo domain.ListsRepository, eventBus events.EventBus) *DeleteCategoryService {
+	return &DeleteCategoryService{repo, listsRepo, eventBus}
 }
 
-func (s *DeleteCategoryService) DeleteCategory(ctx context.Context, categoryID int32, userID int32) error {
-	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, UserID: userID})
+// DeleteCategory deletes the category and applies the strategy to its lists. The strategy is
+// only optional when the category doesn't have lists
+func (s *DeleteCategoryService) DeleteCategory(ctx context.Context, categoryID int32, workspaceID int32, strategy string, targetCategoryID *int32) error {
+	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, WorkspaceID: workspaceID})
 	if err != nil {
 		return err
 	}
 
-	if err := s.repo.DeleteCategory(ctx, *foundCategory); err != nil {
+	// The subcategories must be moved or deleted before, so they don't become root categories
+	// without noticing it
+	hasSubcategories, err := s.repo.ExistsCategory(ctx, domain.CategoryRecord{ParentID: &sql.NullInt32{Int32: categoryID, Valid: true}, WorkspaceID: workspaceID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the category has subcategories", InternalError: err}
+	}
+
+	if hasSubcategories {
+		return &appErrors.BadRequestError{Msg: "The category has subcategories"}
+	}
+
+	if len(strategy) == 0 {
+		hasLists, err := s.listsRepo.ExistsList(ctx, domain.ListRecord{CategoryID: &sql.NullInt32{Int32: categoryID, Valid: true}, WorkspaceID: workspaceID})
+		if err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error checking if the category has lists", InternalError: err}
+		}
+
+		if hasLists {
+			return &appErrors.BadRequestError{Msg: "The category has lists so a strategy is required"}
+		}
+
+		if err := s.repo.DeleteCategory(ctx, *foundCategory); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error deleting the user category", InternalError: err}
+		}
+
+		return nil
+	}
+
+	strategy, err = domain.NewCategoryDeletionStrategy(strategy)
+	if err != nil {
+		return &appErrors.BadRequestError{Msg: err.Error()}
+	}
+
+	if err := s.checkTargetCategory(ctx, categoryID, workspaceID, strategy, targetCategoryID); err != nil {
+		return err
+	}
+
+	// The trashed lists aren't visible anymore, so they are removed like the deleted ones
+	if strategy == domain.CategoryDeletionStrategyCascade {
+		trashedLists, err := s.repo.TrashListsAndDeleteCategory(ctx, categoryID)
+		if err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error deleting the user category", InternalError: err}
+		}
+
+		for i := range trashedLists {
+			go s.eventBus.Publish(events.ListDeleted, domain.NewListEvent(&trashedLists[i]))
+		}
+
+		return nil
+	}
+
+	movedLists, err := s.repo.ReassignAndDeleteCategory(ctx, categoryID, targetCategoryID)
+	if err != nil {
 		return &appErrors.UnexpectedError{Msg: "Error deleting the user category", InternalError: err}
 	}
 
+	var newCategoryID *sql.NullInt32
+	if targetCategoryID != nil {
+		newCategoryID = &sql.NullInt32{Int32: *targetCategoryID, Valid: true}
+	}
+
+	for i := range movedLists {
+		updatedList := movedLists[i]
+		updatedList.CategoryID = newCategoryID
+
+		go s.eventBus.Publish(events.ListUpdated, domain.NewListUpdatedEvent(&movedLists[i], &updatedList))
+	}
+
+	return nil
+}
+
+func (s *DeleteCategoryService) checkTargetCategory(ctx context.Context, categoryID int32, workspaceID int32, strategy string, targetCategoryID *int32) error {
+	if strategy != domain.CategoryDeletionStrategyReassign {
+		if targetCategoryID != nil {
+			return &appErrors.BadRequestError{Msg: "The target category can only be set with the reassign strategy"}
+		}
+
+		return nil
+	}
+
+	if targetCategoryID == nil {
+		return &appErrors.BadRequestError{Msg: "The target category is required"}
+	}
+
+	if *targetCategoryID == categoryID {
+		return &appErrors.BadRequestError{Msg: "The lists can't be reassigned to the category being deleted"}
+	}
+
+	exists, err := s.repo.ExistsCategory(ctx, domain.CategoryRecord{ID: *targetCategoryID, WorkspaceID: workspaceID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the category exists", InternalError: err}
+	}
+
+	if !exists {
+		return &appErrors.BadRequestError{Msg: "The target category doesn't exist"}
+	}
+
 	return nil
 }
@@ -17,8 +17,8 @@ func NewDeleteListService(repo domain.ListsRepository, eventBus events.EventBus)
 	return &DeleteListService{repo, eventBus}
 }
 
-func (s *DeleteListService) DeleteList(ctx context.Context, listID int32, userID int32) error {
-	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listID, UserID: userID})
+func (s *DeleteListService) DeleteList(ctx context.Context, listID int32, workspaceID int32) error {
+	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
 	if err != nil {
 		return err
 	}
@@ -27,7 +27,7 @@ func (s *DeleteListService) DeleteList(ctx context.Context, listID int32, userID
 		return &appErrors.UnexpectedError{Msg: "Error deleting the user list", InternalError: err}
 	}
 
-	go s.eventBus.Publish(events.ListDeleted, listID)
+	go s.eventBus.Publish(events.ListDeleted, domain.NewListEvent(foundList))
 
 	return nil
 }
@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type DeleteListTemplateService struct {
+	repo domain.ListTemplatesRepository
+}
+
+func NewDeleteListTemplateService(repo domain.ListTemplatesRepository) *DeleteListTemplateService {
+	return &DeleteListTemplateService{repo}
+}
+
+// DeleteListTemplate deletes the template if the user is its owner. The lists created from it
+// aren't changed
+func (s *DeleteListTemplateService) DeleteListTemplate(ctx context.Context, templateID int32, userID int32) error {
+	if _, err := s.repo.FindListTemplate(ctx, domain.ListTemplateRecord{ID: templateID, UserID: userID}); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteListTemplate(ctx, templateID); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the template", InternalError: err}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type DeleteTagService struct {
+	repo     domain.TagsRepository
+	eventBus events.EventBus
+}
+
+func NewDeleteTagService(repo domain.TagsRepository, eventBus events.EventBus) *DeleteTagService {
+	return &DeleteTagService{repo, eventBus}
+}
+
+// DeleteTag removes the tag of the user from all its lists and items
+func (s *DeleteTagService) DeleteTag(ctx context.Context, tagID int32, userID int32) error {
+	query := domain.TagRecord{ID: tagID, UserID: userID}
+
+	_, err := s.repo.FindTag(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	// The lists are loaded before deleting the tag because after that they can't be found
+	listIDs, err := s.repo.GetTagListIDs(ctx, tagID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the lists of the tag", InternalError: err}
+	}
+
+	err = s.repo.DeleteTag(ctx, query)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error deleting the user tag", InternalError: err}
+	}
+
+	publishListTagsChanged(s.eventBus, listIDs)
+
+	return nil
+}
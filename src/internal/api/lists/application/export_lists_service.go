@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+const exportListsBatchSize = 100
+
+type ExportListsService struct {
+	repo           domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+}
+
+func NewExportListsService(repo domain.ListsRepository, categoriesRepo domain.CategoriesRepository) *ExportListsService {
+	return &ExportListsService{repo, categoriesRepo}
+}
+
+// ExportLists returns the function that writes the lists of the workspace in the format, or
+// only the list with listID when it isn't nil. The lists are read in batches while they are
+// written, so everything that can fail before writing anything is checked here
+func (s *ExportListsService) ExportLists(ctx context.Context, workspaceID int32, listID *int32, format string) (func(w io.Writer) error, error) {
+	var foundList *domain.ListRecord
+
+	if listID != nil {
+		var err error
+		foundList, err = s.repo.FindList(ctx, domain.ListRecord{ID: *listID, WorkspaceID: workspaceID})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	categories, err := s.categoriesRepo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the categories", InternalError: err}
+	}
+
+	categoryNames := categories.NamesByID()
+
+	exportedAt := time.Now()
+
+	return func(w io.Writer) error {
+		writer, err := domain.NewListsExportWriter(format, w, exportedAt)
+		if err != nil {
+			return err
+		}
+
+		if foundList != nil {
+			err = writer.WriteList(domain.NewExportedList(foundList, categoryNames))
+		} else {
+			err = s.repo.ForEachListsBatch(ctx, domain.ListRecord{WorkspaceID: workspaceID}, exportListsBatchSize, func(lists domain.ListRecords) error {
+				for i := range lists {
+					if err := writer.WriteList(domain.NewExportedList(&lists[i], categoryNames)); err != nil {
+						return err
+					}
+				}
+
+				return nil
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return writer.Close()
+	}, nil
+}
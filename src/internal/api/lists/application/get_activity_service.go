@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetActivityService struct {
+	activityRepo domain.ActivityRepository
+	listsRepo    domain.ListsRepository
+}
+
+func NewGetActivityService(activityRepo domain.ActivityRepository, listsRepo domain.ListsRepository) *GetActivityService {
+	return &GetActivityService{activityRepo, listsRepo}
+}
+
+// GetActivity returns a page of the activity of the user, or only the one of a list when
+// listID is not 0, and the cursor of the next page if there is one
+func (s *GetActivityService) GetActivity(ctx context.Context, userID int32, workspaceID int32, listID int32, cursor string, limit int) ([]*domain.ActivityEntity, string, error) {
+	beforeID, err := domain.DecodeActivityCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if listID > 0 {
+		if _, err := s.listsRepo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID}); err != nil {
+			return nil, "", err
+		}
+	}
+
+	query := domain.ActivityQuery{UserID: userID, ListID: listID, BeforeID: beforeID, Limit: limit + 1}
+	found, err := s.activityRepo.GetActivity(ctx, query)
+	if err != nil {
+		return nil, "", &appErrors.UnexpectedError{Msg: "Error getting the activity", InternalError: err}
+	}
+
+	nextCursor := ""
+	if len(found) > limit {
+		found = found[:limit]
+		nextCursor = domain.EncodeActivityCursor(found[limit-1].ID)
+	}
+
+	return found.ToActivityEntities(), nextCursor, nil
+}
@@ -15,11 +15,90 @@ func NewGetAllCategoriesService(repo domain.CategoriesRepository) *GetAllCategor
 	return &GetAllCategoriesService{repo}
 }
 
-func (s *GetAllCategoriesService) GetAllCategories(ctx context.Context, userID int32) ([]*domain.CategoryEntity, error) {
-	foundCategories, err := s.repo.GetCategories(ctx, domain.CategoryRecord{UserID: userID})
+// GetAllCategories returns the categories of the workspace, with their stats when includeStats is true
+func (s *GetAllCategoriesService) GetAllCategories(ctx context.Context, workspaceID int32, userID int32, includeStats bool) ([]*domain.CategoryEntity, error) {
+	foundCategories, err := s.repo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: workspaceID})
 	if err != nil {
 		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user categories", InternalError: err}
 	}
 
-	return foundCategories.ToCategoriesEntities(), nil
+	res := foundCategories.ToCategoriesEntities()
+
+	if err := s.markDefaultCategory(ctx, workspaceID, userID, res); err != nil {
+		return nil, err
+	}
+
+	if includeStats {
+		if err := s.addStats(ctx, workspaceID, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// GetCategoriesTree returns the root categories of the workspace with their subcategories nested
+func (s *GetAllCategoriesService) GetCategoriesTree(ctx context.Context, workspaceID int32, userID int32, includeStats bool) ([]*domain.CategoryTreeEntity, error) {
+	tree, err := getCategoryTree(ctx, s.repo, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := tree.ToCategoryTreeEntities()
+
+	categories := flattenCategoryTree(res)
+
+	if err := s.markDefaultCategory(ctx, workspaceID, userID, categories); err != nil {
+		return nil, err
+	}
+
+	if includeStats {
+		if err := s.addStats(ctx, workspaceID, categories); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func (s *GetAllCategoriesService) markDefaultCategory(ctx context.Context, workspaceID int32, userID int32, categories []*domain.CategoryEntity) error {
+	defaultCategoryID, err := s.repo.FindDefaultCategoryID(ctx, userID, workspaceID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the default category", InternalError: err}
+	}
+
+	for _, c := range categories {
+		c.IsDefault = defaultCategoryID != nil && *defaultCategoryID == c.ID
+	}
+
+	return nil
+}
+
+func (s *GetAllCategoriesService) addStats(ctx context.Context, workspaceID int32, categories []*domain.CategoryEntity) error {
+	categoryIDs := make([]int32, len(categories))
+	for i, c := range categories {
+		categoryIDs[i] = c.ID
+	}
+
+	stats, err := getCategoriesStats(ctx, s.repo, domain.CategoryStatsQuery{WorkspaceID: workspaceID}, categoryIDs)
+	if err != nil {
+		return err
+	}
+
+	for i, c := range categories {
+		c.Stats = stats[i]
+	}
+
+	return nil
+}
+
+func flattenCategoryTree(nodes []*domain.CategoryTreeEntity) []*domain.CategoryEntity {
+	res := []*domain.CategoryEntity{}
+
+	for _, n := range nodes {
+		res = append(res, n.CategoryEntity)
+		res = append(res, flattenCategoryTree(n.Children)...)
+	}
+
+	return res
 }
@@ -7,19 +7,88 @@ import (
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
+// ListsFilter selects the lists of a workspace. When there is a category it only selects the
+// lists of that category, and also the ones of its subcategories if IncludeDescendants is set.
+// When there is a tag it only selects the lists that have that tag of the user
+type ListsFilter struct {
+	WorkspaceID        int32
+	UserID             int32
+	CategoryID         *int32
+	IncludeDescendants bool
+	Tag                string
+}
+
 type GetAllListsService struct {
-	repo domain.ListsRepository
+	repo           domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	tagsRepo       domain.TagsRepository
+}
+
+func NewGetAllListsService(repo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, tagsRepo domain.TagsRepository) *GetAllListsService {
+	return &GetAllListsService{repo, categoriesRepo, tagsRepo}
 }
 
-func NewGetAllListsService(repo domain.ListsRepository) *GetAllListsService {
-	return &GetAllListsService{repo}
+// GetAllLists returns the lists selected by the filter with the tags that the user has in them
+func (s *GetAllListsService) GetAllLists(ctx context.Context, filter ListsFilter) ([]*domain.ListEntity, error) {
+	foundLists, err := s.findLists(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	res := foundLists.ToListEntities()
+
+	if err := addListsTags(ctx, s.tagsRepo, filter.UserID, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
-func (s *GetAllListsService) GetAllLists(ctx context.Context, userID int32) ([]*domain.ListEntity, error) {
-	foundLists, err := s.repo.GetLists(ctx, domain.ListRecord{UserID: userID})
+func (s *GetAllListsService) findLists(ctx context.Context, filter ListsFilter) (domain.ListRecords, error) {
+	categoryIDs, err := s.categoryIDs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var foundLists domain.ListRecords
+
+	switch {
+	case len(filter.Tag) > 0:
+		foundLists, err = s.repo.GetListsByTag(ctx, filter.WorkspaceID, filter.UserID, filter.Tag)
+	case categoryIDs != nil:
+		foundLists, err = s.repo.GetListsByCategories(ctx, filter.WorkspaceID, categoryIDs)
+	default:
+		foundLists, err = s.repo.GetLists(ctx, domain.ListRecord{WorkspaceID: filter.WorkspaceID})
+	}
+
 	if err != nil {
 		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user lists", InternalError: err}
 	}
 
-	return foundLists.ToListEntities(), nil
+	// The lists with the tag are filtered by category here, so the category and the tag can
+	// be used at the same time
+	if len(filter.Tag) > 0 && categoryIDs != nil {
+		foundLists = foundLists.InCategories(categoryIDs)
+	}
+
+	return foundLists, nil
+}
+
+// categoryIDs returns the categories whose lists are selected, or nil when the filter doesn't
+// have a category
+func (s *GetAllListsService) categoryIDs(ctx context.Context, filter ListsFilter) ([]int32, error) {
+	if filter.CategoryID == nil {
+		return nil, nil
+	}
+
+	if !filter.IncludeDescendants {
+		return []int32{*filter.CategoryID}, nil
+	}
+
+	tree, err := getCategoryTree(ctx, s.categoriesRepo, filter.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tree.WithDescendants(*filter.CategoryID), nil
 }
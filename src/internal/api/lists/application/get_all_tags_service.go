@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetAllTagsService struct {
+	repo domain.TagsRepository
+}
+
+func NewGetAllTagsService(repo domain.TagsRepository) *GetAllTagsService {
+	return &GetAllTagsService{repo}
+}
+
+func (s *GetAllTagsService) GetAllTags(ctx context.Context, userID int32) ([]*domain.TagEntity, error) {
+	foundTags, err := s.repo.GetTags(ctx, userID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user tags", InternalError: err}
+	}
+
+	return foundTags.ToTagEntities(), nil
+}
@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+// CalendarFeedContent is what a feed serves. LastModified is the last time that the content
+// hash changed
+type CalendarFeedContent struct {
+	ContentHash  string
+	LastModified time.Time
+	Lists        []*domain.ExportedList
+}
+
+type GetCalendarFeedService struct {
+	repo           domain.CalendarFeedsRepository
+	listsRepo      domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	workspacesRepo workspacesDomain.WorkspacesRepository
+}
+
+func NewGetCalendarFeedService(repo domain.CalendarFeedsRepository, listsRepo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, workspacesRepo workspacesDomain.WorkspacesRepository) *GetCalendarFeedService {
+	return &GetCalendarFeedService{repo, listsRepo, categoriesRepo, workspacesRepo}
+}
+
+// GetCalendarFeed returns the lists of the feed of the token with their items with due date.
+// The feed stops working when its user leaves the workspace
+func (s *GetCalendarFeedService) GetCalendarFeed(ctx context.Context, token string) (*CalendarFeedContent, error) {
+	foundFeed, err := s.repo.FindCalendarFeed(ctx, domain.CalendarFeedRecord{TokenHash: domain.HashCalendarFeedToken(token)})
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.workspacesRepo.ExistsWorkspaceMember(ctx, workspacesDomain.WorkspaceMemberRecord{WorkspaceID: foundFeed.WorkspaceID, UserID: foundFeed.UserID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error checking if the user is a member of the workspace", InternalError: err}
+	}
+
+	if !isMember {
+		return nil, &appErrors.ForbiddenError{Msg: "The user of the feed isn't a member of its workspace"}
+	}
+
+	lists, err := s.getLists(ctx, foundFeed)
+	if err != nil {
+		return nil, err
+	}
+
+	contentHash, err := domain.CalendarFeedContentHash(lists)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the calendar feed content hash", InternalError: err}
+	}
+
+	res := &CalendarFeedContent{ContentHash: contentHash, Lists: lists}
+
+	if foundFeed.LastModified != nil && foundFeed.ContentHash == contentHash {
+		res.LastModified = *foundFeed.LastModified
+
+		return res, nil
+	}
+
+	// HTTP dates don't have fractions of a second
+	res.LastModified = time.Now().UTC().Truncate(time.Second)
+
+	if err := s.repo.UpdateCalendarFeedContent(ctx, foundFeed.ID, contentHash, res.LastModified); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error updating the calendar feed", InternalError: err}
+	}
+
+	return res, nil
+}
+
+// getLists returns the lists of the feed that have items with due date, with those items
+func (s *GetCalendarFeedService) getLists(ctx context.Context, feed *domain.CalendarFeedRecord) ([]*domain.ExportedList, error) {
+	categories, err := s.categoriesRepo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: feed.WorkspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the categories", InternalError: err}
+	}
+
+	lists, err := s.listsRepo.GetLists(ctx, domain.ListRecord{WorkspaceID: feed.WorkspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the lists", InternalError: err}
+	}
+
+	var listIDs []int32
+
+	if feed.ListID != nil {
+		listIDs = []int32{*feed.ListID}
+	} else if feed.CategoryID != nil {
+		lists = lists.InCategories(domain.NewCategoryTree(categories).WithDescendants(*feed.CategoryID))
+		listIDs = make([]int32, len(lists))
+		for i, v := range lists {
+			listIDs[i] = v.ID
+		}
+	}
+
+	res := []*domain.ExportedList{}
+
+	if listIDs != nil && len(listIDs) == 0 {
+		return res, nil
+	}
+
+	items, err := s.listsRepo.GetDueItems(ctx, feed.WorkspaceID, listIDs)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the items with due date", InternalError: err}
+	}
+
+	itemsByList := map[int32][]domain.ListItemRecord{}
+	for _, v := range items {
+		itemsByList[v.ListID] = append(itemsByList[v.ListID], v)
+	}
+
+	categoryNames := categories.NamesByID()
+
+	for i := range lists {
+		if listItems, ok := itemsByList[lists[i].ID]; ok {
+			lists[i].Items = listItems
+			res = append(res, domain.NewExportedList(&lists[i], categoryNames))
+		}
+	}
+
+	return res, nil
+}
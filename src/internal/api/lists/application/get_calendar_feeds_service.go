@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetCalendarFeedsService struct {
+	repo domain.CalendarFeedsRepository
+}
+
+func NewGetCalendarFeedsService(repo domain.CalendarFeedsRepository) *GetCalendarFeedsService {
+	return &GetCalendarFeedsService{repo}
+}
+
+func (s *GetCalendarFeedsService) GetCalendarFeeds(ctx context.Context, userID int32, workspaceID int32) ([]*domain.CalendarFeedEntity, error) {
+	foundFeeds, err := s.repo.GetCalendarFeeds(ctx, domain.CalendarFeedRecord{UserID: userID, WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the calendar feeds", InternalError: err}
+	}
+
+	return foundFeeds.ToCalendarFeedEntities(), nil
+}
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetCategoriesStatsService struct {
+	repo domain.CategoriesRepository
+}
+
+func NewGetCategoriesStatsService(repo domain.CategoriesRepository) *GetCategoriesStatsService {
+	return &GetCategoriesStatsService{repo}
+}
+
+func (s *GetCategoriesStatsService) GetCategoryStats(ctx context.Context, categoryID int32, workspaceID int32) (*domain.CategoryStatsEntity, error) {
+	if _, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, WorkspaceID: workspaceID}); err != nil {
+		return nil, err
+	}
+
+	res, err := getCategoriesStats(ctx, s.repo, domain.CategoryStatsQuery{WorkspaceID: workspaceID, CategoryID: categoryID}, []int32{categoryID})
+	if err != nil {
+		return nil, err
+	}
+
+	return res[0], nil
+}
+
+// GetCategoriesStats returns the stats of all the categories of the workspace, sorted like the categories
+func (s *GetCategoriesStatsService) GetCategoriesStats(ctx context.Context, workspaceID int32) ([]*domain.CategoryStatsEntity, error) {
+	foundCategories, err := s.repo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user categories", InternalError: err}
+	}
+
+	categoryIDs := make([]int32, len(foundCategories))
+	for i, c := range foundCategories {
+		categoryIDs[i] = c.ID
+	}
+
+	return getCategoriesStats(ctx, s.repo, domain.CategoryStatsQuery{WorkspaceID: workspaceID}, categoryIDs)
+}
+
+func getCategoriesStats(ctx context.Context, repo domain.CategoriesRepository, query domain.CategoryStatsQuery, categoryIDs []int32) ([]*domain.CategoryStatsEntity, error) {
+	stats, err := repo.GetCategoriesStats(ctx, query)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the categories stats", InternalError: err}
+	}
+
+	largestLists, err := repo.GetLargestLists(ctx, query, domain.CategoryStatsLargestLists)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the largest lists of the categories", InternalError: err}
+	}
+
+	return domain.NewCategoryStatsEntities(categoryIDs, stats, largestLists), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 )
 
 type GetCategoryService struct {
@@ -14,11 +15,19 @@ func NewGetCategoryService(repo domain.CategoriesRepository) *GetCategoryService
 	return &GetCategoryService{repo}
 }
 
-func (s *GetCategoryService) GetCategory(ctx context.Context, categoryID int32, userID int32) (*domain.CategoryEntity, error) {
-	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, UserID: userID})
+func (s *GetCategoryService) GetCategory(ctx context.Context, categoryID int32, workspaceID int32, userID int32) (*domain.CategoryEntity, error) {
+	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, WorkspaceID: workspaceID})
 	if err != nil {
 		return nil, err
 	}
 
-	return foundCategory.ToCategoryEntity(), nil
+	defaultCategoryID, err := s.repo.FindDefaultCategoryID(ctx, userID, workspaceID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the default category", InternalError: err}
+	}
+
+	res := foundCategory.ToCategoryEntity()
+	res.IsDefault = defaultCategoryID != nil && *defaultCategoryID == res.ID
+
+	return res, nil
 }
@@ -0,0 +1,39 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetItemsService struct {
+	repo     domain.ListsRepository
+	tagsRepo domain.TagsRepository
+}
+
+func NewGetItemsService(repo domain.ListsRepository, tagsRepo domain.TagsRepository) *GetItemsService {
+	return &GetItemsService{repo, tagsRepo}
+}
+
+// GetItems returns the items of the workspace that have the tag of the user, with all the
+// tags that the user has in them
+func (s *GetItemsService) GetItems(ctx context.Context, workspaceID int32, userID int32, tag string) ([]*domain.ListItemWithListEntity, error) {
+	foundItems, err := s.repo.GetItemsByTag(ctx, workspaceID, userID, tag)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the items", InternalError: err}
+	}
+
+	res := foundItems.ToListItemWithListEntities()
+
+	items := make([]*domain.ListItemEntity, len(res))
+	for i, v := range res {
+		items[i] = v.ListItemEntity
+	}
+
+	if err := addItemsTags(ctx, s.tagsRepo, userID, items); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -7,18 +7,30 @@ import (
 )
 
 type GetListService struct {
-	repo domain.ListsRepository
+	repo     domain.ListsRepository
+	tagsRepo domain.TagsRepository
 }
 
-func NewGetListService(repo domain.ListsRepository) *GetListService {
-	return &GetListService{repo}
+func NewGetListService(repo domain.ListsRepository, tagsRepo domain.TagsRepository) *GetListService {
+	return &GetListService{repo, tagsRepo}
 }
 
-func (s *GetListService) GetList(ctx context.Context, listID int32, userID int32) (*domain.ListEntity, error) {
-	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listID, UserID: userID})
+// GetList returns the list with its items and the tags that the user has in them
+func (s *GetListService) GetList(ctx context.Context, listID int32, workspaceID int32, userID int32) (*domain.ListEntity, error) {
+	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
 	if err != nil {
 		return nil, err
 	}
 
-	return foundList.ToListEntity(), nil
+	res := foundList.ToListEntity()
+
+	if err := addListsTags(ctx, s.tagsRepo, userID, []*domain.ListEntity{res}); err != nil {
+		return nil, err
+	}
+
+	if err := addItemsTags(ctx, s.tagsRepo, userID, res.Items); err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
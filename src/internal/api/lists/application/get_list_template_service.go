@@ -0,0 +1,24 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+type GetListTemplateService struct {
+	repo domain.ListTemplatesRepository
+}
+
+func NewGetListTemplateService(repo domain.ListTemplatesRepository) *GetListTemplateService {
+	return &GetListTemplateService{repo}
+}
+
+func (s *GetListTemplateService) GetListTemplate(ctx context.Context, templateID int32, userID int32) (*domain.ListTemplateEntity, error) {
+	foundTemplate, err := s.repo.FindAccessibleListTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return foundTemplate.ToListTemplateEntity(userID), nil
+}
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetListTemplatesService struct {
+	repo domain.ListTemplatesRepository
+}
+
+func NewGetListTemplatesService(repo domain.ListTemplatesRepository) *GetListTemplatesService {
+	return &GetListTemplatesService{repo}
+}
+
+// GetListTemplates returns the templates of the user and the ones shared with them
+func (s *GetListTemplatesService) GetListTemplates(ctx context.Context, userID int32) ([]*domain.ListTemplateEntity, error) {
+	foundTemplates, err := s.repo.GetAccessibleListTemplates(ctx, userID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the templates", InternalError: err}
+	}
+
+	return foundTemplates.ToListTemplateEntities(userID), nil
+}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+type GetListVersionService struct {
+	listsRepo    domain.ListsRepository
+	versionsRepo domain.ListVersionsRepository
+}
+
+func NewGetListVersionService(listsRepo domain.ListsRepository, versionsRepo domain.ListVersionsRepository) *GetListVersionService {
+	return &GetListVersionService{listsRepo, versionsRepo}
+}
+
+// GetListVersion returns a version of the list and what has changed since then
+func (s *GetListVersionService) GetListVersion(ctx context.Context, listID int32, workspaceID int32, version int32) (*domain.ListVersionEntity, *domain.ListVersionDiff, error) {
+	foundList, err := s.listsRepo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	foundVersion, err := s.versionsRepo.FindListVersion(ctx, domain.ListVersionRecord{ListID: listID, Version: version})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versionEntity := foundVersion.ToListVersionEntity()
+
+	return versionEntity, domain.NewListVersionDiff(versionEntity, foundList), nil
+}
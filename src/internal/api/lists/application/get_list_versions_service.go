@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetListVersionsService struct {
+	listsRepo    domain.ListsRepository
+	versionsRepo domain.ListVersionsRepository
+}
+
+func NewGetListVersionsService(listsRepo domain.ListsRepository, versionsRepo domain.ListVersionsRepository) *GetListVersionsService {
+	return &GetListVersionsService{listsRepo, versionsRepo}
+}
+
+func (s *GetListVersionsService) GetListVersions(ctx context.Context, listID int32, workspaceID int32) ([]*domain.ListVersionEntity, error) {
+	if _, err := s.listsRepo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID}); err != nil {
+		return nil, err
+	}
+
+	foundVersions, err := s.versionsRepo.GetListVersions(ctx, listID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the list versions", InternalError: err}
+	}
+
+	return foundVersions.ToListVersionEntities(), nil
+}
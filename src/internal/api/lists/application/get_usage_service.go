@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetUsageService struct {
+	listsRepo      domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	quotasRepo     domain.QuotasRepository
+	cfgSrv         sharedApp.ConfigurationService
+}
+
+func NewGetUsageService(listsRepo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService) *GetUsageService {
+	return &GetUsageService{listsRepo, categoriesRepo, quotasRepo, cfgSrv}
+}
+
+func (s *GetUsageService) GetUsage(ctx context.Context, userID int32) (*domain.QuotaUsageEntity, error) {
+	limits, err := getQuotaLimits(ctx, s.quotasRepo, s.cfgSrv, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	foundLists, err := s.listsRepo.GetLists(ctx, domain.ListRecord{UserID: userID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting all user lists", InternalError: err}
+	}
+
+	maxItemsCount := int64(0)
+	for _, v := range foundLists {
+		if int64(v.ItemsCount) > maxItemsCount {
+			maxItemsCount = int64(v.ItemsCount)
+		}
+	}
+
+	categoriesCount, err := s.categoriesRepo.CountCategories(ctx, domain.CategoryRecord{UserID: userID})
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error counting the user categories", InternalError: err}
+	}
+
+	return domain.NewQuotaUsageEntity(limits, int64(len(foundLists)), maxItemsCount, categoriesCount), nil
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+// ImportListsResult is the report of an import. Nothing is imported when there are errors
+type ImportListsResult struct {
+	Imported bool                    `json:"imported"`
+	Lists    []*domain.ListEntity    `json:"lists"`
+	Errors   []domain.ImportRowError `json:"errors"`
+}
+
+type ImportListsService struct {
+	repo           domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	tagsRepo       domain.TagsRepository
+	quotasRepo     domain.QuotasRepository
+	cfgSrv         sharedApp.ConfigurationService
+	eventBus       events.EventBus
+}
+
+func NewImportListsService(repo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, tagsRepo domain.TagsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *ImportListsService {
+	return &ImportListsService{repo, categoriesRepo, tagsRepo, quotasRepo, cfgSrv, eventBus}
+}
+
+// ImportLists validates the rows and, if there aren't errors and it isn't a dry run, creates
+// their lists. All the lists are created in one transaction, so nothing is imported when one
+// of them fails
+func (s *ImportListsService) ImportLists(ctx context.Context, userID int32, workspaceID int32, rows domain.ImportRows, dryRun bool) (*ImportListsResult, error) {
+	importedLists, rowErrors := rows.ToImportedLists()
+	if len(importedLists) == 0 && len(rowErrors) == 0 {
+		return nil, &appErrors.BadRequestError{Msg: "The file doesn't have any list", InternalError: nil}
+	}
+
+	result := &ImportListsResult{
+		Lists:  make([]*domain.ListEntity, len(importedLists)),
+		Errors: rowErrors,
+	}
+
+	for i, v := range importedLists {
+		v.List.UserID = userID
+		v.List.WorkspaceID = workspaceID
+		for _, item := range v.List.Items {
+			item.UserID = userID
+		}
+
+		result.Lists[i] = v.List
+	}
+
+	// The lists are created with the CreateListService, so they are checked and created like
+	// the other ones, and they aren't created when a row has errors
+	createSrv := NewCreateListService(s.repo, s.categoriesRepo, s.tagsRepo, s.quotasRepo, s.cfgSrv, s.eventBus)
+	listErrors, err := createSrv.CreateLists(ctx, result.Lists, dryRun || len(result.Errors) > 0)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range listErrors {
+		result.Errors = append(result.Errors, domain.ImportRowError{Row: importedLists[v.Index].Row, Error: v.Err.Error()})
+	}
+
+	result.Imported = !dryRun && len(result.Errors) == 0
+
+	return result, nil
+}
@@ -10,11 +10,12 @@ import (
 
 type IndexAllListsService struct {
 	repo         domain.ListsRepository
+	tagsRepo     domain.TagsRepository
 	searchClient search.SearchIndexClient
 }
 
-func NewIndexAllListsService(repo domain.ListsRepository, searchClient search.SearchIndexClient) *IndexAllListsService {
-	return &IndexAllListsService{repo, searchClient}
+func NewIndexAllListsService(repo domain.ListsRepository, tagsRepo domain.TagsRepository, searchClient search.SearchIndexClient) *IndexAllListsService {
+	return &IndexAllListsService{repo, tagsRepo, searchClient}
 }
 
 func (s *IndexAllListsService) IndexAllLists(ctx context.Context) error {
@@ -23,10 +24,9 @@ func (s *IndexAllListsService) IndexAllLists(ctx context.Context) error {
 		return &errors.UnexpectedError{Msg: "Error getting the lists", InternalError: err}
 	}
 
-	documents := make([]domain.ListSearchDocument, len(foundLists))
-
-	for i, l := range foundLists {
-		documents[i] = l.ToListSearchDocument()
+	documents, err := toListSearchDocuments(ctx, s.tagsRepo, foundLists)
+	if err != nil {
+		return err
 	}
 
 	return s.searchClient.SaveObjects(documents)
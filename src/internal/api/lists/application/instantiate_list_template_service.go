@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type InstantiateListTemplateService struct {
+	repo           domain.ListTemplatesRepository
+	listsRepo      domain.ListsRepository
+	categoriesRepo domain.CategoriesRepository
+	tagsRepo       domain.TagsRepository
+	quotasRepo     domain.QuotasRepository
+	cfgSrv         sharedApp.ConfigurationService
+	eventBus       events.EventBus
+}
+
+func NewInstantiateListTemplateService(repo domain.ListTemplatesRepository, listsRepo domain.ListsRepository, categoriesRepo domain.CategoriesRepository, tagsRepo domain.TagsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *InstantiateListTemplateService {
+	return &InstantiateListTemplateService{repo, listsRepo, categoriesRepo, tagsRepo, quotasRepo, cfgSrv, eventBus}
+}
+
+// InstantiateListTemplate creates a list of the user in the workspace with the items of the
+// template, which can be one of the user or one shared with them. The list is created with
+// the CreateListService, so it has the same checks as any other new list
+func (s *InstantiateListTemplateService) InstantiateListTemplate(ctx context.Context, templateID int32, userID int32, workspaceID int32, name domain.ListNameValueObject, categoryID *int32, variables map[string]string) (*domain.ListEntity, error) {
+	foundTemplate, err := s.repo.FindAccessibleListTemplate(ctx, templateID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	listToCreate, err := foundTemplate.ToListTemplateEntity(userID).ToListEntity(name, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	listToCreate.UserID = userID
+	listToCreate.WorkspaceID = workspaceID
+	listToCreate.CategoryID = categoryID
+	for _, v := range listToCreate.Items {
+		v.UserID = userID
+	}
+
+	createSrv := NewCreateListService(s.listsRepo, s.categoriesRepo, s.tagsRepo, s.quotasRepo, s.cfgSrv, s.eventBus)
+	if err := createSrv.CreateList(ctx, listToCreate); err != nil {
+		return nil, err
+	}
+
+	return listToCreate, nil
+}
@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+// saveListTags saves the tags of the user in the list and its items. Only the tags that are
+// set are saved, so a nil slice leaves the current ones. The items must already have their ids
+func saveListTags(ctx context.Context, tagsRepo domain.TagsRepository, userID int32, list *domain.ListEntity) error {
+	if list.Tags != nil {
+		if err := tagsRepo.SetListTags(ctx, userID, list.ID, list.Tags); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error saving the list tags", InternalError: err}
+		}
+	}
+
+	for _, v := range list.Items {
+		if v.Tags == nil {
+			continue
+		}
+
+		if err := tagsRepo.SetItemTags(ctx, userID, v.ID, v.Tags); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error saving the item tags", InternalError: err}
+		}
+	}
+
+	return nil
+}
+
+// addListsTags sets in the lists the tags that the user has in them
+func addListsTags(ctx context.Context, tagsRepo domain.TagsRepository, userID int32, lists []*domain.ListEntity) error {
+	if len(lists) == 0 {
+		return nil
+	}
+
+	listIDs := make([]int32, len(lists))
+	for i, v := range lists {
+		listIDs[i] = v.ID
+	}
+
+	foundTags, err := tagsRepo.GetListsTags(ctx, userID, listIDs)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the lists tags", InternalError: err}
+	}
+
+	tagNames := foundTags.TagNamesByID()
+	for _, v := range lists {
+		v.Tags = tagNames[v.ID]
+	}
+
+	return nil
+}
+
+// addItemsTags sets in the items the tags that the user has in them
+func addItemsTags(ctx context.Context, tagsRepo domain.TagsRepository, userID int32, items []*domain.ListItemEntity) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	itemIDs := make([]int32, len(items))
+	for i, v := range items {
+		itemIDs[i] = v.ID
+	}
+
+	foundTags, err := tagsRepo.GetItemsTags(ctx, userID, itemIDs)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the items tags", InternalError: err}
+	}
+
+	tagNames := foundTags.TagNamesByID()
+	for _, v := range items {
+		v.Tags = tagNames[v.ID]
+	}
+
+	return nil
+}
+
+// publishListTagsChanged notifies that the tags of the lists have changed, so their search
+// documents are updated
+func publishListTagsChanged(eventBus events.EventBus, listIDs []int32) {
+	for _, id := range listIDs {
+		go eventBus.Publish(events.ListTagsChanged, domain.ListEvent{ListID: id})
+	}
+}
+
+// toListSearchDocuments returns the search documents of the lists with the tags that the owner
+// of each list has in it
+func toListSearchDocuments(ctx context.Context, tagsRepo domain.TagsRepository, lists domain.ListRecords) ([]domain.ListSearchDocument, error) {
+	documents := make([]domain.ListSearchDocument, len(lists))
+	if len(lists) == 0 {
+		return documents, nil
+	}
+
+	listIDs := make([]int32, len(lists))
+	for i, v := range lists {
+		listIDs[i] = v.ID
+	}
+
+	foundTags, err := tagsRepo.GetListsOwnerTags(ctx, listIDs)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the lists tags", InternalError: err}
+	}
+
+	tagNames := foundTags.TagNamesByID()
+	for i, v := range lists {
+		documents[i] = v.ToListSearchDocument()
+
+		if tags, ok := tagNames[v.ID]; ok {
+			documents[i].Tags = tags
+		}
+	}
+
+	return documents, nil
+}
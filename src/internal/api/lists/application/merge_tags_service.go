@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type MergeTagsService struct {
+	repo     domain.TagsRepository
+	eventBus events.EventBus
+}
+
+func NewMergeTagsService(repo domain.TagsRepository, eventBus events.EventBus) *MergeTagsService {
+	return &MergeTagsService{repo, eventBus}
+}
+
+// MergeTags moves the lists and items of the tag to the target tag and deletes the first one.
+// Both tags must belong to the user
+func (s *MergeTagsService) MergeTags(ctx context.Context, tagID int32, targetTagID int32, userID int32) error {
+	if tagID == targetTagID {
+		return &appErrors.BadRequestError{Msg: "A tag can't be merged into itself", InternalError: nil}
+	}
+
+	_, err := s.repo.FindTag(ctx, domain.TagRecord{ID: tagID, UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.repo.ExistsTag(ctx, domain.TagRecord{ID: targetTagID, UserID: userID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the target tag exists", InternalError: err}
+	}
+
+	if !exists {
+		return &appErrors.BadRequestError{Msg: "The target tag doesn't exist", InternalError: nil}
+	}
+
+	listIDs, err := s.repo.GetTagListIDs(ctx, tagID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the lists of the tag", InternalError: err}
+	}
+
+	err = s.repo.MergeTags(ctx, tagID, targetTagID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error merging the tags", InternalError: err}
+	}
+
+	publishListTagsChanged(s.eventBus, listIDs)
+
+	return nil
+}
@@ -5,35 +5,40 @@ import (
 	"fmt"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 )
 
 type MoveListItemService struct {
-	repo     domain.ListsRepository
-	eventBus events.EventBus
+	repo       domain.ListsRepository
+	quotasRepo domain.QuotasRepository
+	cfgSrv     sharedApp.ConfigurationService
+	eventBus   events.EventBus
 }
 
-func NewMoveListItemService(repo domain.ListsRepository, eventBus events.EventBus) *MoveListItemService {
-	return &MoveListItemService{repo, eventBus}
+func NewMoveListItemService(repo domain.ListsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *MoveListItemService {
+	return &MoveListItemService{repo, quotasRepo, cfgSrv, eventBus}
 }
 
-func (s *MoveListItemService) MoveListItem(ctx context.Context, originListID int32, originListItemID int32, destinationListID int32, userID int32) error {
-	foundOriginList, err := s.repo.FindList(ctx, domain.ListRecord{ID: originListID, UserID: userID})
+func (s *MoveListItemService) MoveListItem(ctx context.Context, originListID int32, originListItemID int32, destinationListID int32, userID int32, workspaceID int32) error {
+	foundOriginList, err := s.repo.FindList(ctx, domain.ListRecord{ID: originListID, WorkspaceID: workspaceID})
 	if err != nil {
 		return err
 	}
 
-	foundDestinationList, err := s.repo.FindList(ctx, domain.ListRecord{ID: destinationListID, UserID: userID})
+	foundDestinationList, err := s.repo.FindList(ctx, domain.ListRecord{ID: destinationListID, WorkspaceID: workspaceID})
 	if err != nil {
 		return &appErrors.BadRequestError{Msg: "The destination list does not exist"}
 	}
 
 	indexToRemove := -1
+	movedItemTitle := ""
 
 	for i, item := range foundOriginList.Items {
 		if item.ID == originListItemID {
 			indexToRemove = i
+			movedItemTitle = item.Title
 			item.ListID = destinationListID
 			item.Position = foundDestinationList.GetMaxItemPosition() + 1
 			foundDestinationList.Items = append(foundDestinationList.Items, item)
@@ -46,6 +51,15 @@ func (s *MoveListItemService) MoveListItem(ctx context.Context, originListID int
 		return &appErrors.BadRequestError{Msg: fmt.Sprintf("An item with id %v doesn't exist in the original list", originListItemID)}
 	}
 
+	limits, err := getQuotaLimits(ctx, s.quotasRepo, s.cfgSrv, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := limits.CheckItems(len(foundDestinationList.Items)); err != nil {
+		return err
+	}
+
 	foundOriginList.Items = append(foundOriginList.Items[:indexToRemove], foundOriginList.Items[indexToRemove+1:]...)
 
 	if err = s.repo.UpdateList(ctx, foundOriginList); err != nil {
@@ -56,8 +70,17 @@ func (s *MoveListItemService) MoveListItem(ctx context.Context, originListID int
 		return &appErrors.UnexpectedError{Msg: "Error updating the destination list", InternalError: err}
 	}
 
-	go s.eventBus.Publish(events.ListUpdated, foundOriginList.ID)
-	go s.eventBus.Publish(events.ListUpdated, foundDestinationList.ID)
+	go s.eventBus.Publish(events.ListUpdated, domain.NewListEvent(foundOriginList))
+	go s.eventBus.Publish(events.ListUpdated, domain.NewListEvent(foundDestinationList))
+	go s.eventBus.Publish(events.ListItemMoved, domain.ListItemEvent{
+		ItemID:              originListItemID,
+		ListID:              foundOriginList.ID,
+		UserID:              userID,
+		ListName:            foundOriginList.Name,
+		Title:               movedItemTitle,
+		DestinationListID:   foundDestinationList.ID,
+		DestinationListName: foundDestinationList.Name,
+	})
 
 	return nil
 }
@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// getQuotaLimits returns the default limits of the configuration with the overrides of the user
+func getQuotaLimits(ctx context.Context, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, userID int32) (domain.QuotaLimits, error) {
+	quota, err := quotasRepo.GetUserQuota(ctx, userID)
+	if err != nil {
+		return domain.QuotaLimits{}, &appErrors.UnexpectedError{Msg: "Error getting the user quota", InternalError: err}
+	}
+
+	defaults := domain.QuotaLimits{
+		MaxLists:        cfgSrv.GetMaxListsPerUser(),
+		MaxItemsPerList: cfgSrv.GetMaxItemsPerList(),
+		MaxCategories:   cfgSrv.GetMaxCategoriesPerUser(),
+	}
+
+	return defaults.WithOverrides(quota), nil
+}
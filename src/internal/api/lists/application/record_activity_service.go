@@ -0,0 +1,113 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type RecordActivityService struct {
+	activityRepo   domain.ActivityRepository
+	categoriesRepo domain.CategoriesRepository
+}
+
+func NewRecordActivityService(activityRepo domain.ActivityRepository, categoriesRepo domain.CategoriesRepository) *RecordActivityService {
+	return &RecordActivityService{activityRepo, categoriesRepo}
+}
+
+// RecordActivity stores the activity of a list or item event. A list update can record a
+// rename and a category change at once, or nothing when only the items have changed
+func (s *RecordActivityService) RecordActivity(ctx context.Context, eventName string, data interface{}) error {
+	records, err := s.activityRecords(ctx, eventName, data)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		record.CreatedAt = time.Now()
+
+		if err := s.activityRepo.CreateActivity(ctx, record); err != nil {
+			return &appErrors.UnexpectedError{Msg: "Error recording the activity", InternalError: err}
+		}
+	}
+
+	return nil
+}
+
+func (s *RecordActivityService) activityRecords(ctx context.Context, eventName string, data interface{}) ([]*domain.ActivityRecord, error) {
+	switch event := data.(type) {
+	case domain.ListEvent:
+		return s.listActivityRecords(ctx, eventName, event)
+	case domain.ListItemEvent:
+		return itemActivityRecords(eventName, event)
+	}
+
+	return nil, fmt.Errorf("unexpected data for the event %q", eventName)
+}
+
+func (s *RecordActivityService) listActivityRecords(ctx context.Context, eventName string, event domain.ListEvent) ([]*domain.ActivityRecord, error) {
+	newRecord := func(action string, summary string) *domain.ActivityRecord {
+		return &domain.ActivityRecord{UserID: event.UserID, ListID: event.ListID, Action: action, Summary: summary}
+	}
+
+	switch eventName {
+	case events.ListCreated:
+		return []*domain.ActivityRecord{newRecord(domain.ActivityListCreated, fmt.Sprintf("Created the list %q", event.Name))}, nil
+	case events.ListDeleted:
+		return []*domain.ActivityRecord{newRecord(domain.ActivityListDeleted, fmt.Sprintf("Deleted the list %q", event.Name))}, nil
+	case events.ListUpdated:
+		records := []*domain.ActivityRecord{}
+
+		if event.IsRenamed() {
+			records = append(records, newRecord(domain.ActivityListRenamed, fmt.Sprintf("Renamed the list %q to %q", event.PreviousName, event.Name)))
+		}
+
+		if event.IsRecategorized() {
+			summary := fmt.Sprintf("Removed the list %q from its category", event.Name)
+
+			if event.CategoryID != nil {
+				foundCategory, err := s.categoriesRepo.FindCategory(ctx, domain.CategoryRecord{ID: *event.CategoryID, WorkspaceID: event.WorkspaceID})
+				if err != nil {
+					return nil, &appErrors.UnexpectedError{Msg: "Error getting the list category", InternalError: err}
+				}
+
+				summary = fmt.Sprintf("Moved the list %q to the category %q", event.Name, foundCategory.Name)
+			}
+
+			records = append(records, newRecord(domain.ActivityListRecategorized, summary))
+		}
+
+		return records, nil
+	}
+
+	return nil, fmt.Errorf("unexpected list event %q", eventName)
+}
+
+func itemActivityRecords(eventName string, event domain.ListItemEvent) ([]*domain.ActivityRecord, error) {
+	record := &domain.ActivityRecord{UserID: event.UserID, ListID: event.ListID}
+
+	switch eventName {
+	case events.ListItemAdded:
+		record.Action = domain.ActivityItemAdded
+		record.Summary = fmt.Sprintf("Added %q to %q", event.Title, event.ListName)
+	case events.ListItemRenamed:
+		record.Action = domain.ActivityItemRenamed
+		record.Summary = fmt.Sprintf("Renamed %q to %q in %q", event.PreviousTitle, event.Title, event.ListName)
+	case events.ListItemRemoved:
+		record.Action = domain.ActivityItemRemoved
+		record.Summary = fmt.Sprintf("Removed %q from %q", event.Title, event.ListName)
+	case events.ListItemMoved:
+		destinationListID := event.DestinationListID
+		record.RelatedListID = &destinationListID
+		record.Action = domain.ActivityItemMoved
+		record.Summary = fmt.Sprintf("Moved %q from %q to %q", event.Title, event.ListName, event.DestinationListName)
+	default:
+		return nil, fmt.Errorf("unexpected list item event %q", eventName)
+	}
+
+	return []*domain.ActivityRecord{record}, nil
+}
@@ -0,0 +1,48 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type ReorderCategoriesService struct {
+	repo domain.CategoriesRepository
+}
+
+func NewReorderCategoriesService(repo domain.CategoriesRepository) *ReorderCategoriesService {
+	return &ReorderCategoriesService{repo}
+}
+
+// ReorderCategories sorts the categories of the workspace in the given order, which must
+// have all of them once
+func (s *ReorderCategoriesService) ReorderCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) error {
+	foundCategories, err := s.repo.GetCategories(ctx, domain.CategoryRecord{WorkspaceID: workspaceID})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting all user categories", InternalError: err}
+	}
+
+	pending := map[int32]bool{}
+	for _, c := range foundCategories {
+		pending[c.ID] = true
+	}
+
+	for _, id := range categoryIDs {
+		if !pending[id] {
+			return &appErrors.BadRequestError{Msg: "The order must have all the categories once"}
+		}
+
+		delete(pending, id)
+	}
+
+	if len(pending) > 0 {
+		return &appErrors.BadRequestError{Msg: "The order must have all the categories once"}
+	}
+
+	if err := s.repo.ReorderCategories(ctx, workspaceID, categoryIDs); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error reordering the categories", InternalError: err}
+	}
+
+	return nil
+}
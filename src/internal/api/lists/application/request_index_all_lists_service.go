@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type RequestIndexAllListsService struct {
+	eventBus    events.EventBus
+	auditLogger *sharedApp.AuditLogger
+}
+
+func NewRequestIndexAllListsService(eventBus events.EventBus, auditRepo audit.AuditLogRepository) *RequestIndexAllListsService {
+	return &RequestIndexAllListsService{eventBus, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+// RequestIndexAllLists publishes the event that makes the lists to be indexed in background
+func (s *RequestIndexAllListsService) RequestIndexAllLists(ctx context.Context) {
+	s.auditLogger.Log(ctx, audit.ActionListsIndexRequested, audit.TargetLists, 0, nil, nil)
+
+	go s.eventBus.Publish(events.IndexAllListsRequested, nil)
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type RestoreListVersionService struct {
+	listsRepo      domain.ListsRepository
+	versionsRepo   domain.ListVersionsRepository
+	categoriesRepo domain.CategoriesRepository
+	tagsRepo       domain.TagsRepository
+	quotasRepo     domain.QuotasRepository
+	cfgSrv         sharedApp.ConfigurationService
+	eventBus       events.EventBus
+}
+
+func NewRestoreListVersionService(listsRepo domain.ListsRepository, versionsRepo domain.ListVersionsRepository, categoriesRepo domain.CategoriesRepository, tagsRepo domain.TagsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *RestoreListVersionService {
+	return &RestoreListVersionService{listsRepo, versionsRepo, categoriesRepo, tagsRepo, quotasRepo, cfgSrv, eventBus}
+}
+
+// RestoreListVersion updates the list with the contents of the version. The update takes a
+// new snapshot first, so a restore can be undone restoring that snapshot. The list is left
+// without category if the category of the version doesn't exist anymore
+func (s *RestoreListVersionService) RestoreListVersion(ctx context.Context, listID int32, userID int32, workspaceID int32, version int32) (*domain.ListEntity, error) {
+	if _, err := s.listsRepo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID}); err != nil {
+		return nil, err
+	}
+
+	foundVersion, err := s.versionsRepo.FindListVersion(ctx, domain.ListVersionRecord{ListID: listID, Version: version})
+	if err != nil {
+		return nil, err
+	}
+
+	listToRestore := foundVersion.ToListVersionEntity().ToListEntity(userID)
+	listToRestore.WorkspaceID = workspaceID
+
+	if listToRestore.CategoryID != nil {
+		existsCategory, err := s.categoriesRepo.ExistsCategory(ctx, domain.CategoryRecord{ID: *listToRestore.CategoryID, WorkspaceID: workspaceID})
+		if err != nil {
+			return nil, &appErrors.UnexpectedError{Msg: "Error checking if the category exists", InternalError: err}
+		}
+
+		if !existsCategory {
+			listToRestore.CategoryID = nil
+		}
+	}
+
+	srv := NewUpdateListService(s.listsRepo, s.tagsRepo, s.quotasRepo, s.cfgSrv, s.eventBus)
+	if err := srv.UpdateList(ctx, listToRestore); err != nil {
+		return nil, err
+	}
+
+	return listToRestore, nil
+}
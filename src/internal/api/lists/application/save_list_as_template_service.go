@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+type SaveListAsTemplateService struct {
+	repo      domain.ListTemplatesRepository
+	listsRepo domain.ListsRepository
+}
+
+func NewSaveListAsTemplateService(repo domain.ListTemplatesRepository, listsRepo domain.ListsRepository) *SaveListAsTemplateService {
+	return &SaveListAsTemplateService{repo, listsRepo}
+}
+
+// SaveListAsTemplate creates a template of the user with the items of the list. The template
+// gets the name of the list when the name is nil
+func (s *SaveListAsTemplateService) SaveListAsTemplate(ctx context.Context, listID int32, userID int32, workspaceID int32, name *domain.ListNameValueObject) (*domain.ListTemplateEntity, error) {
+	foundList, err := s.listsRepo.FindList(ctx, domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
+	if err != nil {
+		return nil, err
+	}
+
+	templateName, _ := domain.NewListNameValueObject(foundList.Name)
+	if name != nil {
+		templateName = *name
+	}
+
+	template := domain.NewListTemplateFromList(foundList, templateName)
+	template.UserID = userID
+
+	createSrv := NewCreateListTemplateService(s.repo)
+	if err := createSrv.CreateListTemplate(ctx, template); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type ShareListTemplateService struct {
+	repo      domain.ListTemplatesRepository
+	usersRepo authDomain.UsersRepository
+}
+
+func NewShareListTemplateService(repo domain.ListTemplatesRepository, usersRepo authDomain.UsersRepository) *ShareListTemplateService {
+	return &ShareListTemplateService{repo, usersRepo}
+}
+
+// ShareListTemplate lets another user see and instantiate the template. Only its owner can
+// share it
+func (s *ShareListTemplateService) ShareListTemplate(ctx context.Context, templateID int32, userID int32, shareUserID int32) error {
+	if _, err := s.repo.FindListTemplate(ctx, domain.ListTemplateRecord{ID: templateID, UserID: userID}); err != nil {
+		return err
+	}
+
+	if shareUserID == userID {
+		return &appErrors.BadRequestError{Msg: "A template can't be shared with its owner"}
+	}
+
+	if existsUser, err := s.usersRepo.ExistsUser(ctx, authDomain.UserRecord{ID: shareUserID}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the user exists", InternalError: err}
+	} else if !existsUser {
+		return &appErrors.BadRequestError{Msg: "The user doesn't exist"}
+	}
+
+	query := domain.ListTemplateShareRecord{TemplateID: templateID, UserID: shareUserID}
+	if existsShare, err := s.repo.ExistsListTemplateShare(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the template is already shared with the user", InternalError: err}
+	} else if existsShare {
+		return &appErrors.BadRequestError{Msg: "The template is already shared with the user"}
+	}
+
+	query.CreatedAt = time.Now()
+
+	if err := s.repo.CreateListTemplateShare(ctx, &query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error sharing the template", InternalError: err}
+	}
+
+	return nil
+}
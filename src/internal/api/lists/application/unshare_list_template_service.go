@@ -0,0 +1,37 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type UnshareListTemplateService struct {
+	repo domain.ListTemplatesRepository
+}
+
+func NewUnshareListTemplateService(repo domain.ListTemplatesRepository) *UnshareListTemplateService {
+	return &UnshareListTemplateService{repo}
+}
+
+// UnshareListTemplate stops sharing the template with the user. The lists that the user has
+// already created from it aren't changed
+func (s *UnshareListTemplateService) UnshareListTemplate(ctx context.Context, templateID int32, userID int32, shareUserID int32) error {
+	if _, err := s.repo.FindListTemplate(ctx, domain.ListTemplateRecord{ID: templateID, UserID: userID}); err != nil {
+		return err
+	}
+
+	query := domain.ListTemplateShareRecord{TemplateID: templateID, UserID: shareUserID}
+	if existsShare, err := s.repo.ExistsListTemplateShare(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the template is shared with the user", InternalError: err}
+	} else if !existsShare {
+		return &appErrors.BadRequestError{Msg: "The template isn't shared with the user"}
+	}
+
+	if err := s.repo.DeleteListTemplateShare(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error unsharing the template", InternalError: err}
+	}
+
+	return nil
+}
@@ -15,25 +15,70 @@ func NewUpdateCategoryService(repo domain.CategoriesRepository) *UpdateCategoryS
 	return &UpdateCategoryService{repo}
 }
 
-func (s *UpdateCategoryService) UpdateCategory(ctx context.Context, categoryToUpdate *domain.CategoryEntity) error {
-	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryToUpdate.ID, UserID: categoryToUpdate.UserID})
+// UpdateCategory only changes the fields sent in the update. The userID is the member doing the
+// update, whose default category is changed
+func (s *UpdateCategoryService) UpdateCategory(ctx context.Context, categoryID int32, userID int32, workspaceID int32, update *domain.CategoryUpdate) (*domain.CategoryEntity, error) {
+	foundCategory, err := s.repo.FindCategory(ctx, domain.CategoryRecord{ID: categoryID, WorkspaceID: workspaceID})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if foundCategory.Name != categoryToUpdate.Name.String() {
-		if existsCategory, err := s.repo.ExistsCategory(ctx, domain.CategoryRecord{Name: categoryToUpdate.Name.String(), UserID: categoryToUpdate.UserID}); err != nil {
-			return &appErrors.UnexpectedError{Msg: "Error checking if a category with the same name already exists", InternalError: err}
-		} else if existsCategory {
-			return &appErrors.BadRequestError{Msg: "A category with the same name already exists", InternalError: nil}
+	// The category keeps the user who created it even if another member of the workspace
+	// updates it
+	categoryToUpdate := foundCategory.ToCategoryEntity()
+	categoryToUpdate.ID = categoryID
+	categoryToUpdate.WorkspaceID = workspaceID
+	fields := update.ApplyTo(categoryToUpdate)
+
+	tree, err := getCategoryTree(ctx, s.repo, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moving the category also moves its subcategories, which can't become its parent
+	if err := tree.CheckParent(categoryID, categoryToUpdate.ParentID); err != nil {
+		return nil, err
+	}
+
+	if tree.HasSiblingNamed(categoryToUpdate.Name.String(), categoryToUpdate.ParentID, categoryID) {
+		return nil, &appErrors.BadRequestError{Msg: "A category with the same name already exists", InternalError: nil}
+	}
+
+	if len(fields) > 0 {
+		err = s.repo.UpdateCategory(ctx, categoryToUpdate.ToCategoryRecord(), fields)
+		if err != nil {
+			return nil, &appErrors.UnexpectedError{Msg: "Error updating the user category", InternalError: err}
 		}
 	}
 
-	record := categoryToUpdate.ToCategoryRecord()
+	if err := s.updateDefaultCategory(ctx, userID, categoryToUpdate, update.IsDefault); err != nil {
+		return nil, err
+	}
+
+	return categoryToUpdate, nil
+}
 
-	err = s.repo.UpdateCategory(ctx, record)
+// updateDefaultCategory only changes the default category of the user when isDefault is sent
+func (s *UpdateCategoryService) updateDefaultCategory(ctx context.Context, userID int32, categoryToUpdate *domain.CategoryEntity, isDefault *bool) error {
+	defaultCategoryID, err := s.repo.FindDefaultCategoryID(ctx, userID, categoryToUpdate.WorkspaceID)
 	if err != nil {
-		return &appErrors.UnexpectedError{Msg: "Error updating the user category", InternalError: err}
+		return &appErrors.UnexpectedError{Msg: "Error getting the default category", InternalError: err}
+	}
+
+	categoryToUpdate.IsDefault = defaultCategoryID != nil && *defaultCategoryID == categoryToUpdate.ID
+	if isDefault == nil || *isDefault == categoryToUpdate.IsDefault {
+		return nil
+	}
+
+	categoryToUpdate.IsDefault = *isDefault
+
+	var newDefaultCategoryID *int32
+	if categoryToUpdate.IsDefault {
+		newDefaultCategoryID = &categoryToUpdate.ID
+	}
+
+	if err := s.repo.SetDefaultCategory(ctx, userID, categoryToUpdate.WorkspaceID, newDefaultCategoryID); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error setting the default category", InternalError: err}
 	}
 
 	return nil
@@ -4,41 +4,90 @@ import (
 	"context"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 )
 
 type UpdateListService struct {
-	repo     domain.ListsRepository
-	eventBus events.EventBus
+	repo       domain.ListsRepository
+	tagsRepo   domain.TagsRepository
+	quotasRepo domain.QuotasRepository
+	cfgSrv     sharedApp.ConfigurationService
+	eventBus   events.EventBus
 }
 
-func NewUpdateListService(listRepo domain.ListsRepository, eventBus events.EventBus) *UpdateListService {
-	return &UpdateListService{listRepo, eventBus}
+func NewUpdateListService(listRepo domain.ListsRepository, tagsRepo domain.TagsRepository, quotasRepo domain.QuotasRepository, cfgSrv sharedApp.ConfigurationService, eventBus events.EventBus) *UpdateListService {
+	return &UpdateListService{listRepo, tagsRepo, quotasRepo, cfgSrv, eventBus}
 }
 
 func (s *UpdateListService) UpdateList(ctx context.Context, listToUpdate *domain.ListEntity) error {
-	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listToUpdate.ID, UserID: listToUpdate.UserID})
+	foundList, err := s.repo.FindList(ctx, domain.ListRecord{ID: listToUpdate.ID, WorkspaceID: listToUpdate.WorkspaceID})
 	if err != nil {
 		return err
 	}
 
+	// The list keeps the user who created it even if another member of the workspace updates
+	// it, so the quotas and the activity are the ones of that user. The tags are the ones of the
+	// user doing the update
+	userID := listToUpdate.UserID
+	listToUpdate.UserID = foundList.UserID
+
 	if foundList.Name != listToUpdate.Name.String() {
-		if existsList, err := s.repo.ExistsList(ctx, domain.ListRecord{Name: listToUpdate.Name.String(), UserID: listToUpdate.UserID}); err != nil {
+		if existsList, err := s.repo.ExistsList(ctx, domain.ListRecord{Name: listToUpdate.Name.String(), WorkspaceID: listToUpdate.WorkspaceID}); err != nil {
 			return &appErrors.UnexpectedError{Msg: "Error checking if a list with the same name already exists", InternalError: err}
 		} else if existsList {
 			return &appErrors.BadRequestError{Msg: "A list with the same name already exists", InternalError: nil}
 		}
 	}
 
+	// The items limit is only checked when the list grows, so a list that was over a lowered
+	// limit can still be edited to get under it
+	if len(listToUpdate.Items) > len(foundList.Items) {
+		limits, err := getQuotaLimits(ctx, s.quotasRepo, s.cfgSrv, listToUpdate.UserID)
+		if err != nil {
+			return err
+		}
+
+		if err := limits.CheckItems(len(listToUpdate.Items)); err != nil {
+			return err
+		}
+	}
+
+	version, err := domain.NewListVersionRecord(foundList)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error saving the list version", InternalError: err}
+	}
+
 	record := listToUpdate.ToListRecord()
 
-	err = s.repo.UpdateList(ctx, record)
+	// The version is saved in the same transaction as the update, so there isn't a version
+	// of an update that failed
+	err = s.repo.UpdateListWithVersion(ctx, record, version)
 	if err != nil {
 		return &appErrors.UnexpectedError{Msg: "Error updating the user list", InternalError: err}
 	}
 
-	go s.eventBus.Publish(events.ListUpdated, record.ID)
+	for i, v := range listToUpdate.Items {
+		v.ID = record.Items[i].ID
+	}
+
+	if err := saveListTags(ctx, s.tagsRepo, userID, listToUpdate); err != nil {
+		return err
+	}
+
+	go s.eventBus.Publish(events.ListUpdated, domain.NewListUpdatedEvent(foundList, record))
+
+	added, renamed, removed := domain.NewListItemEvents(foundList, record)
+	s.publishItemEvents(events.ListItemAdded, added)
+	s.publishItemEvents(events.ListItemRenamed, renamed)
+	s.publishItemEvents(events.ListItemRemoved, removed)
 
 	return nil
 }
+
+func (s *UpdateListService) publishItemEvents(eventName string, itemEvents []domain.ListItemEvent) {
+	for _, event := range itemEvents {
+		go s.eventBus.Publish(eventName, event)
+	}
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+)
+
+type UpdateTagService struct {
+	repo     domain.TagsRepository
+	eventBus events.EventBus
+}
+
+func NewUpdateTagService(repo domain.TagsRepository, eventBus events.EventBus) *UpdateTagService {
+	return &UpdateTagService{repo, eventBus}
+}
+
+// UpdateTag renames the tag of the user and updates the search documents of its lists
+func (s *UpdateTagService) UpdateTag(ctx context.Context, tagToUpdate *domain.TagEntity) error {
+	foundTag, err := s.repo.FindTag(ctx, domain.TagRecord{ID: tagToUpdate.ID, UserID: tagToUpdate.UserID})
+	if err != nil {
+		return err
+	}
+
+	if foundTag.Name == tagToUpdate.Name.String() {
+		return nil
+	}
+
+	exists, err := s.repo.ExistsTag(ctx, domain.TagRecord{UserID: tagToUpdate.UserID, Name: tagToUpdate.Name.String()})
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if a tag with the same name already exists", InternalError: err}
+	}
+
+	if exists {
+		return &appErrors.BadRequestError{Msg: "A tag with the same name already exists", InternalError: nil}
+	}
+
+	err = s.repo.UpdateTag(ctx, tagToUpdate.ToTagRecord())
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error updating the user tag", InternalError: err}
+	}
+
+	listIDs, err := s.repo.GetTagListIDs(ctx, tagToUpdate.ID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the lists of the tag", InternalError: err}
+	}
+
+	publishListTagsChanged(s.eventBus, listIDs)
+
+	return nil
+}
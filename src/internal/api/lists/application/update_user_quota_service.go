@@ -0,0 +1,50 @@
+package application
+
+import (
+	"context"
+
+	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type UpdateUserQuotaService struct {
+	quotasRepo  domain.QuotasRepository
+	usersRepo   authDomain.UsersRepository
+	auditLogger *sharedApp.AuditLogger
+}
+
+func NewUpdateUserQuotaService(quotasRepo domain.QuotasRepository, usersRepo authDomain.UsersRepository, auditRepo audit.AuditLogRepository) *UpdateUserQuotaService {
+	return &UpdateUserQuotaService{quotasRepo, usersRepo, sharedApp.NewAuditLogger(auditRepo)}
+}
+
+// UpdateUserQuota replaces the limits that the user has instead of the default ones. A nil
+// limit uses the default one and a limit of 0 means that the user doesn't have any limit
+func (s *UpdateUserQuotaService) UpdateUserQuota(ctx context.Context, quota *domain.UserQuotaRecord) error {
+	for _, limit := range []*int32{quota.MaxLists, quota.MaxItemsPerList, quota.MaxCategories} {
+		if limit != nil && *limit < 0 {
+			return &appErrors.BadRequestError{Msg: "The quota limits can't be negative"}
+		}
+	}
+
+	if existsUser, err := s.usersRepo.ExistsUser(ctx, authDomain.UserRecord{ID: quota.UserID}); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error checking if the user exists", InternalError: err}
+	} else if !existsUser {
+		return &appErrors.BadRequestError{Msg: "The user doesn't exist"}
+	}
+
+	previousQuota, err := s.quotasRepo.GetUserQuota(ctx, quota.UserID)
+	if err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error getting the user quota", InternalError: err}
+	}
+
+	if err := s.quotasRepo.SaveUserQuota(ctx, quota); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error saving the user quota", InternalError: err}
+	}
+
+	s.auditLogger.Log(ctx, audit.ActionUserQuotaUpdated, audit.TargetUser, quota.UserID, previousQuota, quota)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// EncodeActivityCursor returns the opaque cursor of the page that starts after the activity
+func EncodeActivityCursor(activityID int32) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(int(activityID))))
+}
+
+// DecodeActivityCursor returns the id of the activity the page starts after, or 0 for the
+// first page
+func DecodeActivityCursor(cursor string) (int32, error) {
+	if len(cursor) == 0 {
+		return 0, nil
+	}
+
+	invalidErr := &appErrors.BadRequestError{Msg: "Invalid cursor"}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, invalidErr
+	}
+
+	activityID, err := strconv.ParseInt(string(decoded), 10, 32)
+	if err != nil || activityID <= 0 {
+		return 0, invalidErr
+	}
+
+	return int32(activityID), nil
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivityCursor_Can_Be_Decoded(t *testing.T) {
+	activityID, err := DecodeActivityCursor(EncodeActivityCursor(25))
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(25), activityID)
+}
+
+func TestDecodeActivityCursor_Returns_Zero_For_The_First_Page(t *testing.T) {
+	activityID, err := DecodeActivityCursor("")
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), activityID)
+}
+
+func TestDecodeActivityCursor_Returns_An_Error_If_The_Cursor_Is_Not_Valid(t *testing.T) {
+	for _, cursor := range []string{"$$", EncodeActivityCursor(0), "d2FkdXM"} {
+		_, err := DecodeActivityCursor(cursor)
+
+		assert.EqualError(t, err, "Invalid cursor")
+	}
+}
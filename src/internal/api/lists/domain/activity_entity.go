@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+const (
+	ActivityListCreated       = "list.created"
+	ActivityListRenamed       = "list.renamed"
+	ActivityListRecategorized = "list.recategorized"
+	ActivityListDeleted       = "list.deleted"
+	ActivityItemAdded         = "item.added"
+	ActivityItemRenamed       = "item.renamed"
+	ActivityItemRemoved       = "item.removed"
+	ActivityItemMoved         = "item.moved"
+)
+
+// ActivityEntity is something that changed in a list. The related list is the destination
+// list when an item is moved, so the activity is shown in both lists
+type ActivityEntity struct {
+	ID            int32     `json:"id"`
+	UserID        int32     `json:"-"`
+	ListID        int32     `json:"listId"`
+	RelatedListID *int32    `json:"relatedListId,omitempty"`
+	Action        string    `json:"action"`
+	Summary       string    `json:"summary"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+func (e *ActivityEntity) ToActivityRecord() *ActivityRecord {
+	return &ActivityRecord{
+		ID:            e.ID,
+		UserID:        e.UserID,
+		ListID:        e.ListID,
+		RelatedListID: e.RelatedListID,
+		Action:        e.Action,
+		Summary:       e.Summary,
+		CreatedAt:     e.CreatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+type ActivityRecord struct {
+	ID            int32     `gorm:"type:int(32);primary_key"`
+	UserID        int32     `gorm:"column:userId;type:int(32)"`
+	ListID        int32     `gorm:"column:listId;type:int(32)"`
+	RelatedListID *int32    `gorm:"column:relatedListId;type:int(32)"`
+	Action        string    `gorm:"column:action;type:varchar(50)"`
+	Summary       string    `gorm:"column:summary;type:varchar(255)"`
+	CreatedAt     time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+type ActivityRecords []ActivityRecord
+
+func (ActivityRecord) TableName() string {
+	return "activity"
+}
+
+func (r *ActivityRecord) ToActivityEntity() *ActivityEntity {
+	return &ActivityEntity{
+		ID:            r.ID,
+		UserID:        r.UserID,
+		ListID:        r.ListID,
+		RelatedListID: r.RelatedListID,
+		Action:        r.Action,
+		Summary:       r.Summary,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func (a ActivityRecords) ToActivityEntities() []*ActivityEntity {
+	res := make([]*ActivityEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToActivityEntity()
+	}
+
+	return res
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+// ActivityQuery selects the activity of a user, or only the one of a list when ListID is set.
+// The activity is returned from the newest to the oldest, starting before BeforeID if it's set
+type ActivityQuery struct {
+	UserID   int32
+	ListID   int32
+	BeforeID int32
+	Limit    int
+}
+
+type ActivityRepository interface {
+	CreateActivity(ctx context.Context, record *ActivityRecord) error
+	GetActivity(ctx context.Context, query ActivityQuery) (ActivityRecords, error)
+}
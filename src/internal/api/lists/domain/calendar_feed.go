@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// CalendarFeedTypeTodo serves the items as VTODO components, with the due date as DUE
+	CalendarFeedTypeTodo = "todo"
+	// CalendarFeedTypeEvent serves the items as VEVENT components, with the due date as
+	// DTSTART, for the calendar clients that ignore the VTODO ones
+	CalendarFeedTypeEvent = "event"
+)
+
+const calendarFeedName = "Todos"
+
+func NewCalendarFeedType(feedType string) (string, error) {
+	switch feedType {
+	case CalendarFeedTypeTodo, CalendarFeedTypeEvent:
+		return feedType, nil
+	}
+
+	return "", errors.New(`The type must be "todo" or "event"`)
+}
+
+// CalendarFeedContentHash returns the hash of the lists served by a feed, so it changes only
+// when something of the feed changes
+func CalendarFeedContentHash(lists []*ExportedList) (string, error) {
+	content, err := json.Marshal(lists)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// CalendarFeedETag returns the ETag of a feed of the type with the content hash
+func CalendarFeedETag(contentHash string, feedType string) string {
+	return fmt.Sprintf(`"%v-%v"`, contentHash, feedType)
+}
+
+// NewCalendarFeedWriter returns the writer of a feed. Only the items with due date are
+// written, and lastModified is the DTSTAMP of all of them
+func NewCalendarFeedWriter(w io.Writer, feedType string, lastModified time.Time) ListsExportWriter {
+	component := "VTODO"
+	if feedType == CalendarFeedTypeEvent {
+		component = "VEVENT"
+	}
+
+	return &icsListsExportWriter{w: w, stamp: lastModified, component: component, name: calendarFeedName, onlyDue: true}
+}
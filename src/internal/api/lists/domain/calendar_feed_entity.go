@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// CalendarFeedEntity is a calendar feed as returned to its user. The token and the url are
+// only returned when the feed is created, because only the hash of the token is stored
+type CalendarFeedEntity struct {
+	ID           int32      `json:"id"`
+	UserID       int32      `json:"-"`
+	WorkspaceID  int32      `json:"-"`
+	CategoryID   *int32     `json:"categoryId"`
+	ListID       *int32     `json:"listId"`
+	Token        string     `json:"token,omitempty"`
+	Url          string     `json:"url,omitempty"`
+	LastModified *time.Time `json:"lastModified"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// NewCalendarFeedToken generates the random token of a feed and returns it with its hash
+func NewCalendarFeedToken() (string, string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	token := hex.EncodeToString(b)
+
+	return token, HashCalendarFeedToken(token), nil
+}
+
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// CalendarFeedUrl returns the url of the feed of the token
+func CalendarFeedUrl(token string) string {
+	return fmt.Sprintf("/feeds/%v/calendar.ics", token)
+}
+
+func (e *CalendarFeedEntity) ToCalendarFeedRecord() *CalendarFeedRecord {
+	return &CalendarFeedRecord{
+		ID:           e.ID,
+		UserID:       e.UserID,
+		WorkspaceID:  e.WorkspaceID,
+		CategoryID:   e.CategoryID,
+		ListID:       e.ListID,
+		LastModified: e.LastModified,
+		CreatedAt:    e.CreatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// CalendarFeedRecord is a secret url of a user that serves the items with due date of a
+// workspace. Only the hash of its token is stored. ContentHash and LastModified are the ones
+// of the last time the feed was served
+type CalendarFeedRecord struct {
+	ID           int32      `gorm:"type:int(32);primary_key"`
+	UserID       int32      `gorm:"column:userId;type:int(32)"`
+	WorkspaceID  int32      `gorm:"column:workspaceId;type:int(32)"`
+	TokenHash    string     `gorm:"column:tokenHash;type:varchar(64)"`
+	CategoryID   *int32     `gorm:"column:categoryId;type:int(32)"`
+	ListID       *int32     `gorm:"column:listId;type:int(32)"`
+	ContentHash  string     `gorm:"column:contentHash;type:varchar(64)"`
+	LastModified *time.Time `gorm:"column:lastModified;type:timestamp"`
+	CreatedAt    time.Time  `gorm:"column:createdAt;type:timestamp"`
+}
+
+type CalendarFeedRecords []CalendarFeedRecord
+
+func (CalendarFeedRecord) TableName() string {
+	return "calendarFeeds"
+}
+
+func (r *CalendarFeedRecord) ToCalendarFeedEntity() *CalendarFeedEntity {
+	return &CalendarFeedEntity{
+		ID:           r.ID,
+		UserID:       r.UserID,
+		WorkspaceID:  r.WorkspaceID,
+		CategoryID:   r.CategoryID,
+		ListID:       r.ListID,
+		LastModified: r.LastModified,
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func (a CalendarFeedRecords) ToCalendarFeedEntities() []*CalendarFeedEntity {
+	res := make([]*CalendarFeedEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToCalendarFeedEntity()
+	}
+
+	return res
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCalendarFeedType(t *testing.T) {
+	for _, v := range []string{CalendarFeedTypeTodo, CalendarFeedTypeEvent} {
+		feedType, err := NewCalendarFeedType(v)
+		assert.NoError(t, err)
+		assert.Equal(t, v, feedType)
+	}
+
+	_, err := NewCalendarFeedType("wadus")
+	assert.EqualError(t, err, `The type must be "todo" or "event"`)
+}
+
+func TestCalendarFeedContentHash(t *testing.T) {
+	hash, err := CalendarFeedContentHash(exportedLists)
+	require.NoError(t, err)
+	assert.Regexp(t, "^[0-9a-f]{64}$", hash)
+
+	sameHash, err := CalendarFeedContentHash(exportedLists)
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash)
+
+	otherHash, err := CalendarFeedContentHash(exportedLists[:1])
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestCalendarFeedETag(t *testing.T) {
+	assert.Equal(t, `"abc-todo"`, CalendarFeedETag("abc", CalendarFeedTypeTodo))
+	assert.Equal(t, `"abc-event"`, CalendarFeedETag("abc", CalendarFeedTypeEvent))
+}
+
+func TestCalendarFeedWriter_Only_Writes_The_Items_With_Due_Date(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewCalendarFeedWriter(&buf, CalendarFeedTypeEvent, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, v := range exportedLists {
+		require.NoError(t, writer.WriteList(v))
+	}
+	require.NoError(t, writer.Close())
+
+	expected := "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//todos//lists//EN\r\nX-WR-CALNAME:Todos\r\n" +
+		"BEGIN:VEVENT\r\nUID:list-item-11@todos\r\nDTSTAMP:20230101T000000Z\r\nDTSTART:20230201T100000Z\r\n" +
+		"SUMMARY:item1\r\nDESCRIPTION:desc\\, with\\; chars\\nand lines\r\nCATEGORIES:list1,category5\r\nEND:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type CalendarFeedsRepository interface {
+	FindCalendarFeed(ctx context.Context, query CalendarFeedRecord) (*CalendarFeedRecord, error)
+	/* GetCalendarFeeds returns the feeds sorted by id */
+	GetCalendarFeeds(ctx context.Context, query CalendarFeedRecord) (CalendarFeedRecords, error)
+	CreateCalendarFeed(ctx context.Context, record *CalendarFeedRecord) error
+	DeleteCalendarFeed(ctx context.Context, query CalendarFeedRecord) error
+	/* UpdateCalendarFeedContent only changes the content hash and the last modified date of the feed */
+	UpdateCalendarFeedContent(ctx context.Context, feedID int32, contentHash string, lastModified time.Time) error
+}
@@ -5,8 +5,28 @@ import "context"
 type CategoriesRepository interface {
 	FindCategory(ctx context.Context, query CategoryRecord) (*CategoryRecord, error)
 	ExistsCategory(ctx context.Context, query CategoryRecord) (bool, error)
+	CountCategories(ctx context.Context, query CategoryRecord) (int64, error)
+	/* GetCategories returns the categories sorted by their position */
 	GetCategories(ctx context.Context, query CategoryRecord) (CategoryRecords, error)
 	CreateCategory(ctx context.Context, record *CategoryRecord) error
 	DeleteCategory(ctx context.Context, query CategoryRecord) error
-	UpdateCategory(ctx context.Context, record *CategoryRecord) error
+	/* UpdateCategory only changes the given fields, which can't be the user, the workspace or the position of the category */
+	UpdateCategory(ctx context.Context, record *CategoryRecord, fields []string) error
+	/* DeleteCategories removes the matching categories from the lists before deleting them */
+	DeleteCategories(ctx context.Context, query CategoryRecord) error
+	TransferCategories(ctx context.Context, fromUserID int32, toUserID int32) error
+	/* ReassignAndDeleteCategory moves the lists of the category to the target one, or leaves them without category when there isn't a target, and deletes the category in one transaction. It returns the moved lists */
+	ReassignAndDeleteCategory(ctx context.Context, categoryID int32, targetCategoryID *int32) (ListRecords, error)
+	/* TrashListsAndDeleteCategory moves the lists of the category to the trash and deletes the category in one transaction. It returns the trashed lists */
+	TrashListsAndDeleteCategory(ctx context.Context, categoryID int32) (ListRecords, error)
+	/* ReorderCategories sets the position of each category to its index in one transaction */
+	ReorderCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) error
+	/* FindDefaultCategoryID returns nil when the user doesn't have a default category in the workspace */
+	FindDefaultCategoryID(ctx context.Context, userID int32, workspaceID int32) (*int32, error)
+	/* SetDefaultCategory removes the default category of the user in the workspace when the category is nil */
+	SetDefaultCategory(ctx context.Context, userID int32, workspaceID int32, categoryID *int32) error
+	/* GetCategoriesStats returns the numbers of the categories that have lists, without loading the lists */
+	GetCategoriesStats(ctx context.Context, query CategoryStatsQuery) (CategoryStatsRecords, error)
+	/* GetLargestLists returns, for each category, its lists with more items up to the limit, without their items */
+	GetLargestLists(ctx context.Context, query CategoryStatsQuery, limit int) (ListRecords, error)
 }
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CategoryColorValueObject struct {
+	categoryColor string
+}
+
+var categoryColorRegexp = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// NewCategoryColorValueObject accepts an empty color, which means the category doesn't have
+// one, or an hex color like #1a2b3c. The color is stored in lowercase
+func NewCategoryColorValueObject(color string) (CategoryColorValueObject, error) {
+	color = strings.ToLower(color)
+
+	if len(color) > 0 && !categoryColorRegexp.MatchString(color) {
+		return CategoryColorValueObject{}, &appErrors.BadRequestError{Msg: "The category color must be an hex color like #1a2b3c"}
+	}
+
+	return CategoryColorValueObject{categoryColor: color}, nil
+}
+
+func (v CategoryColorValueObject) String() string {
+	return v.categoryColor
+}
+
+func (v CategoryColorValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.categoryColor), nil
+}
+
+func (v *CategoryColorValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewCategoryColorValueObject(string(d))
+	return err
+}
+
+func (v CategoryColorValueObject) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+func (v *CategoryColorValueObject) Scan(value interface{}) error {
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewCategoryColorValueObject(fmt.Sprintf("%s", sv))
+		return nil
+
+	}
+	return errors.New("failed to scan CategoryColorValueObject")
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryColor_Validates_The_Format(t *testing.T) {
+	for _, color := range []string{"red", "#12345", "#1234567", "#12345g", "123456"} {
+		categoryColor, err := NewCategoryColorValueObject(color)
+
+		assert.Empty(t, categoryColor)
+		badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+		require.Equal(t, true, isBadReqErr, "should be a bad request error")
+		assert.Equal(t, "The category color must be an hex color like #1a2b3c", badReqErr.Error())
+	}
+}
+
+func TestNewCategoryColor_Returns_A_Valid_Color_In_Lowercase(t *testing.T) {
+	categoryColor, err := NewCategoryColorValueObject("#1A2b3C")
+
+	assert.Equal(t, "#1a2b3c", categoryColor.String())
+	assert.NoError(t, err)
+}
+
+func TestNewCategoryColor_Allows_An_Empty_Color(t *testing.T) {
+	categoryColor, err := NewCategoryColorValueObject("")
+
+	assert.Equal(t, "", categoryColor.String())
+	assert.NoError(t, err)
+}
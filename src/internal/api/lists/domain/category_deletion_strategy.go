@@ -0,0 +1,21 @@
+package domain
+
+import "errors"
+
+const (
+	// CategoryDeletionStrategyReassign moves the lists of the deleted category to another one
+	CategoryDeletionStrategyReassign = "reassign"
+	// CategoryDeletionStrategyUncategorize leaves the lists of the deleted category without category
+	CategoryDeletionStrategyUncategorize = "uncategorize"
+	// CategoryDeletionStrategyCascade moves the lists of the deleted category to the trash
+	CategoryDeletionStrategyCascade = "cascade"
+)
+
+func NewCategoryDeletionStrategy(strategy string) (string, error) {
+	switch strategy {
+	case CategoryDeletionStrategyReassign, CategoryDeletionStrategyUncategorize, CategoryDeletionStrategyCascade:
+		return strategy, nil
+	}
+
+	return "", errors.New(`The strategy must be "reassign", "uncategorize" or "cascade"`)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCategoryDeletionStrategy(t *testing.T) {
+	for _, strategy := range []string{CategoryDeletionStrategyReassign, CategoryDeletionStrategyUncategorize, CategoryDeletionStrategyCascade} {
+		res, err := NewCategoryDeletionStrategy(strategy)
+
+		assert.Nil(t, err)
+		assert.Equal(t, strategy, res)
+	}
+
+	_, err := NewCategoryDeletionStrategy("wadus")
+
+	assert.EqualError(t, err, `The strategy must be "reassign", "uncategorize" or "cascade"`)
+}
@@ -1,17 +1,46 @@
 package domain
 
+import "database/sql"
+
 type CategoryEntity struct {
 	ID          int32                          `json:"id"`
 	Name        CategoryNameValueObject        `json:"name"`
 	UserID      int32                          `json:"-"`
+	WorkspaceID int32                          `json:"-"`
 	Description CategoryDescriptionValueObject `json:"description"`
+	ParentID    *int32                         `json:"parentId"`
+	Color       CategoryColorValueObject       `json:"color"`
+	Icon        CategoryIconValueObject        `json:"icon"`
+	Position    int32                          `json:"position"`
+	// IsDefault is true when it's the default category of the user doing the request
+	IsDefault bool `json:"isDefault"`
+	// Stats is only set when they are requested
+	Stats *CategoryStatsEntity `json:"stats,omitempty"`
 }
 
 func (e *CategoryEntity) ToCategoryRecord() *CategoryRecord {
+	// The parent is always set, even when it's NULL, so updating the parent of a category
+	// without parent moves it to the root
+	var parentID sql.NullInt32
+	if e.ParentID != nil {
+		parentID = sql.NullInt32{Int32: *e.ParentID, Valid: true}
+	}
+
 	return &CategoryRecord{
 		ID:          e.ID,
 		Name:        e.Name.String(),
 		UserID:      e.UserID,
+		WorkspaceID: e.WorkspaceID,
 		Description: e.Description.String(),
+		ParentID:    &parentID,
+		Color:       e.Color.String(),
+		Icon:        e.Icon.String(),
+		Position:    e.Position,
 	}
 }
+
+// CategoryTreeEntity is a category together with its subcategories
+type CategoryTreeEntity struct {
+	*CategoryEntity
+	Children []*CategoryTreeEntity `json:"children"`
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type CategoryIconValueObject struct {
+	categoryIcon string
+}
+
+const categoryIconMaxLength = 30
+
+var categoryIconRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// NewCategoryIconValueObject accepts an empty icon, which means the category doesn't have
+// one, or the name of an icon like shopping-cart
+func NewCategoryIconValueObject(icon string) (CategoryIconValueObject, error) {
+	if len(icon) > categoryIconMaxLength {
+		return CategoryIconValueObject{}, &appErrors.BadRequestError{Msg: fmt.Sprintf("The category icon can not have more than %v characters", categoryIconMaxLength)}
+	}
+
+	if len(icon) > 0 && !categoryIconRegexp.MatchString(icon) {
+		return CategoryIconValueObject{}, &appErrors.BadRequestError{Msg: "The category icon can only have lowercase letters, numbers and dashes"}
+	}
+
+	return CategoryIconValueObject{categoryIcon: icon}, nil
+}
+
+func (v CategoryIconValueObject) String() string {
+	return v.categoryIcon
+}
+
+func (v CategoryIconValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.categoryIcon), nil
+}
+
+func (v *CategoryIconValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewCategoryIconValueObject(string(d))
+	return err
+}
+
+func (v CategoryIconValueObject) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+func (v *CategoryIconValueObject) Scan(value interface{}) error {
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewCategoryIconValueObject(fmt.Sprintf("%s", sv))
+		return nil
+
+	}
+	return errors.New("failed to scan CategoryIconValueObject")
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryIcon_Validates_MaxLength(t *testing.T) {
+	categoryIcon, err := NewCategoryIconValueObject("a-very-long-icon-name-that-is-not-valid")
+
+	assert.Empty(t, categoryIcon)
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The category icon can not have more than 30 characters", badReqErr.Error())
+}
+
+func TestNewCategoryIcon_Validates_The_Format(t *testing.T) {
+	for _, icon := range []string{"Cart", "shopping cart", "-cart", "cart-", "cart--shopping", "<svg>"} {
+		categoryIcon, err := NewCategoryIconValueObject(icon)
+
+		assert.Empty(t, categoryIcon)
+		badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+		require.Equal(t, true, isBadReqErr, "should be a bad request error")
+		assert.Equal(t, "The category icon can only have lowercase letters, numbers and dashes", badReqErr.Error())
+	}
+}
+
+func TestNewCategoryIcon_Returns_A_Valid_Icon(t *testing.T) {
+	categoryIcon, err := NewCategoryIconValueObject("shopping-cart2")
+
+	assert.Equal(t, "shopping-cart2", categoryIcon.String())
+	assert.NoError(t, err)
+}
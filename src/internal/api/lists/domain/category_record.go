@@ -1,10 +1,17 @@
 package domain
 
+import "database/sql"
+
 type CategoryRecord struct {
-	ID          int32  `gorm:"type:int(32);primary_key"`
-	Name        string `gorm:"type:varchar(12)"`
-	Description string `gorm:"type:varchar(200)"`
-	UserID      int32  `gorm:"column:userId;type:int(32)"`
+	ID          int32          `gorm:"type:int(32);primary_key"`
+	Name        string         `gorm:"type:varchar(12)"`
+	Description string         `gorm:"type:varchar(500)"`
+	UserID      int32          `gorm:"column:userId;type:int(32)"`
+	WorkspaceID int32          `gorm:"column:workspaceId;type:int(32)"`
+	ParentID    *sql.NullInt32 `gorm:"column:parentId;type:int(32)"`
+	Color       string         `gorm:"type:varchar(7)"`
+	Icon        string         `gorm:"type:varchar(30)"`
+	Position    int32          `gorm:"type:int(32)"`
 }
 
 type CategoryRecords []CategoryRecord
@@ -16,13 +23,30 @@ func (CategoryRecord) TableName() string {
 func (r *CategoryRecord) ToCategoryEntity() *CategoryEntity {
 	nvo, _ := NewCategoryNameValueObject(r.Name)
 	dvo, _ := NewCategoryDescriptionValueObject(r.Description)
+	cvo, _ := NewCategoryColorValueObject(r.Color)
+	ivo, _ := NewCategoryIconValueObject(r.Icon)
 
 	return &CategoryEntity{
 		ID:          r.ID,
 		Name:        nvo,
 		Description: dvo,
 		UserID:      r.UserID,
+		WorkspaceID: r.WorkspaceID,
+		ParentID:    r.parentID(),
+		Color:       cvo,
+		Icon:        ivo,
+		Position:    r.Position,
+	}
+}
+
+func (r *CategoryRecord) parentID() *int32 {
+	if r.ParentID == nil || !r.ParentID.Valid {
+		return nil
 	}
+
+	parentID := r.ParentID.Int32
+
+	return &parentID
 }
 
 func (a CategoryRecords) ToCategoriesEntities() []*CategoryEntity {
@@ -34,3 +58,13 @@ func (a CategoryRecords) ToCategoriesEntities() []*CategoryEntity {
 
 	return res
 }
+
+func (a CategoryRecords) NamesByID() map[int32]string {
+	res := make(map[int32]string, len(a))
+
+	for _, v := range a {
+		res[v.ID] = v.Name
+	}
+
+	return res
+}
@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+// CategoryStatsLargestLists is the number of largest lists returned in the stats of a category
+const CategoryStatsLargestLists = 5
+
+// CategoryStatsQuery selects the stats of the categories of a workspace, or only the ones of a
+// category when CategoryID is set
+type CategoryStatsQuery struct {
+	WorkspaceID int32
+	CategoryID  int32
+}
+
+// CategoryStatsRecord has the numbers of the lists of a category. It isn't a table, it's the
+// result of an aggregate query
+type CategoryStatsRecord struct {
+	CategoryID     int32      `gorm:"column:categoryId"`
+	ListsCount     int64      `gorm:"column:listsCount"`
+	ItemsCount     int64      `gorm:"column:itemsCount"`
+	LastActivityAt *time.Time `gorm:"column:lastActivityAt"`
+}
+
+type CategoryStatsRecords []CategoryStatsRecord
+
+type CategoryStatsListEntity struct {
+	ID         int32  `json:"id"`
+	Name       string `json:"name"`
+	ItemsCount int32  `json:"itemsCount"`
+}
+
+type CategoryStatsEntity struct {
+	CategoryID     int32                      `json:"categoryId"`
+	ListsCount     int64                      `json:"listsCount"`
+	ItemsCount     int64                      `json:"itemsCount"`
+	LastActivityAt *time.Time                 `json:"lastActivityAt"`
+	LargestLists   []*CategoryStatsListEntity `json:"largestLists"`
+}
+
+// NewCategoryStatsEntities returns the stats of each one of the categories, in the same order.
+// The categories without lists get empty stats
+func NewCategoryStatsEntities(categoryIDs []int32, stats CategoryStatsRecords, largestLists ListRecords) []*CategoryStatsEntity {
+	byCategory := make(map[int32]*CategoryStatsEntity, len(categoryIDs))
+	res := make([]*CategoryStatsEntity, len(categoryIDs))
+
+	for i, id := range categoryIDs {
+		res[i] = &CategoryStatsEntity{CategoryID: id, LargestLists: []*CategoryStatsListEntity{}}
+		byCategory[id] = res[i]
+	}
+
+	for _, s := range stats {
+		if e, ok := byCategory[s.CategoryID]; ok {
+			e.ListsCount = s.ListsCount
+			e.ItemsCount = s.ItemsCount
+			e.LastActivityAt = s.LastActivityAt
+		}
+	}
+
+	for _, l := range largestLists {
+		if l.CategoryID == nil || !l.CategoryID.Valid {
+			continue
+		}
+
+		if e, ok := byCategory[l.CategoryID.Int32]; ok {
+			e.LargestLists = append(e.LargestLists, &CategoryStatsListEntity{ID: l.ID, Name: l.Name, ItemsCount: l.ItemsCount})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCategoryStatsEntities(t *testing.T) {
+	lastActivityAt := time.Date(2022, 5, 3, 10, 0, 0, 0, time.UTC)
+	stats := CategoryStatsRecords{
+		{CategoryID: 2, ListsCount: 3, ItemsCount: 10, LastActivityAt: &lastActivityAt},
+		{CategoryID: 9, ListsCount: 1, ItemsCount: 1},
+	}
+	largestLists := ListRecords{
+		{ID: 5, Name: "list5", ItemsCount: 7, CategoryID: &sql.NullInt32{Int32: 2, Valid: true}},
+		{ID: 6, Name: "list6", ItemsCount: 3, CategoryID: &sql.NullInt32{Int32: 2, Valid: true}},
+		{ID: 7, Name: "list7", ItemsCount: 1, CategoryID: &sql.NullInt32{}},
+	}
+
+	res := NewCategoryStatsEntities([]int32{1, 2}, stats, largestLists)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, &CategoryStatsEntity{CategoryID: 1, LargestLists: []*CategoryStatsListEntity{}}, res[0])
+	assert.Equal(t, &CategoryStatsEntity{
+		CategoryID:     2,
+		ListsCount:     3,
+		ItemsCount:     10,
+		LastActivityAt: &lastActivityAt,
+		LargestLists: []*CategoryStatsListEntity{
+			{ID: 5, Name: "list5", ItemsCount: 7},
+			{ID: 6, Name: "list6", ItemsCount: 3},
+		},
+	}, res[1])
+}
@@ -0,0 +1,124 @@
+package domain
+
+import appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+
+// CategoryTree is the hierarchy of the categories of a workspace. The categories whose
+// parent isn't in the tree are treated as root ones
+type CategoryTree struct {
+	records  CategoryRecords
+	byID     map[int32]*CategoryRecord
+	children map[int32][]int32
+	roots    []int32
+}
+
+func NewCategoryTree(records CategoryRecords) *CategoryTree {
+	t := &CategoryTree{
+		records:  records,
+		byID:     map[int32]*CategoryRecord{},
+		children: map[int32][]int32{},
+	}
+
+	for i := range records {
+		t.byID[records[i].ID] = &records[i]
+	}
+
+	for _, r := range records {
+		if parentID := r.parentID(); parentID != nil && t.Exists(*parentID) {
+			t.children[*parentID] = append(t.children[*parentID], r.ID)
+		} else {
+			t.roots = append(t.roots, r.ID)
+		}
+	}
+
+	return t
+}
+
+func (t *CategoryTree) Exists(categoryID int32) bool {
+	_, ok := t.byID[categoryID]
+
+	return ok
+}
+
+// NextPosition returns the position that puts a new category after all the existing ones
+func (t *CategoryTree) NextPosition() int32 {
+	next := int32(0)
+
+	for _, r := range t.records {
+		if r.Position >= next {
+			next = r.Position + 1
+		}
+	}
+
+	return next
+}
+
+// HasSiblingNamed returns true when a category other than the given one has the same parent
+// and the same name
+func (t *CategoryTree) HasSiblingNamed(name string, parentID *int32, categoryID int32) bool {
+	for _, r := range t.records {
+		if r.ID != categoryID && r.Name == name && sameCategoryID(r.parentID(), parentID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckParent returns an error when the parent doesn't exist or when it's the category itself
+// or one of its descendants, because that would make a cycle. New categories use 0 as their id
+func (t *CategoryTree) CheckParent(categoryID int32, parentID *int32) error {
+	if parentID == nil {
+		return nil
+	}
+
+	if !t.Exists(*parentID) {
+		return &appErrors.BadRequestError{Msg: "The parent category doesn't exist"}
+	}
+
+	visited := map[int32]bool{}
+
+	for current := parentID; current != nil && t.Exists(*current) && !visited[*current]; current = t.byID[*current].parentID() {
+		if *current == categoryID {
+			return &appErrors.BadRequestError{Msg: "A category can't be moved inside itself or one of its subcategories"}
+		}
+
+		visited[*current] = true
+	}
+
+	return nil
+}
+
+// WithDescendants returns the id of the category followed by the ids of all its descendants
+func (t *CategoryTree) WithDescendants(categoryID int32) []int32 {
+	res := []int32{categoryID}
+	visited := map[int32]bool{categoryID: true}
+
+	for i := 0; i < len(res); i++ {
+		for _, childID := range t.children[res[i]] {
+			if !visited[childID] {
+				visited[childID] = true
+				res = append(res, childID)
+			}
+		}
+	}
+
+	return res
+}
+
+// ToCategoryTreeEntities returns the root categories with their subcategories nested
+func (t *CategoryTree) ToCategoryTreeEntities() []*CategoryTreeEntity {
+	return t.toCategoryTreeEntities(t.roots)
+}
+
+func (t *CategoryTree) toCategoryTreeEntities(ids []int32) []*CategoryTreeEntity {
+	res := make([]*CategoryTreeEntity, len(ids))
+
+	for i, id := range ids {
+		res[i] = &CategoryTreeEntity{
+			CategoryEntity: t.byID[id].ToCategoryEntity(),
+			Children:       t.toCategoryTreeEntities(t.children[id]),
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testCategoryTree() *CategoryTree {
+	// 1 is the parent of 2 and 3, 2 is the parent of 4 and 5 is a root category
+	return NewCategoryTree(CategoryRecords{
+		{ID: 1, Name: "root"},
+		{ID: 2, Name: "child", ParentID: &sql.NullInt32{Int32: 1, Valid: true}},
+		{ID: 3, Name: "other", ParentID: &sql.NullInt32{Int32: 1, Valid: true}},
+		{ID: 4, Name: "child", ParentID: &sql.NullInt32{Int32: 2, Valid: true}},
+		{ID: 5, Name: "another", ParentID: &sql.NullInt32{}},
+	})
+}
+
+func TestCategoryTree_HasSiblingNamed(t *testing.T) {
+	tree := testCategoryTree()
+	root := int32(1)
+	child := int32(2)
+
+	assert.True(t, tree.HasSiblingNamed("child", &root, 0))
+	assert.False(t, tree.HasSiblingNamed("child", &root, 2))
+	assert.True(t, tree.HasSiblingNamed("child", &child, 0))
+	assert.False(t, tree.HasSiblingNamed("child", nil, 0))
+	assert.True(t, tree.HasSiblingNamed("another", nil, 0))
+}
+
+func TestCategoryTree_CheckParent(t *testing.T) {
+	tree := testCategoryTree()
+	parentID := func(id int32) *int32 { return &id }
+
+	assert.Nil(t, tree.CheckParent(2, nil))
+	assert.Nil(t, tree.CheckParent(0, parentID(4)))
+	assert.Nil(t, tree.CheckParent(4, parentID(5)))
+	assert.Nil(t, tree.CheckParent(2, parentID(3)))
+	assert.EqualError(t, tree.CheckParent(0, parentID(9)), "The parent category doesn't exist")
+	assert.EqualError(t, tree.CheckParent(2, parentID(2)), "A category can't be moved inside itself or one of its subcategories")
+	assert.EqualError(t, tree.CheckParent(1, parentID(4)), "A category can't be moved inside itself or one of its subcategories")
+}
+
+func TestCategoryTree_WithDescendants(t *testing.T) {
+	tree := testCategoryTree()
+
+	assert.Equal(t, []int32{1, 2, 3, 4}, tree.WithDescendants(1))
+	assert.Equal(t, []int32{2, 4}, tree.WithDescendants(2))
+	assert.Equal(t, []int32{5}, tree.WithDescendants(5))
+	assert.Equal(t, []int32{9}, tree.WithDescendants(9))
+}
+
+func TestCategoryTree_ToCategoryTreeEntities(t *testing.T) {
+	res := testCategoryTree().ToCategoryTreeEntities()
+
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, int32(1), res[0].ID)
+	assert.Equal(t, 2, len(res[0].Children))
+	assert.Equal(t, int32(2), res[0].Children[0].ID)
+	assert.Equal(t, int32(4), res[0].Children[0].Children[0].ID)
+	assert.Equal(t, int32(2), *res[0].Children[0].Children[0].ParentID)
+	assert.Equal(t, int32(3), res[0].Children[1].ID)
+	assert.Empty(t, res[0].Children[1].Children)
+	assert.Equal(t, int32(5), res[1].ID)
+	assert.Nil(t, res[1].ParentID)
+}
+
+func TestCategoryTree_Ignores_Cycles(t *testing.T) {
+	tree := NewCategoryTree(CategoryRecords{
+		{ID: 1, Name: "a", ParentID: &sql.NullInt32{Int32: 2, Valid: true}},
+		{ID: 2, Name: "b", ParentID: &sql.NullInt32{Int32: 1, Valid: true}},
+	})
+
+	parentID := int32(1)
+
+	assert.Equal(t, []int32{1, 2}, tree.WithDescendants(1))
+	assert.Nil(t, tree.CheckParent(3, &parentID))
+	assert.Empty(t, tree.ToCategoryTreeEntities())
+}
+
+func TestCategoryTree_NextPosition(t *testing.T) {
+	assert.Equal(t, int32(0), NewCategoryTree(CategoryRecords{}).NextPosition())
+	assert.Equal(t, int32(8), NewCategoryTree(CategoryRecords{{ID: 1, Position: 7}, {ID: 2, Position: 3}}).NextPosition())
+}
@@ -0,0 +1,46 @@
+package domain
+
+// CategoryUpdate has the fields sent to update a category. The nil ones aren't changed, except
+// the parent, which is changed when HasParentID is true because a nil parent means the root.
+// IsDefault isn't a field of the category, it changes the default category of the user
+type CategoryUpdate struct {
+	Name        *CategoryNameValueObject
+	Description *CategoryDescriptionValueObject
+	HasParentID bool
+	ParentID    *int32
+	Color       *CategoryColorValueObject
+	Icon        *CategoryIconValueObject
+	IsDefault   *bool
+}
+
+// ApplyTo changes the category and returns the fields of the CategoryRecord that have to be updated
+func (u *CategoryUpdate) ApplyTo(category *CategoryEntity) []string {
+	fields := []string{}
+
+	if u.Name != nil {
+		category.Name = *u.Name
+		fields = append(fields, "Name")
+	}
+
+	if u.Description != nil {
+		category.Description = *u.Description
+		fields = append(fields, "Description")
+	}
+
+	if u.HasParentID {
+		category.ParentID = u.ParentID
+		fields = append(fields, "ParentID")
+	}
+
+	if u.Color != nil {
+		category.Color = *u.Color
+		fields = append(fields, "Color")
+	}
+
+	if u.Icon != nil {
+		category.Icon = *u.Icon
+		fields = append(fields, "Icon")
+	}
+
+	return fields
+}
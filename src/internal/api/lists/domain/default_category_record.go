@@ -0,0 +1,13 @@
+package domain
+
+// DefaultCategoryRecord is the category that a user gives to the new lists of a workspace when
+// they don't have one
+type DefaultCategoryRecord struct {
+	UserID      int32 `gorm:"column:userId;type:int(32);primary_key"`
+	WorkspaceID int32 `gorm:"column:workspaceId;type:int(32);primary_key"`
+	CategoryID  int32 `gorm:"column:categoryId;type:int(32)"`
+}
+
+func (DefaultCategoryRecord) TableName() string {
+	return "defaultCategories"
+}
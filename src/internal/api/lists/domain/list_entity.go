@@ -5,12 +5,16 @@ import (
 )
 
 type ListEntity struct {
-	ID         int32               `json:"id"`
-	Name       ListNameValueObject `json:"name"`
-	UserID     int32               `json:"-"`
-	CategoryID *int32              `json:"categoryId"`
-	ItemsCount int32               `json:"itemsCount"`
-	Items      []*ListItemEntity   `json:"items,omitempty"`
+	ID          int32               `json:"id"`
+	Name        ListNameValueObject `json:"name"`
+	UserID      int32               `json:"-"`
+	WorkspaceID int32               `json:"-"`
+	CategoryID  *int32              `json:"categoryId"`
+	ItemsCount  int32               `json:"itemsCount"`
+	Items       []*ListItemEntity   `json:"items,omitempty"`
+	// Tags are the ones of the user doing the request. Nil means that they aren't changed
+	// when the list is created or updated
+	Tags []string `json:"tags,omitempty"`
 }
 
 func (e *ListEntity) ToListRecord() *ListRecord {
@@ -26,12 +30,13 @@ func (e *ListEntity) ToListRecord() *ListRecord {
 	}
 
 	r := &ListRecord{
-		ID:         e.ID,
-		Name:       e.Name.String(),
-		CategoryID: &categoryID,
-		UserID:     e.UserID,
-		ItemsCount: e.ItemsCount,
-		Items:      make([]ListItemRecord, len(e.Items)),
+		ID:          e.ID,
+		Name:        e.Name.String(),
+		CategoryID:  &categoryID,
+		UserID:      e.UserID,
+		WorkspaceID: e.WorkspaceID,
+		ItemsCount:  e.ItemsCount,
+		Items:       make([]ListItemRecord, len(e.Items)),
 	}
 
 	for i, v := range e.Items {
@@ -42,6 +47,7 @@ func (e *ListEntity) ToListRecord() *ListRecord {
 			Title:       v.Title.String(),
 			Description: v.Description.String(),
 			Position:    int32(i),
+			DueDate:     v.DueDate,
 		}
 	}
 
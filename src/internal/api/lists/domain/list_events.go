@@ -0,0 +1,102 @@
+package domain
+
+// ListEvent is the data of the ListCreated, ListUpdated and ListDeleted events. The previous
+// values are the same as the current ones unless the update has changed them
+type ListEvent struct {
+	ListID             int32
+	UserID             int32
+	WorkspaceID        int32
+	Name               string
+	PreviousName       string
+	CategoryID         *int32
+	PreviousCategoryID *int32
+}
+
+func NewListEvent(record *ListRecord) ListEvent {
+	return NewListUpdatedEvent(record, record)
+}
+
+func NewListUpdatedEvent(before *ListRecord, after *ListRecord) ListEvent {
+	return ListEvent{
+		ListID:             after.ID,
+		UserID:             after.UserID,
+		WorkspaceID:        after.WorkspaceID,
+		Name:               after.Name,
+		PreviousName:       before.Name,
+		CategoryID:         categoryIDOf(after),
+		PreviousCategoryID: categoryIDOf(before),
+	}
+}
+
+func (e ListEvent) IsRenamed() bool {
+	return e.Name != e.PreviousName
+}
+
+func (e ListEvent) IsRecategorized() bool {
+	return !sameCategoryID(e.CategoryID, e.PreviousCategoryID)
+}
+
+// ListItemEvent is the data of the list item events. The destination list is only set when
+// the item is moved to another list
+type ListItemEvent struct {
+	ItemID              int32
+	ListID              int32
+	UserID              int32
+	ListName            string
+	Title               string
+	PreviousTitle       string
+	DestinationListID   int32
+	DestinationListName string
+}
+
+// NewListItemEvents compares the items of a list before and after an update and returns the
+// events of the added, renamed and removed items
+func NewListItemEvents(before *ListRecord, after *ListRecord) (added []ListItemEvent, renamed []ListItemEvent, removed []ListItemEvent) {
+	previousItems := map[int32]ListItemRecord{}
+	for _, item := range before.Items {
+		previousItems[item.ID] = item
+	}
+
+	for _, item := range after.Items {
+		event := ListItemEvent{ItemID: item.ID, ListID: after.ID, UserID: after.UserID, ListName: after.Name, Title: item.Title}
+
+		previousItem, found := previousItems[item.ID]
+		if !found {
+			added = append(added, event)
+			continue
+		}
+
+		delete(previousItems, item.ID)
+
+		if previousItem.Title != item.Title {
+			event.PreviousTitle = previousItem.Title
+			renamed = append(renamed, event)
+		}
+	}
+
+	for _, item := range before.Items {
+		if _, found := previousItems[item.ID]; found {
+			removed = append(removed, ListItemEvent{ItemID: item.ID, ListID: after.ID, UserID: after.UserID, ListName: after.Name, Title: item.Title})
+		}
+	}
+
+	return added, renamed, removed
+}
+
+func sameCategoryID(a *int32, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func categoryIDOf(record *ListRecord) *int32 {
+	if record.CategoryID == nil || !record.CategoryID.Valid {
+		return nil
+	}
+
+	categoryID := record.CategoryID.Int32
+
+	return &categoryID
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewListUpdatedEvent_Detects_The_Changes(t *testing.T) {
+	before := &ListRecord{ID: 1, UserID: 2, Name: "list", CategoryID: &sql.NullInt32{}}
+	after := &ListRecord{ID: 1, UserID: 2, Name: "list", CategoryID: &sql.NullInt32{Int32: 3, Valid: true}}
+
+	event := NewListUpdatedEvent(before, after)
+
+	assert.False(t, event.IsRenamed())
+	assert.True(t, event.IsRecategorized())
+
+	after.Name = "new name"
+	before.CategoryID = &sql.NullInt32{Int32: 3, Valid: true}
+
+	event = NewListUpdatedEvent(before, after)
+
+	assert.True(t, event.IsRenamed())
+	assert.False(t, event.IsRecategorized())
+	assert.False(t, NewListEvent(after).IsRenamed())
+}
+
+func TestNewListItemEvents_Returns_The_Added_Renamed_And_Removed_Items(t *testing.T) {
+	before := &ListRecord{ID: 1, UserID: 2, Name: "list", Items: []ListItemRecord{
+		{ID: 10, Title: "kept"},
+		{ID: 11, Title: "old title"},
+		{ID: 12, Title: "removed"},
+	}}
+	after := &ListRecord{ID: 1, UserID: 2, Name: "list", Items: []ListItemRecord{
+		{ID: 10, Title: "kept"},
+		{ID: 11, Title: "new title"},
+		{ID: 13, Title: "added"},
+	}}
+
+	added, renamed, removed := NewListItemEvents(before, after)
+
+	assert.Equal(t, []ListItemEvent{{ItemID: 13, ListID: 1, UserID: 2, ListName: "list", Title: "added"}}, added)
+	assert.Equal(t, []ListItemEvent{{ItemID: 11, ListID: 1, UserID: 2, ListName: "list", Title: "new title", PreviousTitle: "old title"}}, renamed)
+	assert.Equal(t, []ListItemEvent{{ItemID: 12, ListID: 1, UserID: 2, ListName: "list", Title: "removed"}}, removed)
+}
@@ -0,0 +1,378 @@
+package domain
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	ExportFormatJSON      = "json"
+	ExportFormatCSV       = "csv"
+	ExportFormatMarkdown  = "markdown"
+	ExportFormatICalendar = "ics"
+)
+
+var exportFormatMediaTypes = map[string]string{
+	ExportFormatJSON:      "application/json",
+	ExportFormatCSV:       "text/csv",
+	ExportFormatMarkdown:  "text/markdown",
+	ExportFormatICalendar: "text/calendar",
+}
+
+var exportFormatExtensions = map[string]string{
+	ExportFormatJSON:      "json",
+	ExportFormatCSV:       "csv",
+	ExportFormatMarkdown:  "md",
+	ExportFormatICalendar: "ics",
+}
+
+func NewExportFormat(format string) (string, error) {
+	if _, ok := exportFormatMediaTypes[format]; ok {
+		return format, nil
+	}
+
+	return "", errors.New(`The format must be "json", "csv", "markdown" or "ics"`)
+}
+
+// NegotiateExportFormat returns the format of the first media type of the Accept header
+// that can be exported, or json when there isn't any
+func NegotiateExportFormat(accept string) string {
+	for _, v := range strings.Split(accept, ",") {
+		mediaType := strings.ToLower(strings.TrimSpace(strings.Split(v, ";")[0]))
+
+		for format, formatMediaType := range exportFormatMediaTypes {
+			if mediaType == formatMediaType {
+				return format
+			}
+		}
+	}
+
+	return ExportFormatJSON
+}
+
+func ExportFormatContentType(format string) string {
+	if format == ExportFormatJSON {
+		return exportFormatMediaTypes[format]
+	}
+
+	return exportFormatMediaTypes[format] + "; charset=utf-8"
+}
+
+// ExportFileName returns the name of the exported file with the extension of the format
+func ExportFileName(name string, format string) string {
+	return name + "." + exportFormatExtensions[format]
+}
+
+// ExportedList is a list with its category and its items sorted by position
+type ExportedList struct {
+	ID         int32          `json:"id"`
+	Name       string         `json:"name"`
+	CategoryID *int32         `json:"categoryId"`
+	Category   string         `json:"category"`
+	Items      []ExportedItem `json:"items"`
+}
+
+type ExportedItem struct {
+	ID          int32      `json:"id"`
+	Position    int32      `json:"position"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	DueDate     *time.Time `json:"dueDate"`
+}
+
+// NewExportedList builds the exported list of a record with its items loaded. categoryNames
+// has the names of the categories of the workspace by id
+func NewExportedList(record *ListRecord, categoryNames map[int32]string) *ExportedList {
+	list := &ExportedList{
+		ID:    record.ID,
+		Name:  record.Name,
+		Items: make([]ExportedItem, len(record.Items)),
+	}
+
+	if record.CategoryID != nil && record.CategoryID.Valid {
+		categoryID := record.CategoryID.Int32
+		list.CategoryID = &categoryID
+		list.Category = categoryNames[categoryID]
+	}
+
+	for i, v := range record.Items {
+		list.Items[i] = ExportedItem{ID: v.ID, Position: v.Position, Title: v.Title, Description: v.Description, DueDate: v.DueDate}
+	}
+
+	return list
+}
+
+// ListsExportWriter writes the exported lists one by one, so they don't need to be in memory
+// at the same time. Close writes the end of the export and must be called after the last list
+type ListsExportWriter interface {
+	WriteList(list *ExportedList) error
+	Close() error
+}
+
+// NewListsExportWriter returns the writer of the format. exportedAt is the timestamp of the
+// formats that need one
+func NewListsExportWriter(format string, w io.Writer, exportedAt time.Time) (ListsExportWriter, error) {
+	switch format {
+	case ExportFormatJSON:
+		return &jsonListsExportWriter{w: w}, nil
+	case ExportFormatCSV:
+		return &csvListsExportWriter{w: csv.NewWriter(w)}, nil
+	case ExportFormatMarkdown:
+		return &markdownListsExportWriter{w: w}, nil
+	case ExportFormatICalendar:
+		return &icsListsExportWriter{w: w, stamp: exportedAt, component: "VTODO"}, nil
+	}
+
+	return nil, errors.New("Invalid export format")
+}
+
+// jsonListsExportWriter writes an array of lists
+type jsonListsExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *jsonListsExportWriter) WriteList(list *ExportedList) error {
+	content, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	separator := ","
+	if e.count == 0 {
+		separator = "["
+	}
+	e.count++
+
+	if _, err := io.WriteString(e.w, separator); err != nil {
+		return err
+	}
+
+	_, err = e.w.Write(content)
+
+	return err
+}
+
+func (e *jsonListsExportWriter) Close() error {
+	end := "]\n"
+	if e.count == 0 {
+		end = "[]\n"
+	}
+
+	_, err := io.WriteString(e.w, end)
+
+	return err
+}
+
+// csvListsExportWriter writes a row per item with the columns of the csv import. The lists
+// without items have a row without title, so they are also imported
+type csvListsExportWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvListsExportWriter) WriteList(list *ExportedList) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	if len(list.Items) == 0 {
+		return e.write([]string{list.Name, list.Category, "", "", "", ""})
+	}
+
+	for _, v := range list.Items {
+		dueDate := ""
+		if v.DueDate != nil {
+			dueDate = v.DueDate.UTC().Format(time.RFC3339)
+		}
+
+		if err := e.write([]string{list.Name, list.Category, strconv.Itoa(int(v.Position)), v.Title, v.Description, dueDate}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *csvListsExportWriter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+func (e *csvListsExportWriter) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+
+	return e.write([]string{"list", "category", "position", "title", "description", "dueDate"})
+}
+
+func (e *csvListsExportWriter) write(record []string) error {
+	if err := e.w.Write(record); err != nil {
+		return err
+	}
+
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+// markdownListsExportWriter writes a heading per list with its items as a task list, the
+// same way the markdown import reads them
+type markdownListsExportWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (e *markdownListsExportWriter) WriteList(list *ExportedList) error {
+	var sb strings.Builder
+
+	if e.count > 0 {
+		sb.WriteString("\n")
+	}
+	e.count++
+
+	fmt.Fprintf(&sb, "# %v\n", list.Name)
+
+	if len(list.Category) > 0 {
+		fmt.Fprintf(&sb, "\nCategory: %v\n", list.Category)
+	}
+
+	if len(list.Items) > 0 {
+		sb.WriteString("\n")
+	}
+
+	for _, v := range list.Items {
+		fmt.Fprintf(&sb, "- [ ] %v\n", v.Title)
+
+		for _, line := range strings.Split(v.Description, "\n") {
+			if line = strings.TrimSpace(line); len(line) > 0 {
+				fmt.Fprintf(&sb, "  %v\n", line)
+			}
+		}
+	}
+
+	_, err := io.WriteString(e.w, sb.String())
+
+	return err
+}
+
+func (e *markdownListsExportWriter) Close() error {
+	return nil
+}
+
+// icsListsExportWriter writes a calendar with a component per item. The list and its category
+// are the categories of the component, and the due date of the item is the DUE of a VTODO or
+// the DTSTART of a VEVENT
+type icsListsExportWriter struct {
+	w             io.Writer
+	stamp         time.Time
+	component     string
+	name          string
+	onlyDue       bool
+	headerWritten bool
+}
+
+func (e *icsListsExportWriter) WriteList(list *ExportedList) error {
+	var sb strings.Builder
+
+	e.writeHeader(&sb)
+
+	categories := []string{escapeICalendarText(list.Name)}
+	if len(list.Category) > 0 {
+		categories = append(categories, escapeICalendarText(list.Category))
+	}
+
+	for _, v := range list.Items {
+		if e.onlyDue && v.DueDate == nil {
+			continue
+		}
+
+		writeICalendarLine(&sb, "BEGIN:"+e.component)
+		writeICalendarLine(&sb, fmt.Sprintf("UID:list-item-%v@todos", v.ID))
+		writeICalendarLine(&sb, "DTSTAMP:"+FormatICalendarTime(e.stamp))
+		if v.DueDate != nil && e.component == "VEVENT" {
+			writeICalendarLine(&sb, "DTSTART:"+FormatICalendarTime(*v.DueDate))
+		} else if v.DueDate != nil {
+			writeICalendarLine(&sb, "DUE:"+FormatICalendarTime(*v.DueDate))
+		}
+		writeICalendarLine(&sb, "SUMMARY:"+escapeICalendarText(v.Title))
+		if len(v.Description) > 0 {
+			writeICalendarLine(&sb, "DESCRIPTION:"+escapeICalendarText(v.Description))
+		}
+		writeICalendarLine(&sb, "CATEGORIES:"+strings.Join(categories, ","))
+		writeICalendarLine(&sb, "END:"+e.component)
+	}
+
+	_, err := io.WriteString(e.w, sb.String())
+
+	return err
+}
+
+func (e *icsListsExportWriter) Close() error {
+	var sb strings.Builder
+
+	e.writeHeader(&sb)
+	writeICalendarLine(&sb, "END:VCALENDAR")
+
+	_, err := io.WriteString(e.w, sb.String())
+
+	return err
+}
+
+func (e *icsListsExportWriter) writeHeader(sb *strings.Builder) {
+	if e.headerWritten {
+		return
+	}
+	e.headerWritten = true
+
+	writeICalendarLine(sb, "BEGIN:VCALENDAR")
+	writeICalendarLine(sb, "VERSION:2.0")
+	writeICalendarLine(sb, "PRODID:-//todos//lists//EN")
+	if len(e.name) > 0 {
+		writeICalendarLine(sb, "X-WR-CALNAME:"+escapeICalendarText(e.name))
+	}
+}
+
+// FormatICalendarTime returns the time in the UTC format of the iCalendar date-time values
+func FormatICalendarTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+func escapeICalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
+
+// writeICalendarLine folds the lines longer than 75 octets without splitting a character. The
+// folded lines start with a space, that counts for their length
+func writeICalendarLine(sb *strings.Builder, line string) {
+	maxLength := 75
+
+	for len(line) > maxLength {
+		cut := maxLength
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		sb.WriteString(line[:cut])
+		sb.WriteString("\r\n ")
+		line = line[cut:]
+		maxLength = 74
+	}
+
+	sb.WriteString(line)
+	sb.WriteString("\r\n")
+}
@@ -0,0 +1,202 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var itemDueDate = time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+var exportedLists = []*ExportedList{
+	{
+		ID:         1,
+		Name:       "list1",
+		CategoryID: func() *int32 { v := int32(5); return &v }(),
+		Category:   "category5",
+		Items: []ExportedItem{
+			{ID: 11, Position: 0, Title: "item1", Description: "desc, with; chars\nand lines", DueDate: &itemDueDate},
+			{ID: 12, Position: 1, Title: "item2"},
+		},
+	},
+	{ID: 2, Name: "list2", Items: []ExportedItem{}},
+}
+
+func exportLists(t *testing.T, format string) string {
+	var buf bytes.Buffer
+
+	writer, err := NewListsExportWriter(format, &buf, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.Nil(t, err)
+
+	for _, v := range exportedLists {
+		require.Nil(t, writer.WriteList(v))
+	}
+	require.Nil(t, writer.Close())
+
+	return buf.String()
+}
+
+func TestNewExportFormat(t *testing.T) {
+	for _, format := range []string{ExportFormatJSON, ExportFormatCSV, ExportFormatMarkdown, ExportFormatICalendar} {
+		res, err := NewExportFormat(format)
+
+		assert.Nil(t, err)
+		assert.Equal(t, format, res)
+	}
+
+	_, err := NewExportFormat("wadus")
+
+	assert.EqualError(t, err, `The format must be "json", "csv", "markdown" or "ics"`)
+}
+
+func TestNegotiateExportFormat(t *testing.T) {
+	assert.Equal(t, ExportFormatJSON, NegotiateExportFormat(""))
+	assert.Equal(t, ExportFormatJSON, NegotiateExportFormat("*/*"))
+	assert.Equal(t, ExportFormatCSV, NegotiateExportFormat("text/html, text/CSV;q=0.9, application/json"))
+	assert.Equal(t, ExportFormatMarkdown, NegotiateExportFormat("text/markdown"))
+	assert.Equal(t, ExportFormatICalendar, NegotiateExportFormat("text/calendar"))
+}
+
+func TestExportFormatContentTypeAndFileName(t *testing.T) {
+	assert.Equal(t, "application/json", ExportFormatContentType(ExportFormatJSON))
+	assert.Equal(t, "text/markdown; charset=utf-8", ExportFormatContentType(ExportFormatMarkdown))
+	assert.Equal(t, "lists.md", ExportFileName("lists", ExportFormatMarkdown))
+	assert.Equal(t, "lists.ics", ExportFileName("lists", ExportFormatICalendar))
+}
+
+func TestNewExportedList(t *testing.T) {
+	record := &ListRecord{
+		ID:         1,
+		Name:       "list1",
+		CategoryID: &sql.NullInt32{Int32: 5, Valid: true},
+		Items:      []ListItemRecord{{ID: 11, Position: 0, Title: "item1", Description: "desc1"}},
+	}
+
+	record.Items[0].DueDate = &itemDueDate
+
+	list := NewExportedList(record, map[int32]string{5: "category5"})
+
+	assert.Equal(t, int32(5), *list.CategoryID)
+	assert.Equal(t, "category5", list.Category)
+	assert.Equal(t, []ExportedItem{{ID: 11, Position: 0, Title: "item1", Description: "desc1", DueDate: &itemDueDate}}, list.Items)
+
+	list = NewExportedList(&ListRecord{ID: 2, Name: "list2"}, map[int32]string{})
+
+	assert.Nil(t, list.CategoryID)
+	assert.Equal(t, []ExportedItem{}, list.Items)
+}
+
+func TestNewListsExportWriter_Returns_An_Error_If_The_Format_Is_Not_Valid(t *testing.T) {
+	_, err := NewListsExportWriter("wadus", &bytes.Buffer{}, time.Now())
+
+	assert.EqualError(t, err, "Invalid export format")
+}
+
+func TestListsExportWriter_JSON(t *testing.T) {
+	expected := `[{"id":1,"name":"list1","categoryId":5,"category":"category5","items":[` +
+		`{"id":11,"position":0,"title":"item1","description":"desc, with; chars\nand lines","dueDate":"2023-02-01T10:00:00Z"},` +
+		`{"id":12,"position":1,"title":"item2","description":"","dueDate":null}]},` +
+		`{"id":2,"name":"list2","categoryId":null,"category":"","items":[]}]` + "\n"
+
+	assert.Equal(t, expected, exportLists(t, ExportFormatJSON))
+
+	var buf bytes.Buffer
+	writer, _ := NewListsExportWriter(ExportFormatJSON, &buf, time.Now())
+	require.Nil(t, writer.Close())
+
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestListsExportWriter_CSV(t *testing.T) {
+	expected := "list,category,position,title,description,dueDate\n" +
+		"list1,category5,0,item1,\"desc, with; chars\nand lines\",2023-02-01T10:00:00Z\n" +
+		"list1,category5,1,item2,,\n" +
+		"list2,,,,,\n"
+
+	content := exportLists(t, ExportFormatCSV)
+
+	assert.Equal(t, expected, content)
+
+	rows, err := ParseImportRows(ImportFormatCSV, []byte(content), DefaultImportListName)
+
+	require.Nil(t, err)
+	assert.Equal(t, ImportRows{
+		{Row: 2, ListName: "list1", Title: "item1", Description: "desc, with; chars\nand lines"},
+		{Row: 4, ListName: "list1", Title: "item2"},
+		{Row: 5, ListName: "list2"},
+	}, rows)
+}
+
+func TestListsExportWriter_Markdown(t *testing.T) {
+	expected := strings.Join([]string{
+		"# list1",
+		"",
+		"Category: category5",
+		"",
+		"- [ ] item1",
+		"  desc, with; chars",
+		"  and lines",
+		"- [ ] item2",
+		"",
+		"# list2",
+		"",
+	}, "\n")
+
+	content := exportLists(t, ExportFormatMarkdown)
+
+	assert.Equal(t, expected, content)
+
+	rows := parseMarkdownImportRows([]byte(content), DefaultImportListName)
+
+	assert.Equal(t, ImportRows{
+		{Row: 5, ListName: "list1", Title: "item1", Description: "desc, with; chars and lines"},
+		{Row: 8, ListName: "list1", Title: "item2"},
+	}, rows)
+}
+
+func TestListsExportWriter_ICalendar(t *testing.T) {
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//todos//lists//EN",
+		"BEGIN:VTODO",
+		"UID:list-item-11@todos",
+		"DTSTAMP:20230102T030405Z",
+		"DUE:20230201T100000Z",
+		"SUMMARY:item1",
+		`DESCRIPTION:desc\, with\; chars\nand lines`,
+		"CATEGORIES:list1,category5",
+		"END:VTODO",
+		"BEGIN:VTODO",
+		"UID:list-item-12@todos",
+		"DTSTAMP:20230102T030405Z",
+		"SUMMARY:item2",
+		"CATEGORIES:list1,category5",
+		"END:VTODO",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, expected, exportLists(t, ExportFormatICalendar))
+}
+
+func TestWriteICalendarLine_Folds_The_Long_Lines(t *testing.T) {
+	var sb strings.Builder
+
+	writeICalendarLine(&sb, "SUMMARY:"+strings.Repeat("a", 66)+"ñ"+strings.Repeat("b", 80))
+
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\r\n"), "\r\n")
+
+	require.Equal(t, 3, len(lines))
+	assert.Equal(t, "SUMMARY:"+strings.Repeat("a", 66), lines[0])
+	assert.Equal(t, " ñ"+strings.Repeat("b", 72), lines[1])
+	assert.Equal(t, " "+strings.Repeat("b", 8), lines[2])
+	for _, v := range lines {
+		assert.LessOrEqual(t, len(v), 75)
+	}
+}
@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ImportFormatCSV is a csv file with the list, title and description columns
+	ImportFormatCSV = "csv"
+	// ImportFormatMarkdown is a markdown file where the headings are the lists and the task
+	// list items (- [ ] item) are their items
+	ImportFormatMarkdown = "markdown"
+	// ImportFormatTodoTxt is a todo.txt file where the first +project of each task is its list
+	ImportFormatTodoTxt = "todotxt"
+)
+
+// DefaultImportListName is the list of the imported items that don't say their list
+const DefaultImportListName = "Imported"
+
+var (
+	markdownHeadingRegexp = regexp.MustCompile(`^#{1,6}\s+(.+?)\s*#*$`)
+	markdownTaskRegexp    = regexp.MustCompile(`^\s*[-*+]\s+\[[ xX]\]\s+(.*)$`)
+	todoTxtDateRegexp     = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	todoTxtPriorityRegexp = regexp.MustCompile(`^\([A-Z]\)$`)
+)
+
+func NewImportFormat(format string) (string, error) {
+	switch format {
+	case ImportFormatCSV, ImportFormatMarkdown, ImportFormatTodoTxt:
+		return format, nil
+	}
+
+	return "", errors.New(`The format must be "csv", "markdown" or "todotxt"`)
+}
+
+// DetectImportFormat returns the format of the file using its extension or, when the
+// extension doesn't say it, its content
+func DetectImportFormat(fileName string, content []byte) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv":
+		return ImportFormatCSV
+	case ".md", ".markdown":
+		return ImportFormatMarkdown
+	}
+
+	lines := strings.Split(string(content), "\n")
+
+	for _, line := range lines {
+		if markdownTaskRegexp.MatchString(line) || markdownHeadingRegexp.MatchString(line) {
+			return ImportFormatMarkdown
+		}
+	}
+
+	if columns, err := csv.NewReader(strings.NewReader(lines[0])).Read(); err == nil && csvColumnIndex(columns, "list") >= 0 && csvColumnIndex(columns, "title") >= 0 {
+		return ImportFormatCSV
+	}
+
+	return ImportFormatTodoTxt
+}
+
+// ImportRow is an item read from an imported file. Row is its line in the file
+type ImportRow struct {
+	Row         int
+	ListName    string
+	Title       string
+	Description string
+}
+
+type ImportRows []ImportRow
+
+// ImportRowError is the reason why a row of an imported file can't be imported
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+}
+
+// ImportedList is a list read from an imported file. Row is the first row of the list
+type ImportedList struct {
+	Row  int
+	List *ListEntity
+}
+
+// ParseImportRows reads the items of a file. The items without list go to defaultListName
+func ParseImportRows(format string, content []byte, defaultListName string) (ImportRows, error) {
+	switch format {
+	case ImportFormatCSV:
+		return parseCSVImportRows(content)
+	case ImportFormatMarkdown:
+		return parseMarkdownImportRows(content, defaultListName), nil
+	case ImportFormatTodoTxt:
+		return parseTodoTxtImportRows(content, defaultListName), nil
+	}
+
+	return nil, errors.New("Invalid import format")
+}
+
+func parseCSVImportRows(content []byte) (ImportRows, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("The csv file must have a header with the list and title columns")
+	}
+
+	listIndex := csvColumnIndex(header, "list")
+	titleIndex := csvColumnIndex(header, "title")
+	descriptionIndex := csvColumnIndex(header, "description")
+
+	if listIndex < 0 || titleIndex < 0 {
+		return nil, errors.New("The csv file must have a header with the list and title columns")
+	}
+
+	rows := ImportRows{}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.New("Invalid csv file")
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		rows = append(rows, ImportRow{
+			Row:         line,
+			ListName:    csvColumn(record, listIndex),
+			Title:       csvColumn(record, titleIndex),
+			Description: csvColumn(record, descriptionIndex),
+		})
+	}
+
+	return rows, nil
+}
+
+func csvColumnIndex(header []string, name string) int {
+	for i, v := range header {
+		if strings.EqualFold(strings.TrimSpace(v), name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func csvColumn(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+
+	return strings.TrimSpace(record[index])
+}
+
+// parseMarkdownImportRows reads the task list items of the headings. The indented lines after
+// a task are its description
+func parseMarkdownImportRows(content []byte, defaultListName string) ImportRows {
+	rows := ImportRows{}
+	listName := defaultListName
+	var lastRow *ImportRow
+
+	for i, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		if matches := markdownHeadingRegexp.FindStringSubmatch(line); matches != nil {
+			listName = strings.TrimSpace(matches[1])
+			lastRow = nil
+			continue
+		}
+
+		if matches := markdownTaskRegexp.FindStringSubmatch(line); matches != nil {
+			rows = append(rows, ImportRow{Row: i + 1, ListName: listName, Title: strings.TrimSpace(matches[1])})
+			lastRow = &rows[len(rows)-1]
+			continue
+		}
+
+		text := strings.TrimSpace(line)
+		if lastRow != nil && len(text) > 0 && line != strings.TrimLeft(line, " \t") {
+			lastRow.Description = strings.TrimSpace(lastRow.Description + " " + text)
+			continue
+		}
+
+		lastRow = nil
+	}
+
+	return rows
+}
+
+// parseTodoTxtImportRows reads a task per line. The completion mark, the priority and the
+// dates are left out of the title
+func parseTodoTxtImportRows(content []byte, defaultListName string) ImportRows {
+	rows := ImportRows{}
+
+	for i, line := range strings.Split(string(content), "\n") {
+		words := strings.Fields(line)
+		if len(words) == 0 {
+			continue
+		}
+
+		if words[0] == "x" {
+			words = words[1:]
+		}
+
+		for len(words) > 0 && (todoTxtPriorityRegexp.MatchString(words[0]) || todoTxtDateRegexp.MatchString(words[0])) {
+			words = words[1:]
+		}
+
+		listName := ""
+		titleWords := []string{}
+
+		for _, w := range words {
+			if strings.HasPrefix(w, "+") && len(w) > 1 {
+				if len(listName) == 0 {
+					listName = w[1:]
+				}
+				continue
+			}
+
+			titleWords = append(titleWords, w)
+		}
+
+		if len(listName) == 0 {
+			listName = defaultListName
+		}
+
+		rows = append(rows, ImportRow{Row: i + 1, ListName: listName, Title: strings.Join(titleWords, " ")})
+	}
+
+	return rows
+}
+
+// ToImportedLists validates the rows and groups them by list, keeping the order of the file.
+// A row without title and description only creates its list
+func (a ImportRows) ToImportedLists() ([]*ImportedList, []ImportRowError) {
+	lists := []*ImportedList{}
+	listsByName := map[string]*ImportedList{}
+	rowErrors := []ImportRowError{}
+
+	for _, row := range a {
+		nvo, err := NewListNameValueObject(row.ListName)
+		if err != nil {
+			rowErrors = append(rowErrors, ImportRowError{Row: row.Row, Error: err.Error()})
+			continue
+		}
+
+		list, ok := listsByName[nvo.String()]
+		if !ok {
+			list = &ImportedList{Row: row.Row, List: &ListEntity{Name: nvo, Items: []*ListItemEntity{}}}
+			listsByName[nvo.String()] = list
+			lists = append(lists, list)
+		}
+
+		if len(row.Title) == 0 && len(row.Description) == 0 {
+			continue
+		}
+
+		tvo, err := NewItemTitleValueObject(row.Title)
+		if err != nil {
+			rowErrors = append(rowErrors, ImportRowError{Row: row.Row, Error: err.Error()})
+			continue
+		}
+
+		dvo, err := NewItemDescriptionValueObject(row.Description)
+		if err != nil {
+			rowErrors = append(rowErrors, ImportRowError{Row: row.Row, Error: err.Error()})
+			continue
+		}
+
+		list.List.Items = append(list.List.Items, &ListItemEntity{
+			Title:       tvo,
+			Description: dvo,
+			Position:    int32(len(list.List.Items)),
+		})
+	}
+
+	return lists, rowErrors
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewImportFormat(t *testing.T) {
+	for _, format := range []string{ImportFormatCSV, ImportFormatMarkdown, ImportFormatTodoTxt} {
+		res, err := NewImportFormat(format)
+
+		assert.Nil(t, err)
+		assert.Equal(t, format, res)
+	}
+
+	_, err := NewImportFormat("wadus")
+
+	assert.EqualError(t, err, `The format must be "csv", "markdown" or "todotxt"`)
+}
+
+func TestDetectImportFormat(t *testing.T) {
+	assert.Equal(t, ImportFormatCSV, DetectImportFormat("lists.CSV", []byte("")))
+	assert.Equal(t, ImportFormatMarkdown, DetectImportFormat("lists.md", []byte("")))
+	assert.Equal(t, ImportFormatMarkdown, DetectImportFormat("lists.markdown", []byte("")))
+	assert.Equal(t, ImportFormatMarkdown, DetectImportFormat("lists.txt", []byte("Some notes\n- [ ] item")))
+	assert.Equal(t, ImportFormatCSV, DetectImportFormat("lists", []byte("Title,List\nitem,list")))
+	assert.Equal(t, ImportFormatTodoTxt, DetectImportFormat("todo.txt", []byte("(A) call mom +family")))
+}
+
+func TestParseImportRows_Returns_An_Error_If_The_Format_Is_Not_Valid(t *testing.T) {
+	_, err := ParseImportRows("wadus", []byte(""), DefaultImportListName)
+
+	assert.EqualError(t, err, "Invalid import format")
+}
+
+func TestParseImportRows_CSV(t *testing.T) {
+	content := "list,title,description\nlist1,item1,desc1\n\"list 2\",\"item, 2\"\nlist1,item3,\"multi\nline\"\nlist3\n"
+
+	rows, err := ParseImportRows(ImportFormatCSV, []byte(content), DefaultImportListName)
+
+	require.Nil(t, err)
+	assert.Equal(t, ImportRows{
+		{Row: 2, ListName: "list1", Title: "item1", Description: "desc1"},
+		{Row: 3, ListName: "list 2", Title: "item, 2"},
+		{Row: 4, ListName: "list1", Title: "item3", Description: "multi\nline"},
+		{Row: 6, ListName: "list3"},
+	}, rows)
+}
+
+func TestParseImportRows_CSV_Without_The_Required_Columns(t *testing.T) {
+	_, err := ParseImportRows(ImportFormatCSV, []byte("name,description\na,b"), DefaultImportListName)
+
+	assert.EqualError(t, err, "The csv file must have a header with the list and title columns")
+
+	_, err = ParseImportRows(ImportFormatCSV, []byte(""), DefaultImportListName)
+
+	assert.EqualError(t, err, "The csv file must have a header with the list and title columns")
+}
+
+func TestParseImportRows_CSV_With_Invalid_Quotes(t *testing.T) {
+	_, err := ParseImportRows(ImportFormatCSV, []byte("list,title\nlist1,\"item"), DefaultImportListName)
+
+	assert.EqualError(t, err, "Invalid csv file")
+}
+
+func TestParseImportRows_Markdown(t *testing.T) {
+	content := strings.Join([]string{
+		"- [ ] item without heading",
+		"# Shopping",
+		"",
+		"- [ ] milk",
+		"  two bottles",
+		"  of the big ones",
+		"- [x] bread",
+		"Some text",
+		"  not a description",
+		"## Work ##",
+		"* [X] report",
+		"- not a task",
+	}, "\r\n")
+
+	rows, err := ParseImportRows(ImportFormatMarkdown, []byte(content), "notes")
+
+	require.Nil(t, err)
+	assert.Equal(t, ImportRows{
+		{Row: 1, ListName: "notes", Title: "item without heading"},
+		{Row: 4, ListName: "Shopping", Title: "milk", Description: "two bottles of the big ones"},
+		{Row: 7, ListName: "Shopping", Title: "bread"},
+		{Row: 11, ListName: "Work", Title: "report"},
+	}, rows)
+}
+
+func TestParseImportRows_TodoTxt(t *testing.T) {
+	content := strings.Join([]string{
+		"(A) 2023-01-02 call mom +family @phone",
+		"",
+		"x 2023-01-03 2023-01-01 pay bills +home +money due:2023-01-10",
+		"buy milk",
+	}, "\n")
+
+	rows, err := ParseImportRows(ImportFormatTodoTxt, []byte(content), DefaultImportListName)
+
+	require.Nil(t, err)
+	assert.Equal(t, ImportRows{
+		{Row: 1, ListName: "family", Title: "call mom @phone"},
+		{Row: 3, ListName: "home", Title: "pay bills due:2023-01-10"},
+		{Row: 4, ListName: DefaultImportListName, Title: "buy milk"},
+	}, rows)
+}
+
+func TestImportRows_ToImportedLists(t *testing.T) {
+	rows := ImportRows{
+		{Row: 2, ListName: "list1", Title: "item1", Description: "desc1"},
+		{Row: 3, ListName: "list2"},
+		{Row: 4, ListName: "list1", Title: "item2"},
+		{Row: 5, ListName: "", Title: "item3"},
+		{Row: 6, ListName: "list2", Title: strings.Repeat("a", 51)},
+		{Row: 7, ListName: "list2", Description: "desc without title"},
+	}
+
+	lists, rowErrors := rows.ToImportedLists()
+
+	require.Equal(t, 2, len(lists))
+	assert.Equal(t, 2, lists[0].Row)
+	assert.Equal(t, "list1", lists[0].List.Name.String())
+	require.Equal(t, 2, len(lists[0].List.Items))
+	assert.Equal(t, "item1", lists[0].List.Items[0].Title.String())
+	assert.Equal(t, "desc1", lists[0].List.Items[0].Description.String())
+	assert.Equal(t, int32(0), lists[0].List.Items[0].Position)
+	assert.Equal(t, "item2", lists[0].List.Items[1].Title.String())
+	assert.Equal(t, int32(1), lists[0].List.Items[1].Position)
+	assert.Equal(t, 3, lists[1].Row)
+	assert.Equal(t, "list2", lists[1].List.Name.String())
+	assert.Equal(t, 0, len(lists[1].List.Items))
+
+	assert.Equal(t, []ImportRowError{
+		{Row: 5, Error: "The list name can not be empty"},
+		{Row: 6, Error: "The item title can not have more than 50 characters"},
+		{Row: 7, Error: "The item title can not be empty"},
+	}, rowErrors)
+}
@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 type ListItemEntity struct {
 	ID          int32                      `json:"id"`
 	ListID      int32                      `json:"-"`
@@ -7,4 +9,14 @@ type ListItemEntity struct {
 	Title       ItemTitleValueObject       `json:"title"`
 	Description ItemDescriptionValueObject `json:"description"`
 	Position    int32                      `json:"position"`
+	DueDate     *time.Time                 `json:"dueDate"`
+	// Tags are the ones of the user doing the request. Nil means that they aren't changed
+	// when the list is created or updated
+	Tags []string `json:"tags,omitempty"`
+}
+
+// ListItemWithListEntity is an item returned out of its list, so it includes the list id
+type ListItemWithListEntity struct {
+	*ListItemEntity
+	ListID int32 `json:"listId"`
 }
@@ -1,14 +1,44 @@
 package domain
 
+import "time"
+
 type ListItemRecord struct {
-	ID          int32  `gorm:"type:int(32);primary_key"`
-	ListID      int32  `gorm:"column:listId;type:int(32)"`
-	UserID      int32  `gorm:"column:userId;type:int(32)"`
-	Title       string `gorm:"type:varchar(50)"`
-	Description string `gorm:"type:varchar(200)"`
-	Position    int32  `gorm:"column:position;type:int(32)"`
+	ID          int32      `gorm:"type:int(32);primary_key"`
+	ListID      int32      `gorm:"column:listId;type:int(32)"`
+	UserID      int32      `gorm:"column:userId;type:int(32)"`
+	Title       string     `gorm:"type:varchar(50)"`
+	Description string     `gorm:"type:varchar(200)"`
+	Position    int32      `gorm:"column:position;type:int(32)"`
+	DueDate     *time.Time `gorm:"column:dueDate;type:timestamp"`
 }
 
 func (ListItemRecord) TableName() string {
 	return "listItems"
 }
+
+type ListItemRecords []ListItemRecord
+
+func (r *ListItemRecord) ToListItemEntity() *ListItemEntity {
+	tvo, _ := NewItemTitleValueObject(r.Title)
+	dvo, _ := NewItemDescriptionValueObject(r.Description)
+
+	return &ListItemEntity{
+		ID:          r.ID,
+		ListID:      r.ListID,
+		UserID:      r.UserID,
+		Title:       tvo,
+		Description: dvo,
+		Position:    r.Position,
+		DueDate:     r.DueDate,
+	}
+}
+
+func (a ListItemRecords) ToListItemWithListEntities() []*ListItemWithListEntity {
+	res := make([]*ListItemWithListEntity, len(a))
+
+	for i, v := range a {
+		res[i] = &ListItemWithListEntity{ListItemEntity: v.ToListItemEntity(), ListID: v.ListID}
+	}
+
+	return res
+}
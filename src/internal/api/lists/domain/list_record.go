@@ -6,12 +6,13 @@ import (
 )
 
 type ListRecord struct {
-	ID         int32            `gorm:"type:int(32);primary_key"`
-	Name       string           `gorm:"type:varchar(50)"`
-	UserID     int32            `gorm:"column:userId;type:int(32)"`
-	CategoryID *sql.NullInt32   `gorm:"column:categoryId;type:int(32)"`
-	ItemsCount int32            `gorm:"column:itemsCount;type:int(32)"`
-	Items      []ListItemRecord `gorm:"foreignKey:ListID"`
+	ID          int32            `gorm:"type:int(32);primary_key"`
+	Name        string           `gorm:"type:varchar(50)"`
+	UserID      int32            `gorm:"column:userId;type:int(32)"`
+	WorkspaceID int32            `gorm:"column:workspaceId;type:int(32)"`
+	CategoryID  *sql.NullInt32   `gorm:"column:categoryId;type:int(32)"`
+	ItemsCount  int32            `gorm:"column:itemsCount;type:int(32)"`
+	Items       []ListItemRecord `gorm:"foreignKey:ListID"`
 }
 
 type ListRecords []ListRecord
@@ -26,17 +27,7 @@ func (r *ListRecord) ToListEntity() *ListEntity {
 	items := make([]*ListItemEntity, len(r.Items))
 
 	for i, v := range r.Items {
-		tvo, _ := NewItemTitleValueObject(v.Title)
-		dvo, _ := NewItemDescriptionValueObject(v.Description)
-
-		items[i] = &ListItemEntity{
-			ID:          v.ID,
-			ListID:      v.ListID,
-			UserID:      v.UserID,
-			Title:       tvo,
-			Description: dvo,
-			Position:    v.Position,
-		}
+		items[i] = v.ToListItemEntity()
 	}
 
 	var categoryID *int32
@@ -46,12 +37,13 @@ func (r *ListRecord) ToListEntity() *ListEntity {
 	}
 
 	return &ListEntity{
-		ID:         r.ID,
-		Name:       nvo,
-		CategoryID: categoryID,
-		UserID:     r.UserID,
-		ItemsCount: r.ItemsCount,
-		Items:      items,
+		ID:          r.ID,
+		Name:        nvo,
+		CategoryID:  categoryID,
+		UserID:      r.UserID,
+		WorkspaceID: r.WorkspaceID,
+		ItemsCount:  r.ItemsCount,
+		Items:       items,
 	}
 }
 
@@ -83,6 +75,7 @@ func (e *ListRecord) ToListSearchDocument() ListSearchDocument {
 		Name:              e.Name,
 		ItemsTitles:       make([]string, len(e.Items)),
 		ItemsDescriptions: make([]string, len(e.Items)),
+		Tags:              []string{},
 	}
 
 	for i, v := range e.Items {
@@ -92,3 +85,23 @@ func (e *ListRecord) ToListSearchDocument() ListSearchDocument {
 
 	return d
 }
+
+// InCategories returns the lists that belong to one of the categories
+func (a ListRecords) InCategories(categoryIDs []int32) ListRecords {
+	res := ListRecords{}
+
+	for _, v := range a {
+		if v.CategoryID == nil || !v.CategoryID.Valid {
+			continue
+		}
+
+		for _, id := range categoryIDs {
+			if v.CategoryID.Int32 == id {
+				res = append(res, v)
+				break
+			}
+		}
+	}
+
+	return res
+}
@@ -6,4 +6,6 @@ type ListSearchDocument struct {
 	Name              string   `json:"name"`
 	ItemsTitles       []string `json:"itemsTitles"`
 	ItemsDescriptions []string `json:"itemsDescriptions"`
+	// Tags are the ones that the owner of the list has in it. They are a facet of the index
+	Tags []string `json:"tags"`
 }
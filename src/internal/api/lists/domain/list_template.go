@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+var templateVariableRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_]+)\s*\}\}`)
+
+// TemplateVariables returns the names of the placeholders of the titles of the items, in the
+// order they first appear
+func TemplateVariables(items []ListTemplateItem) []string {
+	res := []string{}
+	found := map[string]bool{}
+
+	for _, v := range items {
+		for _, match := range templateVariableRegexp.FindAllStringSubmatch(v.Title.String(), -1) {
+			if !found[match[1]] {
+				found[match[1]] = true
+				res = append(res, match[1])
+			}
+		}
+	}
+
+	return res
+}
+
+// ToListEntity returns a new list with the items of the template, replacing the placeholders
+// of their titles with the values of the variables. The variables that the template doesn't
+// have are ignored
+func (e *ListTemplateEntity) ToListEntity(name ListNameValueObject, variables map[string]string) (*ListEntity, error) {
+	for _, v := range TemplateVariables(e.Items) {
+		if _, ok := variables[v]; !ok {
+			return nil, &appErrors.BadRequestError{Msg: fmt.Sprintf("The variable %q doesn't have a value", v)}
+		}
+	}
+
+	list := &ListEntity{
+		Name:  name,
+		Items: make([]*ListItemEntity, len(e.Items)),
+	}
+
+	for i, v := range e.Items {
+		title := templateVariableRegexp.ReplaceAllStringFunc(v.Title.String(), func(placeholder string) string {
+			return variables[templateVariableRegexp.FindStringSubmatch(placeholder)[1]]
+		})
+
+		tvo, err := NewItemTitleValueObject(title)
+		if err != nil {
+			return nil, &appErrors.BadRequestError{Msg: fmt.Sprintf("Item #%v: %v", i, err)}
+		}
+
+		list.Items[i] = &ListItemEntity{
+			Title:       tvo,
+			Description: v.Description,
+			Position:    int32(i),
+		}
+	}
+
+	return list, nil
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ListTemplateItem struct {
+	Title       ItemTitleValueObject       `json:"title"`
+	Description ItemDescriptionValueObject `json:"description"`
+}
+
+// ListTemplateEntity is a list that can be instantiated many times. The titles of its items can
+// have placeholders like {{destination}}, and Variables are the names of those placeholders
+type ListTemplateEntity struct {
+	ID         int32               `json:"id"`
+	UserID     int32               `json:"userId"`
+	Name       ListNameValueObject `json:"name"`
+	Items      []ListTemplateItem  `json:"items"`
+	Variables  []string            `json:"variables"`
+	SharedWith []int32             `json:"sharedWith,omitempty"`
+	CreatedAt  time.Time           `json:"createdAt"`
+}
+
+// NewListTemplateFromList returns a template with the items of the list. The due dates of the
+// items aren't kept
+func NewListTemplateFromList(list *ListRecord, name ListNameValueObject) *ListTemplateEntity {
+	template := &ListTemplateEntity{
+		UserID: list.UserID,
+		Name:   name,
+		Items:  make([]ListTemplateItem, len(list.Items)),
+	}
+
+	for i, v := range list.Items {
+		tvo, _ := NewItemTitleValueObject(v.Title)
+		dvo, _ := NewItemDescriptionValueObject(v.Description)
+
+		template.Items[i] = ListTemplateItem{Title: tvo, Description: dvo}
+	}
+
+	return template
+}
+
+func (e *ListTemplateEntity) ToListTemplateRecord() (*ListTemplateRecord, error) {
+	items := e.Items
+	if items == nil {
+		items = []ListTemplateItem{}
+	}
+
+	itemsJson, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListTemplateRecord{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		Name:      e.Name.String(),
+		Items:     string(itemsJson),
+		CreatedAt: e.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ListTemplateRecord struct {
+	ID        int32     `gorm:"type:int(32);primary_key"`
+	UserID    int32     `gorm:"column:userId;type:int(32)"`
+	Name      string    `gorm:"type:varchar(50)"`
+	Items     string    `gorm:"column:items;type:json"`
+	CreatedAt time.Time `gorm:"column:createdAt;type:timestamp"`
+	// Shares are only loaded when finding or getting templates
+	Shares []ListTemplateShareRecord `gorm:"foreignKey:TemplateID"`
+}
+
+type ListTemplateRecords []ListTemplateRecord
+
+func (ListTemplateRecord) TableName() string {
+	return "listTemplates"
+}
+
+// ToListTemplateEntity returns the template as seen by the user. The users that the template
+// is shared with are only returned to its owner
+func (r *ListTemplateRecord) ToListTemplateEntity(userID int32) *ListTemplateEntity {
+	nvo, _ := NewListNameValueObject(r.Name)
+
+	items := []ListTemplateItem{}
+	json.Unmarshal([]byte(r.Items), &items)
+
+	e := &ListTemplateEntity{
+		ID:        r.ID,
+		UserID:    r.UserID,
+		Name:      nvo,
+		Items:     items,
+		Variables: TemplateVariables(items),
+		CreatedAt: r.CreatedAt,
+	}
+
+	if r.UserID == userID {
+		e.SharedWith = make([]int32, len(r.Shares))
+		for i, v := range r.Shares {
+			e.SharedWith[i] = v.UserID
+		}
+	}
+
+	return e
+}
+
+func (a ListTemplateRecords) ToListTemplateEntities(userID int32) []*ListTemplateEntity {
+	res := make([]*ListTemplateEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToListTemplateEntity(userID)
+	}
+
+	return res
+}
+
+type ListTemplateShareRecord struct {
+	TemplateID int32     `gorm:"column:templateId;type:int(32);primary_key"`
+	UserID     int32     `gorm:"column:userId;type:int(32);primary_key"`
+	CreatedAt  time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+func (ListTemplateShareRecord) TableName() string {
+	return "listTemplateShares"
+}
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func templateItem(title string, description string) ListTemplateItem {
+	tvo, _ := NewItemTitleValueObject(title)
+	dvo, _ := NewItemDescriptionValueObject(description)
+
+	return ListTemplateItem{Title: tvo, Description: dvo}
+}
+
+func TestTemplateVariables_Returns_The_Variables_In_The_Order_They_First_Appear(t *testing.T) {
+	items := []ListTemplateItem{
+		templateItem("Book hotel in {{destination}}", "{{ignored}}"),
+		templateItem("Clothes for {{ days }} days in {{destination}}", ""),
+		templateItem("Passport", ""),
+		templateItem("{not a variable} {{with-dash}}", ""),
+	}
+
+	assert.Equal(t, []string{"destination", "days"}, TemplateVariables(items))
+	assert.Equal(t, []string{}, TemplateVariables(nil))
+}
+
+func TestListTemplateEntity_ToListEntity_Replaces_The_Variables(t *testing.T) {
+	template := &ListTemplateEntity{Items: []ListTemplateItem{
+		templateItem("Book hotel in {{destination}}", "desc {{destination}}"),
+		templateItem("Clothes for {{ days }} days", ""),
+	}}
+	nvo, _ := NewListNameValueObject("Trip")
+
+	list, err := template.ToListEntity(nvo, map[string]string{"destination": "Paris", "days": "3", "other": "x"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Trip", list.Name.String())
+	require.Equal(t, 2, len(list.Items))
+	assert.Equal(t, "Book hotel in Paris", list.Items[0].Title.String())
+	assert.Equal(t, "desc {{destination}}", list.Items[0].Description.String())
+	assert.Equal(t, int32(0), list.Items[0].Position)
+	assert.Equal(t, "Clothes for 3 days", list.Items[1].Title.String())
+	assert.Equal(t, int32(1), list.Items[1].Position)
+}
+
+func TestListTemplateEntity_ToListEntity_Returns_An_Error_If_A_Variable_Does_Not_Have_A_Value(t *testing.T) {
+	template := &ListTemplateEntity{Items: []ListTemplateItem{templateItem("Book hotel in {{destination}}", "")}}
+	nvo, _ := NewListNameValueObject("Trip")
+
+	_, err := template.ToListEntity(nvo, map[string]string{})
+
+	assert.EqualError(t, err, `The variable "destination" doesn't have a value`)
+}
+
+func TestListTemplateEntity_ToListEntity_Returns_An_Error_If_A_Title_Is_Not_Valid_After_Replacing_The_Variables(t *testing.T) {
+	template := &ListTemplateEntity{Items: []ListTemplateItem{templateItem("item", ""), templateItem("{{destination}}", "")}}
+	nvo, _ := NewListNameValueObject("Trip")
+
+	_, err := template.ToListEntity(nvo, map[string]string{"destination": ""})
+	assert.EqualError(t, err, "Item #1: The item title can not be empty")
+
+	_, err = template.ToListEntity(nvo, map[string]string{"destination": strings.Repeat("a", 51)})
+	assert.EqualError(t, err, "Item #1: The item title can not have more than 50 characters")
+}
+
+func TestNewListTemplateFromList(t *testing.T) {
+	dueDate := time.Now()
+	list := &ListRecord{ID: 1, UserID: 2, Name: "list", Items: []ListItemRecord{
+		{ID: 3, Title: "item1", Description: "desc1", DueDate: &dueDate},
+		{ID: 4, Title: "item2"},
+	}}
+	nvo, _ := NewListNameValueObject("template")
+
+	template := NewListTemplateFromList(list, nvo)
+
+	assert.Equal(t, int32(2), template.UserID)
+	assert.Equal(t, "template", template.Name.String())
+	assert.Equal(t, []ListTemplateItem{templateItem("item1", "desc1"), templateItem("item2", "")}, template.Items)
+}
+
+func TestListTemplateRecord_ToListTemplateEntity_Only_Returns_The_Shares_To_The_Owner(t *testing.T) {
+	record := &ListTemplateRecord{ID: 1, UserID: 2, Name: "packing", Items: `[{"title":"Clothes for {{days}} days","description":""}]`, Shares: []ListTemplateShareRecord{{TemplateID: 1, UserID: 3}}}
+
+	ownerEntity := record.ToListTemplateEntity(2)
+
+	assert.Equal(t, "packing", ownerEntity.Name.String())
+	assert.Equal(t, []ListTemplateItem{templateItem("Clothes for {{days}} days", "")}, ownerEntity.Items)
+	assert.Equal(t, []string{"days"}, ownerEntity.Variables)
+	assert.Equal(t, []int32{3}, ownerEntity.SharedWith)
+
+	sharedEntity := record.ToListTemplateEntity(3)
+
+	assert.Nil(t, sharedEntity.SharedWith)
+	assert.Equal(t, ownerEntity.Items, sharedEntity.Items)
+}
+
+func TestListTemplateEntity_ToListTemplateRecord(t *testing.T) {
+	nvo, _ := NewListNameValueObject("packing")
+	entity := &ListTemplateEntity{UserID: 2, Name: nvo, Items: []ListTemplateItem{templateItem("item", "desc")}}
+
+	record, err := entity.ToListTemplateRecord()
+
+	require.NoError(t, err)
+	assert.Equal(t, &ListTemplateRecord{UserID: 2, Name: "packing", Items: `[{"title":"item","description":"desc"}]`}, record)
+
+	entity.Items = nil
+	record, err = entity.ToListTemplateRecord()
+
+	require.NoError(t, err)
+	assert.Equal(t, "[]", record.Items)
+}
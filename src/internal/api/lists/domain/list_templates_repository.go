@@ -0,0 +1,21 @@
+package domain
+
+import "context"
+
+type ListTemplatesRepository interface {
+	/* FindListTemplate returns an error if the template doesn't exist */
+	FindListTemplate(ctx context.Context, query ListTemplateRecord) (*ListTemplateRecord, error)
+	/* FindAccessibleListTemplate returns an error if the template doesn't exist or if it isn't owned by or shared with the user */
+	FindAccessibleListTemplate(ctx context.Context, templateID int32, userID int32) (*ListTemplateRecord, error)
+	/* GetAccessibleListTemplates returns the templates owned by or shared with the user sorted by name */
+	GetAccessibleListTemplates(ctx context.Context, userID int32) (ListTemplateRecords, error)
+	ExistsListTemplate(ctx context.Context, query ListTemplateRecord) (bool, error)
+	CreateListTemplate(ctx context.Context, record *ListTemplateRecord) error
+	/* DeleteListTemplate also deletes its shares */
+	DeleteListTemplate(ctx context.Context, templateID int32) error
+	/* TransferListTemplates gives the templates of a user to another one in one transaction. The templates whose name the other user already has are renamed and the shares with the other user are removed */
+	TransferListTemplates(ctx context.Context, fromUserID int32, toUserID int32) error
+	ExistsListTemplateShare(ctx context.Context, query ListTemplateShareRecord) (bool, error)
+	CreateListTemplateShare(ctx context.Context, record *ListTemplateShareRecord) error
+	DeleteListTemplateShare(ctx context.Context, query ListTemplateShareRecord) error
+}
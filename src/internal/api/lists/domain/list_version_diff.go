@@ -0,0 +1,82 @@
+package domain
+
+import "time"
+
+type ListVersionNameChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type ListVersionCategoryChange struct {
+	From *int32 `json:"from"`
+	To   *int32 `json:"to"`
+}
+
+type ListVersionItemChange struct {
+	From ListVersionItem `json:"from"`
+	To   ListVersionItem `json:"to"`
+}
+
+// ListVersionDiff contains what has changed from a version of a list to its current state.
+// The added items are the ones that don't exist in the version and the removed ones are the
+// ones that only exist in the version, so restoring the version undoes all of them
+type ListVersionDiff struct {
+	Name         *ListVersionNameChange     `json:"name,omitempty"`
+	Category     *ListVersionCategoryChange `json:"category,omitempty"`
+	AddedItems   []ListVersionItem          `json:"addedItems"`
+	RemovedItems []ListVersionItem          `json:"removedItems"`
+	ChangedItems []ListVersionItemChange    `json:"changedItems"`
+}
+
+func NewListVersionDiff(version *ListVersionEntity, current *ListRecord) *ListVersionDiff {
+	diff := &ListVersionDiff{
+		AddedItems:   []ListVersionItem{},
+		RemovedItems: []ListVersionItem{},
+		ChangedItems: []ListVersionItemChange{},
+	}
+
+	if version.Name != current.Name {
+		diff.Name = &ListVersionNameChange{From: version.Name, To: current.Name}
+	}
+
+	if currentCategoryID := categoryIDOf(current); !sameCategoryID(version.CategoryID, currentCategoryID) {
+		diff.Category = &ListVersionCategoryChange{From: version.CategoryID, To: currentCategoryID}
+	}
+
+	versionItems := map[int32]ListVersionItem{}
+	for _, v := range version.Items {
+		versionItems[v.ID] = v
+	}
+
+	for _, v := range current.Items {
+		item := ListVersionItem{ID: v.ID, Title: v.Title, Description: v.Description, Position: v.Position, DueDate: v.DueDate}
+
+		versionItem, found := versionItems[v.ID]
+		if !found {
+			diff.AddedItems = append(diff.AddedItems, item)
+			continue
+		}
+
+		delete(versionItems, v.ID)
+
+		if versionItem.Title != item.Title || versionItem.Description != item.Description || !sameDueDate(versionItem.DueDate, item.DueDate) {
+			diff.ChangedItems = append(diff.ChangedItems, ListVersionItemChange{From: versionItem, To: item})
+		}
+	}
+
+	for _, v := range version.Items {
+		if _, found := versionItems[v.ID]; found {
+			diff.RemovedItems = append(diff.RemovedItems, v)
+		}
+	}
+
+	return diff
+}
+
+func sameDueDate(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
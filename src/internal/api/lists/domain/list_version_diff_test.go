@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListVersionRecord_Takes_A_Snapshot_Of_The_List(t *testing.T) {
+	list := &ListRecord{ID: 1, UserID: 2, Name: "list", CategoryID: &sql.NullInt32{Int32: 3, Valid: true}, Items: []ListItemRecord{
+		{ID: 4, Title: "item", Description: "desc", Position: 0},
+	}}
+
+	record, err := NewListVersionRecord(list)
+
+	require.Nil(t, err)
+	assert.Equal(t, `[{"id":4,"title":"item","description":"desc","position":0}]`, record.Items)
+	assert.False(t, record.CreatedAt.IsZero())
+
+	entity := record.ToListVersionEntity()
+
+	assert.Equal(t, int32(1), entity.ListID)
+	assert.Equal(t, "list", entity.Name)
+	assert.Equal(t, int32(3), *entity.CategoryID)
+	assert.Equal(t, []ListVersionItem{{ID: 4, Title: "item", Description: "desc", Position: 0}}, entity.Items)
+}
+
+func TestNewListVersionDiff_Returns_The_Changes_Since_The_Version(t *testing.T) {
+	categoryID := int32(3)
+	version := &ListVersionEntity{ListID: 1, Name: "list", CategoryID: &categoryID, Items: []ListVersionItem{
+		{ID: 1, Title: "item1"},
+		{ID: 2, Title: "item2"},
+		{ID: 3, Title: "item3", Description: "desc"},
+	}}
+	current := &ListRecord{ID: 1, Name: "new list", Items: []ListItemRecord{
+		{ID: 1, Title: "item1", Position: 1},
+		{ID: 3, Title: "item3", Description: "new desc"},
+		{ID: 4, Title: "item4"},
+	}}
+
+	diff := NewListVersionDiff(version, current)
+
+	assert.Equal(t, &ListVersionNameChange{From: "list", To: "new list"}, diff.Name)
+	assert.Equal(t, &ListVersionCategoryChange{From: &categoryID}, diff.Category)
+	assert.Equal(t, []ListVersionItem{{ID: 4, Title: "item4"}}, diff.AddedItems)
+	assert.Equal(t, []ListVersionItem{{ID: 2, Title: "item2"}}, diff.RemovedItems)
+	assert.Equal(t, []ListVersionItemChange{{From: ListVersionItem{ID: 3, Title: "item3", Description: "desc"}, To: ListVersionItem{ID: 3, Title: "item3", Description: "new desc"}}}, diff.ChangedItems)
+}
+
+func TestNewListVersionDiff_Returns_An_Empty_Diff_If_Nothing_Has_Changed(t *testing.T) {
+	version := &ListVersionEntity{ListID: 1, Name: "list", Items: []ListVersionItem{{ID: 1, Title: "item1"}}}
+	current := &ListRecord{ID: 1, Name: "list", CategoryID: &sql.NullInt32{}, Items: []ListItemRecord{{ID: 1, Title: "item1"}}}
+
+	diff := NewListVersionDiff(version, current)
+
+	assert.Nil(t, diff.Name)
+	assert.Nil(t, diff.Category)
+	assert.Empty(t, diff.AddedItems)
+	assert.Empty(t, diff.RemovedItems)
+	assert.Empty(t, diff.ChangedItems)
+}
+
+func TestNewListVersionDiff_Returns_The_Items_Whose_Due_Date_Has_Changed(t *testing.T) {
+	dueDate := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+	sameDueDate := dueDate.In(time.FixedZone("CET", 3600))
+	newDueDate := dueDate.Add(time.Hour)
+	version := &ListVersionEntity{ListID: 1, Name: "list", Items: []ListVersionItem{
+		{ID: 1, Title: "item1", DueDate: &dueDate},
+		{ID: 2, Title: "item2", DueDate: &dueDate},
+		{ID: 3, Title: "item3"},
+	}}
+	current := &ListRecord{ID: 1, Name: "list", Items: []ListItemRecord{
+		{ID: 1, Title: "item1", DueDate: &sameDueDate},
+		{ID: 2, Title: "item2", DueDate: &newDueDate},
+		{ID: 3, Title: "item3", DueDate: &dueDate},
+	}}
+
+	diff := NewListVersionDiff(version, current)
+
+	require.Equal(t, 2, len(diff.ChangedItems))
+	assert.Equal(t, int32(2), diff.ChangedItems[0].To.ID)
+	assert.Equal(t, &newDueDate, diff.ChangedItems[0].To.DueDate)
+	assert.Equal(t, int32(3), diff.ChangedItems[1].To.ID)
+	assert.Nil(t, diff.ChangedItems[1].From.DueDate)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+type ListVersionItem struct {
+	ID          int32      `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Position    int32      `json:"position"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+}
+
+// ListVersionEntity is the snapshot of a list taken before it was updated
+type ListVersionEntity struct {
+	ListID     int32             `json:"listId"`
+	Version    int32             `json:"version"`
+	Name       string            `json:"name"`
+	CategoryID *int32            `json:"categoryId"`
+	Items      []ListVersionItem `json:"items"`
+	CreatedAt  time.Time         `json:"createdAt"`
+}
+
+// ToListEntity returns the list as it was when the snapshot was taken
+func (e *ListVersionEntity) ToListEntity(userID int32) *ListEntity {
+	nvo, _ := NewListNameValueObject(e.Name)
+
+	list := &ListEntity{
+		ID:         e.ListID,
+		Name:       nvo,
+		UserID:     userID,
+		CategoryID: e.CategoryID,
+		Items:      make([]*ListItemEntity, len(e.Items)),
+	}
+
+	for i, v := range e.Items {
+		tvo, _ := NewItemTitleValueObject(v.Title)
+		dvo, _ := NewItemDescriptionValueObject(v.Description)
+
+		list.Items[i] = &ListItemEntity{
+			ID:          v.ID,
+			ListID:      e.ListID,
+			UserID:      userID,
+			Title:       tvo,
+			Description: dvo,
+			Position:    v.Position,
+			DueDate:     v.DueDate,
+		}
+	}
+
+	return list
+}
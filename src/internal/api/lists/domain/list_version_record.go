@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ListVersionRecord struct {
+	ID         int32     `gorm:"type:int(32);primary_key"`
+	ListID     int32     `gorm:"column:listId;type:int(32)"`
+	UserID     int32     `gorm:"column:userId;type:int(32)"`
+	Version    int32     `gorm:"column:version;type:int(32)"`
+	Name       string    `gorm:"column:name;type:varchar(50)"`
+	CategoryID *int32    `gorm:"column:categoryId;type:int(32)"`
+	Items      string    `gorm:"column:items;type:json"`
+	CreatedAt  time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+type ListVersionRecords []ListVersionRecord
+
+func (ListVersionRecord) TableName() string {
+	return "listVersions"
+}
+
+// NewListVersionRecord returns a snapshot of the list. The version number is assigned by the
+// repository when the snapshot is stored
+func NewListVersionRecord(list *ListRecord) (*ListVersionRecord, error) {
+	items := make([]ListVersionItem, len(list.Items))
+	for i, v := range list.Items {
+		items[i] = ListVersionItem{ID: v.ID, Title: v.Title, Description: v.Description, Position: v.Position, DueDate: v.DueDate}
+	}
+
+	itemsJson, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListVersionRecord{
+		ListID:     list.ID,
+		UserID:     list.UserID,
+		Name:       list.Name,
+		CategoryID: categoryIDOf(list),
+		Items:      string(itemsJson),
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+func (r *ListVersionRecord) ToListVersionEntity() *ListVersionEntity {
+	items := []ListVersionItem{}
+	json.Unmarshal([]byte(r.Items), &items)
+
+	return &ListVersionEntity{
+		ListID:     r.ListID,
+		Version:    r.Version,
+		Name:       r.Name,
+		CategoryID: r.CategoryID,
+		Items:      items,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func (a ListVersionRecords) ToListVersionEntities() []*ListVersionEntity {
+	res := make([]*ListVersionEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToListVersionEntity()
+	}
+
+	return res
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+type ListVersionsRepository interface {
+	/* GetListVersions returns the versions from the newest to the oldest */
+	GetListVersions(ctx context.Context, listID int32) (ListVersionRecords, error)
+	/* FindListVersion returns an error if the version doesn't exist */
+	FindListVersion(ctx context.Context, query ListVersionRecord) (*ListVersionRecord, error)
+}
@@ -6,9 +6,27 @@ type ListsRepository interface {
 	/* FindList returns an error if the list doesn't exist */
 	FindList(ctx context.Context, query ListRecord) (*ListRecord, error)
 	ExistsList(ctx context.Context, query ListRecord) (bool, error)
+	CountLists(ctx context.Context, query ListRecord) (int64, error)
 	GetLists(ctx context.Context, query ListRecord) (ListRecords, error)
+	/* ForEachListsBatch calls fn with the lists, with their items sorted by position, in batches sorted by id. It stops when fn returns an error */
+	ForEachListsBatch(ctx context.Context, query ListRecord, batchSize int, fn func(lists ListRecords) error) error
+	GetListsByCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) (ListRecords, error)
+	/* GetListsByTag returns the lists of the workspace that have the tag of the user */
+	GetListsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (ListRecords, error)
+	/* GetItemsByTag returns the items of the lists of the workspace that have the tag of the user, sorted by list and position */
+	GetItemsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (ListItemRecords, error)
+	/* GetDueItems returns the items with due date of the lists of the workspace, or only of the given lists when they aren't nil, sorted by list and position */
+	GetDueItems(ctx context.Context, workspaceID int32, listIDs []int32) (ListItemRecords, error)
 	CreateList(ctx context.Context, record *ListRecord) error
+	/* CreateLists creates the lists and their items in one transaction */
+	CreateLists(ctx context.Context, records []*ListRecord) error
 	DeleteList(ctx context.Context, query ListRecord) error
+	/* DeleteTrashedLists deletes the lists in the trash that match the query and their items in one transaction */
+	DeleteTrashedLists(ctx context.Context, query ListRecord) error
 	UpdateList(ctx context.Context, record *ListRecord) error
+	/* UpdateListWithVersion creates the version, as the one that follows the last version of the list, and updates the list in one transaction */
+	UpdateListWithVersion(ctx context.Context, record *ListRecord, version *ListVersionRecord) error
 	UpdateListItemsCount(ctx context.Context, listID int32) error
+	/* TransferLists changes the user of the lists and their items */
+	TransferLists(ctx context.Context, fromUserID int32, toUserID int32) error
 }
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"fmt"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// QuotaLimits are the maximum number of resources a user can have. A limit of 0 means that
+// there isn't any limit
+type QuotaLimits struct {
+	MaxLists        int
+	MaxItemsPerList int
+	MaxCategories   int
+}
+
+func (l QuotaLimits) WithOverrides(quota *UserQuotaRecord) QuotaLimits {
+	if quota == nil {
+		return l
+	}
+
+	if quota.MaxLists != nil {
+		l.MaxLists = int(*quota.MaxLists)
+	}
+
+	if quota.MaxItemsPerList != nil {
+		l.MaxItemsPerList = int(*quota.MaxItemsPerList)
+	}
+
+	if quota.MaxCategories != nil {
+		l.MaxCategories = int(*quota.MaxCategories)
+	}
+
+	return l
+}
+
+// CheckNewList returns an error if the user can't create another list
+func (l QuotaLimits) CheckNewList(listsCount int64) error {
+	if l.MaxLists > 0 && listsCount >= int64(l.MaxLists) {
+		return &appErrors.BadRequestError{Msg: fmt.Sprintf("You have reached the limit of %v lists", l.MaxLists)}
+	}
+
+	return nil
+}
+
+// CheckItems returns an error if a list can't have that number of items
+func (l QuotaLimits) CheckItems(itemsCount int) error {
+	if l.MaxItemsPerList > 0 && itemsCount > l.MaxItemsPerList {
+		return &appErrors.BadRequestError{Msg: fmt.Sprintf("A list can't have more than %v items", l.MaxItemsPerList)}
+	}
+
+	return nil
+}
+
+// CheckNewCategory returns an error if the user can't create another category
+func (l QuotaLimits) CheckNewCategory(categoriesCount int64) error {
+	if l.MaxCategories > 0 && categoriesCount >= int64(l.MaxCategories) {
+		return &appErrors.BadRequestError{Msg: fmt.Sprintf("You have reached the limit of %v categories", l.MaxCategories)}
+	}
+
+	return nil
+}
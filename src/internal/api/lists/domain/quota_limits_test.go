@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaLimits_WithOverrides(t *testing.T) {
+	defaults := QuotaLimits{MaxLists: 10, MaxItemsPerList: 100, MaxCategories: 5}
+
+	assert.Equal(t, defaults, defaults.WithOverrides(nil))
+	assert.Equal(t, defaults, defaults.WithOverrides(&UserQuotaRecord{UserID: 1}))
+
+	maxLists := int32(0)
+	maxCategories := int32(20)
+	assert.Equal(t, QuotaLimits{MaxLists: 0, MaxItemsPerList: 100, MaxCategories: 20}, defaults.WithOverrides(&UserQuotaRecord{UserID: 1, MaxLists: &maxLists, MaxCategories: &maxCategories}))
+}
+
+func TestQuotaLimits_Checks(t *testing.T) {
+	limits := QuotaLimits{MaxLists: 2, MaxItemsPerList: 3, MaxCategories: 1}
+
+	assert.Nil(t, limits.CheckNewList(1))
+	assert.Equal(t, &appErrors.BadRequestError{Msg: "You have reached the limit of 2 lists"}, limits.CheckNewList(2))
+
+	assert.Nil(t, limits.CheckItems(3))
+	assert.Equal(t, &appErrors.BadRequestError{Msg: "A list can't have more than 3 items"}, limits.CheckItems(4))
+
+	assert.Nil(t, limits.CheckNewCategory(0))
+	assert.Equal(t, &appErrors.BadRequestError{Msg: "You have reached the limit of 1 categories"}, limits.CheckNewCategory(1))
+
+	unlimited := QuotaLimits{}
+	assert.Nil(t, unlimited.CheckNewList(1000))
+	assert.Nil(t, unlimited.CheckItems(1000))
+	assert.Nil(t, unlimited.CheckNewCategory(1000))
+}
@@ -0,0 +1,30 @@
+package domain
+
+type QuotaUsage struct {
+	Used  int64 `json:"used"`
+	Limit *int  `json:"limit"`
+}
+
+// QuotaUsageEntity contains what a user is using of each quota. A nil limit means that there
+// isn't any limit. The items usage is the one of the user's biggest list
+type QuotaUsageEntity struct {
+	Lists        QuotaUsage `json:"lists"`
+	ItemsPerList QuotaUsage `json:"itemsPerList"`
+	Categories   QuotaUsage `json:"categories"`
+}
+
+func NewQuotaUsageEntity(limits QuotaLimits, listsCount int64, maxItemsCount int64, categoriesCount int64) *QuotaUsageEntity {
+	return &QuotaUsageEntity{
+		Lists:        QuotaUsage{Used: listsCount, Limit: quotaLimit(limits.MaxLists)},
+		ItemsPerList: QuotaUsage{Used: maxItemsCount, Limit: quotaLimit(limits.MaxItemsPerList)},
+		Categories:   QuotaUsage{Used: categoriesCount, Limit: quotaLimit(limits.MaxCategories)},
+	}
+}
+
+func quotaLimit(limit int) *int {
+	if limit <= 0 {
+		return nil
+	}
+
+	return &limit
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+type QuotasRepository interface {
+	/* GetUserQuota returns a quota without overrides if the user doesn't have one */
+	GetUserQuota(ctx context.Context, userID int32) (*UserQuotaRecord, error)
+	/* SaveUserQuota creates or replaces the overrides of the user. A nil limit goes back to the default one */
+	SaveUserQuota(ctx context.Context, record *UserQuotaRecord) error
+}
@@ -0,0 +1,17 @@
+package domain
+
+type TagEntity struct {
+	ID         int32              `json:"id"`
+	Name       TagNameValueObject `json:"name"`
+	UserID     int32              `json:"-"`
+	ListsCount int64              `json:"listsCount"`
+	ItemsCount int64              `json:"itemsCount"`
+}
+
+func (e *TagEntity) ToTagRecord() *TagRecord {
+	return &TagRecord{
+		ID:     e.ID,
+		Name:   e.Name.String(),
+		UserID: e.UserID,
+	}
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// TagNameValueObject is the name of a tag. It's trimmed and lowercased, so "Work" and "work "
+// are the same tag
+type TagNameValueObject struct {
+	tagName string
+}
+
+const tagNameMaxLength = 30
+
+func NewTagNameValueObject(name string) (TagNameValueObject, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	if len(name) == 0 {
+		return TagNameValueObject{}, &appErrors.BadRequestError{Msg: "The tag name can not be empty"}
+	}
+
+	if len(name) > tagNameMaxLength {
+		return TagNameValueObject{}, &appErrors.BadRequestError{Msg: fmt.Sprintf("The tag name can not have more than %v characters", tagNameMaxLength)}
+	}
+
+	return TagNameValueObject{tagName: name}, nil
+}
+
+// NewTagNames validates the names and removes the repeated ones
+func NewTagNames(names []string) ([]string, error) {
+	if names == nil {
+		return nil, nil
+	}
+
+	res := []string{}
+	found := map[string]bool{}
+
+	for _, n := range names {
+		nvo, err := NewTagNameValueObject(n)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found[nvo.String()] {
+			found[nvo.String()] = true
+			res = append(res, nvo.String())
+		}
+	}
+
+	return res, nil
+}
+
+func (v TagNameValueObject) String() string {
+	return v.tagName
+}
+
+func (v TagNameValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.tagName), nil
+}
+
+func (v *TagNameValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewTagNameValueObject(string(d))
+	return err
+}
+
+func (v TagNameValueObject) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+func (v *TagNameValueObject) Scan(value interface{}) error {
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewTagNameValueObject(fmt.Sprintf("%s", sv))
+		return nil
+
+	}
+	return errors.New("failed to scan TagNameValueObject")
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTagName_Validates_MinLength(t *testing.T) {
+	tagName, err := NewTagNameValueObject("  ")
+
+	assert.Empty(t, tagName)
+
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The tag name can not be empty", badReqErr.Error())
+}
+
+func TestNewTagName_Validates_MaxLength(t *testing.T) {
+	tagName, err := NewTagNameValueObject("0123456789012345678901234567890")
+
+	assert.Empty(t, tagName)
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The tag name can not have more than 30 characters", badReqErr.Error())
+}
+
+func TestNewTagName_Returns_A_Trimmed_And_Lowercased_TagName(t *testing.T) {
+	tagName, err := NewTagNameValueObject(" Work ")
+
+	assert.Equal(t, "work", tagName.String())
+	assert.NoError(t, err)
+}
+
+func TestNewTagNames_Removes_The_Repeated_Names(t *testing.T) {
+	names, err := NewTagNames([]string{"work", "Home", "WORK"})
+
+	assert.Equal(t, []string{"work", "home"}, names)
+	assert.NoError(t, err)
+}
+
+func TestNewTagNames_Keeps_Nil_And_Empty_Apart(t *testing.T) {
+	names, err := NewTagNames(nil)
+	assert.Nil(t, names)
+	assert.NoError(t, err)
+
+	names, err = NewTagNames([]string{})
+	assert.Equal(t, []string{}, names)
+	assert.NoError(t, err)
+}
+
+func TestNewTagNames_Validates_Each_Name(t *testing.T) {
+	names, err := NewTagNames([]string{"work", ""})
+
+	assert.Nil(t, names)
+	assert.EqualError(t, err, "The tag name can not be empty")
+}
@@ -0,0 +1,76 @@
+package domain
+
+type TagRecord struct {
+	ID     int32  `gorm:"type:int(32);primary_key"`
+	UserID int32  `gorm:"column:userId;type:int(32)"`
+	Name   string `gorm:"type:varchar(30)"`
+	// ListsCount and ItemsCount are only loaded when getting all the tags of a user
+	ListsCount int64 `gorm:"->;column:listsCount"`
+	ItemsCount int64 `gorm:"->;column:itemsCount"`
+}
+
+type TagRecords []TagRecord
+
+func (TagRecord) TableName() string {
+	return "tags"
+}
+
+func (r *TagRecord) ToTagEntity() *TagEntity {
+	nvo, _ := NewTagNameValueObject(r.Name)
+
+	return &TagEntity{
+		ID:         r.ID,
+		Name:       nvo,
+		UserID:     r.UserID,
+		ListsCount: r.ListsCount,
+		ItemsCount: r.ItemsCount,
+	}
+}
+
+func (a TagRecords) ToTagEntities() []*TagEntity {
+	res := make([]*TagEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToTagEntity()
+	}
+
+	return res
+}
+
+type ListTagRecord struct {
+	ListID int32 `gorm:"column:listId;type:int(32);primary_key"`
+	TagID  int32 `gorm:"column:tagId;type:int(32);primary_key"`
+}
+
+func (ListTagRecord) TableName() string {
+	return "listTags"
+}
+
+type ListItemTagRecord struct {
+	ListItemID int32 `gorm:"column:listItemId;type:int(32);primary_key"`
+	TagID      int32 `gorm:"column:tagId;type:int(32);primary_key"`
+}
+
+func (ListItemTagRecord) TableName() string {
+	return "listItemTags"
+}
+
+// TaggedRecord is a list or an item together with the name of one of its tags. It isn't a
+// table, it's the result of a query
+type TaggedRecord struct {
+	ID   int32  `gorm:"column:id"`
+	Name string `gorm:"column:name"`
+}
+
+type TaggedRecords []TaggedRecord
+
+// TagNamesByID returns the names of the tags of each list or item
+func (a TaggedRecords) TagNamesByID() map[int32][]string {
+	res := map[int32][]string{}
+
+	for _, v := range a {
+		res[v.ID] = append(res[v.ID], v.Name)
+	}
+
+	return res
+}
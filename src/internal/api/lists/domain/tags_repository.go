@@ -0,0 +1,31 @@
+package domain
+
+import "context"
+
+type TagsRepository interface {
+	FindTag(ctx context.Context, query TagRecord) (*TagRecord, error)
+	ExistsTag(ctx context.Context, query TagRecord) (bool, error)
+	/* GetTags returns the tags of the user sorted by name, with the number of lists and items that have them */
+	GetTags(ctx context.Context, userID int32) (TagRecords, error)
+	CreateTag(ctx context.Context, record *TagRecord) error
+	/* UpdateTag only changes the name of the tag */
+	UpdateTag(ctx context.Context, record *TagRecord) error
+	/* DeleteTag also removes the tag from its lists and items */
+	DeleteTag(ctx context.Context, query TagRecord) error
+	/* MergeTags gives the lists and items of the tag to the target one and deletes the tag in one transaction */
+	MergeTags(ctx context.Context, tagID int32, targetTagID int32) error
+	/* TransferTags gives the tags of a user to another one in one transaction. The tags whose name the other user already has are merged into the other user's ones */
+	TransferTags(ctx context.Context, fromUserID int32, toUserID int32) error
+	/* GetTagListIDs returns the ids of the lists that have the tag */
+	GetTagListIDs(ctx context.Context, tagID int32) ([]int32, error)
+	/* SetListTags replaces the tags that the user has in the list, creating the ones that don't exist yet */
+	SetListTags(ctx context.Context, userID int32, listID int32, names []string) error
+	/* SetItemTags replaces the tags that the user has in the item, creating the ones that don't exist yet */
+	SetItemTags(ctx context.Context, userID int32, itemID int32, names []string) error
+	/* GetListsTags returns the tags that the user has in the lists */
+	GetListsTags(ctx context.Context, userID int32, listIDs []int32) (TaggedRecords, error)
+	/* GetItemsTags returns the tags that the user has in the items */
+	GetItemsTags(ctx context.Context, userID int32, itemIDs []int32) (TaggedRecords, error)
+	/* GetListsOwnerTags returns the tags that the owner of each list has in it */
+	GetListsOwnerTags(ctx context.Context, listIDs []int32) (TaggedRecords, error)
+}
@@ -0,0 +1,14 @@
+package domain
+
+// UserQuotaRecord contains the limits of a user that are different from the default ones.
+// A nil limit uses the default one
+type UserQuotaRecord struct {
+	UserID          int32  `gorm:"column:userId;type:int(32);primary_key"`
+	MaxLists        *int32 `gorm:"column:maxLists;type:int(32)"`
+	MaxItemsPerList *int32 `gorm:"column:maxItemsPerList;type:int(32)"`
+	MaxCategories   *int32 `gorm:"column:maxCategories;type:int(32)"`
+}
+
+func (UserQuotaRecord) TableName() string {
+	return "userQuotas"
+}
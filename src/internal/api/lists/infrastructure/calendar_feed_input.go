@@ -0,0 +1,6 @@
+package infrastructure
+
+type CalendarFeedInput struct {
+	CategoryID *int32 `json:"categoryId"`
+	ListID     *int32 `json:"listId"`
+}
@@ -0,0 +1,5 @@
+package infrastructure
+
+type CategoriesOrderInput struct {
+	CategoryIDs []int32 `json:"categoryIds"`
+}
@@ -9,12 +9,20 @@ import (
 type CategoryInput struct {
 	Name        domain.CategoryNameValueObject        `json:"name"`
 	Description domain.CategoryDescriptionValueObject `json:"description"`
+	ParentID    *int32                                `json:"parentId"`
+	Color       domain.CategoryColorValueObject       `json:"color"`
+	Icon        domain.CategoryIconValueObject        `json:"icon"`
+	IsDefault   bool                                  `json:"isDefault"`
 }
 
 func (i *CategoryInput) UnmarshalJSON(data []byte) error {
 	var realInput struct {
 		Name        string `json:"name"`
 		Description string `json:"description"`
+		ParentID    *int32 `json:"parentId"`
+		Color       string `json:"color"`
+		Icon        string `json:"icon"`
+		IsDefault   bool   `json:"isDefault"`
 	}
 
 	if err := json.Unmarshal(data, &realInput); err != nil {
@@ -31,9 +39,23 @@ func (i *CategoryInput) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	cvo, err := domain.NewCategoryColorValueObject(realInput.Color)
+	if err != nil {
+		return err
+	}
+
+	ivo, err := domain.NewCategoryIconValueObject(realInput.Icon)
+	if err != nil {
+		return err
+	}
+
 	*i = CategoryInput{
 		Name:        nvo,
 		Description: dvo,
+		ParentID:    realInput.ParentID,
+		Color:       cvo,
+		Icon:        ivo,
+		IsDefault:   realInput.IsDefault,
 	}
 
 	return nil
@@ -43,7 +65,96 @@ func (i *CategoryInput) ToCategoryEntity() *domain.CategoryEntity {
 	list := &domain.CategoryEntity{
 		Name:        i.Name,
 		Description: i.Description,
+		ParentID:    i.ParentID,
+		Color:       i.Color,
+		Icon:        i.Icon,
+		IsDefault:   i.IsDefault,
 	}
 
 	return list
 }
+
+// UpdateCategoryInput only has the fields sent in the request, so the category keeps the value
+// of the other ones. A null parentId moves the category to the root
+type UpdateCategoryInput struct {
+	Name        *domain.CategoryNameValueObject
+	Description *domain.CategoryDescriptionValueObject
+	HasParentID bool
+	ParentID    *int32
+	Color       *domain.CategoryColorValueObject
+	Icon        *domain.CategoryIconValueObject
+	IsDefault   *bool
+}
+
+func (i *UpdateCategoryInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name        *string         `json:"name"`
+		Description *string         `json:"description"`
+		ParentID    json.RawMessage `json:"parentId"`
+		Color       *string         `json:"color"`
+		Icon        *string         `json:"icon"`
+		IsDefault   *bool           `json:"isDefault"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	input := UpdateCategoryInput{IsDefault: realInput.IsDefault}
+
+	if realInput.Name != nil {
+		nvo, err := domain.NewCategoryNameValueObject(*realInput.Name)
+		if err != nil {
+			return err
+		}
+		input.Name = &nvo
+	}
+
+	if realInput.Description != nil {
+		dvo, err := domain.NewCategoryDescriptionValueObject(*realInput.Description)
+		if err != nil {
+			return err
+		}
+		input.Description = &dvo
+	}
+
+	// The raw message is "null" when the parentId is sent as null and empty when it isn't sent
+	if len(realInput.ParentID) > 0 {
+		if err := json.Unmarshal(realInput.ParentID, &input.ParentID); err != nil {
+			return err
+		}
+		input.HasParentID = true
+	}
+
+	if realInput.Color != nil {
+		cvo, err := domain.NewCategoryColorValueObject(*realInput.Color)
+		if err != nil {
+			return err
+		}
+		input.Color = &cvo
+	}
+
+	if realInput.Icon != nil {
+		ivo, err := domain.NewCategoryIconValueObject(*realInput.Icon)
+		if err != nil {
+			return err
+		}
+		input.Icon = &ivo
+	}
+
+	*i = input
+
+	return nil
+}
+
+func (i *UpdateCategoryInput) ToCategoryUpdate() *domain.CategoryUpdate {
+	return &domain.CategoryUpdate{
+		Name:        i.Name,
+		Description: i.Description,
+		HasParentID: i.HasParentID,
+		ParentID:    i.ParentID,
+		Color:       i.Color,
+		Icon:        i.Icon,
+		IsDefault:   i.IsDefault,
+	}
+}
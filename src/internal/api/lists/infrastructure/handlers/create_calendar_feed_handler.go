@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func CreateCalendarFeedHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.CalendarFeedInput)
+
+	feedEntity := &domain.CalendarFeedEntity{
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		CategoryID:  input.CategoryID,
+		ListID:      input.ListID,
+	}
+
+	srv := application.NewCreateCalendarFeedService(h.CalendarFeedsRepository, h.ListsRepository, h.CategoriesRepository)
+	err := srv.CreateCalendarFeed(r.Context(), feedEntity)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: feedEntity, StatusCode: http.StatusCreated}
+}
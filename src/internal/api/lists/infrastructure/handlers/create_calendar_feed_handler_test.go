@@ -0,0 +1,141 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_It_Is_Filtered_By_A_Category_And_By_A_List(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	categoryID := int32(3)
+	listID := int32(4)
+	h := handler.Handler{RequestInput: &infrastructure.CalendarFeedInput{CategoryID: &categoryID, ListID: &listID}}
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A calendar feed can be filtered by a category or by a list, but not by both")
+}
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_If_The_Category_Exists_Fails(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	categoryID := int32(3)
+	h := handler.Handler{CategoriesRepository: &mockedCategoriesRepo, RequestInput: &infrastructure.CalendarFeedInput{CategoryID: &categoryID}}
+
+	mockedCategoriesRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 3, WorkspaceID: 2}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the category exists")
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Category_Does_Not_Exist(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	categoryID := int32(3)
+	h := handler.Handler{CategoriesRepository: &mockedCategoriesRepo, RequestInput: &infrastructure.CalendarFeedInput{CategoryID: &categoryID}}
+
+	mockedCategoriesRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 3, WorkspaceID: 2}).Return(false, nil).Once()
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The category doesn't exist")
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_If_The_List_Exists_Fails(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	listID := int32(4)
+	h := handler.Handler{ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.CalendarFeedInput{ListID: &listID}}
+
+	mockedListsRepo.On("ExistsList", request.Context(), domain.ListRecord{ID: 4, WorkspaceID: 2}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the list exists")
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_List_Does_Not_Exist(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	listID := int32(4)
+	h := handler.Handler{ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.CalendarFeedInput{ListID: &listID}}
+
+	mockedListsRepo.On("ExistsList", request.Context(), domain.ListRecord{ID: 4, WorkspaceID: 2}).Return(false, nil).Once()
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The list doesn't exist")
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestCreateCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Create_Fails(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo, RequestInput: &infrastructure.CalendarFeedInput{}}
+
+	mockedRepo.On("CreateCalendarFeed", request.Context(), mock.AnythingOfType("*domain.CalendarFeedRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the calendar feed")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateCalendarFeedHandler_Creates_The_Feed_And_Returns_Its_Token_Only_Once(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	listID := int32(4)
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo, ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.CalendarFeedInput{ListID: &listID}}
+
+	var createdRecord *domain.CalendarFeedRecord
+	mockedListsRepo.On("ExistsList", request.Context(), domain.ListRecord{ID: 4, WorkspaceID: 2}).Return(true, nil).Once()
+	mockedRepo.On("CreateCalendarFeed", request.Context(), mock.AnythingOfType("*domain.CalendarFeedRecord")).Return(nil).Once().Run(func(args mock.Arguments) {
+		createdRecord = args.Get(1).(*domain.CalendarFeedRecord)
+		createdRecord.ID = 11
+	})
+
+	result := CreateCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	feedEntity, isOk := okRes.Content.(*domain.CalendarFeedEntity)
+	require.True(t, isOk, "should be a pointer to CalendarFeedEntity")
+	assert.Equal(t, int32(11), feedEntity.ID)
+	assert.Equal(t, &listID, feedEntity.ListID)
+	assert.Nil(t, feedEntity.CategoryID)
+	assert.Regexp(t, "^[0-9a-f]{64}$", feedEntity.Token)
+	assert.Equal(t, "/feeds/"+feedEntity.Token+"/calendar.ics", feedEntity.Url)
+	assert.False(t, feedEntity.CreatedAt.IsZero())
+	require.NotNil(t, createdRecord)
+	assert.Equal(t, int32(1), createdRecord.UserID)
+	assert.Equal(t, int32(2), createdRecord.WorkspaceID)
+	assert.Equal(t, domain.HashCalendarFeedToken(feedEntity.Token), createdRecord.TokenHash)
+	assert.NotEqual(t, feedEntity.Token, createdRecord.TokenHash)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
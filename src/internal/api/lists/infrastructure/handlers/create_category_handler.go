@@ -11,12 +11,14 @@ import (
 
 func CreateCategoryHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	input, _ := h.RequestInput.(*infrastructure.CategoryInput)
 
 	categoryEntity := input.ToCategoryEntity()
 	categoryEntity.UserID = userID
+	categoryEntity.WorkspaceID = workspaceID
 
-	srv := application.NewCreateCategoryService(h.CategoriesRepository)
+	srv := application.NewCreateCategoryService(h.CategoriesRepository, h.QuotasRepository, h.CfgSrv)
 	err := srv.CreateCategory(r.Context(), categoryEntity)
 	if err != nil {
 		return results.ErrorResult{Err: err}
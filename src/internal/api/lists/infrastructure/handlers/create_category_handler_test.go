@@ -13,6 +13,7 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
@@ -25,11 +26,12 @@ func createCategoryRequest() *http.Request {
 	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
 
-func TestCreateCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_If_A_Category_With_The_Same_Name_Already_Exists_Fails(t *testing.T) {
+func TestCreateCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Categories_Fails(t *testing.T) {
 	request := createCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
@@ -39,11 +41,11 @@ func TestCreateCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If
 		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
 	}
 
-	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{Name: "category1", UserID: 1}).Return(false, fmt.Errorf("some error")).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
 
-	results.CheckUnexpectedErrorResult(t, result, "Error checking if a category with the same name already exists")
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user categories")
 	mockedRepo.AssertExpectations(t)
 }
 
@@ -57,7 +59,7 @@ func TestCreateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If
 		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
 	}
 
-	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{Name: "category1", UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 2, Name: "category1"}}, nil).Once()
 
 	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -65,20 +67,72 @@ func TestCreateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If
 	mockedRepo.AssertExpectations(t)
 }
 
+func TestCreateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_Parent_Does_Not_Exist(t *testing.T) {
+	request := createCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	parentID := int32(5)
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.CategoryInput{Name: nvo, ParentID: &parentID},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 2, Name: "category1"}}, nil).Once()
+
+	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The parent category doesn't exist")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_User_Has_Reached_The_Categories_Limit(t *testing.T) {
+	request := createCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxCategories: 2})
+	mockedRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(2), nil).Once()
+
+	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "You have reached the limit of 2 categories")
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
 func TestCreateCategoryHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Creating_The_Category_Fails(t *testing.T) {
 	request := createCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CfgSrv:               mockedCfgSrv,
 		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
 	}
 
-	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{Name: "category1", UserID: 1}).Return(false, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(0), nil).Once()
 	newCategory := domain.CategoryEntity{
-		Name:   nvo,
-		UserID: 1,
+		Name:        nvo,
+		UserID:      1,
+		WorkspaceID: 1,
 	}
 	mockedRepo.On("CreateCategory", request.Context(), newCategory.ToCategoryRecord()).Return(fmt.Errorf("some error")).Once()
 
@@ -92,16 +146,23 @@ func TestCreateCategoryHandler_Creates_A_New_Category(t *testing.T) {
 	request := createCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CfgSrv:               mockedCfgSrv,
 		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
 	}
 
-	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{Name: "category1", UserID: 1}).Return(false, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxCategories: 2})
+	mockedRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(1), nil).Once()
 	newCategory := domain.CategoryEntity{
-		Name:   nvo,
-		UserID: 1,
+		Name:        nvo,
+		UserID:      1,
+		WorkspaceID: 1,
 	}
 
 	mockedRepo.On("CreateCategory", request.Context(), newCategory.ToCategoryRecord()).Run(func(args mock.Arguments) {
@@ -119,3 +180,82 @@ func TestCreateCategoryHandler_Creates_A_New_Category(t *testing.T) {
 
 	mockedRepo.AssertExpectations(t)
 }
+
+func TestCreateCategoryHandler_Creates_A_New_Subcategory_With_The_Name_Of_A_Category_With_Another_Parent(t *testing.T) {
+	request := createCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	parentID := int32(2)
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.CategoryInput{Name: nvo, ParentID: &parentID},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 2, Name: "category1"}}, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(1), nil).Once()
+	newCategory := domain.CategoryEntity{
+		Name:        nvo,
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &parentID,
+		Position:    1,
+	}
+	mockedRepo.On("CreateCategory", request.Context(), newCategory.ToCategoryRecord()).Return(nil).Once()
+
+	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.Equal(t, int32(2), *res.ParentID)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateCategoryHandler_Creates_The_Default_Category_Of_The_User(t *testing.T) {
+	request := createCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	ivo, _ := domain.NewCategoryIconValueObject("cart")
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.CategoryInput{Name: nvo, Icon: ivo, IsDefault: true},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(0), nil).Once()
+	newCategory := domain.CategoryEntity{
+		Name:        nvo,
+		Icon:        ivo,
+		UserID:      1,
+		WorkspaceID: 1,
+	}
+	mockedRepo.On("CreateCategory", request.Context(), newCategory.ToCategoryRecord()).Run(func(args mock.Arguments) {
+		param := args.Get(1).(*domain.CategoryRecord)
+		param.ID = 4
+	}).Return(nil).Once()
+	categoryID := int32(4)
+	mockedRepo.On("SetDefaultCategory", request.Context(), int32(1), int32(1), &categoryID).Return(nil).Once()
+
+	result := CreateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.True(t, res.IsDefault)
+	assert.Equal(t, "cart", res.Icon.String())
+
+	mockedRepo.AssertExpectations(t)
+}
@@ -11,15 +11,17 @@ import (
 
 func CreateListHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	input, _ := h.RequestInput.(*infrastructure.ListInput)
 
 	listEntity := input.ToListEntity()
 	listEntity.UserID = userID
+	listEntity.WorkspaceID = workspaceID
 	for _, v := range listEntity.Items {
 		v.UserID = userID
 	}
 
-	srv := application.NewCreateListService(h.ListsRepository, h.EventBus)
+	srv := application.NewCreateListService(h.ListsRepository, h.CategoriesRepository, h.TagsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
 	err := srv.CreateList(r.Context(), listEntity)
 	if err != nil {
 		return results.ErrorResult{Err: err}
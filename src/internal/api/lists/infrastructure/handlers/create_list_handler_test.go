@@ -13,6 +13,7 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
@@ -26,6 +27,7 @@ func createRequest() *http.Request {
 	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -40,7 +42,7 @@ func TestCreateListHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The
 		RequestInput:    &infrastructure.ListInput{Name: listName},
 	}
 
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), UserID: 1}).Return(false, fmt.Errorf("some error")).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, fmt.Errorf("some error")).Once()
 
 	result := CreateListHandler(httptest.NewRecorder(), request, h)
 
@@ -58,7 +60,7 @@ func TestCreateListHandler_Returns_An_Error_Result_With_A_BadRequestError_If_A_L
 		RequestInput:    &infrastructure.ListInput{Name: listName},
 	}
 
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(true, nil).Once()
 
 	result := CreateListHandler(httptest.NewRecorder(), request, h)
 
@@ -66,21 +68,85 @@ func TestCreateListHandler_Returns_An_Error_Result_With_A_BadRequestError_If_A_L
 	mockedRepo.AssertExpectations(t)
 }
 
+func TestCreateListHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_User_Has_Reached_The_Lists_Limit(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	listName, _ := domain.NewListNameValueObject("list1")
+	h := handler.Handler{
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.ListInput{Name: listName},
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(3), nil).Once()
+
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "You have reached the limit of 3 lists")
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+func TestCreateListHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_List_Has_More_Items_Than_Allowed(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	listName, _ := domain.NewListNameValueObject("list1")
+	title1, _ := domain.NewItemTitleValueObject("item1")
+	title2, _ := domain.NewItemTitleValueObject("item2")
+	h := handler.Handler{
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.ListInput{Name: listName, Items: []infrastructure.ListItemInput{{Title: title1}, {Title: title2}}},
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3, MaxItemsPerList: 1})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A list can't have more than 1 items")
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
 func TestCreateListHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Creating_The_List_Fails(t *testing.T) {
 	request := createRequest()
 
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	listName, _ := domain.NewListNameValueObject("list1")
 	h := handler.Handler{
-		ListsRepository: &mockedRepo,
-		RequestInput:    &infrastructure.ListInput{Name: listName},
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.ListInput{Name: listName},
 	}
 
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), UserID: 1}).Return(false, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
 	createdList := domain.ListEntity{
-		Name:   listName,
-		UserID: 1,
-		Items:  []*domain.ListItemEntity{},
+		Name:        listName,
+		UserID:      1,
+		WorkspaceID: 1,
+		Items:       []*domain.ListItemEntity{},
 	}
 	mockedRepo.On("CreateList", request.Context(), createdList.ToListRecord()).Return(fmt.Errorf("some error")).Once()
 
@@ -94,18 +160,28 @@ func TestCreateListHandler_Creates_A_New_List_And_Sends_The_ListCreatedOrUpdated
 	request := createRequest()
 
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	mockedEventBus := events.MockedEventBus{}
 	listName, _ := domain.NewListNameValueObject("list1")
 	h := handler.Handler{
-		ListsRepository: &mockedRepo,
-		RequestInput:    &infrastructure.ListInput{Name: listName},
-		EventBus:        &mockedEventBus,
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.ListInput{Name: listName},
+		EventBus:             &mockedEventBus,
 	}
 
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), UserID: 1}).Return(false, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(2), nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
 	listToCreate := domain.ListEntity{
-		Name:   listName,
-		UserID: 1,
+		Name:        listName,
+		UserID:      1,
+		WorkspaceID: 1,
 	}
 
 	mockedRepo.On("CreateList", request.Context(), listToCreate.ToListRecord()).Run(func(args mock.Arguments) {
@@ -113,7 +189,7 @@ func TestCreateListHandler_Creates_A_New_List_And_Sends_The_ListCreatedOrUpdated
 		param.ID = 1
 	}).Return(nil).Once()
 
-	mockedEventBus.On("Publish", events.ListCreated, int32(1))
+	mockedEventBus.On("Publish", events.ListCreated, domain.ListEvent{ListID: 1, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1"})
 
 	mockedEventBus.Wg.Add(1)
 	result := CreateListHandler(httptest.NewRecorder(), request, h)
@@ -128,3 +204,170 @@ func TestCreateListHandler_Creates_A_New_List_And_Sends_The_ListCreatedOrUpdated
 	mockedRepo.AssertExpectations(t)
 	mockedEventBus.AssertExpectations(t)
 }
+
+func TestCreateListHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Getting_The_Default_Category_Fails(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	listName, _ := domain.NewListNameValueObject("list1")
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.ListInput{Name: listName},
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the default category")
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestCreateListHandler_Creates_A_New_List_With_The_Default_Category_When_It_Does_Not_Have_One(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	listName, _ := domain.NewListNameValueObject("list1")
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.ListInput{Name: listName},
+		EventBus:             &mockedEventBus,
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	defaultCategoryID := int32(7)
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&defaultCategoryID, nil).Once()
+	listToCreate := domain.ListEntity{
+		Name:        listName,
+		UserID:      1,
+		WorkspaceID: 1,
+		CategoryID:  &defaultCategoryID,
+	}
+
+	mockedRepo.On("CreateList", request.Context(), listToCreate.ToListRecord()).Run(func(args mock.Arguments) {
+		param := args.Get(1).(*domain.ListRecord)
+		param.ID = 1
+	}).Return(nil).Once()
+
+	mockedEventBus.On("Publish", events.ListCreated, domain.ListEvent{ListID: 1, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1", CategoryID: &defaultCategoryID, PreviousCategoryID: &defaultCategoryID})
+
+	mockedEventBus.Wg.Add(1)
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.ListEntity)
+	require.True(t, isOk, "should be a ListEntity")
+	assert.Equal(t, int32(7), *res.CategoryID)
+
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestCreateListHandler_Creates_A_New_List_With_The_Tags_Of_The_List_And_Its_Items(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	listName, _ := domain.NewListNameValueObject("list1")
+	itemTitle, _ := domain.NewItemTitleValueObject("item1")
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		TagsRepository:       &mockedTagsRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput: &infrastructure.ListInput{
+			Name:  listName,
+			Items: []infrastructure.ListItemInput{{Title: itemTitle, Tags: []string{"urgent"}}},
+			Tags:  []string{"home"},
+		},
+		EventBus: &mockedEventBus,
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3, MaxItemsPerList: 3})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("CreateList", request.Context(), mock.AnythingOfType("*domain.ListRecord")).Run(func(args mock.Arguments) {
+		param := args.Get(1).(*domain.ListRecord)
+		param.ID = 1
+		param.Items[0].ID = 5
+	}).Return(nil).Once()
+	mockedTagsRepo.On("SetListTags", request.Context(), int32(1), int32(1), []string{"home"}).Return(nil).Once()
+	mockedTagsRepo.On("SetItemTags", request.Context(), int32(1), int32(5), []string{"urgent"}).Return(nil).Once()
+	mockedEventBus.On("Publish", events.ListCreated, mock.AnythingOfType("domain.ListEvent"))
+
+	mockedEventBus.Wg.Add(1)
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.ListEntity)
+	require.True(t, isOk, "should be a ListEntity")
+	assert.Equal(t, []string{"home"}, res.Tags)
+	assert.Equal(t, []string{"urgent"}, res.Items[0].Tags)
+
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestCreateListHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Saving_The_Tags_Fails(t *testing.T) {
+	request := createRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	listName, _ := domain.NewListNameValueObject("list1")
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		TagsRepository:       &mockedTagsRepo,
+		CfgSrv:               mockedCfgSrv,
+		RequestInput:         &infrastructure.ListInput{Name: listName, Tags: []string{"home"}},
+	}
+
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: listName.String(), WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("CreateList", request.Context(), mock.AnythingOfType("*domain.ListRecord")).Run(func(args mock.Arguments) {
+		param := args.Get(1).(*domain.ListRecord)
+		param.ID = 1
+	}).Return(nil).Once()
+	mockedTagsRepo.On("SetListTags", request.Context(), int32(1), int32(1), []string{"home"}).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error saving the list tags")
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
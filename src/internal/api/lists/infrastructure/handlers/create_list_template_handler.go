@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func CreateListTemplateHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.ListTemplateInput)
+
+	templateEntity := &domain.ListTemplateEntity{
+		UserID: userID,
+		Name:   input.Name,
+		Items:  input.Items,
+	}
+
+	srv := application.NewCreateListTemplateService(h.ListTemplatesRepository)
+	err := srv.CreateListTemplate(r.Context(), templateEntity)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: templateEntity, StatusCode: http.StatusCreated}
+}
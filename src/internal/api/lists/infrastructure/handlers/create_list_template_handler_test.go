@@ -0,0 +1,98 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func listTemplateInput() *infrastructure.ListTemplateInput {
+	nvo, _ := domain.NewListNameValueObject("packing")
+	tvo, _ := domain.NewItemTitleValueObject("Clothes for {{days}} days")
+
+	return &infrastructure.ListTemplateInput{Name: nvo, Items: []domain.ListTemplateItem{{Title: tvo}}}
+}
+
+func TestCreateListTemplateHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_If_The_Template_Exists_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: listTemplateInput()}
+
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "packing"}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := CreateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if a template with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_Template_With_The_Same_Name_Already_Exists(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: listTemplateInput()}
+
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "packing"}).Return(true, nil).Once()
+
+	result := CreateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A template with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateListTemplateHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Create_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: listTemplateInput()}
+
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "packing"}).Return(false, nil).Once()
+	mockedRepo.On("CreateListTemplate", request.Context(), mock.AnythingOfType("*domain.ListTemplateRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the template")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateListTemplateHandler_Creates_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: listTemplateInput()}
+
+	var createdRecord *domain.ListTemplateRecord
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "packing"}).Return(false, nil).Once()
+	mockedRepo.On("CreateListTemplate", request.Context(), mock.AnythingOfType("*domain.ListTemplateRecord")).Return(nil).Once().Run(func(args mock.Arguments) {
+		createdRecord = args.Get(1).(*domain.ListTemplateRecord)
+		createdRecord.ID = 5
+	})
+
+	result := CreateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	template, isOk := okRes.Content.(*domain.ListTemplateEntity)
+	require.True(t, isOk, "should be a pointer to ListTemplateEntity")
+	assert.Equal(t, int32(5), template.ID)
+	assert.Equal(t, []string{"days"}, template.Variables)
+	assert.False(t, template.CreatedAt.IsZero())
+	require.NotNil(t, createdRecord)
+	assert.Equal(t, int32(1), createdRecord.UserID)
+	assert.Equal(t, "packing", createdRecord.Name)
+	assert.Equal(t, `[{"title":"Clothes for {{days}} days","description":""}]`, createdRecord.Items)
+	mockedRepo.AssertExpectations(t)
+}
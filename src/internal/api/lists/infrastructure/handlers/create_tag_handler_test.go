@@ -0,0 +1,100 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createTagRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestCreateTagHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_If_The_Tag_Exists_Fails(t *testing.T) {
+	request := createTagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("home")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "home"}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := CreateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if a tag with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateTagHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Tag_Already_Exists(t *testing.T) {
+	request := createTagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("home")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "home"}).Return(true, nil).Once()
+
+	result := CreateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A tag with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateTagHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Tag_Fails(t *testing.T) {
+	request := createTagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("home")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "home"}).Return(false, nil).Once()
+	mockedRepo.On("CreateTag", request.Context(), &domain.TagRecord{UserID: 1, Name: "home"}).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the user tag")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateTagHandler_Creates_The_Tag(t *testing.T) {
+	request := createTagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("home")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "home"}).Return(false, nil).Once()
+	mockedRepo.On("CreateTag", request.Context(), &domain.TagRecord{UserID: 1, Name: "home"}).Run(func(args mock.Arguments) {
+		param := args.Get(1).(*domain.TagRecord)
+		param.ID = 3
+	}).Return(nil).Once()
+
+	result := CreateTagHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.TagEntity)
+	require.True(t, isOk, "should be a TagEntity")
+	assert.Equal(t, int32(3), res.ID)
+	assert.Equal(t, "home", res.Name.String())
+
+	mockedRepo.AssertExpectations(t)
+}
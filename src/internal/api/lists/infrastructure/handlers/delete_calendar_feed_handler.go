@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func DeleteCalendarFeedHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	feedID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewDeleteCalendarFeedService(h.CalendarFeedsRepository)
+	err := srv.DeleteCalendarFeed(r.Context(), feedID, userID, workspaceID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
@@ -0,0 +1,63 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+)
+
+func TestDeleteCalendarFeedHandler_Returns_An_Error_If_The_Query_To_Find_The_Feed_Fails(t *testing.T) {
+	request := mux.SetURLVars(calendarFeedsRequest(http.MethodDelete), map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo}
+
+	mockedRepo.On("FindCalendarFeed", request.Context(), domain.CalendarFeedRecord{ID: 11, UserID: 1, WorkspaceID: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DeleteCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := mux.SetURLVars(calendarFeedsRequest(http.MethodDelete), map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo}
+
+	query := domain.CalendarFeedRecord{ID: 11, UserID: 1, WorkspaceID: 2}
+	mockedRepo.On("FindCalendarFeed", request.Context(), query).Return(&domain.CalendarFeedRecord{ID: 11}, nil).Once()
+	mockedRepo.On("DeleteCalendarFeed", request.Context(), query).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the calendar feed")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteCalendarFeedHandler_Deletes_The_Feed(t *testing.T) {
+	request := mux.SetURLVars(calendarFeedsRequest(http.MethodDelete), map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo}
+
+	query := domain.CalendarFeedRecord{ID: 11, UserID: 1, WorkspaceID: 2}
+	mockedRepo.On("FindCalendarFeed", request.Context(), query).Return(&domain.CalendarFeedRecord{ID: 11}, nil).Once()
+	mockedRepo.On("DeleteCalendarFeed", request.Context(), query).Return(nil).Once()
+
+	result := DeleteCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
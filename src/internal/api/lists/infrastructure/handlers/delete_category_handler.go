@@ -2,18 +2,31 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 )
 
 func DeleteCategoryHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	categoryID := h.ParseInt32UrlVar(r, "id")
-	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 
-	srv := application.NewDeleteCategoryService(h.CategoriesRepository)
-	err := srv.DeleteCategory(r.Context(), categoryID, userID)
+	var targetCategoryID *int32
+	if value := r.URL.Query().Get("target"); len(value) > 0 {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Invalid target category"}}
+		}
+
+		id := int32(parsed)
+		targetCategoryID = &id
+	}
+
+	srv := application.NewDeleteCategoryService(h.CategoriesRepository, h.ListsRepository, h.EventBus)
+	err := srv.DeleteCategory(r.Context(), categoryID, workspaceID, r.URL.Query().Get("strategy"), targetCategoryID)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
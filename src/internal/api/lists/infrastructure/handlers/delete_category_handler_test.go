@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
@@ -19,23 +21,44 @@ import (
 )
 
 func deleteCategoryRequest() *http.Request {
-	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	return deleteCategoryRequestWithQuery("")
+}
+
+func deleteCategoryRequestWithQuery(query string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus"+query, nil)
 	request = mux.SetURLVars(request, map[string]string{
 		"id": "11",
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
 
+func categoryListsQuery() domain.ListRecord {
+	return domain.ListRecord{CategoryID: &sql.NullInt32{Int32: 11, Valid: true}, WorkspaceID: 1}
+}
+
+func subcategoriesQuery() domain.CategoryRecord {
+	return domain.CategoryRecord{ParentID: &sql.NullInt32{Int32: 11, Valid: true}, WorkspaceID: 1}
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Target_Is_Not_Valid(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=reassign&target=wadus")
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid target category")
+}
+
 func TestDeletesCategoryHandler_Returns_An_Error_If_The_Query_To_Find_The_Existing_Category_Fails(t *testing.T) {
 	request := deleteCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	h := handler.Handler{CategoriesRepository: &mockedRepo}
 
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -43,34 +66,275 @@ func TestDeletesCategoryHandler_Returns_An_Error_If_The_Query_To_Find_The_Existi
 	mockedRepo.AssertExpectations(t)
 }
 
-func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Category_Has_Subcategories(t *testing.T) {
 	request := deleteCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	h := handler.Handler{CategoriesRepository: &mockedRepo}
 
 	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(true, nil).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The category has subcategories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Checking_The_Lists_Fails(t *testing.T) {
+	request := deleteCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), categoryListsQuery()).Return(false, fmt.Errorf("some error")).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the category has lists")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Category_Has_Lists_And_There_Is_Not_A_Strategy(t *testing.T) {
+	request := deleteCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), categoryListsQuery()).Return(true, nil).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The category has lists so a strategy is required")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := deleteCategoryRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), categoryListsQuery()).Return(false, nil).Once()
 	mockedRepo.On("DeleteCategory", request.Context(), existingCategory).Return(fmt.Errorf("some error")).Once()
 
 	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
 
 	results.CheckUnexpectedErrorResult(t, result, "Error deleting the user category")
 	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
 }
 
 func TestDeletesCategoryHandler_Deletes_The_Category(t *testing.T) {
 	request := deleteCategoryRequest()
 
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
-	h := handler.Handler{CategoriesRepository: &mockedRepo}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
 
 	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), categoryListsQuery()).Return(false, nil).Once()
 	mockedRepo.On("DeleteCategory", request.Context(), existingCategory).Return(nil).Once()
 
 	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
 
 	results.CheckOkResult(t, result, http.StatusNoContent)
 	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Strategy_Is_Not_Valid(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=wadus")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, `The strategy must be "reassign", "uncategorize" or "cascade"`)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Checks_The_Target_Category(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		msg   string
+	}{
+		{"target without reassign", "?strategy=cascade&target=12", "The target category can only be set with the reassign strategy"},
+		{"reassign without target", "?strategy=reassign", "The target category is required"},
+		{"target is the deleted category", "?strategy=reassign&target=11", "The lists can't be reassigned to the category being deleted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := deleteCategoryRequestWithQuery(tt.query)
+
+			mockedRepo := listsRepository.MockedCategoriesRepository{}
+			mockedListsRepo := listsRepository.MockedListsRepository{}
+			h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+			existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+			mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+			mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+
+			result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+			results.CheckBadRequestErrorResult(t, result, tt.msg)
+			mockedRepo.AssertExpectations(t)
+			mockedListsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Target_Category_Does_Not_Exist(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=reassign&target=12")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 12, WorkspaceID: 1}).Return(false, nil).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The target category doesn't exist")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Reassigning_The_Lists_Fails(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=uncategorize")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	mockedRepo.On("ReassignAndDeleteCategory", request.Context(), int32(11), (*int32)(nil)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the user category")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Reassigns_The_Lists_And_Deletes_The_Category(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=reassign&target=12")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, EventBus: &mockedEventBus}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	movedLists := domain.ListRecords{
+		{ID: 5, Name: "list1", UserID: 1, WorkspaceID: 1, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+		{ID: 6, Name: "list2", UserID: 2, WorkspaceID: 1, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+	}
+	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 12, WorkspaceID: 1}).Return(true, nil).Once()
+	targetCategoryID := int32(12)
+	mockedRepo.On("ReassignAndDeleteCategory", request.Context(), int32(11), &targetCategoryID).Return(movedLists, nil).Once()
+
+	previousCategoryID := int32(11)
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 5, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1", CategoryID: &targetCategoryID, PreviousCategoryID: &previousCategoryID}).Once()
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 6, UserID: 2, WorkspaceID: 1, Name: "list2", PreviousName: "list2", CategoryID: &targetCategoryID, PreviousCategoryID: &previousCategoryID}).Once()
+
+	mockedEventBus.Wg.Add(2)
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Uncategorizes_The_Lists_And_Deletes_The_Category(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=uncategorize")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, EventBus: &mockedEventBus}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	movedLists := domain.ListRecords{{ID: 5, Name: "list1", UserID: 1, WorkspaceID: 1, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}}}
+	mockedRepo.On("ReassignAndDeleteCategory", request.Context(), int32(11), (*int32)(nil)).Return(movedLists, nil).Once()
+
+	previousCategoryID := int32(11)
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 5, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1", PreviousCategoryID: &previousCategoryID}).Once()
+
+	mockedEventBus.Wg.Add(1)
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestDeletesCategoryHandler_Trashes_The_Lists_And_Deletes_The_Category(t *testing.T) {
+	request := deleteCategoryRequestWithQuery("?strategy=cascade")
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, EventBus: &mockedEventBus}
+
+	existingCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&existingCategory, nil).Once()
+	mockedRepo.On("ExistsCategory", request.Context(), subcategoriesQuery()).Return(false, nil).Once()
+	trashedLists := domain.ListRecords{
+		{ID: 5, Name: "list1", UserID: 1, WorkspaceID: 1, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+		{ID: 6, Name: "list2", UserID: 2, WorkspaceID: 1, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+	}
+	mockedRepo.On("TrashListsAndDeleteCategory", request.Context(), int32(11)).Return(trashedLists, nil).Once()
+
+	categoryID := int32(11)
+	mockedEventBus.On("Publish", events.ListDeleted, domain.ListEvent{ListID: 5, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1", CategoryID: &categoryID, PreviousCategoryID: &categoryID}).Once()
+	mockedEventBus.On("Publish", events.ListDeleted, domain.ListEvent{ListID: 6, UserID: 2, WorkspaceID: 1, Name: "list2", PreviousName: "list2", CategoryID: &categoryID, PreviousCategoryID: &categoryID}).Once()
+
+	mockedEventBus.Wg.Add(2)
+	result := DeleteCategoryHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
 }
@@ -10,10 +10,10 @@ import (
 
 func DeleteListHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	listID := h.ParseInt32UrlVar(r, "id")
-	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 
 	srv := application.NewDeleteListService(h.ListsRepository, h.EventBus)
-	err := srv.DeleteList(r.Context(), listID, userID)
+	err := srv.DeleteList(r.Context(), listID, workspaceID)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
@@ -26,6 +26,7 @@ func deleteRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -36,7 +37,7 @@ func TestDeletesListHandler_Returns_An_Error_If_The_Query_To_Find_The_Existing_L
 	mockedRepo := listsRepository.MockedListsRepository{}
 	h := handler.Handler{ListsRepository: &mockedRepo}
 
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := DeleteListHandler(httptest.NewRecorder(), request, h)
 
@@ -51,7 +52,7 @@ func TestDeletesListHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Th
 	h := handler.Handler{ListsRepository: &mockedRepo}
 
 	existingList := domain.ListRecord{ID: 11, Name: "list1"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&existingList, nil).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&existingList, nil).Once()
 	mockedRepo.On("DeleteList", request.Context(), existingList).Return(fmt.Errorf("some error")).Once()
 
 	result := DeleteListHandler(httptest.NewRecorder(), request, h)
@@ -70,11 +71,11 @@ func TestDeletesListHandler_Deletes_The_List(t *testing.T) {
 		EventBus:        &mockedEventBus,
 	}
 
-	existingList := domain.ListRecord{ID: 11, Name: "list1", UserID: 1}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&existingList, nil).Once()
+	existingList := domain.ListRecord{ID: 11, Name: "list1", UserID: 1, WorkspaceID: 1}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&existingList, nil).Once()
 	mockedRepo.On("DeleteList", request.Context(), existingList).Return(nil).Once()
 
-	mockedEventBus.On("Publish", events.ListDeleted, int32(11))
+	mockedEventBus.On("Publish", events.ListDeleted, domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1"})
 
 	mockedEventBus.Wg.Add(1)
 	result := DeleteListHandler(httptest.NewRecorder(), request, h)
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func DeleteListTemplateHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	templateID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewDeleteListTemplateService(h.ListTemplatesRepository)
+	err := srv.DeleteListTemplate(r.Context(), templateID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
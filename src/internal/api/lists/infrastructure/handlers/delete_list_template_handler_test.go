@@ -0,0 +1,60 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestDeleteListTemplateHandler_Returns_An_Error_If_The_Template_Is_Not_Owned_By_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DeleteListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteListTemplateHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedRepo.On("DeleteListTemplate", request.Context(), int32(5)).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the template")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteListTemplateHandler_Deletes_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedRepo.On("DeleteListTemplate", request.Context(), int32(5)).Return(nil).Once()
+
+	result := DeleteListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
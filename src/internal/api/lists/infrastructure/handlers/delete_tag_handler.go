@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func DeleteTagHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	tagID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewDeleteTagService(h.TagsRepository, h.EventBus)
+	err := srv.DeleteTag(r.Context(), tagID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
@@ -0,0 +1,68 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestDeleteTagHandler_Returns_An_Error_If_The_Query_To_Find_The_Tag_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := DeleteTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteTagHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Deleting_The_Tag_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("GetTagListIDs", request.Context(), int32(3)).Return([]int32{}, nil).Once()
+	mockedRepo.On("DeleteTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(fmt.Errorf("some error")).Once()
+
+	result := DeleteTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error deleting the user tag")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestDeleteTagHandler_Deletes_The_Tag_And_Sends_The_ListTagsChanged_Events(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{TagsRepository: &mockedRepo, EventBus: &mockedEventBus}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("GetTagListIDs", request.Context(), int32(3)).Return([]int32{11}, nil).Once()
+	mockedRepo.On("DeleteTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(nil).Once()
+	mockedEventBus.On("Publish", events.ListTagsChanged, domain.ListEvent{ListID: 11})
+
+	mockedEventBus.Wg.Add(1)
+	result := DeleteTagHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+)
+
+// ExportListHandler streams a list in the same formats as ExportListsHandler
+func ExportListHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+
+	return exportLists(r, h, &listID, fmt.Sprintf("list-%v", listID))
+}
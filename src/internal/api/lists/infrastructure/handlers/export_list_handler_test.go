@@ -0,0 +1,54 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportListHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := mux.SetURLVars(exportListsRequest("/lists/11/export", ""), map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ExportListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestExportListHandler_Streams_The_List(t *testing.T) {
+	request := mux.SetURLVars(exportListsRequest("/lists/11/export?format=ics", ""), map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo}
+
+	foundList := domain.ListRecord{ID: 11, Name: "list1", Items: []domain.ListItemRecord{{ID: 21, Title: "item1"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+
+	result := ExportListHandler(httptest.NewRecorder(), request, h)
+
+	content := results.CheckStreamResult(t, result, "text/calendar; charset=utf-8")
+	assert.Equal(t, "list-11.ics", result.(results.StreamResult).FileName)
+	assert.True(t, strings.HasPrefix(content, "BEGIN:VCALENDAR\r\n"))
+	assert.Contains(t, content, "UID:list-item-21@todos\r\nDTSTAMP:")
+	assert.Contains(t, content, "SUMMARY:item1\r\nCATEGORIES:list1\r\nEND:VTODO\r\nEND:VCALENDAR\r\n")
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+// ExportListsHandler streams all the lists of the workspace. The format is the one of the
+// format query param or, when it isn't sent, the one of the Accept header
+func ExportListsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	return exportLists(r, h, nil, "lists")
+}
+
+func exportLists(r *http.Request, h handler.Handler, listID *int32, fileName string) handler.HandlerResult {
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	format := domain.NegotiateExportFormat(r.Header.Get("Accept"))
+	if value := r.URL.Query().Get("format"); len(value) > 0 {
+		var err error
+		if format, err = domain.NewExportFormat(value); err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: err.Error()}}
+		}
+	}
+
+	srv := application.NewExportListsService(h.ListsRepository, h.CategoriesRepository)
+	write, err := srv.ExportLists(r.Context(), workspaceID, listID, format)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.StreamResult{
+		ContentType: domain.ExportFormatContentType(format),
+		FileName:    domain.ExportFileName(fileName, format),
+		Write:       write,
+	}
+}
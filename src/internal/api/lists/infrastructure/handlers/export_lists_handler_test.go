@@ -0,0 +1,122 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportListsRequest(url string, accept string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, url, nil)
+	if len(accept) > 0 {
+		request.Header.Set("Accept", accept)
+	}
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestExportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Format_Is_Not_Valid(t *testing.T) {
+	request := exportListsRequest("/lists/export?format=wadus", "")
+
+	result := ExportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, `The format must be "json", "csv", "markdown" or "ics"`)
+}
+
+func TestExportListsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Categories_Fails(t *testing.T) {
+	request := exportListsRequest("/lists/export", "")
+
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedCategoriesRepo}
+
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ExportListsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the categories")
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestExportListsHandler_Streams_The_Lists_In_The_Format_Of_The_Query(t *testing.T) {
+	request := exportListsRequest("/lists/export?format=csv", "application/json")
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo}
+
+	batches := []domain.ListRecords{
+		{
+			{ID: 11, Name: "list1", CategoryID: &sql.NullInt32{Int32: 2, Valid: true}, Items: []domain.ListItemRecord{{ID: 21, Title: "item1", Position: 0}, {ID: 22, Title: "item2", Description: "desc2", Position: 1}}},
+		},
+		{
+			{ID: 12, Name: "list2"},
+		},
+	}
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 2, Name: "category1"}}, nil).Once()
+	mockedRepo.On("ForEachListsBatch", request.Context(), domain.ListRecord{WorkspaceID: 1}, 100).Return(batches, nil).Once()
+
+	result := ExportListsHandler(httptest.NewRecorder(), request, h)
+
+	content := results.CheckStreamResult(t, result, "text/csv; charset=utf-8")
+	assert.Equal(t, "lists.csv", result.(results.StreamResult).FileName)
+	assert.Equal(t, "list,category,position,title,description,dueDate\nlist1,category1,0,item1,,\nlist1,category1,1,item2,desc2,\nlist2,,,,,\n", content)
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestExportListsHandler_Streams_The_Lists_In_The_Format_Of_The_Accept_Header(t *testing.T) {
+	request := exportListsRequest("/lists/export", "text/markdown")
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo}
+
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockedRepo.On("ForEachListsBatch", request.Context(), domain.ListRecord{WorkspaceID: 1}, 100).Return([]domain.ListRecords{{{ID: 11, Name: "list1", Items: []domain.ListItemRecord{{ID: 21, Title: "item1"}}}}}, nil).Once()
+
+	result := ExportListsHandler(httptest.NewRecorder(), request, h)
+
+	content := results.CheckStreamResult(t, result, "text/markdown; charset=utf-8")
+	assert.Equal(t, "# list1\n\n- [ ] item1\n", content)
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestExportListsHandler_Returns_The_Error_Of_The_Stream_If_Getting_The_Lists_Fails(t *testing.T) {
+	request := exportListsRequest("/lists/export", "")
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo}
+
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockedRepo.On("ForEachListsBatch", request.Context(), domain.ListRecord{WorkspaceID: 1}, 100).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ExportListsHandler(httptest.NewRecorder(), request, h)
+
+	streamRes, isStreamResult := result.(results.StreamResult)
+	require.Equal(t, true, isStreamResult, "should be a stream result")
+	assert.Equal(t, "application/json", streamRes.ContentType)
+
+	err := streamRes.Write(httptest.NewRecorder().Body)
+
+	assert.EqualError(t, err, "some error")
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+type ActivityPageResponse struct {
+	Items      []*domain.ActivityEntity `json:"items"`
+	NextCursor string                   `json:"nextCursor,omitempty"`
+}
+
+func GetActivityHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	return getActivity(r, h, 0)
+}
+
+func getActivity(r *http.Request, h handler.Handler, listID int32) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	switch {
+	case limit > 100:
+		limit = 100
+	case limit <= 0:
+		limit = 20
+	}
+
+	srv := application.NewGetActivityService(h.ActivityRepository, h.ListsRepository)
+	found, nextCursor, err := srv.GetActivity(r.Context(), userID, workspaceID, listID, r.URL.Query().Get("cursor"), limit)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: ActivityPageResponse{Items: found, NextCursor: nextCursor}, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,100 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getActivityRequest(url string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, url, nil)
+	ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetActivityHandler_Returns_An_Error_If_The_Cursor_Is_Not_Valid(t *testing.T) {
+	request := getActivityRequest("/activity?cursor=wadus")
+
+	result := GetActivityHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid cursor")
+}
+
+func TestGetActivityHandler_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	request := getActivityRequest("/activity")
+
+	mockedActivityRepo := listsRepository.MockedActivityRepository{}
+	h := handler.Handler{ActivityRepository: &mockedActivityRepo}
+
+	mockedActivityRepo.On("GetActivity", request.Context(), domain.ActivityQuery{UserID: 1, Limit: 21}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetActivityHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the activity")
+	mockedActivityRepo.AssertExpectations(t)
+}
+
+func TestGetActivityHandler_Returns_The_First_Page_And_The_Cursor_Of_The_Next_One(t *testing.T) {
+	request := getActivityRequest("/activity?limit=2")
+
+	mockedActivityRepo := listsRepository.MockedActivityRepository{}
+	h := handler.Handler{ActivityRepository: &mockedActivityRepo}
+
+	now := time.Now()
+	found := domain.ActivityRecords{
+		{ID: 9, UserID: 1, ListID: 11, Action: domain.ActivityItemAdded, Summary: `Added "item" to "list1"`, CreatedAt: now},
+		{ID: 7, UserID: 1, ListID: 11, Action: domain.ActivityListCreated, Summary: `Created the list "list1"`, CreatedAt: now},
+		{ID: 3, UserID: 1, ListID: 10, Action: domain.ActivityListCreated, Summary: `Created the list "list0"`, CreatedAt: now},
+	}
+	mockedActivityRepo.On("GetActivity", request.Context(), domain.ActivityQuery{UserID: 1, Limit: 3}).Return(found, nil).Once()
+
+	result := GetActivityHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(ActivityPageResponse)
+	require.True(t, isOk, "should be an ActivityPageResponse")
+
+	require.Len(t, res.Items, 2)
+	assert.Equal(t, int32(9), res.Items[0].ID)
+	assert.Equal(t, `Added "item" to "list1"`, res.Items[0].Summary)
+	assert.Equal(t, int32(7), res.Items[1].ID)
+	assert.Equal(t, domain.EncodeActivityCursor(7), res.NextCursor)
+	mockedActivityRepo.AssertExpectations(t)
+}
+
+func TestGetActivityHandler_Returns_The_Last_Page_Without_Cursor(t *testing.T) {
+	request := getActivityRequest("/activity?limit=500&cursor=" + domain.EncodeActivityCursor(7))
+
+	mockedActivityRepo := listsRepository.MockedActivityRepository{}
+	h := handler.Handler{ActivityRepository: &mockedActivityRepo}
+
+	found := domain.ActivityRecords{{ID: 3, UserID: 1, ListID: 10, Action: domain.ActivityListCreated, Summary: `Created the list "list0"`}}
+	mockedActivityRepo.On("GetActivity", request.Context(), domain.ActivityQuery{UserID: 1, BeforeID: 7, Limit: 101}).Return(found, nil).Once()
+
+	result := GetActivityHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(ActivityPageResponse)
+	require.True(t, isOk, "should be an ActivityPageResponse")
+
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, int32(3), res.Items[0].ID)
+	assert.Empty(t, res.NextCursor)
+	mockedActivityRepo.AssertExpectations(t)
+}
@@ -9,10 +9,23 @@ import (
 )
 
 func GetAllCategoriesHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	userID := h.GetUserIDFromContext(r)
 
+	includeStats := r.URL.Query().Get("include") == "stats"
+
 	srv := application.NewGetAllCategoriesService(h.CategoriesRepository)
-	foundCategories, err := srv.GetAllCategories(r.Context(), userID)
+
+	if r.URL.Query().Get("tree") == "true" {
+		tree, err := srv.GetCategoriesTree(r.Context(), workspaceID, userID, includeStats)
+		if err != nil {
+			return results.ErrorResult{Err: err}
+		}
+
+		return results.OkResult{Content: tree, StatusCode: http.StatusOK}
+	}
+
+	foundCategories, err := srv.GetAllCategories(r.Context(), workspaceID, userID, includeStats)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
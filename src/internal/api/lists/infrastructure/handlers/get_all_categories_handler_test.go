@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -23,6 +24,7 @@ func getAllCategoriesRequest() *http.Request {
 	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -33,7 +35,7 @@ func TestGetAllCategoriesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	h := handler.Handler{CategoriesRepository: &mockedRepo}
 
-	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
 
@@ -52,7 +54,9 @@ func TestGetAllCategoriesHandler_Returns_The_Categories(t *testing.T) {
 		{ID: 12, Name: "category2"},
 	}
 
-	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(found, nil)
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(found, nil)
+	defaultCategoryID := int32(12)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&defaultCategoryID, nil).Once()
 
 	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
 
@@ -65,6 +69,101 @@ func TestGetAllCategoriesHandler_Returns_The_Categories(t *testing.T) {
 	assert.Equal(t, "category1", categoriesRes[0].Name.String())
 	assert.Equal(t, int32(12), categoriesRes[1].ID)
 	assert.Equal(t, "category2", categoriesRes[1].Name.String())
+	assert.False(t, categoriesRes[0].IsDefault)
+	assert.True(t, categoriesRes[1].IsDefault)
 
 	mockedRepo.AssertExpectations(t)
 }
+
+func TestGetAllCategoriesHandler_Returns_The_Categories_Tree(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tree=true", nil)
+	request = request.WithContext(getAllCategoriesRequest().Context())
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	found := domain.CategoryRecords{
+		{ID: 11, Name: "category1"},
+		{ID: 12, Name: "category2", ParentID: &sql.NullInt32{Int32: 11, Valid: true}},
+		{ID: 13, Name: "category3"},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(found, nil)
+	defaultCategoryID := int32(12)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&defaultCategoryID, nil).Once()
+
+	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	treeRes, isOk := okRes.Content.([]*domain.CategoryTreeEntity)
+	require.Equal(t, true, isOk, "should be an array of CategoryTreeEntity")
+
+	require.Equal(t, 2, len(treeRes))
+	assert.Equal(t, int32(11), treeRes[0].ID)
+	require.Equal(t, 1, len(treeRes[0].Children))
+	assert.Equal(t, int32(12), treeRes[0].Children[0].ID)
+	assert.True(t, treeRes[0].Children[0].IsDefault)
+	assert.Equal(t, int32(13), treeRes[1].ID)
+	assert.Empty(t, treeRes[1].Children)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllCategoriesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Default_Category_Fails(t *testing.T) {
+	request := getAllCategoriesRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{}, nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the default category")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllCategoriesHandler_Returns_The_Categories_With_Their_Stats(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?include=stats", nil)
+	request = request.WithContext(getAllCategoriesRequest().Context())
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	query := domain.CategoryStatsQuery{WorkspaceID: 1}
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}, {ID: 12, Name: "category2"}}, nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), query).Return(domain.CategoryStatsRecords{{CategoryID: 12, ListsCount: 1, ItemsCount: 2}}, nil).Once()
+	mockedRepo.On("GetLargestLists", request.Context(), query, domain.CategoryStatsLargestLists).Return(domain.ListRecords{}, nil).Once()
+
+	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	categoriesRes, isOk := okRes.Content.([]*domain.CategoryEntity)
+	require.True(t, isOk, "should be an array of CategoryEntity")
+
+	require.Len(t, categoriesRes, 2)
+	assert.Equal(t, int64(0), categoriesRes[0].Stats.ListsCount)
+	assert.Equal(t, int64(1), categoriesRes[1].Stats.ListsCount)
+	assert.Equal(t, int64(2), categoriesRes[1].Stats.ItemsCount)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllCategoriesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Stats_Fails(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tree=true&include=stats", nil)
+	request = request.WithContext(getAllCategoriesRequest().Context())
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}}, nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), domain.CategoryStatsQuery{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAllCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the categories stats")
+	mockedRepo.AssertExpectations(t)
+}
@@ -2,17 +2,44 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 )
 
 func GetAllListsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
-	userID := h.GetUserIDFromContext(r)
+	filter := application.ListsFilter{
+		WorkspaceID: h.GetWorkspaceIDFromContext(r),
+		UserID:      h.GetUserIDFromContext(r),
+	}
+
+	if value := r.URL.Query().Get("categoryId"); len(value) > 0 {
+		parsed, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Invalid category"}}
+		}
+
+		id := int32(parsed)
+		filter.CategoryID = &id
+	}
+
+	filter.IncludeDescendants = r.URL.Query().Get("includeDescendants") == "true"
+
+	if value := r.URL.Query().Get("tag"); len(value) > 0 {
+		tag, err := domain.NewTagNameValueObject(value)
+		if err != nil {
+			return results.ErrorResult{Err: err}
+		}
+
+		filter.Tag = tag.String()
+	}
 
-	srv := application.NewGetAllListsService(h.ListsRepository)
-	foundLists, err := srv.GetAllLists(r.Context(), userID)
+	srv := application.NewGetAllListsService(h.ListsRepository, h.CategoriesRepository, h.TagsRepository)
+	foundLists, err := srv.GetAllLists(r.Context(), filter)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
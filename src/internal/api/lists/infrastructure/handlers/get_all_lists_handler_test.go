@@ -5,6 +5,7 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -23,6 +24,7 @@ func getAllRequest() *http.Request {
 	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -33,7 +35,7 @@ func TestGetAllListsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Th
 	mockedRepo := listsRepository.MockedListsRepository{}
 	h := handler.Handler{ListsRepository: &mockedRepo}
 
-	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
 
@@ -45,14 +47,17 @@ func TestGetAllListsHandler_Returns_The_Lists(t *testing.T) {
 	request := getAllRequest()
 
 	mockedRepo := listsRepository.MockedListsRepository{}
-	h := handler.Handler{ListsRepository: &mockedRepo}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
 
 	found := domain.ListRecords{
 		{ID: 11, Name: "list1", ItemsCount: 4},
 		{ID: 12, Name: "list2", ItemsCount: 8},
 	}
 
-	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{UserID: 1}).Return(found, nil)
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 1}).Return(found, nil)
+	foundTags := domain.TaggedRecords{{ID: 11, Name: "home"}, {ID: 11, Name: "urgent"}}
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11, 12}).Return(foundTags, nil).Once()
 
 	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
 
@@ -64,9 +69,151 @@ func TestGetAllListsHandler_Returns_The_Lists(t *testing.T) {
 	assert.Equal(t, int32(11), listRes[0].ID)
 	assert.Equal(t, "list1", listRes[0].Name.String())
 	assert.Equal(t, int32(4), listRes[0].ItemsCount)
+	assert.Equal(t, []string{"home", "urgent"}, listRes[0].Tags)
 	assert.Equal(t, int32(12), listRes[1].ID)
 	assert.Equal(t, "list2", listRes[1].Name.String())
 	assert.Equal(t, int32(8), listRes[1].ItemsCount)
+	assert.Nil(t, listRes[1].Tags)
+
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
+
+func TestGetAllListsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Tags_Fails(t *testing.T) {
+	request := getAllRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	found := domain.ListRecords{{ID: 11, Name: "list1"}}
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 1}).Return(found, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the lists tags")
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
+
+func TestGetAllListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Category_Is_Not_Valid(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?categoryId=wadus", nil)
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid category")
+}
+
+func TestGetAllListsHandler_Returns_The_Lists_Of_A_Category(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?categoryId=5", nil)
+	request = request.WithContext(getAllRequest().Context())
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	found := domain.ListRecords{{ID: 11, Name: "list1"}}
+	mockedRepo.On("GetListsByCategories", request.Context(), int32(1), []int32{5}).Return(found, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(domain.TaggedRecords{}, nil).Once()
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	listRes, isOk := okRes.Content.([]*domain.ListEntity)
+	require.Equal(t, true, isOk, "should be an array of ListEntity")
+	require.Equal(t, 1, len(listRes))
+	assert.Equal(t, int32(11), listRes[0].ID)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllListsHandler_Returns_The_Lists_Of_A_Category_And_Its_Subcategories(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?categoryId=5&includeDescendants=true", nil)
+	request = request.WithContext(getAllRequest().Context())
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo, TagsRepository: &mockedTagsRepo}
+
+	mockedCategoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 5, Name: "parent"},
+		{ID: 6, Name: "child", ParentID: &sql.NullInt32{Int32: 5, Valid: true}},
+		{ID: 7, Name: "other"},
+	}, nil).Once()
+	found := domain.ListRecords{{ID: 11, Name: "list1"}, {ID: 12, Name: "list2"}}
+	mockedRepo.On("GetListsByCategories", request.Context(), int32(1), []int32{5, 6}).Return(found, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11, 12}).Return(domain.TaggedRecords{}, nil).Once()
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	listRes, isOk := okRes.Content.([]*domain.ListEntity)
+	require.Equal(t, true, isOk, "should be an array of ListEntity")
+	require.Equal(t, 2, len(listRes))
+
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestGetAllListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Tag_Is_Not_Valid(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tag=abcdefghijklmnopqrstuvwxyz12345", nil)
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "The tag name can not have more than 30 characters")
+}
+
+func TestGetAllListsHandler_Returns_The_Lists_With_A_Tag(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tag=Home", nil)
+	request = request.WithContext(getAllRequest().Context())
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	found := domain.ListRecords{{ID: 11, Name: "list1"}}
+	mockedRepo.On("GetListsByTag", request.Context(), int32(1), int32(1), "home").Return(found, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(domain.TaggedRecords{{ID: 11, Name: "home"}}, nil).Once()
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	listRes, isOk := okRes.Content.([]*domain.ListEntity)
+	require.Equal(t, true, isOk, "should be an array of ListEntity")
+	require.Equal(t, 1, len(listRes))
+	assert.Equal(t, int32(11), listRes[0].ID)
+	assert.Equal(t, []string{"home"}, listRes[0].Tags)
+
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
+
+func TestGetAllListsHandler_Returns_The_Lists_Of_A_Category_With_A_Tag(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tag=home&categoryId=5", nil)
+	request = request.WithContext(getAllRequest().Context())
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	found := domain.ListRecords{
+		{ID: 11, Name: "list1", CategoryID: &sql.NullInt32{Int32: 5, Valid: true}},
+		{ID: 12, Name: "list2", CategoryID: &sql.NullInt32{Int32: 6, Valid: true}},
+		{ID: 13, Name: "list3"},
+	}
+	mockedRepo.On("GetListsByTag", request.Context(), int32(1), int32(1), "home").Return(found, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(domain.TaggedRecords{{ID: 11, Name: "home"}}, nil).Once()
+
+	result := GetAllListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	listRes, isOk := okRes.Content.([]*domain.ListEntity)
+	require.Equal(t, true, isOk, "should be an array of ListEntity")
+	require.Equal(t, 1, len(listRes))
+	assert.Equal(t, int32(11), listRes[0].ID)
 
 	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
 }
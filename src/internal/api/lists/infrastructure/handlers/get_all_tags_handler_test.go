@@ -0,0 +1,72 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getAllTagsRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetAllTagsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request := getAllTagsRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo}
+
+	mockedRepo.On("GetTags", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetAllTagsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user tags")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetAllTagsHandler_Returns_The_Tags_With_Their_Usage(t *testing.T) {
+	request := getAllTagsRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo}
+
+	found := domain.TagRecords{
+		{ID: 1, UserID: 1, Name: "home", ListsCount: 2, ItemsCount: 5},
+		{ID: 2, UserID: 1, Name: "urgent"},
+	}
+	mockedRepo.On("GetTags", request.Context(), int32(1)).Return(found, nil).Once()
+
+	result := GetAllTagsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	tagsRes, isOk := okRes.Content.([]*domain.TagEntity)
+	require.Equal(t, true, isOk, "should be an array of TagEntity")
+
+	require.Equal(t, 2, len(tagsRes))
+	assert.Equal(t, int32(1), tagsRes[0].ID)
+	assert.Equal(t, "home", tagsRes[0].Name.String())
+	assert.Equal(t, int64(2), tagsRes[0].ListsCount)
+	assert.Equal(t, int64(5), tagsRes[0].ItemsCount)
+	assert.Equal(t, int32(2), tagsRes[1].ID)
+	assert.Equal(t, "urgent", tagsRes[1].Name.String())
+	assert.Equal(t, int64(0), tagsRes[1].ListsCount)
+
+	mockedRepo.AssertExpectations(t)
+}
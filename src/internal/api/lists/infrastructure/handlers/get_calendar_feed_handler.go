@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+)
+
+// GetCalendarFeedHandler serves the feed of the token of the url, so it doesn't need any
+// other authentication. The items are VTODO components unless the type query param is event.
+// It returns a 304 when the ETag or the Last-Modified date sent by the client are still valid
+func GetCalendarFeedHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	token := mux.Vars(r)["token"]
+
+	feedType := domain.CalendarFeedTypeTodo
+	if value := r.URL.Query().Get("type"); len(value) > 0 {
+		var err error
+		if feedType, err = domain.NewCalendarFeedType(value); err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: err.Error()}}
+		}
+	}
+
+	srv := application.NewGetCalendarFeedService(h.CalendarFeedsRepository, h.ListsRepository, h.CategoriesRepository, h.WorkspacesRepository)
+	content, err := srv.GetCalendarFeed(r.Context(), token)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	etag := domain.CalendarFeedETag(content.ContentHash, feedType)
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Last-Modified", content.LastModified.UTC().Format(http.TimeFormat))
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	if isCalendarFeedNotModified(r, etag, content.LastModified) {
+		return results.OkResult{Content: nil, StatusCode: http.StatusNotModified}
+	}
+
+	return results.StreamResult{
+		ContentType: domain.ExportFormatContentType(domain.ExportFormatICalendar),
+		Write: func(w io.Writer) error {
+			writer := domain.NewCalendarFeedWriter(w, feedType, content.LastModified)
+
+			for _, v := range content.Lists {
+				if err := writer.WriteList(v); err != nil {
+					return err
+				}
+			}
+
+			return writer.Close()
+		},
+	}
+}
+
+// isCalendarFeedNotModified checks the If-None-Match header or, when it isn't sent, the
+// If-Modified-Since one
+func isCalendarFeedNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := r.Header.Get("If-None-Match"); len(ifNoneMatch) > 0 {
+		for _, v := range strings.Split(ifNoneMatch, ",") {
+			v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+			if v == etag || v == "*" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	return !lastModified.After(ifModifiedSince)
+}
@@ -0,0 +1,284 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const calendarFeedToken = "0a1b2c"
+
+func calendarFeedRequest(url string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, url, nil)
+
+	return mux.SetURLVars(request, map[string]string{"token": calendarFeedToken})
+}
+
+type calendarFeedMocks struct {
+	feedsRepo      *listsRepository.MockedCalendarFeedsRepository
+	listsRepo      *listsRepository.MockedListsRepository
+	categoriesRepo *listsRepository.MockedCategoriesRepository
+	workspacesRepo *workspacesRepository.MockedWorkspacesRepository
+}
+
+func newCalendarFeedMocks() (calendarFeedMocks, handler.Handler) {
+	m := calendarFeedMocks{
+		feedsRepo:      &listsRepository.MockedCalendarFeedsRepository{},
+		listsRepo:      &listsRepository.MockedListsRepository{},
+		categoriesRepo: &listsRepository.MockedCategoriesRepository{},
+		workspacesRepo: &workspacesRepository.MockedWorkspacesRepository{},
+	}
+
+	h := handler.Handler{CalendarFeedsRepository: m.feedsRepo, ListsRepository: m.listsRepo, CategoriesRepository: m.categoriesRepo, WorkspacesRepository: m.workspacesRepo}
+
+	return m, h
+}
+
+func (m calendarFeedMocks) assertExpectations(t *testing.T) {
+	m.feedsRepo.AssertExpectations(t)
+	m.listsRepo.AssertExpectations(t)
+	m.categoriesRepo.AssertExpectations(t)
+	m.workspacesRepo.AssertExpectations(t)
+}
+
+func calendarFeedQuery() domain.CalendarFeedRecord {
+	return domain.CalendarFeedRecord{TokenHash: domain.HashCalendarFeedToken(calendarFeedToken)}
+}
+
+func calendarFeedMember() workspacesDomain.WorkspaceMemberRecord {
+	return workspacesDomain.WorkspaceMemberRecord{WorkspaceID: 2, UserID: 1}
+}
+
+// calendarFeedLists returns the lists of the workspace, and the items with due date of the first one
+func calendarFeedLists() (domain.CategoryRecords, domain.ListRecords, domain.ListItemRecords) {
+	dueDate := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+	categories := domain.CategoryRecords{{ID: 3, Name: "category1"}}
+	lists := domain.ListRecords{
+		{ID: 11, Name: "list1", CategoryID: &sql.NullInt32{Int32: 3, Valid: true}},
+		{ID: 12, Name: "list2"},
+	}
+	items := domain.ListItemRecords{{ID: 21, ListID: 11, Title: "item1", DueDate: &dueDate}}
+
+	return categories, lists, items
+}
+
+func calendarFeedContentHash(t *testing.T) string {
+	categories, lists, items := calendarFeedLists()
+	lists[0].Items = items
+
+	hash, err := domain.CalendarFeedContentHash([]*domain.ExportedList{domain.NewExportedList(&lists[0], categories.NamesByID())})
+	assert.NoError(t, err)
+
+	return hash
+}
+
+func TestGetCalendarFeedHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Type_Is_Not_Valid(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics?type=wadus")
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, `The type must be "todo" or "event"`)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_Error_If_The_Query_To_Find_The_Feed_Fails(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Check_The_Membership_Fails(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(false, fmt.Errorf("some error")).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the user is a member of the workspace")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_ErrorResult_With_A_ForbiddenError_If_The_User_Is_No_Longer_A_Member_Of_The_Workspace(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(false, nil).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckForbiddenErrorResult(t, result, "The user of the feed isn't a member of its workspace")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Get_The_Due_Items_Fails(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	categories, lists, _ := calendarFeedLists()
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+	m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+	m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+	m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32(nil)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the items with due date")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Updating_The_Content_Hash_Fails(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	categories, lists, items := calendarFeedLists()
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+	m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+	m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+	m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32(nil)).Return(items, nil).Once()
+	m.feedsRepo.On("UpdateCalendarFeedContent", request.Context(), int32(5), calendarFeedContentHash(t), mock.AnythingOfType("time.Time")).Return(fmt.Errorf("some error")).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error updating the calendar feed")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Streams_The_Items_With_Due_Date_And_Updates_The_Last_Modified_Date_When_The_Content_Changes(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics?type=event")
+	m, h := newCalendarFeedMocks()
+	w := httptest.NewRecorder()
+
+	previous := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories, lists, items := calendarFeedLists()
+	contentHash := calendarFeedContentHash(t)
+	var lastModified time.Time
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2, ContentHash: "old", LastModified: &previous}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+	m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+	m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+	m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32(nil)).Return(items, nil).Once()
+	m.feedsRepo.On("UpdateCalendarFeedContent", request.Context(), int32(5), contentHash, mock.AnythingOfType("time.Time")).Return(nil).Once().Run(func(args mock.Arguments) {
+		lastModified = args.Get(3).(time.Time)
+	})
+
+	result := GetCalendarFeedHandler(w, request, h)
+
+	content := results.CheckStreamResult(t, result, "text/calendar; charset=utf-8")
+	assert.Equal(t, "", result.(results.StreamResult).FileName)
+	assert.True(t, lastModified.After(previous))
+	assert.Equal(t, `"`+contentHash+`-event"`, w.Header().Get("ETag"))
+	assert.Equal(t, lastModified.Format(http.TimeFormat), w.Header().Get("Last-Modified"))
+	assert.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//todos//lists//EN\r\nX-WR-CALNAME:Todos\r\n"+
+		"BEGIN:VEVENT\r\nUID:list-item-21@todos\r\nDTSTAMP:"+domain.FormatICalendarTime(lastModified)+"\r\nDTSTART:20230201T100000Z\r\n"+
+		"SUMMARY:item1\r\nCATEGORIES:list1,category1\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n", content)
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Only_Gets_The_Due_Items_Of_The_Lists_Of_The_Category_Of_The_Feed(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	categoryID := int32(3)
+	lastModified := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	categories, lists, items := calendarFeedLists()
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2, CategoryID: &categoryID, ContentHash: calendarFeedContentHash(t), LastModified: &lastModified}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+	m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+	m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+	m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32{11}).Return(items, nil).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	content := results.CheckStreamResult(t, result, "text/calendar; charset=utf-8")
+	assert.Contains(t, content, "DTSTAMP:20230101T000000Z\r\nDUE:20230201T100000Z\r\n")
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_An_Empty_Calendar_If_The_List_Of_The_Feed_Has_No_Due_Items(t *testing.T) {
+	request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+	m, h := newCalendarFeedMocks()
+
+	listID := int32(12)
+	categories, lists, _ := calendarFeedLists()
+	m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2, ListID: &listID}, nil).Once()
+	m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+	m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+	m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+	m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32{12}).Return(domain.ListItemRecords{}, nil).Once()
+	m.feedsRepo.On("UpdateCalendarFeedContent", request.Context(), int32(5), mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil).Once()
+
+	result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+	content := results.CheckStreamResult(t, result, "text/calendar; charset=utf-8")
+	assert.Equal(t, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//todos//lists//EN\r\nX-WR-CALNAME:Todos\r\nEND:VCALENDAR\r\n", content)
+	m.assertExpectations(t)
+}
+
+func TestGetCalendarFeedHandler_Returns_Not_Modified_If_The_Content_Has_Not_Changed(t *testing.T) {
+	lastModified := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	contentHash := calendarFeedContentHash(t)
+
+	tests := []struct {
+		name           string
+		header         string
+		value          string
+		expectedStatus int
+	}{
+		{"The ETag matches", "If-None-Match", `"other", "` + contentHash + `-todo"`, http.StatusNotModified},
+		{"The weak ETag matches", "If-None-Match", `W/"` + contentHash + `-todo"`, http.StatusNotModified},
+		{"The ETag of other type", "If-None-Match", `"` + contentHash + `-event"`, 0},
+		{"Not modified since", "If-Modified-Since", lastModified.Format(http.TimeFormat), http.StatusNotModified},
+		{"Modified since", "If-Modified-Since", lastModified.Add(-time.Second).Format(http.TimeFormat), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := calendarFeedRequest("/feeds/0a1b2c/calendar.ics")
+			request.Header.Set(tt.header, tt.value)
+			m, h := newCalendarFeedMocks()
+
+			categories, lists, items := calendarFeedLists()
+			m.feedsRepo.On("FindCalendarFeed", request.Context(), calendarFeedQuery()).Return(&domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2, ContentHash: contentHash, LastModified: &lastModified}, nil).Once()
+			m.workspacesRepo.On("ExistsWorkspaceMember", request.Context(), calendarFeedMember()).Return(true, nil).Once()
+			m.categoriesRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 2}).Return(categories, nil).Once()
+			m.listsRepo.On("GetLists", request.Context(), domain.ListRecord{WorkspaceID: 2}).Return(lists, nil).Once()
+			m.listsRepo.On("GetDueItems", request.Context(), int32(2), []int32(nil)).Return(items, nil).Once()
+
+			result := GetCalendarFeedHandler(httptest.NewRecorder(), request, h)
+
+			if tt.expectedStatus == http.StatusNotModified {
+				results.CheckOkResult(t, result, http.StatusNotModified)
+			} else {
+				results.CheckStreamResult(t, result, "text/calendar; charset=utf-8")
+			}
+			m.assertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func calendarFeedsRequest(method string) *http.Request {
+	request, _ := http.NewRequest(method, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(2))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetCalendarFeedsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Get_The_Feeds_Fails(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodGet)
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo}
+
+	mockedRepo.On("GetCalendarFeeds", request.Context(), domain.CalendarFeedRecord{UserID: 1, WorkspaceID: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCalendarFeedsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the calendar feeds")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCalendarFeedsHandler_Returns_The_Feeds_Without_Their_Tokens(t *testing.T) {
+	request := calendarFeedsRequest(http.MethodGet)
+
+	mockedRepo := listsRepository.MockedCalendarFeedsRepository{}
+	h := handler.Handler{CalendarFeedsRepository: &mockedRepo}
+
+	categoryID := int32(3)
+	now := time.Now()
+	foundFeeds := domain.CalendarFeedRecords{
+		{ID: 11, UserID: 1, WorkspaceID: 2, TokenHash: "hash1", CreatedAt: now},
+		{ID: 12, UserID: 1, WorkspaceID: 2, TokenHash: "hash2", CategoryID: &categoryID, LastModified: &now, CreatedAt: now},
+	}
+	mockedRepo.On("GetCalendarFeeds", request.Context(), domain.CalendarFeedRecord{UserID: 1, WorkspaceID: 2}).Return(foundFeeds, nil).Once()
+
+	result := GetCalendarFeedsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	feedEntities, isOk := okRes.Content.([]*domain.CalendarFeedEntity)
+	require.True(t, isOk, "should be a slice of pointers to CalendarFeedEntity")
+	require.Equal(t, 2, len(feedEntities))
+	assert.Equal(t, int32(11), feedEntities[0].ID)
+	assert.Nil(t, feedEntities[0].CategoryID)
+	assert.Equal(t, "", feedEntities[0].Token)
+	assert.Equal(t, "", feedEntities[0].Url)
+	assert.Equal(t, int32(12), feedEntities[1].ID)
+	assert.Equal(t, &categoryID, feedEntities[1].CategoryID)
+	assert.Equal(t, &now, feedEntities[1].LastModified)
+	mockedRepo.AssertExpectations(t)
+}
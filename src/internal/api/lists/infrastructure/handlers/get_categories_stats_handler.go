@@ -0,0 +1,21 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetCategoriesStatsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewGetCategoriesStatsService(h.CategoriesRepository)
+	stats, err := srv.GetCategoriesStats(r.Context(), workspaceID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: stats, StatusCode: http.StatusOK}
+}
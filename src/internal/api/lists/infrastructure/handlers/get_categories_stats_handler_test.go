@@ -0,0 +1,89 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getCategoriesStatsRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetCategoriesStatsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Categories_Fails(t *testing.T) {
+	request := getCategoriesStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCategoriesStatsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user categories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCategoriesStatsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Stats_Fails(t *testing.T) {
+	request := getCategoriesStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11}}, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), domain.CategoryStatsQuery{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCategoriesStatsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the categories stats")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCategoriesStatsHandler_Returns_The_Stats_Of_All_The_Categories(t *testing.T) {
+	request := getCategoriesStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	query := domain.CategoryStatsQuery{WorkspaceID: 1}
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 12}, {ID: 11}}, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), query).Return(domain.CategoryStatsRecords{{CategoryID: 11, ListsCount: 1, ItemsCount: 4}}, nil).Once()
+	largestLists := domain.ListRecords{{ID: 3, Name: "list3", ItemsCount: 4, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}}}
+	mockedRepo.On("GetLargestLists", request.Context(), query, domain.CategoryStatsLargestLists).Return(largestLists, nil).Once()
+
+	result := GetCategoriesStatsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]*domain.CategoryStatsEntity)
+	require.True(t, isOk, "should be an array of CategoryStatsEntity")
+
+	require.Len(t, res, 2)
+	assert.Equal(t, &domain.CategoryStatsEntity{CategoryID: 12, LargestLists: []*domain.CategoryStatsListEntity{}}, res[0])
+	assert.Equal(t, &domain.CategoryStatsEntity{
+		CategoryID:   11,
+		ListsCount:   1,
+		ItemsCount:   4,
+		LargestLists: []*domain.CategoryStatsListEntity{{ID: 3, Name: "list3", ItemsCount: 4}},
+	}, res[1])
+
+	mockedRepo.AssertExpectations(t)
+}
@@ -10,10 +10,11 @@ import (
 
 func GetCategoryHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	categoryID := h.ParseInt32UrlVar(r, "id")
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	userID := h.GetUserIDFromContext(r)
 
 	srv := application.NewGetCategoryService(h.CategoriesRepository)
-	foundCategory, err := srv.GetCategory(r.Context(), categoryID, userID)
+	foundCategory, err := srv.GetCategory(r.Context(), categoryID, workspaceID, userID)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
@@ -27,6 +27,7 @@ func getCategoryRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -37,7 +38,7 @@ func TestGetCategoryHandler_Returns_An_Error_If_The_Query_To_Find_The_Category_F
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	h := handler.Handler{CategoriesRepository: &mockedRepo}
 
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := GetCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -51,8 +52,10 @@ func TestGetCategoryHandler_Returns_The_Category(t *testing.T) {
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	h := handler.Handler{CategoriesRepository: &mockedRepo}
 
-	foundCategory := domain.CategoryRecord{ID: 11, Name: "category1"}
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&foundCategory, nil).Once()
+	foundCategory := domain.CategoryRecord{ID: 11, Name: "category1", Color: "#1a2b3c", Icon: "cart", Position: 2}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&foundCategory, nil).Once()
+	defaultCategoryID := int32(11)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&defaultCategoryID, nil).Once()
 
 	result := GetCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -62,5 +65,9 @@ func TestGetCategoryHandler_Returns_The_Category(t *testing.T) {
 
 	assert.Equal(t, int32(11), listRes.ID)
 	assert.Equal(t, "category1", listRes.Name.String())
+	assert.Equal(t, "#1a2b3c", listRes.Color.String())
+	assert.Equal(t, "cart", listRes.Icon.String())
+	assert.Equal(t, int32(2), listRes.Position)
+	assert.True(t, listRes.IsDefault)
 	mockedRepo.AssertExpectations(t)
 }
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetCategoryStatsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	categoryID := h.ParseInt32UrlVar(r, "id")
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewGetCategoriesStatsService(h.CategoriesRepository)
+	stats, err := srv.GetCategoryStats(r.Context(), categoryID, workspaceID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: stats, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,114 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getCategoryStatsRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "11",
+	})
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetCategoryStatsHandler_Returns_An_Error_If_The_Query_To_Find_The_Category_Fails(t *testing.T) {
+	request := getCategoryStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCategoryStatsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCategoryStatsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Stats_Fails(t *testing.T) {
+	request := getCategoryStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11}, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), domain.CategoryStatsQuery{WorkspaceID: 1, CategoryID: 11}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCategoryStatsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the categories stats")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCategoryStatsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Largest_Lists_Fails(t *testing.T) {
+	request := getCategoryStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	query := domain.CategoryStatsQuery{WorkspaceID: 1, CategoryID: 11}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11}, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), query).Return(domain.CategoryStatsRecords{}, nil).Once()
+	mockedRepo.On("GetLargestLists", request.Context(), query, domain.CategoryStatsLargestLists).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetCategoryStatsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the largest lists of the categories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetCategoryStatsHandler_Returns_The_Stats_Of_The_Category(t *testing.T) {
+	request := getCategoryStatsRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{CategoriesRepository: &mockedRepo}
+
+	query := domain.CategoryStatsQuery{WorkspaceID: 1, CategoryID: 11}
+	lastActivityAt := time.Date(2022, 5, 3, 10, 0, 0, 0, time.UTC)
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11}, nil).Once()
+	mockedRepo.On("GetCategoriesStats", request.Context(), query).Return(domain.CategoryStatsRecords{{CategoryID: 11, ListsCount: 2, ItemsCount: 9, LastActivityAt: &lastActivityAt}}, nil).Once()
+	largestLists := domain.ListRecords{
+		{ID: 3, Name: "list3", ItemsCount: 6, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+		{ID: 4, Name: "list4", ItemsCount: 3, CategoryID: &sql.NullInt32{Int32: 11, Valid: true}},
+	}
+	mockedRepo.On("GetLargestLists", request.Context(), query, domain.CategoryStatsLargestLists).Return(largestLists, nil).Once()
+
+	result := GetCategoryStatsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryStatsEntity)
+	require.True(t, isOk, "should be a CategoryStatsEntity")
+
+	assert.Equal(t, int32(11), res.CategoryID)
+	assert.Equal(t, int64(2), res.ListsCount)
+	assert.Equal(t, int64(9), res.ItemsCount)
+	assert.Equal(t, &lastActivityAt, res.LastActivityAt)
+	require.Len(t, res.LargestLists, 2)
+	assert.Equal(t, &domain.CategoryStatsListEntity{ID: 3, Name: "list3", ItemsCount: 6}, res.LargestLists[0])
+	assert.Equal(t, &domain.CategoryStatsListEntity{ID: 4, Name: "list4", ItemsCount: 3}, res.LargestLists[1])
+
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetItemsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	userID := h.GetUserIDFromContext(r)
+
+	// The items are always filtered by tag because getting all the items of a workspace is too much
+	tag, err := domain.NewTagNameValueObject(r.URL.Query().Get("tag"))
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	srv := application.NewGetItemsService(h.ListsRepository, h.TagsRepository)
+	foundItems, err := srv.GetItems(r.Context(), workspaceID, userID, tag.String())
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: foundItems, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,83 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getItemsRequest(tag string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus?tag="+tag, nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetItemsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_There_Is_No_Tag(t *testing.T) {
+	result := GetItemsHandler(httptest.NewRecorder(), getItemsRequest(""), handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "The tag name can not be empty")
+}
+
+func TestGetItemsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request := getItemsRequest("home")
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo}
+
+	mockedRepo.On("GetItemsByTag", request.Context(), int32(1), int32(1), "home").Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetItemsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the items")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetItemsHandler_Returns_The_Items_With_The_Tag(t *testing.T) {
+	request := getItemsRequest("Home")
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	found := domain.ListItemRecords{
+		{ID: 21, ListID: 11, Title: "item1"},
+		{ID: 22, ListID: 12, Title: "item2"},
+	}
+	mockedRepo.On("GetItemsByTag", request.Context(), int32(1), int32(1), "home").Return(found, nil).Once()
+	foundTags := domain.TaggedRecords{{ID: 21, Name: "home"}, {ID: 22, Name: "home"}, {ID: 22, Name: "urgent"}}
+	mockedTagsRepo.On("GetItemsTags", request.Context(), int32(1), []int32{21, 22}).Return(foundTags, nil).Once()
+
+	result := GetItemsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	itemsRes, isOk := okRes.Content.([]*domain.ListItemWithListEntity)
+	require.Equal(t, true, isOk, "should be an array of ListItemWithListEntity")
+
+	require.Equal(t, 2, len(itemsRes))
+	assert.Equal(t, int32(21), itemsRes[0].ID)
+	assert.Equal(t, int32(11), itemsRes[0].ListID)
+	assert.Equal(t, "item1", itemsRes[0].Title.String())
+	assert.Equal(t, []string{"home"}, itemsRes[0].Tags)
+	assert.Equal(t, int32(22), itemsRes[1].ID)
+	assert.Equal(t, int32(12), itemsRes[1].ListID)
+	assert.Equal(t, []string{"home", "urgent"}, itemsRes[1].Tags)
+
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+)
+
+func GetListActivityHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+
+	return getActivity(r, h, listID)
+}
@@ -0,0 +1,58 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetListActivityHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := getRequest()
+
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedListsRepo}
+
+	mockedListsRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListActivityHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestGetListActivityHandler_Returns_The_Activity_Of_The_List(t *testing.T) {
+	request := getRequest()
+
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	mockedActivityRepo := listsRepository.MockedActivityRepository{}
+	h := handler.Handler{ListsRepository: &mockedListsRepo, ActivityRepository: &mockedActivityRepo}
+
+	mockedListsRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11, UserID: 1}, nil).Once()
+	destinationListID := int32(20)
+	found := domain.ActivityRecords{{ID: 4, UserID: 1, ListID: 11, RelatedListID: &destinationListID, Action: domain.ActivityItemMoved, Summary: `Moved "item" from "list1" to "list2"`}}
+	mockedActivityRepo.On("GetActivity", request.Context(), domain.ActivityQuery{UserID: 1, ListID: 11, Limit: 21}).Return(found, nil).Once()
+
+	result := GetListActivityHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(ActivityPageResponse)
+	require.True(t, isOk, "should be an ActivityPageResponse")
+
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, int32(4), res.Items[0].ID)
+	assert.Equal(t, &destinationListID, res.Items[0].RelatedListID)
+	assert.Empty(t, res.NextCursor)
+	mockedListsRepo.AssertExpectations(t)
+	mockedActivityRepo.AssertExpectations(t)
+}
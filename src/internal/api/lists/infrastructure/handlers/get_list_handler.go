@@ -10,10 +10,11 @@ import (
 
 func GetListHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	listID := h.ParseInt32UrlVar(r, "id")
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	userID := h.GetUserIDFromContext(r)
 
-	srv := application.NewGetListService(h.ListsRepository)
-	foundList, err := srv.GetList(r.Context(), listID, userID)
+	srv := application.NewGetListService(h.ListsRepository, h.TagsRepository)
+	foundList, err := srv.GetList(r.Context(), listID, workspaceID, userID)
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
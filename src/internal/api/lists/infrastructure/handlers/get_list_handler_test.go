@@ -27,6 +27,7 @@ func getRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -37,7 +38,7 @@ func TestGetListHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *
 	mockedRepo := listsRepository.MockedListsRepository{}
 	h := handler.Handler{ListsRepository: &mockedRepo}
 
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := GetListHandler(httptest.NewRecorder(), request, h)
 
@@ -49,10 +50,13 @@ func TestGetListHandler_Returns_The_List(t *testing.T) {
 	request := getRequest()
 
 	mockedRepo := listsRepository.MockedListsRepository{}
-	h := handler.Handler{ListsRepository: &mockedRepo}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
 
-	foundList := domain.ListRecord{ID: 11, Name: "list1", ItemsCount: 4}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&foundList, nil).Once()
+	foundList := domain.ListRecord{ID: 11, Name: "list1", ItemsCount: 4, Items: []domain.ListItemRecord{{ID: 21, ListID: 11, Title: "item1"}, {ID: 22, ListID: 11, Title: "item2"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(domain.TaggedRecords{{ID: 11, Name: "home"}}, nil).Once()
+	mockedTagsRepo.On("GetItemsTags", request.Context(), int32(1), []int32{21, 22}).Return(domain.TaggedRecords{{ID: 22, Name: "urgent"}}, nil).Once()
 
 	result := GetListHandler(httptest.NewRecorder(), request, h)
 
@@ -63,5 +67,29 @@ func TestGetListHandler_Returns_The_List(t *testing.T) {
 	assert.Equal(t, int32(11), listRes.ID)
 	assert.Equal(t, "list1", listRes.Name.String())
 	assert.Equal(t, int32(4), listRes.ItemsCount)
+	assert.Equal(t, []string{"home"}, listRes.Tags)
+	require.Equal(t, 2, len(listRes.Items))
+	assert.Nil(t, listRes.Items[0].Tags)
+	assert.Equal(t, []string{"urgent"}, listRes.Items[1].Tags)
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+}
+
+func TestGetListHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Items_Tags_Fails(t *testing.T) {
+	request := getRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, TagsRepository: &mockedTagsRepo}
+
+	foundList := domain.ListRecord{ID: 11, Name: "list1", Items: []domain.ListItemRecord{{ID: 21, ListID: 11, Title: "item1"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedTagsRepo.On("GetListsTags", request.Context(), int32(1), []int32{11}).Return(domain.TaggedRecords{}, nil).Once()
+	mockedTagsRepo.On("GetItemsTags", request.Context(), int32(1), []int32{21}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the items tags")
 	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
 }
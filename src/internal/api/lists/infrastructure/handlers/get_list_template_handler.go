@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func GetListTemplateHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	templateID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewGetListTemplateService(h.ListTemplatesRepository)
+	foundTemplate, err := srv.GetListTemplate(r.Context(), templateID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: foundTemplate, StatusCode: http.StatusOK}
+}
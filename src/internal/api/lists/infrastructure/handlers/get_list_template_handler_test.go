@@ -0,0 +1,55 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetListTemplateHandler_Returns_An_Error_If_The_Query_To_Find_The_Template_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodGet, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetListTemplateHandler_Returns_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodGet, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	foundTemplate := domain.ListTemplateRecord{ID: 5, UserID: 3, Name: "packing", Items: `[{"title":"Hotel in {{destination}}","description":"desc"}]`, Shares: []domain.ListTemplateShareRecord{{TemplateID: 5, UserID: 1}}}
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(&foundTemplate, nil).Once()
+
+	result := GetListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	template, isOk := okRes.Content.(*domain.ListTemplateEntity)
+	require.True(t, isOk, "should be a pointer to ListTemplateEntity")
+	assert.Equal(t, int32(5), template.ID)
+	require.Equal(t, 1, len(template.Items))
+	assert.Equal(t, "Hotel in {{destination}}", template.Items[0].Title.String())
+	assert.Equal(t, "desc", template.Items[0].Description.String())
+	assert.Equal(t, []string{"destination"}, template.Variables)
+	assert.Nil(t, template.SharedWith)
+	mockedRepo.AssertExpectations(t)
+}
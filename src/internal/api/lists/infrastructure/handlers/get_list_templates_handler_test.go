@@ -0,0 +1,71 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listTemplatesRequest(method string, vars map[string]string) *http.Request {
+	request, _ := http.NewRequest(method, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(2))
+
+	return mux.SetURLVars(request.WithContext(ctx), vars)
+}
+
+func TestGetListTemplatesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_To_Get_The_Templates_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodGet, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("GetAccessibleListTemplates", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListTemplatesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the templates")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetListTemplatesHandler_Returns_The_Templates_Of_The_User_And_The_Ones_Shared_With_Them(t *testing.T) {
+	request := listTemplatesRequest(http.MethodGet, nil)
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	foundTemplates := domain.ListTemplateRecords{
+		{ID: 5, UserID: 1, Name: "packing", Items: `[{"title":"{{days}} t-shirts","description":""}]`, Shares: []domain.ListTemplateShareRecord{{TemplateID: 5, UserID: 3}}},
+		{ID: 6, UserID: 3, Name: "sprint", Items: `[]`, Shares: []domain.ListTemplateShareRecord{{TemplateID: 6, UserID: 1}, {TemplateID: 6, UserID: 4}}},
+	}
+	mockedRepo.On("GetAccessibleListTemplates", request.Context(), int32(1)).Return(foundTemplates, nil).Once()
+
+	result := GetListTemplatesHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	templates, isOk := okRes.Content.([]*domain.ListTemplateEntity)
+	require.True(t, isOk, "should be a slice of pointers to ListTemplateEntity")
+	require.Equal(t, 2, len(templates))
+	assert.Equal(t, "packing", templates[0].Name.String())
+	assert.Equal(t, []string{"days"}, templates[0].Variables)
+	assert.Equal(t, []int32{3}, templates[0].SharedWith)
+	assert.Equal(t, "sprint", templates[1].Name.String())
+	assert.Equal(t, int32(3), templates[1].UserID)
+	assert.Nil(t, templates[1].SharedWith)
+	mockedRepo.AssertExpectations(t)
+}
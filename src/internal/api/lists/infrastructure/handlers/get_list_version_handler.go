@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+type ListVersionDetailResponse struct {
+	*domain.ListVersionEntity
+	Diff *domain.ListVersionDiff `json:"diff"`
+}
+
+func GetListVersionHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+	version := h.ParseInt32UrlVar(r, "version")
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewGetListVersionService(h.ListsRepository, h.ListVersionsRepository)
+	foundVersion, diff, err := srv.GetListVersion(r.Context(), listID, workspaceID, version)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: ListVersionDetailResponse{ListVersionEntity: foundVersion, Diff: diff}, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,91 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listVersionRequest(method string) *http.Request {
+	request, _ := http.NewRequest(method, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id":      "11",
+		"version": "2",
+	})
+	ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetListVersionHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := listVersionRequest(http.MethodGet)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListVersionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetListVersionHandler_Returns_An_Error_If_The_Query_To_Find_The_Version_Fails(t *testing.T) {
+	request := listVersionRequest(http.MethodGet)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11}, nil).Once()
+	mockedVersionsRepo.On("FindListVersion", request.Context(), domain.ListVersionRecord{ListID: 11, Version: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListVersionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+}
+
+func TestGetListVersionHandler_Returns_The_Version_And_The_Diff_With_The_Current_List(t *testing.T) {
+	request := listVersionRequest(http.MethodGet)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo}
+
+	currentList := domain.ListRecord{ID: 11, UserID: 1, Name: "list1"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&currentList, nil).Once()
+	foundVersion := domain.ListVersionRecord{ID: 5, ListID: 11, UserID: 1, Version: 2, Name: "list1", Items: `[{"id":1,"title":"item1"}]`}
+	mockedVersionsRepo.On("FindListVersion", request.Context(), domain.ListVersionRecord{ListID: 11, Version: 2}).Return(&foundVersion, nil).Once()
+
+	result := GetListVersionHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(ListVersionDetailResponse)
+	require.True(t, isOk, "should be a ListVersionDetailResponse")
+
+	assert.Equal(t, int32(2), res.Version)
+	assert.Equal(t, []domain.ListVersionItem{{ID: 1, Title: "item1"}}, res.Items)
+	assert.Nil(t, res.Diff.Name)
+	assert.Equal(t, []domain.ListVersionItem{{ID: 1, Title: "item1"}}, res.Diff.RemovedItems)
+	assert.Empty(t, res.Diff.AddedItems)
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+}
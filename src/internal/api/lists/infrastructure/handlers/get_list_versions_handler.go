@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+type ListVersionResponse struct {
+	Version    int32     `json:"version"`
+	Name       string    `json:"name"`
+	CategoryID *int32    `json:"categoryId"`
+	ItemsCount int       `json:"itemsCount"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func GetListVersionsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewGetListVersionsService(h.ListsRepository, h.ListVersionsRepository)
+	found, err := srv.GetListVersions(r.Context(), listID, workspaceID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	res := make([]ListVersionResponse, len(found))
+
+	for i, v := range found {
+		res[i] = ListVersionResponse{
+			Version:    v.Version,
+			Name:       v.Name,
+			CategoryID: v.CategoryID,
+			ItemsCount: len(v.Items),
+			CreatedAt:  v.CreatedAt,
+		}
+	}
+
+	return results.OkResult{Content: res, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,78 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetListVersionsHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := getRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListVersionsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetListVersionsHandler_Returns_An_Error_If_The_Query_To_Get_The_Versions_Fails(t *testing.T) {
+	request := getRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11}, nil).Once()
+	mockedVersionsRepo.On("GetListVersions", request.Context(), int32(11)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetListVersionsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the list versions")
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+}
+
+func TestGetListVersionsHandler_Returns_The_Versions(t *testing.T) {
+	request := getRequest()
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo}
+
+	now := time.Now()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11}, nil).Once()
+	found := domain.ListVersionRecords{
+		{ID: 5, ListID: 11, Version: 2, Name: "list1", Items: `[{"id":1,"title":"item1"},{"id":2,"title":"item2"}]`, CreatedAt: now},
+		{ID: 3, ListID: 11, Version: 1, Name: "list0", Items: `[]`, CreatedAt: now},
+	}
+	mockedVersionsRepo.On("GetListVersions", request.Context(), int32(11)).Return(found, nil).Once()
+
+	result := GetListVersionsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]ListVersionResponse)
+	require.True(t, isOk, "should be an array of ListVersionResponse")
+
+	require.Len(t, res, 2)
+	assert.Equal(t, ListVersionResponse{Version: 2, Name: "list1", ItemsCount: 2, CreatedAt: now}, res[0])
+	assert.Equal(t, ListVersionResponse{Version: 1, Name: "list0", ItemsCount: 0, CreatedAt: now}, res[1])
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+}
@@ -0,0 +1,124 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockQuotaLimits(ctx context.Context, quotasRepo *listsRepository.MockedQuotasRepository, cfgSrv *sharedApp.MockedConfigurationService, limits domain.QuotaLimits) {
+	quotasRepo.On("GetUserQuota", ctx, int32(1)).Return(&domain.UserQuotaRecord{UserID: 1}, nil).Once()
+	cfgSrv.On("GetMaxListsPerUser").Return(limits.MaxLists).Once()
+	cfgSrv.On("GetMaxItemsPerList").Return(limits.MaxItemsPerList).Once()
+	cfgSrv.On("GetMaxCategoriesPerUser").Return(limits.MaxCategories).Once()
+}
+
+func usageRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetMyUsageHandler_Returns_An_Error_If_The_Query_To_Get_The_User_Quota_Fails(t *testing.T) {
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	h := handler.Handler{QuotasRepository: &mockedQuotasRepo}
+
+	request := usageRequest()
+
+	mockedQuotasRepo.On("GetUserQuota", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetMyUsageHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the user quota")
+	mockedQuotasRepo.AssertExpectations(t)
+}
+
+func TestGetMyUsageHandler_Returns_An_Error_If_The_Query_To_Get_The_Lists_Fails(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	request := usageRequest()
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetMyUsageHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user lists")
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+}
+
+func TestGetMyUsageHandler_Returns_An_Error_If_The_Query_To_Count_The_Categories_Fails(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	request := usageRequest()
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{UserID: 1}).Return(domain.ListRecords{}, nil).Once()
+	mockedCategoriesRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(0), fmt.Errorf("some error")).Once()
+
+	result := GetMyUsageHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error counting the user categories")
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestGetMyUsageHandler_Returns_The_Usage_With_The_User_Overrides(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, CategoriesRepository: &mockedCategoriesRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	request := usageRequest()
+
+	maxLists := int32(0)
+	mockedQuotasRepo.On("GetUserQuota", request.Context(), int32(1)).Return(&domain.UserQuotaRecord{UserID: 1, MaxLists: &maxLists}, nil).Once()
+	mockedCfgSrv.On("GetMaxListsPerUser").Return(200).Once()
+	mockedCfgSrv.On("GetMaxItemsPerList").Return(500).Once()
+	mockedCfgSrv.On("GetMaxCategoriesPerUser").Return(50).Once()
+	foundLists := domain.ListRecords{{ID: 1, ItemsCount: 4}, {ID: 2, ItemsCount: 9}}
+	mockedRepo.On("GetLists", request.Context(), domain.ListRecord{UserID: 1}).Return(foundLists, nil).Once()
+	mockedCategoriesRepo.On("CountCategories", request.Context(), domain.CategoryRecord{UserID: 1}).Return(int64(3), nil).Once()
+
+	result := GetMyUsageHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.QuotaUsageEntity)
+	require.True(t, isOk, "should be a QuotaUsageEntity")
+	assert.Equal(t, int64(2), res.Lists.Used)
+	assert.Nil(t, res.Lists.Limit)
+	assert.Equal(t, int64(9), res.ItemsPerList.Used)
+	assert.Equal(t, 500, *res.ItemsPerList.Limit)
+	assert.Equal(t, int64(3), res.Categories.Used)
+	assert.Equal(t, 50, *res.Categories.Limit)
+
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
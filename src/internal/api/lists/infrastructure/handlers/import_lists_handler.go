@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+const maxImportFileSize = 1 << 20
+
+// maxImportBodySize leaves room for the multipart headers and the other fields of the body
+const maxImportBodySize = maxImportFileSize + 64<<10
+
+// ImportListsHandler imports the lists of the file of a multipart upload. The format is
+// detected when it isn't sent, and a dry run only returns the errors of the rows
+func ImportListsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	// ParseMultipartForm only limits the memory it uses, the rest of the body goes to temporary files
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportBodySize)
+
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return results.ErrorResult{Err: &appErrors.RequestEntityTooLargeError{Msg: fmt.Sprintf("The file can't have more than %v bytes", maxImportFileSize), InternalError: err}}
+		}
+
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "Invalid multipart body", InternalError: err}}
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: "The file is required", InternalError: err}}
+	}
+	defer file.Close()
+
+	if header.Size > maxImportFileSize {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: fmt.Sprintf("The file can't have more than %v bytes", maxImportFileSize)}}
+	}
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.UnexpectedError{Msg: "Error reading the file", InternalError: err}}
+	}
+
+	format := domain.DetectImportFormat(header.Filename, content)
+	if value := r.FormValue("format"); len(value) > 0 {
+		if format, err = domain.NewImportFormat(value); err != nil {
+			return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: err.Error()}}
+		}
+	}
+
+	// The items that don't say their list go to a list named as the file
+	defaultListName := strings.TrimSuffix(filepath.Base(header.Filename), filepath.Ext(header.Filename))
+	if len(defaultListName) == 0 || defaultListName == "." {
+		defaultListName = domain.DefaultImportListName
+	}
+
+	rows, err := domain.ParseImportRows(format, content, defaultListName)
+	if err != nil {
+		return results.ErrorResult{Err: &appErrors.BadRequestError{Msg: err.Error()}}
+	}
+
+	dryRun := r.FormValue("dryRun") == "true"
+
+	srv := application.NewImportListsService(h.ListsRepository, h.CategoriesRepository, h.TagsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
+	result, err := srv.ImportLists(r.Context(), userID, workspaceID, rows, dryRun)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	switch {
+	case result.Imported:
+		return results.OkResult{Content: result, StatusCode: http.StatusCreated}
+	case dryRun:
+		return results.OkResult{Content: result, StatusCode: http.StatusOK}
+	}
+
+	return results.OkResult{Content: result, StatusCode: http.StatusUnprocessableEntity}
+}
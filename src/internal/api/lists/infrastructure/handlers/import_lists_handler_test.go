@@ -0,0 +1,255 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func importRequest(fileName string, content string, fields map[string]string) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	if len(fileName) > 0 {
+		part, _ := writer.CreateFormFile("file", fileName)
+		part.Write([]byte(content))
+	}
+
+	for k, v := range fields {
+		writer.WriteField(k, v)
+	}
+
+	writer.Close()
+
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", body)
+	request.Header.Set("Content-Type", writer.FormDataContentType())
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Body_Is_Not_Multipart(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodPost, "/wadus", bytes.NewBufferString("{}"))
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "Invalid multipart body")
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_RequestEntityTooLargeError_If_The_Body_Is_Too_Large(t *testing.T) {
+	request := importRequest("lists.csv", strings.Repeat("a", maxImportBodySize), nil)
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckRequestEntityTooLargeErrorResult(t, result, "The file can't have more than 1048576 bytes")
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_There_Is_No_File(t *testing.T) {
+	request := importRequest("", "", map[string]string{"format": "csv"})
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "The file is required")
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Format_Is_Not_Valid(t *testing.T) {
+	request := importRequest("lists.csv", "list,title", map[string]string{"format": "wadus"})
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, `The format must be "csv", "markdown" or "todotxt"`)
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_File_Can_Not_Be_Parsed(t *testing.T) {
+	request := importRequest("lists.csv", "name,description", nil)
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "The csv file must have a header with the list and title columns")
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_File_Does_Not_Have_Lists(t *testing.T) {
+	request := importRequest("lists.md", "Some notes", nil)
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, handler.Handler{})
+
+	results.CheckBadRequestErrorResult(t, result, "The file doesn't have any list")
+}
+
+func TestImportListsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Counting_The_Lists_Fails(t *testing.T) {
+	request := importRequest("lists.csv", "list,title\nlist1,item1", nil)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), fmt.Errorf("some error")).Once()
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error counting the user lists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestImportListsHandler_Returns_The_Errors_Of_A_Dry_Run_Without_Creating_The_Lists(t *testing.T) {
+	content := "- [ ] item1\n# list2\n- [ ] " + string(bytes.Repeat([]byte("a"), 51)) + "\n- [ ] item3\n- [ ] item4\n"
+	request := importRequest("list1.md", content, map[string]string{"dryRun": "true"})
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 2, MaxItemsPerList: 1})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(1), nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list1", WorkspaceID: 1}).Return(true, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list2", WorkspaceID: 1}).Return(false, nil).Once()
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*application.ImportListsResult)
+	require.True(t, isOk, "should be an ImportListsResult")
+	assert.False(t, res.Imported)
+	require.Equal(t, 2, len(res.Lists))
+	assert.Equal(t, "list1", res.Lists[0].Name.String())
+	assert.Equal(t, "list2", res.Lists[1].Name.String())
+	assert.Equal(t, []domain.ImportRowError{
+		{Row: 3, Error: "The item title can not have more than 50 characters"},
+		{Row: 1, Error: "A list with the same name already exists"},
+		{Row: 3, Error: "You have reached the limit of 2 lists"},
+		{Row: 3, Error: "A list can't have more than 1 items"},
+	}, res.Errors)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestImportListsHandler_Does_Not_Import_Anything_If_There_Are_Errors(t *testing.T) {
+	request := importRequest("todo.txt", "call mom +family", nil)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{ListsRepository: &mockedRepo, QuotasRepository: &mockedQuotasRepo, CfgSrv: mockedCfgSrv}
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "family", WorkspaceID: 1}).Return(true, nil).Once()
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusUnprocessableEntity)
+	res, isOk := okRes.Content.(*application.ImportListsResult)
+	require.True(t, isOk, "should be an ImportListsResult")
+	assert.False(t, res.Imported)
+	assert.Equal(t, []domain.ImportRowError{{Row: 1, Error: "A list with the same name already exists"}}, res.Errors)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestImportListsHandler_Imports_The_Lists(t *testing.T) {
+	request := importRequest("lists.csv", "list,title,description\nlist1,item1,desc1\nlist2,item2,\nlist1,item3,", nil)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		EventBus:             &mockedEventBus,
+	}
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list1", WorkspaceID: 1}).Return(false, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list2", WorkspaceID: 1}).Return(false, nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("CreateLists", request.Context(), mock.AnythingOfType("[]*domain.ListRecord")).Run(func(args mock.Arguments) {
+		for _, v := range args.Get(1).([]*domain.ListRecord) {
+			v.ID = int32(10 + len(v.Items))
+		}
+	}).Return(nil).Once()
+	mockedEventBus.On("Publish", events.ListCreated, mock.AnythingOfType("domain.ListEvent")).Twice()
+
+	mockedEventBus.Wg.Add(2)
+	result := ImportListsHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*application.ImportListsResult)
+	require.True(t, isOk, "should be an ImportListsResult")
+	assert.True(t, res.Imported)
+	assert.Equal(t, 0, len(res.Errors))
+	require.Equal(t, 2, len(res.Lists))
+	assert.Equal(t, int32(12), res.Lists[0].ID)
+	assert.Equal(t, "list1", res.Lists[0].Name.String())
+	require.Equal(t, 2, len(res.Lists[0].Items))
+	assert.Equal(t, "item1", res.Lists[0].Items[0].Title.String())
+	assert.Equal(t, "desc1", res.Lists[0].Items[0].Description.String())
+	assert.Equal(t, "item3", res.Lists[0].Items[1].Title.String())
+	assert.Equal(t, int32(11), res.Lists[1].ID)
+	assert.Equal(t, "list2", res.Lists[1].Name.String())
+
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestImportListsHandler_Does_Not_Publish_Any_Event_If_Creating_The_Lists_Fails(t *testing.T) {
+	request := importRequest("lists.csv", "list,title\nlist1,item1\nlist2,item2", nil)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{
+		ListsRepository:      &mockedRepo,
+		QuotasRepository:     &mockedQuotasRepo,
+		CategoriesRepository: &mockedCategoriesRepo,
+		CfgSrv:               mockedCfgSrv,
+		EventBus:             &mockedEventBus,
+	}
+
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{})
+	mockedRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(0), nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list1", WorkspaceID: 1}).Return(false, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list2", WorkspaceID: 1}).Return(false, nil).Once()
+	mockedCategoriesRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+	mockedRepo.On("CreateLists", request.Context(), mock.AnythingOfType("[]*domain.ListRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := ImportListsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the user lists")
+	mockedRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+	mockedEventBus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
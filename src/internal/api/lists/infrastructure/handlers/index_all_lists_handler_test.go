@@ -8,21 +8,29 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestIndexAllListsHandler(t *testing.T) {
 	mockedEventBus := events.MockedEventBus{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
 
 	h := handler.Handler{
-		EventBus: &mockedEventBus,
+		EventBus:           &mockedEventBus,
+		AuditLogRepository: &mockedAuditLogRepo,
 	}
 
 	mockedEventBus.On("Publish", events.IndexAllListsRequested, nil)
 
 	request, _ := http.NewRequest(http.MethodPost, "/", nil)
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionListsIndexRequested && e.TargetType == audit.TargetLists && e.TargetID == nil
+	})).Return(nil).Once()
 
 	mockedEventBus.Wg.Add(1)
 	result := IndexAllListsHandler(httptest.NewRecorder(), request, h)
@@ -30,4 +38,5 @@ func TestIndexAllListsHandler(t *testing.T) {
 
 	results.CheckOkResult(t, result, http.StatusNoContent)
 	mockedEventBus.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
 }
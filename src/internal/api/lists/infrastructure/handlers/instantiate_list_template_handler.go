@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func InstantiateListTemplateHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	templateID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.InstantiateListTemplateInput)
+
+	srv := application.NewInstantiateListTemplateService(h.ListTemplatesRepository, h.ListsRepository, h.CategoriesRepository, h.TagsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
+	createdList, err := srv.InstantiateListTemplate(r.Context(), templateID, userID, workspaceID, input.Name, input.CategoryID, input.Variables)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: createdList, StatusCode: http.StatusCreated}
+}
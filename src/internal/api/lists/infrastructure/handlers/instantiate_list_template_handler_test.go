@@ -0,0 +1,134 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var instantiatedTemplate = domain.ListTemplateRecord{ID: 5, UserID: 3, Name: "packing", Items: `[{"title":"Hotel in {{destination}}","description":"desc"},{"title":"Passport","description":""}]`}
+
+func instantiateListTemplateInput(variables map[string]string) *infrastructure.InstantiateListTemplateInput {
+	nvo, _ := domain.NewListNameValueObject("Trip to Paris")
+	categoryID := int32(4)
+
+	return &infrastructure.InstantiateListTemplateInput{Name: nvo, CategoryID: &categoryID, Variables: variables}
+}
+
+func TestInstantiateListTemplateHandler_Returns_An_Error_If_The_Template_Is_Not_Accessible_By_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: instantiateListTemplateInput(nil)}
+
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := InstantiateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestInstantiateListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_Variable_Does_Not_Have_A_Value(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: instantiateListTemplateInput(map[string]string{"other": "value"})}
+
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(&instantiatedTemplate, nil).Once()
+
+	result := InstantiateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, `The variable "destination" doesn't have a value`)
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestInstantiateListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_List_With_The_Same_Name_Already_Exists(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, RequestInput: instantiateListTemplateInput(map[string]string{"destination": "Paris"})}
+
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(&instantiatedTemplate, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "Trip to Paris", WorkspaceID: 2}).Return(true, nil).Once()
+
+	result := InstantiateListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A list with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestInstantiateListTemplateHandler_Creates_A_List_With_The_Items_Of_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{
+		ListTemplatesRepository: &mockedRepo,
+		ListsRepository:         &mockedListsRepo,
+		QuotasRepository:        &mockedQuotasRepo,
+		CfgSrv:                  mockedCfgSrv,
+		EventBus:                &mockedEventBus,
+		RequestInput:            instantiateListTemplateInput(map[string]string{"destination": "Paris"}),
+	}
+
+	mockedRepo.On("FindAccessibleListTemplate", request.Context(), int32(5), int32(1)).Return(&instantiatedTemplate, nil).Once()
+	mockedListsRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "Trip to Paris", WorkspaceID: 2}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxLists: 3, MaxItemsPerList: 2})
+	mockedListsRepo.On("CountLists", request.Context(), domain.ListRecord{UserID: 1}).Return(int64(2), nil).Once()
+	expectedRecord := &domain.ListRecord{
+		Name:        "Trip to Paris",
+		UserID:      1,
+		WorkspaceID: 2,
+		CategoryID:  &sql.NullInt32{Int32: 4, Valid: true},
+		Items: []domain.ListItemRecord{
+			{UserID: 1, Title: "Hotel in Paris", Description: "desc", Position: 0},
+			{UserID: 1, Title: "Passport", Position: 1},
+		},
+	}
+	mockedListsRepo.On("CreateList", request.Context(), expectedRecord).Return(nil).Once().Run(func(args mock.Arguments) {
+		record := args.Get(1).(*domain.ListRecord)
+		record.ID = 11
+		record.Items[0].ID = 21
+		record.Items[1].ID = 22
+	})
+	mockedEventBus.On("Publish", events.ListCreated, mock.AnythingOfType("domain.ListEvent"))
+
+	mockedEventBus.Wg.Add(1)
+	result := InstantiateListTemplateHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	list, isOk := okRes.Content.(*domain.ListEntity)
+	require.True(t, isOk, "should be a pointer to ListEntity")
+	assert.Equal(t, int32(11), list.ID)
+	assert.Equal(t, "Trip to Paris", list.Name.String())
+	require.Equal(t, 2, len(list.Items))
+	assert.Equal(t, int32(21), list.Items[0].ID)
+	assert.Equal(t, "Hotel in Paris", list.Items[0].Title.String())
+	assert.Equal(t, int32(22), list.Items[1].ID)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func MergeTagsHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	tagID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.MergeTagsInput)
+
+	srv := application.NewMergeTagsService(h.TagsRepository, h.EventBus)
+	err := srv.MergeTags(r.Context(), tagID, input.TargetTagID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
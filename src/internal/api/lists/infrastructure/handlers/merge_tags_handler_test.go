@@ -0,0 +1,94 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestMergeTagsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Target_Is_The_Same_Tag(t *testing.T) {
+	h := handler.Handler{RequestInput: &infrastructure.MergeTagsInput{TargetTagID: 3}}
+
+	result := MergeTagsHandler(httptest.NewRecorder(), tagRequest(), h)
+
+	results.CheckBadRequestErrorResult(t, result, "A tag can't be merged into itself")
+}
+
+func TestMergeTagsHandler_Returns_An_Error_If_The_Query_To_Find_The_Tag_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.MergeTagsInput{TargetTagID: 4}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := MergeTagsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestMergeTagsHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Target_Tag_Does_Not_Exist(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.MergeTagsInput{TargetTagID: 4}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{ID: 4, UserID: 1}).Return(false, nil).Once()
+
+	result := MergeTagsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The target tag doesn't exist")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestMergeTagsHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Merging_The_Tags_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.MergeTagsInput{TargetTagID: 4}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{ID: 4, UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("GetTagListIDs", request.Context(), int32(3)).Return([]int32{}, nil).Once()
+	mockedRepo.On("MergeTags", request.Context(), int32(3), int32(4)).Return(fmt.Errorf("some error")).Once()
+
+	result := MergeTagsHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error merging the tags")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestMergeTagsHandler_Merges_The_Tags_And_Sends_The_ListTagsChanged_Events(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.MergeTagsInput{TargetTagID: 4}, EventBus: &mockedEventBus}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{ID: 4, UserID: 1}).Return(true, nil).Once()
+	mockedRepo.On("GetTagListIDs", request.Context(), int32(3)).Return([]int32{11}, nil).Once()
+	mockedRepo.On("MergeTags", request.Context(), int32(3), int32(4)).Return(nil).Once()
+	mockedEventBus.On("Publish", events.ListTagsChanged, domain.ListEvent{ListID: 11})
+
+	mockedEventBus.Wg.Add(1)
+	result := MergeTagsHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
@@ -12,10 +12,11 @@ import (
 func MoveListItemHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	listID := h.ParseInt32UrlVar(r, "id")
 	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	input, _ := h.RequestInput.(*infrastructure.MoveListItemInput)
 
-	srv := application.NewMoveListItemService(h.ListsRepository, h.EventBus)
-	if err := srv.MoveListItem(r.Context(), listID, input.OriginListItemID, input.DestinationListID, userID); err != nil {
+	srv := application.NewMoveListItemService(h.ListsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
+	if err := srv.MoveListItem(r.Context(), listID, input.OriginListItemID, input.DestinationListID, userID, workspaceID); err != nil {
 		return results.ErrorResult{Err: err}
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
@@ -29,6 +30,7 @@ func moveRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -42,7 +44,7 @@ func TestMoveListItemHandler_Returns_An_Error_If_The_Query_To_Find_The_Origin_Li
 
 	request := moveRequest()
 
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
 
@@ -59,9 +61,9 @@ func TestMoveListItemHandler_Returns_An_Error_If_The_Query_To_Find_The_Destinati
 
 	request := moveRequest()
 
-	originList := domain.ListRecord{ID: 11, UserID: 1, Name: "origin list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&originList, nil).Once()
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
 
@@ -78,10 +80,10 @@ func TestMoveListItemHandler_Returns_An_Error_If_The_ListItem_Does_Not_Exist_In_
 
 	request := moveRequest()
 
-	originList := domain.ListRecord{ID: 11, UserID: 1, Name: "origin list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&originList, nil).Once()
-	destinationList := domain.ListRecord{ID: 20, UserID: 1, Name: "destination list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, UserID: 1}).Return(&destinationList, nil).Once()
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	destinationList := domain.ListRecord{ID: 20, UserID: 1, WorkspaceID: 1, Name: "destination list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(&destinationList, nil).Once()
 
 	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
 
@@ -89,20 +91,53 @@ func TestMoveListItemHandler_Returns_An_Error_If_The_ListItem_Does_Not_Exist_In_
 	mockedRepo.AssertExpectations(t)
 }
 
+func TestMoveListItemHandler_Returns_An_Error_If_The_Destination_List_Would_Have_More_Items_Than_Allowed(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	h := handler.Handler{
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
+	}
+
+	request := moveRequest()
+
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list", Items: []domain.ListItemRecord{{ID: 5}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	destinationList := domain.ListRecord{ID: 20, UserID: 1, Name: "destination list", Items: []domain.ListItemRecord{{ID: 7}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(&destinationList, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 1})
+
+	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A list can't have more than 1 items")
+	assert.Equal(t, 1, len(originList.Items))
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
 func TestMoveListItemHandler_Returns_An_Error_If_Updating_The_Origin_List_Fails(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	h := handler.Handler{
-		ListsRepository: &mockedRepo,
-		RequestInput:    &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
 	}
 
 	request := moveRequest()
 
 	originListItem := domain.ListItemRecord{ID: 5}
-	originList := domain.ListRecord{ID: 11, UserID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&originList, nil).Once()
-	destinationList := domain.ListRecord{ID: 20, UserID: 1, Name: "destination list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, UserID: 1}).Return(&destinationList, nil).Once()
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	destinationList := domain.ListRecord{ID: 20, UserID: 1, WorkspaceID: 1, Name: "destination list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(&destinationList, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 2})
 	mockedRepo.On("UpdateList", request.Context(), &originList).Return(fmt.Errorf("some error")).Once()
 
 	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
@@ -113,18 +148,23 @@ func TestMoveListItemHandler_Returns_An_Error_If_Updating_The_Origin_List_Fails(
 
 func TestMoveListItemHandler_Returns_An_Error_If_The_Update_Of_The_Destination_List_Fails(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	h := handler.Handler{
-		ListsRepository: &mockedRepo,
-		RequestInput:    &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
 	}
 
 	request := moveRequest()
 
 	originListItem := domain.ListItemRecord{ID: 5}
-	originList := domain.ListRecord{ID: 11, UserID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&originList, nil).Once()
-	destinationList := domain.ListRecord{ID: 20, UserID: 1, Name: "destination list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, UserID: 1}).Return(&destinationList, nil).Once()
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	destinationList := domain.ListRecord{ID: 20, UserID: 1, WorkspaceID: 1, Name: "destination list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(&destinationList, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 2})
 	mockedRepo.On("UpdateList", request.Context(), &originList).Return(nil).Once()
 	destinationList.Items = []domain.ListItemRecord{originListItem}
 	mockedRepo.On("UpdateList", request.Context(), &destinationList).Return(fmt.Errorf("some error")).Once()
@@ -135,22 +175,27 @@ func TestMoveListItemHandler_Returns_An_Error_If_The_Update_Of_The_Destination_L
 	mockedRepo.AssertExpectations(t)
 }
 
-func TestMoveListItemHandler_Updates_The_Lists_An_And_Sends_Two_ListCreatedOrUpdated_Event(t *testing.T) {
+func TestMoveListItemHandler_Updates_The_Lists_And_Sends_The_ListUpdated_And_ListItemMoved_Events(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
 	mockedEventBus := events.MockedEventBus{}
 	h := handler.Handler{
-		ListsRepository: &mockedRepo,
-		RequestInput:    &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
-		EventBus:        &mockedEventBus,
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.MoveListItemInput{OriginListItemID: 5, DestinationListID: 20},
+		EventBus:         &mockedEventBus,
 	}
 
 	request := moveRequest()
 
-	originListItem := domain.ListItemRecord{ID: 5}
-	originList := domain.ListRecord{ID: 11, UserID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&originList, nil).Once()
-	destinationList := domain.ListRecord{ID: 20, UserID: 1, Name: "destination list"}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, UserID: 1}).Return(&destinationList, nil).Once()
+	originListItem := domain.ListItemRecord{ID: 5, Title: "item"}
+	originList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list", Items: []domain.ListItemRecord{originListItem}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&originList, nil).Once()
+	destinationList := domain.ListRecord{ID: 20, UserID: 1, WorkspaceID: 1, Name: "destination list"}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 20, WorkspaceID: 1}).Return(&destinationList, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 2})
 	mockedRepo.On("UpdateList", request.Context(), &originList).Run(func(args mock.Arguments) {
 		param := args.Get(1).(*domain.ListRecord)
 
@@ -162,10 +207,11 @@ func TestMoveListItemHandler_Updates_The_Lists_An_And_Sends_Two_ListCreatedOrUpd
 		assert.Equal(t, 1, len(param.Items))
 	}).Return(nil).Once()
 
-	mockedEventBus.On("Publish", events.ListUpdated, int32(11))
-	mockedEventBus.On("Publish", events.ListUpdated, int32(20))
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 1, Name: "origin list", PreviousName: "origin list"})
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 20, UserID: 1, WorkspaceID: 1, Name: "destination list", PreviousName: "destination list"})
+	mockedEventBus.On("Publish", events.ListItemMoved, domain.ListItemEvent{ItemID: 5, ListID: 11, UserID: 1, ListName: "origin list", Title: "item", DestinationListID: 20, DestinationListName: "destination list"})
 
-	mockedEventBus.Wg.Add(2)
+	mockedEventBus.Wg.Add(3)
 	result := MoveListItemHandler(httptest.NewRecorder(), request, h)
 	mockedEventBus.Wg.Wait()
 
@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func ReorderCategoriesHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.CategoriesOrderInput)
+
+	srv := application.NewReorderCategoriesService(h.CategoriesRepository)
+	err := srv.ReorderCategories(r.Context(), workspaceID, input.CategoryIDs)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
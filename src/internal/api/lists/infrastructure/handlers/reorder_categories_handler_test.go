@@ -0,0 +1,100 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func reorderCategoriesRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodPut, "/wadus", nil)
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestReorderCategoriesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Categories_Fails(t *testing.T) {
+	request := reorderCategoriesRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.CategoriesOrderInput{CategoryIDs: []int32{2, 1}},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ReorderCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user categories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestReorderCategoriesHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Order_Does_Not_Have_All_The_Categories_Once(t *testing.T) {
+	for _, categoryIDs := range [][]int32{{2}, {2, 1, 1}, {2, 1, 3}} {
+		request := reorderCategoriesRequest()
+
+		mockedRepo := listsRepository.MockedCategoriesRepository{}
+		h := handler.Handler{
+			CategoriesRepository: &mockedRepo,
+			RequestInput:         &infrastructure.CategoriesOrderInput{CategoryIDs: categoryIDs},
+		}
+
+		mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 1}, {ID: 2}}, nil).Once()
+
+		result := ReorderCategoriesHandler(httptest.NewRecorder(), request, h)
+
+		results.CheckBadRequestErrorResult(t, result, "The order must have all the categories once")
+		mockedRepo.AssertExpectations(t)
+	}
+}
+
+func TestReorderCategoriesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Reorder_Fails(t *testing.T) {
+	request := reorderCategoriesRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.CategoriesOrderInput{CategoryIDs: []int32{2, 1}},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 1}, {ID: 2}}, nil).Once()
+	mockedRepo.On("ReorderCategories", request.Context(), int32(1), []int32{2, 1}).Return(fmt.Errorf("some error")).Once()
+
+	result := ReorderCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error reordering the categories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestReorderCategoriesHandler_Reorders_The_Categories(t *testing.T) {
+	request := reorderCategoriesRequest()
+
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.CategoriesOrderInput{CategoryIDs: []int32{2, 1}},
+	}
+
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 1}, {ID: 2}}, nil).Once()
+	mockedRepo.On("ReorderCategories", request.Context(), int32(1), []int32{2, 1}).Return(nil).Once()
+
+	result := ReorderCategoriesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func RestoreListVersionHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+	version := h.ParseInt32UrlVar(r, "version")
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+
+	srv := application.NewRestoreListVersionService(h.ListsRepository, h.ListVersionsRepository, h.CategoriesRepository, h.TagsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
+	restoredList, err := srv.RestoreListVersion(r.Context(), listID, userID, workspaceID, version)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: restoredList, StatusCode: http.StatusOK}
+}
@@ -0,0 +1,128 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreListVersionHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := listVersionRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := RestoreListVersionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestRestoreListVersionHandler_Returns_An_Error_If_The_Query_To_Find_The_Version_Fails(t *testing.T) {
+	request := listVersionRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1}, nil).Once()
+	mockedVersionsRepo.On("FindListVersion", request.Context(), domain.ListVersionRecord{ListID: 11, Version: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := RestoreListVersionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedVersionsRepo.AssertExpectations(t)
+}
+
+func TestRestoreListVersionHandler_Returns_An_Error_If_The_Query_To_Check_The_Category_Fails(t *testing.T) {
+	request := listVersionRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	h := handler.Handler{ListsRepository: &mockedRepo, ListVersionsRepository: &mockedVersionsRepo, CategoriesRepository: &mockedCategoriesRepo}
+
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1}, nil).Once()
+	categoryID := int32(5)
+	foundVersion := domain.ListVersionRecord{ListID: 11, UserID: 1, Version: 2, Name: "list1", CategoryID: &categoryID, Items: "[]"}
+	mockedVersionsRepo.On("FindListVersion", request.Context(), domain.ListVersionRecord{ListID: 11, Version: 2}).Return(&foundVersion, nil).Once()
+	mockedCategoriesRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 5, WorkspaceID: 1}).Return(false, fmt.Errorf("some error")).Once()
+
+	result := RestoreListVersionHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error checking if the category exists")
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+}
+
+func TestRestoreListVersionHandler_Restores_The_Version_Without_The_Removed_Category(t *testing.T) {
+	request := listVersionRequest(http.MethodPost)
+
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedVersionsRepo := listsRepository.MockedListVersionsRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedEventBus := events.MockedEventBus{}
+	h := handler.Handler{
+		ListsRepository:        &mockedRepo,
+		ListVersionsRepository: &mockedVersionsRepo,
+		CategoriesRepository:   &mockedCategoriesRepo,
+		QuotasRepository:       &mockedQuotasRepo,
+		CfgSrv:                 mockedCfgSrv,
+		EventBus:               &mockedEventBus,
+	}
+
+	currentList := domain.ListRecord{ID: 11, UserID: 1, WorkspaceID: 1, Name: "list1", CategoryID: &sql.NullInt32{}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&currentList, nil).Twice()
+	categoryID := int32(5)
+	foundVersion := domain.ListVersionRecord{ListID: 11, UserID: 1, Version: 2, Name: "list1", CategoryID: &categoryID, Items: `[{"id":3,"title":"item","description":"desc","position":0}]`}
+	mockedVersionsRepo.On("FindListVersion", request.Context(), domain.ListVersionRecord{ListID: 11, Version: 2}).Return(&foundVersion, nil).Once()
+	mockedCategoriesRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{ID: 5, WorkspaceID: 1}).Return(false, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 1})
+	restoredRecord := domain.ListRecord{
+		ID:          11,
+		UserID:      1,
+		WorkspaceID: 1,
+		Name:        "list1",
+		CategoryID:  &sql.NullInt32{},
+		Items:       []domain.ListItemRecord{{ID: 3, ListID: 11, UserID: 1, Title: "item", Description: "desc", Position: 0}},
+	}
+	mockedRepo.On("UpdateListWithVersion", request.Context(), &restoredRecord, listVersionOf(11, "list1")).Return(nil).Once()
+
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1"})
+	mockedEventBus.On("Publish", events.ListItemAdded, domain.ListItemEvent{ItemID: 3, ListID: 11, UserID: 1, ListName: "list1", Title: "item"})
+
+	mockedEventBus.Wg.Add(2)
+	result := RestoreListVersionHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.ListEntity)
+	require.True(t, isOk, "should be a ListEntity")
+	assert.Nil(t, res.CategoryID)
+	require.Len(t, res.Items, 1)
+	assert.Equal(t, "item", res.Items[0].Title.String())
+
+	mockedRepo.AssertExpectations(t)
+	mockedVersionsRepo.AssertExpectations(t)
+	mockedCategoriesRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
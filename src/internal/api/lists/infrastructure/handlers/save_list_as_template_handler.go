@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func SaveListAsTemplateHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	listID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.SaveListAsTemplateInput)
+
+	srv := application.NewSaveListAsTemplateService(h.ListTemplatesRepository, h.ListsRepository)
+	createdTemplate, err := srv.SaveListAsTemplate(r.Context(), listID, userID, workspaceID, input.Name)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: createdTemplate, StatusCode: http.StatusCreated}
+}
@@ -0,0 +1,88 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveListAsTemplateHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "11"})
+
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.SaveListAsTemplateInput{}}
+
+	mockedListsRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 2}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := SaveListAsTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestSaveListAsTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_Template_With_The_Same_Name_Already_Exists(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.SaveListAsTemplateInput{}}
+
+	mockedListsRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 2}).Return(&domain.ListRecord{ID: 11, Name: "list1"}, nil).Once()
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "list1"}).Return(true, nil).Once()
+
+	result := SaveListAsTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A template with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
+
+func TestSaveListAsTemplateHandler_Creates_A_Template_Of_The_User_With_The_Items_Of_The_List(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "11"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedListsRepo := listsRepository.MockedListsRepository{}
+	templateName, _ := domain.NewListNameValueObject("packing")
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, ListsRepository: &mockedListsRepo, RequestInput: &infrastructure.SaveListAsTemplateInput{Name: &templateName}}
+
+	dueDate := time.Now()
+	foundList := domain.ListRecord{ID: 11, UserID: 3, Name: "list1", Items: []domain.ListItemRecord{
+		{ID: 21, Title: "Passport", Position: 0, DueDate: &dueDate},
+		{ID: 22, Title: "Hotel in {{destination}}", Description: "desc", Position: 1},
+	}}
+	var createdRecord *domain.ListTemplateRecord
+	mockedListsRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 2}).Return(&foundList, nil).Once()
+	mockedRepo.On("ExistsListTemplate", request.Context(), domain.ListTemplateRecord{UserID: 1, Name: "packing"}).Return(false, nil).Once()
+	mockedRepo.On("CreateListTemplate", request.Context(), mock.AnythingOfType("*domain.ListTemplateRecord")).Return(nil).Once().Run(func(args mock.Arguments) {
+		createdRecord = args.Get(1).(*domain.ListTemplateRecord)
+		createdRecord.ID = 5
+	})
+
+	result := SaveListAsTemplateHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	template, isOk := okRes.Content.(*domain.ListTemplateEntity)
+	require.True(t, isOk, "should be a pointer to ListTemplateEntity")
+	assert.Equal(t, int32(5), template.ID)
+	assert.Equal(t, int32(1), template.UserID)
+	assert.Equal(t, "packing", template.Name.String())
+	assert.Equal(t, []string{"destination"}, template.Variables)
+	require.NotNil(t, createdRecord)
+	assert.Equal(t, `[{"title":"Passport","description":""},{"title":"Hotel in {{destination}}","description":"desc"}]`, createdRecord.Items)
+	mockedRepo.AssertExpectations(t)
+	mockedListsRepo.AssertExpectations(t)
+}
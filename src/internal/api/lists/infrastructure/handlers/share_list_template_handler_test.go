@@ -0,0 +1,128 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	authRepository "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShareListTemplateHandler_Returns_An_Error_If_The_Template_Is_Not_Owned_By_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 3}}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestShareListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_It_Is_Shared_With_The_Owner(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 1}}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A template can't be shared with its owner")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestShareListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_User_Does_Not_Exist(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, UsersRepository: &mockedUsersRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 3}}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 3}).Return(false, nil).Once()
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user doesn't exist")
+	mockedRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestShareListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_It_Is_Already_Shared_With_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, UsersRepository: &mockedUsersRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 3}}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 3}).Return(true, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}).Return(true, nil).Once()
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The template is already shared with the user")
+	mockedRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestShareListTemplateHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Create_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, UsersRepository: &mockedUsersRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 3}}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 3}).Return(true, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}).Return(false, nil).Once()
+	mockedRepo.On("CreateListTemplateShare", request.Context(), mock.AnythingOfType("*domain.ListTemplateShareRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error sharing the template")
+	mockedRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestShareListTemplateHandler_Shares_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodPost, map[string]string{"id": "5"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo, UsersRepository: &mockedUsersRepo, RequestInput: &infrastructure.ListTemplateShareInput{UserID: 3}}
+
+	var createdShare *domain.ListTemplateShareRecord
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 3}).Return(true, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}).Return(false, nil).Once()
+	mockedRepo.On("CreateListTemplateShare", request.Context(), mock.AnythingOfType("*domain.ListTemplateShareRecord")).Return(nil).Once().Run(func(args mock.Arguments) {
+		createdShare = args.Get(1).(*domain.ListTemplateShareRecord)
+	})
+
+	result := ShareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusCreated)
+	assert.Equal(t, int32(5), createdShare.TemplateID)
+	assert.Equal(t, int32(3), createdShare.UserID)
+	assert.False(t, createdShare.CreatedAt.IsZero())
+	mockedRepo.AssertExpectations(t)
+	mockedUsersRepo.AssertExpectations(t)
+}
@@ -0,0 +1,79 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func TestUnshareListTemplateHandler_Returns_An_Error_If_The_Template_Is_Not_Owned_By_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5", "userId": "3"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := UnshareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUnshareListTemplateHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_It_Is_Not_Shared_With_The_User(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5", "userId": "3"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}).Return(false, nil).Once()
+
+	result := UnshareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The template isn't shared with the user")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUnshareListTemplateHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Delete_Fails(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5", "userId": "3"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	query := domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), query).Return(true, nil).Once()
+	mockedRepo.On("DeleteListTemplateShare", request.Context(), query).Return(fmt.Errorf("some error")).Once()
+
+	result := UnshareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error unsharing the template")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUnshareListTemplateHandler_Unshares_The_Template(t *testing.T) {
+	request := listTemplatesRequest(http.MethodDelete, map[string]string{"id": "5", "userId": "3"})
+
+	mockedRepo := listsRepository.MockedListTemplatesRepository{}
+	h := handler.Handler{ListTemplatesRepository: &mockedRepo}
+
+	query := domain.ListTemplateShareRecord{TemplateID: 5, UserID: 3}
+	mockedRepo.On("FindListTemplate", request.Context(), domain.ListTemplateRecord{ID: 5, UserID: 1}).Return(&domain.ListTemplateRecord{ID: 5}, nil).Once()
+	mockedRepo.On("ExistsListTemplateShare", request.Context(), query).Return(true, nil).Once()
+	mockedRepo.On("DeleteListTemplateShare", request.Context(), query).Return(nil).Once()
+
+	result := UnshareListTemplateHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
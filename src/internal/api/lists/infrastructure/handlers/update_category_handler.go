@@ -12,14 +12,11 @@ import (
 func UpdateCategoryHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	categoryID := h.ParseInt32UrlVar(r, "id")
 	userID := h.GetUserIDFromContext(r)
-	input, _ := h.RequestInput.(*infrastructure.CategoryInput)
-
-	categoryEntity := input.ToCategoryEntity()
-	categoryEntity.ID = categoryID
-	categoryEntity.UserID = userID
+	workspaceID := h.GetWorkspaceIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.UpdateCategoryInput)
 
 	srv := application.NewUpdateCategoryService(h.CategoriesRepository)
-	err := srv.UpdateCategory(r.Context(), categoryEntity)
+	categoryEntity, err := srv.UpdateCategory(r.Context(), categoryID, userID, workspaceID, input.ToCategoryUpdate())
 	if err != nil {
 		return results.ErrorResult{Err: err}
 	}
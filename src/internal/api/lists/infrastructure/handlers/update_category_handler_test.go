@@ -5,6 +5,8 @@ package handlers
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -28,6 +30,7 @@ func updateCategoryRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
@@ -37,12 +40,12 @@ func TestUpdateCategoryHandler_Returns_An_Error_If_The_Query_To_Find_The_Categor
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
-		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo},
 	}
 
 	request := updateCategoryRequest()
 
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -50,22 +53,22 @@ func TestUpdateCategoryHandler_Returns_An_Error_If_The_Query_To_Find_The_Categor
 	mockedRepo.AssertExpectations(t)
 }
 
-func TestUpdateCategoryHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Is_Trying_To_Update_The_Category_Name_But_The_Query_To_Check_If_The_Name_Exists_Fails(t *testing.T) {
+func TestUpdateCategoryHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Getting_The_Categories_Fails(t *testing.T) {
 	mockedRepo := listsRepository.MockedCategoriesRepository{}
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
-		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo},
 	}
 
 	request := updateCategoryRequest()
 
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "oldName"}, nil).Once()
-	mockedRepo.On("ExistsCategory", request.Context(), domain.CategoryRecord{Name: "category1", UserID: 1}).Return(false, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "oldName"}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
 
-	results.CheckError(t, result, "Error checking if a category with the same name already exists")
+	results.CheckUnexpectedErrorResult(t, result, "Error getting all user categories")
 	mockedRepo.AssertExpectations(t)
 }
 
@@ -74,18 +77,21 @@ func TestUpdateCategoryHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
-		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo},
 	}
 
 	request := updateCategoryRequest()
 
 	category := domain.CategoryRecord{
-		ID:     int32(11),
-		Name:   "category1",
-		UserID: 1,
+		ID:          int32(11),
+		Name:        "category1",
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{},
 	}
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1"}, nil).Once()
-	mockedRepo.On("UpdateCategory", request.Context(), &category).Return(fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1, WorkspaceID: 1}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}}, nil).Once()
+	mockedRepo.On("UpdateCategory", request.Context(), &category, []string{"Name"}).Return(fmt.Errorf("some error")).Once()
 
 	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -98,18 +104,22 @@ func TestUpdateCategoryHandler_Updates_The_Category(t *testing.T) {
 	nvo, _ := domain.NewCategoryNameValueObject("category1")
 	h := handler.Handler{
 		CategoriesRepository: &mockedRepo,
-		RequestInput:         &infrastructure.CategoryInput{Name: nvo},
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo},
 	}
 
 	request := updateCategoryRequest()
 
 	recordToUpdate := domain.CategoryRecord{
-		ID:     11,
-		Name:   "category1",
-		UserID: 1,
+		ID:          11,
+		Name:        "category1",
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{},
 	}
-	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, UserID: 1}).Return(&recordToUpdate, nil).Once()
-	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate).Return(nil).Once()
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&recordToUpdate, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{recordToUpdate}, nil).Once()
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"Name"}).Return(nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
 
 	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
 
@@ -120,3 +130,266 @@ func TestUpdateCategoryHandler_Updates_The_Category(t *testing.T) {
 
 	mockedRepo.AssertExpectations(t)
 }
+
+func TestUpdateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If_A_Sibling_Has_The_Same_Name(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	parentID := int32(12)
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo, HasParentID: true, ParentID: &parentID},
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1"}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 11, Name: "category1"},
+		{ID: 12, Name: "parent"},
+		{ID: 13, Name: "category1", ParentID: &sql.NullInt32{Int32: 12, Valid: true}},
+	}, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A category with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_Category_Is_Moved_Inside_A_Subcategory(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	parentID := int32(12)
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo, HasParentID: true, ParentID: &parentID},
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1"}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 11, Name: "category1"},
+		{ID: 12, Name: "child", ParentID: &sql.NullInt32{Int32: 11, Valid: true}},
+	}, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A category can't be moved inside itself or one of its subcategories")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Moves_The_Category(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	parentID := int32(12)
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo, HasParentID: true, ParentID: &parentID},
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 2}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 11, Name: "category1"},
+		{ID: 12, Name: "parent"},
+	}, nil).Once()
+	recordToUpdate := domain.CategoryRecord{
+		ID:          11,
+		Name:        "category1",
+		UserID:      2,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{Int32: 12, Valid: true},
+	}
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"Name", "ParentID"}).Return(nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.Equal(t, int32(12), *res.ParentID)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Keeps_The_Parent_When_The_Body_Does_Not_Have_It(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	input := infrastructure.UpdateCategoryInput{}
+	require.Nil(t, json.Unmarshal([]byte(`{"name":"newName","description":"new description"}`), &input))
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &input,
+	}
+
+	request := updateCategoryRequest()
+
+	parentID := sql.NullInt32{Int32: 12, Valid: true}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1, WorkspaceID: 1, ParentID: &parentID, Color: "#1a2b3c", Icon: "star"}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 11, Name: "category1", ParentID: &parentID},
+		{ID: 12, Name: "parent"},
+	}, nil).Once()
+	recordToUpdate := domain.CategoryRecord{
+		ID:          11,
+		Name:        "newName",
+		Description: "new description",
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &parentID,
+		Color:       "#1a2b3c",
+		Icon:        "star",
+	}
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"Name", "Description"}).Return(nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.Equal(t, "newName", res.Name.String())
+	assert.Equal(t, int32(12), *res.ParentID)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Moves_The_Category_To_The_Root_When_The_Parent_Is_Null(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	input := infrastructure.UpdateCategoryInput{}
+	require.Nil(t, json.Unmarshal([]byte(`{"parentId":null}`), &input))
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &input,
+	}
+
+	request := updateCategoryRequest()
+
+	parentID := sql.NullInt32{Int32: 12, Valid: true}
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1, WorkspaceID: 1, ParentID: &parentID}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{
+		{ID: 11, Name: "category1", ParentID: &parentID},
+		{ID: 12, Name: "parent"},
+	}, nil).Once()
+	recordToUpdate := domain.CategoryRecord{
+		ID:          11,
+		Name:        "category1",
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{},
+	}
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"ParentID"}).Return(nil).Once()
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(nil, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.Nil(t, res.ParentID)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Sets_The_Category_As_The_Default_One_Of_The_User_Doing_The_Update(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	cvo, _ := domain.NewCategoryColorValueObject("#1a2b3c")
+	isDefault := true
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo, Color: &cvo, IsDefault: &isDefault},
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 2, Position: 3}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}}, nil).Once()
+	recordToUpdate := domain.CategoryRecord{
+		ID:          11,
+		Name:        "category1",
+		UserID:      2,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{},
+		Color:       "#1a2b3c",
+		Position:    3,
+	}
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"Name", "Color"}).Return(nil).Once()
+	otherCategoryID := int32(5)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&otherCategoryID, nil).Once()
+	categoryID := int32(11)
+	mockedRepo.On("SetDefaultCategory", request.Context(), int32(1), int32(1), &categoryID).Return(nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.True(t, res.IsDefault)
+	assert.Equal(t, "#1a2b3c", res.Color.String())
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Keeps_The_Color_The_Icon_And_The_Default_Category_When_The_Body_Does_Not_Have_Them(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	input := infrastructure.UpdateCategoryInput{}
+	require.Nil(t, json.Unmarshal([]byte(`{"name":"newName"}`), &input))
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &input,
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1, WorkspaceID: 1, Color: "#1a2b3c", Icon: "star"}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}}, nil).Once()
+	recordToUpdate := domain.CategoryRecord{
+		ID:          11,
+		Name:        "newName",
+		UserID:      1,
+		WorkspaceID: 1,
+		ParentID:    &sql.NullInt32{},
+		Color:       "#1a2b3c",
+		Icon:        "star",
+	}
+	mockedRepo.On("UpdateCategory", request.Context(), &recordToUpdate, []string{"Name"}).Return(nil).Once()
+	categoryID := int32(11)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&categoryID, nil).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.CategoryEntity)
+	require.True(t, isOk, "should be a CategoryEntity")
+	assert.Equal(t, "#1a2b3c", res.Color.String())
+	assert.Equal(t, "star", res.Icon.String())
+	assert.True(t, res.IsDefault)
+
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategoryHandler_Removes_The_Default_Category_Of_The_User(t *testing.T) {
+	mockedRepo := listsRepository.MockedCategoriesRepository{}
+	nvo, _ := domain.NewCategoryNameValueObject("category1")
+	isDefault := false
+	h := handler.Handler{
+		CategoriesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.UpdateCategoryInput{Name: &nvo, IsDefault: &isDefault},
+	}
+
+	request := updateCategoryRequest()
+
+	mockedRepo.On("FindCategory", request.Context(), domain.CategoryRecord{ID: 11, WorkspaceID: 1}).Return(&domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1}, nil).Once()
+	mockedRepo.On("GetCategories", request.Context(), domain.CategoryRecord{WorkspaceID: 1}).Return(domain.CategoryRecords{{ID: 11, Name: "category1"}}, nil).Once()
+	mockedRepo.On("UpdateCategory", request.Context(), &domain.CategoryRecord{ID: 11, Name: "category1", UserID: 1, WorkspaceID: 1, ParentID: &sql.NullInt32{}}, []string{"Name"}).Return(nil).Once()
+	categoryID := int32(11)
+	mockedRepo.On("FindDefaultCategoryID", request.Context(), int32(1), int32(1)).Return(&categoryID, nil).Once()
+	mockedRepo.On("SetDefaultCategory", request.Context(), int32(1), int32(1), (*int32)(nil)).Return(fmt.Errorf("some error")).Once()
+
+	result := UpdateCategoryHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error setting the default category")
+	mockedRepo.AssertExpectations(t)
+}
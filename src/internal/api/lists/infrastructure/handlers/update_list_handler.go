@@ -12,17 +12,19 @@ import (
 func UpdateListHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
 	listID := h.ParseInt32UrlVar(r, "id")
 	userID := h.GetUserIDFromContext(r)
+	workspaceID := h.GetWorkspaceIDFromContext(r)
 	input, _ := h.RequestInput.(*infrastructure.ListInput)
 
 	listEntity := input.ToListEntity()
 	listEntity.ID = listID
 	listEntity.UserID = userID
+	listEntity.WorkspaceID = workspaceID
 	for _, v := range listEntity.Items {
 		v.ListID = listID
 		v.UserID = userID
 	}
 
-	srv := application.NewUpdateListService(h.ListsRepository, h.EventBus)
+	srv := application.NewUpdateListService(h.ListsRepository, h.TagsRepository, h.QuotasRepository, h.CfgSrv, h.EventBus)
 	err := srv.UpdateList(r.Context(), listEntity)
 	if err != nil {
 		return results.ErrorResult{Err: err}
@@ -14,12 +14,14 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -30,10 +32,17 @@ func updateRequest() *http.Request {
 	})
 	ctx := request.Context()
 	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+	ctx = context.WithValue(ctx, consts.ReqContextWorkspaceIDKey, int32(1))
 
 	return request.WithContext(ctx)
 }
 
+func listVersionOf(listID int32, name string) interface{} {
+	return mock.MatchedBy(func(r *domain.ListVersionRecord) bool {
+		return r.ListID == listID && r.Name == name
+	})
+}
+
 func TestUpdateListHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
 	listName, _ := domain.NewListNameValueObject("list1")
@@ -44,7 +53,7 @@ func TestUpdateListHandler_Returns_An_Error_If_The_Query_To_Find_The_List_Fails(
 
 	request := updateRequest()
 
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(nil, fmt.Errorf("some error")).Once()
 
 	result := UpdateListHandler(httptest.NewRecorder(), request, h)
 
@@ -63,8 +72,8 @@ func TestUpdateListHandler_Returns_An_Error_Result_With_An_UnexpectedError_If_Is
 	request := updateRequest()
 
 	foundList := domain.ListRecord{ID: 11, Name: "oldName", UserID: 11}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&foundList, nil).Once()
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list1", UserID: 1}).Return(false, fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list1", WorkspaceID: 1}).Return(false, fmt.Errorf("some error")).Once()
 
 	result := UpdateListHandler(httptest.NewRecorder(), request, h)
 
@@ -83,14 +92,15 @@ func TestUpdateListHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Upd
 	request := updateRequest()
 
 	foundList := domain.ListRecord{
-		ID:         int32(11),
-		Name:       "list1",
-		UserID:     1,
-		Items:      []domain.ListItemRecord{},
-		CategoryID: &sql.NullInt32{Valid: false},
+		ID:          int32(11),
+		Name:        "list1",
+		UserID:      1,
+		WorkspaceID: 1,
+		Items:       []domain.ListItemRecord{},
+		CategoryID:  &sql.NullInt32{Valid: false},
 	}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&foundList, nil).Once()
-	mockedRepo.On("UpdateList", request.Context(), &foundList).Return(fmt.Errorf("some error")).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedRepo.On("UpdateListWithVersion", request.Context(), &foundList, listVersionOf(11, "list1")).Return(fmt.Errorf("some error")).Once()
 
 	result := UpdateListHandler(httptest.NewRecorder(), request, h)
 
@@ -112,17 +122,19 @@ func TestUpdateListHandler_Updates_The_List_Name_And_Sends_The_ListCreatedOrUpda
 	request := updateRequest()
 
 	recordToUpdate := domain.ListRecord{
-		ID:         int32(11),
-		Name:       "list new name",
-		UserID:     1,
-		Items:      []domain.ListItemRecord{},
-		CategoryID: &sql.NullInt32{Int32: 5, Valid: true},
+		ID:          int32(11),
+		Name:        "list new name",
+		UserID:      1,
+		WorkspaceID: 1,
+		Items:       []domain.ListItemRecord{},
+		CategoryID:  &sql.NullInt32{Int32: 5, Valid: true},
 	}
-	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, UserID: 1}).Return(&domain.ListRecord{Name: "list1"}, nil).Once()
-	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list new name", UserID: 1}).Return(false, nil).Once()
-	mockedRepo.On("UpdateList", request.Context(), &recordToUpdate).Return(nil).Once()
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&domain.ListRecord{Name: "list1", UserID: 1, WorkspaceID: 1}, nil).Once()
+	mockedRepo.On("ExistsList", request.Context(), domain.ListRecord{Name: "list new name", WorkspaceID: 1}).Return(false, nil).Once()
+	mockedRepo.On("UpdateListWithVersion", request.Context(), &recordToUpdate, listVersionOf(0, "list1")).Return(nil).Once()
 
-	mockedEventBus.On("Publish", events.ListUpdated, int32(11))
+	categoryID := int32(5)
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 1, Name: "list new name", PreviousName: "list1", CategoryID: &categoryID})
 
 	mockedEventBus.Wg.Add(1)
 	result := UpdateListHandler(httptest.NewRecorder(), request, h)
@@ -137,3 +149,99 @@ func TestUpdateListHandler_Updates_The_List_Name_And_Sends_The_ListCreatedOrUpda
 	mockedRepo.AssertExpectations(t)
 	mockedEventBus.AssertExpectations(t)
 }
+
+func TestUpdateListHandler_Sends_The_Events_Of_The_Added_Renamed_And_Removed_Items(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	listName, _ := domain.NewListNameValueObject("list1")
+	renamedTitle, _ := domain.NewItemTitleValueObject("item renamed")
+	addedTitle, _ := domain.NewItemTitleValueObject("item added")
+	h := handler.Handler{
+		ListsRepository: &mockedRepo,
+		RequestInput:    &infrastructure.ListInput{Name: listName, Items: []infrastructure.ListItemInput{{ID: 1, Title: renamedTitle}, {Title: addedTitle}}},
+		EventBus:        &mockedEventBus,
+	}
+
+	request := updateRequest()
+
+	foundList := domain.ListRecord{ID: 11, UserID: 1, Name: "list1", Items: []domain.ListItemRecord{{ID: 1, Title: "item"}, {ID: 2, Title: "item removed"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedRepo.On("UpdateListWithVersion", request.Context(), mock.AnythingOfType("*domain.ListRecord"), listVersionOf(11, "list1")).Run(func(args mock.Arguments) {
+		record := args.Get(1).(*domain.ListRecord)
+		record.Items[1].ID = 3
+	}).Return(nil).Once()
+
+	mockedEventBus.On("Publish", events.ListUpdated, domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 1, Name: "list1", PreviousName: "list1"})
+	mockedEventBus.On("Publish", events.ListItemAdded, domain.ListItemEvent{ItemID: 3, ListID: 11, UserID: 1, ListName: "list1", Title: "item added"})
+	mockedEventBus.On("Publish", events.ListItemRenamed, domain.ListItemEvent{ItemID: 1, ListID: 11, UserID: 1, ListName: "list1", Title: "item renamed", PreviousTitle: "item"})
+	mockedEventBus.On("Publish", events.ListItemRemoved, domain.ListItemEvent{ItemID: 2, ListID: 11, UserID: 1, ListName: "list1", Title: "item removed"})
+
+	mockedEventBus.Wg.Add(4)
+	result := UpdateListHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusOK)
+	mockedRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestUpdateListHandler_Returns_An_Error_Result_With_A_BadRequestError_If_The_List_Would_Have_More_Items_Than_Allowed(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	listName, _ := domain.NewListNameValueObject("list1")
+	title1, _ := domain.NewItemTitleValueObject("item1")
+	title2, _ := domain.NewItemTitleValueObject("item2")
+	h := handler.Handler{
+		ListsRepository:  &mockedRepo,
+		QuotasRepository: &mockedQuotasRepo,
+		CfgSrv:           mockedCfgSrv,
+		RequestInput:     &infrastructure.ListInput{Name: listName, Items: []infrastructure.ListItemInput{{ID: 1, Title: title1}, {Title: title2}}},
+	}
+
+	request := updateRequest()
+
+	foundList := domain.ListRecord{ID: 11, UserID: 1, Name: "list1", Items: []domain.ListItemRecord{{ID: 1, Title: "item1"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockQuotaLimits(request.Context(), &mockedQuotasRepo, mockedCfgSrv, domain.QuotaLimits{MaxItemsPerList: 1})
+
+	result := UpdateListHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A list can't have more than 1 items")
+	mockedRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedCfgSrv.AssertExpectations(t)
+}
+
+func TestUpdateListHandler_Saves_The_Tags_Of_The_User_Doing_The_Update(t *testing.T) {
+	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	listName, _ := domain.NewListNameValueObject("list1")
+	itemTitle, _ := domain.NewItemTitleValueObject("item")
+	h := handler.Handler{
+		ListsRepository: &mockedRepo,
+		TagsRepository:  &mockedTagsRepo,
+		RequestInput:    &infrastructure.ListInput{Name: listName, Items: []infrastructure.ListItemInput{{ID: 3, Title: itemTitle, Tags: []string{"urgent"}}}, Tags: []string{}},
+		EventBus:        &mockedEventBus,
+	}
+
+	request := updateRequest()
+
+	foundList := domain.ListRecord{ID: 11, UserID: 2, Name: "list1", Items: []domain.ListItemRecord{{ID: 3, Title: "item"}}}
+	mockedRepo.On("FindList", request.Context(), domain.ListRecord{ID: 11, WorkspaceID: 1}).Return(&foundList, nil).Once()
+	mockedRepo.On("UpdateListWithVersion", request.Context(), mock.AnythingOfType("*domain.ListRecord"), mock.Anything).Return(nil).Once()
+	mockedTagsRepo.On("SetListTags", request.Context(), int32(1), int32(11), []string{}).Return(nil).Once()
+	mockedTagsRepo.On("SetItemTags", request.Context(), int32(1), int32(3), []string{"urgent"}).Return(nil).Once()
+	mockedEventBus.On("Publish", events.ListUpdated, mock.AnythingOfType("domain.ListEvent"))
+
+	mockedEventBus.Wg.Add(1)
+	result := UpdateListHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	results.CheckOkResult(t, result, http.StatusOK)
+
+	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
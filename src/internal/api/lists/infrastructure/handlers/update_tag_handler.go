@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func UpdateTagHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	tagID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.TagInput)
+
+	tagEntity := &domain.TagEntity{ID: tagID, Name: input.Name, UserID: userID}
+
+	srv := application.NewUpdateTagService(h.TagsRepository, h.EventBus)
+	err := srv.UpdateTag(r.Context(), tagEntity)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: tagEntity, StatusCode: http.StatusOK}
+}
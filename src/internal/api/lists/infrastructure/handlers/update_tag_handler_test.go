@@ -0,0 +1,126 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tagRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, map[string]string{
+		"id": "3",
+	})
+	ctx := request.Context()
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestUpdateTagHandler_Returns_An_Error_If_The_Query_To_Find_The_Tag_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("house")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := UpdateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, "some error")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateTagHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_Tag_With_The_New_Name_Already_Exists(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("house")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "house"}).Return(true, nil).Once()
+
+	result := UpdateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A tag with the same name already exists")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateTagHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Updating_The_Tag_Fails(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("house")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "house"}).Return(false, nil).Once()
+	mockedRepo.On("UpdateTag", request.Context(), &domain.TagRecord{ID: 3, UserID: 1, Name: "house"}).Return(fmt.Errorf("some error")).Once()
+
+	result := UpdateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error updating the user tag")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestUpdateTagHandler_Renames_The_Tag_And_Sends_The_ListTagsChanged_Events(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	mockedEventBus := events.MockedEventBus{}
+	nvo, _ := domain.NewTagNameValueObject("house")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}, EventBus: &mockedEventBus}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+	mockedRepo.On("ExistsTag", request.Context(), domain.TagRecord{UserID: 1, Name: "house"}).Return(false, nil).Once()
+	mockedRepo.On("UpdateTag", request.Context(), &domain.TagRecord{ID: 3, UserID: 1, Name: "house"}).Return(nil).Once()
+	mockedRepo.On("GetTagListIDs", request.Context(), int32(3)).Return([]int32{11, 12}, nil).Once()
+	mockedEventBus.On("Publish", events.ListTagsChanged, domain.ListEvent{ListID: 11})
+	mockedEventBus.On("Publish", events.ListTagsChanged, domain.ListEvent{ListID: 12})
+
+	mockedEventBus.Wg.Add(2)
+	result := UpdateTagHandler(httptest.NewRecorder(), request, h)
+	mockedEventBus.Wg.Wait()
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.(*domain.TagEntity)
+	require.True(t, isOk, "should be a TagEntity")
+	assert.Equal(t, int32(3), res.ID)
+	assert.Equal(t, "house", res.Name.String())
+
+	mockedRepo.AssertExpectations(t)
+	mockedEventBus.AssertExpectations(t)
+}
+
+func TestUpdateTagHandler_Does_Nothing_If_The_Name_Does_Not_Change(t *testing.T) {
+	request := tagRequest()
+
+	mockedRepo := listsRepository.MockedTagsRepository{}
+	nvo, _ := domain.NewTagNameValueObject("home")
+	h := handler.Handler{TagsRepository: &mockedRepo, RequestInput: &infrastructure.TagInput{Name: nvo}}
+
+	mockedRepo.On("FindTag", request.Context(), domain.TagRecord{ID: 3, UserID: 1}).Return(&domain.TagRecord{ID: 3, UserID: 1, Name: "home"}, nil).Once()
+
+	result := UpdateTagHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusOK)
+	mockedRepo.AssertExpectations(t)
+}
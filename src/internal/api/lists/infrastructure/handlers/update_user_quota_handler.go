@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+)
+
+func UpdateUserQuotaHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.ParseInt32UrlVar(r, "id")
+	input, _ := h.RequestInput.(*infrastructure.UserQuotaInput)
+
+	quota := &domain.UserQuotaRecord{UserID: userID, MaxLists: input.MaxLists, MaxItemsPerList: input.MaxItemsPerList, MaxCategories: input.MaxCategories}
+
+	srv := application.NewUpdateUserQuotaService(h.QuotasRepository, h.UsersRepository, h.AuditLogRepository)
+	err := srv.UpdateUserQuota(r.Context(), quota)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
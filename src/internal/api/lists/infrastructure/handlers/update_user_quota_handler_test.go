@@ -0,0 +1,102 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	authRepository "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/mock"
+)
+
+func updateUserQuotaRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodPut, "/wadus", nil)
+
+	return mux.SetURLVars(request, map[string]string{"id": "5"})
+}
+
+func TestUpdateUserQuotaHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_A_Limit_Is_Negative(t *testing.T) {
+	request := updateUserQuotaRequest()
+
+	maxLists := int32(-1)
+	h := handler.Handler{RequestInput: &infrastructure.UserQuotaInput{MaxLists: &maxLists}}
+
+	result := UpdateUserQuotaHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The quota limits can't be negative")
+}
+
+func TestUpdateUserQuotaHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_User_Does_Not_Exist(t *testing.T) {
+	request := updateUserQuotaRequest()
+
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, RequestInput: &infrastructure.UserQuotaInput{}}
+
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 5}).Return(false, nil).Once()
+
+	result := UpdateUserQuotaHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user doesn't exist")
+	mockedUsersRepo.AssertExpectations(t)
+}
+
+func TestUpdateUserQuotaHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Saving_The_Quota_Fails(t *testing.T) {
+	request := updateUserQuotaRequest()
+
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	h := handler.Handler{UsersRepository: &mockedUsersRepo, QuotasRepository: &mockedQuotasRepo, RequestInput: &infrastructure.UserQuotaInput{}}
+
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 5}).Return(true, nil).Once()
+	mockedQuotasRepo.On("GetUserQuota", request.Context(), int32(5)).Return(&domain.UserQuotaRecord{UserID: 5}, nil).Once()
+	mockedQuotasRepo.On("SaveUserQuota", request.Context(), &domain.UserQuotaRecord{UserID: 5}).Return(fmt.Errorf("some error")).Once()
+
+	result := UpdateUserQuotaHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error saving the user quota")
+	mockedUsersRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+}
+
+func TestUpdateUserQuotaHandler_Saves_The_Quota(t *testing.T) {
+	request := updateUserQuotaRequest()
+
+	mockedUsersRepo := authRepository.MockedUsersRepository{}
+	mockedQuotasRepo := listsRepository.MockedQuotasRepository{}
+	mockedAuditLogRepo := sharedRepository.MockedAuditLogRepository{}
+	maxLists := int32(10)
+	maxItemsPerList := int32(0)
+	h := handler.Handler{
+		UsersRepository:    &mockedUsersRepo,
+		QuotasRepository:   &mockedQuotasRepo,
+		AuditLogRepository: &mockedAuditLogRepo,
+		RequestInput:       &infrastructure.UserQuotaInput{MaxLists: &maxLists, MaxItemsPerList: &maxItemsPerList},
+	}
+
+	mockedUsersRepo.On("ExistsUser", request.Context(), authDomain.UserRecord{ID: 5}).Return(true, nil).Once()
+	mockedQuotasRepo.On("GetUserQuota", request.Context(), int32(5)).Return(&domain.UserQuotaRecord{UserID: 5}, nil).Once()
+	mockedQuotasRepo.On("SaveUserQuota", request.Context(), &domain.UserQuotaRecord{UserID: 5, MaxLists: &maxLists, MaxItemsPerList: &maxItemsPerList}).Return(nil).Once()
+	mockedAuditLogRepo.On("Create", request.Context(), mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.Action == audit.ActionUserQuotaUpdated && e.TargetType == audit.TargetUser && *e.TargetID == 5
+	})).Return(nil).Once()
+
+	result := UpdateUserQuotaHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedUsersRepo.AssertExpectations(t)
+	mockedQuotasRepo.AssertExpectations(t)
+	mockedAuditLogRepo.AssertExpectations(t)
+}
@@ -3,6 +3,7 @@ package infrastructure
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 )
@@ -11,6 +12,7 @@ type ListInput struct {
 	Name       domain.ListNameValueObject `json:"name"`
 	CategoryID *int32                     `json:"categoryId"`
 	Items      []ListItemInput            `json:"items"`
+	Tags       []string                   `json:"tags"`
 }
 
 func (i *ListInput) UnmarshalJSON(data []byte) error {
@@ -18,11 +20,14 @@ func (i *ListInput) UnmarshalJSON(data []byte) error {
 		Name       string `json:"name"`
 		CategoryID *int32 `json:"categoryId"`
 		Items      []struct {
-			ID          int32  `json:"id"`
-			Title       string `json:"title"`
-			Description string `json:"description"`
-			Position    int32  `json:"position"`
+			ID          int32      `json:"id"`
+			Title       string     `json:"title"`
+			Description string     `json:"description"`
+			Position    int32      `json:"position"`
+			DueDate     *time.Time `json:"dueDate"`
+			Tags        []string   `json:"tags"`
 		} `json:"items"`
+		Tags []string `json:"tags"`
 	}
 
 	if err := json.Unmarshal(data, &realInput); err != nil {
@@ -34,10 +39,16 @@ func (i *ListInput) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	tags, err := domain.NewTagNames(realInput.Tags)
+	if err != nil {
+		return err
+	}
+
 	*i = ListInput{
 		Name:       nvo,
 		CategoryID: realInput.CategoryID,
 		Items:      make([]ListItemInput, len(realInput.Items)),
+		Tags:       tags,
 	}
 
 	for index, v := range realInput.Items {
@@ -51,11 +62,18 @@ func (i *ListInput) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("Item #%v: %v", index, err)
 		}
 
+		itemTags, err := domain.NewTagNames(v.Tags)
+		if err != nil {
+			return fmt.Errorf("Item #%v: %v", index, err)
+		}
+
 		i.Items[index] = ListItemInput{
 			ID:          v.ID,
 			Title:       tvo,
 			Description: dvo,
 			Position:    v.Position,
+			DueDate:     v.DueDate,
+			Tags:        itemTags,
 		}
 	}
 
@@ -67,6 +85,7 @@ func (i *ListInput) ToListEntity() *domain.ListEntity {
 		Name:       i.Name,
 		CategoryID: i.CategoryID,
 		Items:      make([]*domain.ListItemEntity, len(i.Items)),
+		Tags:       i.Tags,
 	}
 
 	for i, v := range i.Items {
@@ -75,6 +94,8 @@ func (i *ListInput) ToListEntity() *domain.ListEntity {
 			Title:       v.Title,
 			Description: v.Description,
 			Position:    int32(i),
+			DueDate:     v.DueDate,
+			Tags:        v.Tags,
 		}
 	}
 
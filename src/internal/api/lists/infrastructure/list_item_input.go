@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 )
@@ -11,14 +12,18 @@ type ListItemInput struct {
 	Title       domain.ItemTitleValueObject       `json:"title"`
 	Description domain.ItemDescriptionValueObject `json:"description"`
 	Position    int32                             `json:"position"`
+	DueDate     *time.Time                        `json:"dueDate"`
+	Tags        []string                          `json:"tags"`
 }
 
 func (i *ListItemInput) UnmarshalJSON(data []byte) error {
 	var realInput struct {
-		ID          int32  `json:"id"`
-		Title       string `json:"title"`
-		Description string `json:"description"`
-		Position    int32  `json:"position"`
+		ID          int32      `json:"id"`
+		Title       string     `json:"title"`
+		Description string     `json:"description"`
+		Position    int32      `json:"position"`
+		DueDate     *time.Time `json:"dueDate"`
+		Tags        []string   `json:"tags"`
 	}
 
 	if err := json.Unmarshal(data, &realInput); err != nil {
@@ -35,11 +40,18 @@ func (i *ListItemInput) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
+	tags, err := domain.NewTagNames(realInput.Tags)
+	if err != nil {
+		return err
+	}
+
 	*i = ListItemInput{
 		ID:          realInput.ID,
 		Title:       tvo,
 		Description: dvo,
 		Position:    realInput.Position,
+		DueDate:     realInput.DueDate,
+		Tags:        tags,
 	}
 
 	return nil
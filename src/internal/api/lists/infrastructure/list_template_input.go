@@ -0,0 +1,112 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+type ListTemplateInput struct {
+	Name  domain.ListNameValueObject `json:"name"`
+	Items []domain.ListTemplateItem  `json:"items"`
+}
+
+func (i *ListTemplateInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name  string `json:"name"`
+		Items []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+		} `json:"items"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	nvo, err := domain.NewListNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	*i = ListTemplateInput{
+		Name:  nvo,
+		Items: make([]domain.ListTemplateItem, len(realInput.Items)),
+	}
+
+	for index, v := range realInput.Items {
+		tvo, err := domain.NewItemTitleValueObject(v.Title)
+		if err != nil {
+			return fmt.Errorf("Item #%v: %v", index, err)
+		}
+
+		dvo, err := domain.NewItemDescriptionValueObject(v.Description)
+		if err != nil {
+			return fmt.Errorf("Item #%v: %v", index, err)
+		}
+
+		i.Items[index] = domain.ListTemplateItem{Title: tvo, Description: dvo}
+	}
+
+	return nil
+}
+
+// SaveListAsTemplateInput has an optional name, the template gets the name of the list without it
+type SaveListAsTemplateInput struct {
+	Name *domain.ListNameValueObject `json:"name"`
+}
+
+func (i *SaveListAsTemplateInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name *string `json:"name"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	*i = SaveListAsTemplateInput{}
+
+	if realInput.Name != nil {
+		nvo, err := domain.NewListNameValueObject(*realInput.Name)
+		if err != nil {
+			return err
+		}
+
+		i.Name = &nvo
+	}
+
+	return nil
+}
+
+type InstantiateListTemplateInput struct {
+	Name       domain.ListNameValueObject `json:"name"`
+	CategoryID *int32                     `json:"categoryId"`
+	Variables  map[string]string          `json:"variables"`
+}
+
+func (i *InstantiateListTemplateInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name       string            `json:"name"`
+		CategoryID *int32            `json:"categoryId"`
+		Variables  map[string]string `json:"variables"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	nvo, err := domain.NewListNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	*i = InstantiateListTemplateInput{Name: nvo, CategoryID: realInput.CategoryID, Variables: realInput.Variables}
+
+	return nil
+}
+
+type ListTemplateShareInput struct {
+	UserID int32 `json:"userId"`
+}
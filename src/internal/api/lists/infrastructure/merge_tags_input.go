@@ -0,0 +1,5 @@
+package infrastructure
+
+type MergeTagsInput struct {
+	TargetTagID int32 `json:"targetTagId"`
+}
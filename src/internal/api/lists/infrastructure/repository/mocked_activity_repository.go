@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedActivityRepository struct {
+	mock.Mock
+}
+
+func NewMockedActivityRepository() *MockedActivityRepository {
+	return &MockedActivityRepository{}
+}
+
+func (m *MockedActivityRepository) CreateActivity(ctx context.Context, record *domain.ActivityRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedActivityRepository) GetActivity(ctx context.Context, query domain.ActivityQuery) (domain.ActivityRecords, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ActivityRecords), args.Error(1)
+}
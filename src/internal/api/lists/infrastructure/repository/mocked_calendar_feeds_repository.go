@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedCalendarFeedsRepository struct {
+	mock.Mock
+}
+
+func NewMockedCalendarFeedsRepository() *MockedCalendarFeedsRepository {
+	return &MockedCalendarFeedsRepository{}
+}
+
+func (m *MockedCalendarFeedsRepository) FindCalendarFeed(ctx context.Context, query domain.CalendarFeedRecord) (*domain.CalendarFeedRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.CalendarFeedRecord), args.Error(1)
+}
+
+func (m *MockedCalendarFeedsRepository) GetCalendarFeeds(ctx context.Context, query domain.CalendarFeedRecord) (domain.CalendarFeedRecords, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.CalendarFeedRecords), args.Error(1)
+}
+
+func (m *MockedCalendarFeedsRepository) CreateCalendarFeed(ctx context.Context, record *domain.CalendarFeedRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedCalendarFeedsRepository) DeleteCalendarFeed(ctx context.Context, query domain.CalendarFeedRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedCalendarFeedsRepository) UpdateCalendarFeedContent(ctx context.Context, feedID int32, contentHash string, lastModified time.Time) error {
+	args := m.Called(ctx, feedID, contentHash, lastModified)
+
+	return args.Error(0)
+}
@@ -30,6 +30,12 @@ func (m *MockedCategoriesRepository) ExistsCategory(ctx context.Context, query d
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockedCategoriesRepository) CountCategories(ctx context.Context, query domain.CategoryRecord) (int64, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockedCategoriesRepository) GetCategories(ctx context.Context, query domain.CategoryRecord) (domain.CategoryRecords, error) {
 	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
@@ -51,8 +57,77 @@ func (m *MockedCategoriesRepository) DeleteCategory(ctx context.Context, query d
 	return args.Error(0)
 }
 
-func (m *MockedCategoriesRepository) UpdateCategory(ctx context.Context, record *domain.CategoryRecord) error {
-	args := m.Called(ctx, record)
+func (m *MockedCategoriesRepository) UpdateCategory(ctx context.Context, record *domain.CategoryRecord, fields []string) error {
+	args := m.Called(ctx, record, fields)
+
+	return args.Error(0)
+}
+
+func (m *MockedCategoriesRepository) DeleteCategories(ctx context.Context, query domain.CategoryRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedCategoriesRepository) TransferCategories(ctx context.Context, fromUserID int32, toUserID int32) error {
+	args := m.Called(ctx, fromUserID, toUserID)
 
 	return args.Error(0)
 }
+
+func (m *MockedCategoriesRepository) ReassignAndDeleteCategory(ctx context.Context, categoryID int32, targetCategoryID *int32) (domain.ListRecords, error) {
+	args := m.Called(ctx, categoryID, targetCategoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListRecords), args.Error(1)
+}
+
+func (m *MockedCategoriesRepository) TrashListsAndDeleteCategory(ctx context.Context, categoryID int32) (domain.ListRecords, error) {
+	args := m.Called(ctx, categoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListRecords), args.Error(1)
+}
+
+func (m *MockedCategoriesRepository) ReorderCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) error {
+	args := m.Called(ctx, workspaceID, categoryIDs)
+
+	return args.Error(0)
+}
+
+func (m *MockedCategoriesRepository) FindDefaultCategoryID(ctx context.Context, userID int32, workspaceID int32) (*int32, error) {
+	args := m.Called(ctx, userID, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*int32), args.Error(1)
+}
+
+func (m *MockedCategoriesRepository) SetDefaultCategory(ctx context.Context, userID int32, workspaceID int32, categoryID *int32) error {
+	args := m.Called(ctx, userID, workspaceID, categoryID)
+
+	return args.Error(0)
+}
+
+func (m *MockedCategoriesRepository) GetCategoriesStats(ctx context.Context, query domain.CategoryStatsQuery) (domain.CategoryStatsRecords, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.CategoryStatsRecords), args.Error(1)
+}
+
+func (m *MockedCategoriesRepository) GetLargestLists(ctx context.Context, query domain.CategoryStatsQuery, limit int) (domain.ListRecords, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListRecords), args.Error(1)
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedListTemplatesRepository struct {
+	mock.Mock
+}
+
+func NewMockedListTemplatesRepository() *MockedListTemplatesRepository {
+	return &MockedListTemplatesRepository{}
+}
+
+func (m *MockedListTemplatesRepository) FindListTemplate(ctx context.Context, query domain.ListTemplateRecord) (*domain.ListTemplateRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.ListTemplateRecord), args.Error(1)
+}
+
+func (m *MockedListTemplatesRepository) FindAccessibleListTemplate(ctx context.Context, templateID int32, userID int32) (*domain.ListTemplateRecord, error) {
+	args := m.Called(ctx, templateID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.ListTemplateRecord), args.Error(1)
+}
+
+func (m *MockedListTemplatesRepository) GetAccessibleListTemplates(ctx context.Context, userID int32) (domain.ListTemplateRecords, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListTemplateRecords), args.Error(1)
+}
+
+func (m *MockedListTemplatesRepository) ExistsListTemplate(ctx context.Context, query domain.ListTemplateRecord) (bool, error) {
+	args := m.Called(ctx, query)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedListTemplatesRepository) CreateListTemplate(ctx context.Context, record *domain.ListTemplateRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedListTemplatesRepository) DeleteListTemplate(ctx context.Context, templateID int32) error {
+	args := m.Called(ctx, templateID)
+
+	return args.Error(0)
+}
+
+func (m *MockedListTemplatesRepository) TransferListTemplates(ctx context.Context, fromUserID int32, toUserID int32) error {
+	args := m.Called(ctx, fromUserID, toUserID)
+
+	return args.Error(0)
+}
+
+func (m *MockedListTemplatesRepository) ExistsListTemplateShare(ctx context.Context, query domain.ListTemplateShareRecord) (bool, error) {
+	args := m.Called(ctx, query)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedListTemplatesRepository) CreateListTemplateShare(ctx context.Context, record *domain.ListTemplateShareRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedListTemplatesRepository) DeleteListTemplateShare(ctx context.Context, query domain.ListTemplateShareRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
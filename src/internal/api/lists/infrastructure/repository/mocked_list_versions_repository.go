@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedListVersionsRepository struct {
+	mock.Mock
+}
+
+func NewMockedListVersionsRepository() *MockedListVersionsRepository {
+	return &MockedListVersionsRepository{}
+}
+
+func (m *MockedListVersionsRepository) GetListVersions(ctx context.Context, listID int32) (domain.ListVersionRecords, error) {
+	args := m.Called(ctx, listID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListVersionRecords), args.Error(1)
+}
+
+func (m *MockedListVersionsRepository) FindListVersion(ctx context.Context, query domain.ListVersionRecord) (*domain.ListVersionRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.ListVersionRecord), args.Error(1)
+}
@@ -30,6 +30,12 @@ func (m *MockedListsRepository) ExistsList(ctx context.Context, query domain.Lis
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockedListsRepository) CountLists(ctx context.Context, query domain.ListRecord) (int64, error) {
+	args := m.Called(ctx, query)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockedListsRepository) GetLists(ctx context.Context, query domain.ListRecord) (domain.ListRecords, error) {
 	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
@@ -39,12 +45,50 @@ func (m *MockedListsRepository) GetLists(ctx context.Context, query domain.ListR
 	return args.Get(0).(domain.ListRecords), args.Error(1)
 }
 
+func (m *MockedListsRepository) ForEachListsBatch(ctx context.Context, query domain.ListRecord, batchSize int, fn func(lists domain.ListRecords) error) error {
+	args := m.Called(ctx, query, batchSize)
+
+	if batches, ok := args.Get(0).([]domain.ListRecords); ok {
+		for _, v := range batches {
+			if err := fn(v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return args.Error(1)
+}
+
+func (m *MockedListsRepository) GetListsByCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) (domain.ListRecords, error) {
+	args := m.Called(ctx, workspaceID, categoryIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListRecords), args.Error(1)
+}
+
+func (m *MockedListsRepository) GetDueItems(ctx context.Context, workspaceID int32, listIDs []int32) (domain.ListItemRecords, error) {
+	args := m.Called(ctx, workspaceID, listIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListItemRecords), args.Error(1)
+}
+
 func (m *MockedListsRepository) CreateList(ctx context.Context, record *domain.ListRecord) error {
 	args := m.Called(ctx, record)
 
 	return args.Error(0)
 }
 
+func (m *MockedListsRepository) CreateLists(ctx context.Context, records []*domain.ListRecord) error {
+	args := m.Called(ctx, records)
+
+	return args.Error(0)
+}
+
 func (m *MockedListsRepository) DeleteList(ctx context.Context, query domain.ListRecord) error {
 	args := m.Called(ctx, query)
 
@@ -57,8 +101,44 @@ func (m *MockedListsRepository) UpdateList(ctx context.Context, record *domain.L
 	return args.Error(0)
 }
 
+func (m *MockedListsRepository) UpdateListWithVersion(ctx context.Context, record *domain.ListRecord, version *domain.ListVersionRecord) error {
+	args := m.Called(ctx, record, version)
+
+	return args.Error(0)
+}
+
 func (m *MockedListsRepository) UpdateListItemsCount(ctx context.Context, listID int32) error {
 	args := m.Called(ctx, listID)
 
 	return args.Error(0)
 }
+
+func (m *MockedListsRepository) DeleteTrashedLists(ctx context.Context, query domain.ListRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedListsRepository) TransferLists(ctx context.Context, fromUserID int32, toUserID int32) error {
+	args := m.Called(ctx, fromUserID, toUserID)
+
+	return args.Error(0)
+}
+
+func (m *MockedListsRepository) GetListsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (domain.ListRecords, error) {
+	args := m.Called(ctx, workspaceID, userID, tagName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListRecords), args.Error(1)
+}
+
+func (m *MockedListsRepository) GetItemsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (domain.ListItemRecords, error) {
+	args := m.Called(ctx, workspaceID, userID, tagName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.ListItemRecords), args.Error(1)
+}
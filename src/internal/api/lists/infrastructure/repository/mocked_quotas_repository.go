@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedQuotasRepository struct {
+	mock.Mock
+}
+
+func NewMockedQuotasRepository() *MockedQuotasRepository {
+	return &MockedQuotasRepository{}
+}
+
+func (m *MockedQuotasRepository) GetUserQuota(ctx context.Context, userID int32) (*domain.UserQuotaRecord, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.UserQuotaRecord), args.Error(1)
+}
+
+func (m *MockedQuotasRepository) SaveUserQuota(ctx context.Context, record *domain.UserQuotaRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
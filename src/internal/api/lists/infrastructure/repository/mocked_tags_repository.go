@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedTagsRepository struct {
+	mock.Mock
+}
+
+func NewMockedTagsRepository() *MockedTagsRepository {
+	return &MockedTagsRepository{}
+}
+
+func (m *MockedTagsRepository) FindTag(ctx context.Context, query domain.TagRecord) (*domain.TagRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.TagRecord), args.Error(1)
+}
+
+func (m *MockedTagsRepository) ExistsTag(ctx context.Context, query domain.TagRecord) (bool, error) {
+	args := m.Called(ctx, query)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedTagsRepository) GetTags(ctx context.Context, userID int32) (domain.TagRecords, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.TagRecords), args.Error(1)
+}
+
+func (m *MockedTagsRepository) CreateTag(ctx context.Context, record *domain.TagRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) UpdateTag(ctx context.Context, record *domain.TagRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) DeleteTag(ctx context.Context, query domain.TagRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) MergeTags(ctx context.Context, tagID int32, targetTagID int32) error {
+	args := m.Called(ctx, tagID, targetTagID)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) TransferTags(ctx context.Context, fromUserID int32, toUserID int32) error {
+	args := m.Called(ctx, fromUserID, toUserID)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) GetTagListIDs(ctx context.Context, tagID int32) ([]int32, error) {
+	args := m.Called(ctx, tagID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]int32), args.Error(1)
+}
+
+func (m *MockedTagsRepository) SetListTags(ctx context.Context, userID int32, listID int32, names []string) error {
+	args := m.Called(ctx, userID, listID, names)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) SetItemTags(ctx context.Context, userID int32, itemID int32, names []string) error {
+	args := m.Called(ctx, userID, itemID, names)
+
+	return args.Error(0)
+}
+
+func (m *MockedTagsRepository) GetListsTags(ctx context.Context, userID int32, listIDs []int32) (domain.TaggedRecords, error) {
+	args := m.Called(ctx, userID, listIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.TaggedRecords), args.Error(1)
+}
+
+func (m *MockedTagsRepository) GetItemsTags(ctx context.Context, userID int32, itemIDs []int32) (domain.TaggedRecords, error) {
+	args := m.Called(ctx, userID, itemIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.TaggedRecords), args.Error(1)
+}
+
+func (m *MockedTagsRepository) GetListsOwnerTags(ctx context.Context, listIDs []int32) (domain.TaggedRecords, error) {
+	args := m.Called(ctx, listIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.TaggedRecords), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+)
+
+type MySqlActivityRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlActivityRepository(db *gorm.DB) *MySqlActivityRepository {
+	return &MySqlActivityRepository{db}
+}
+
+func (r *MySqlActivityRepository) CreateActivity(ctx context.Context, record *domain.ActivityRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlActivityRepository) GetActivity(ctx context.Context, query domain.ActivityQuery) (domain.ActivityRecords, error) {
+	db := r.db.WithContext(ctx).Where("userId = ?", query.UserID)
+
+	if query.ListID > 0 {
+		db = db.Where("listId = ? OR relatedListId = ?", query.ListID, query.ListID)
+	}
+
+	if query.BeforeID > 0 {
+		db = db.Where("id < ?", query.BeforeID)
+	}
+
+	res := domain.ActivityRecords{}
+	if err := db.Order("id DESC").Limit(query.Limit).Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,89 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var activityColumns = []string{"id", "userId", "listId", "relatedListId", "action", "summary", "createdAt"}
+
+func TestMySqlActivityRepository_CreateActivity_Returns_An_Error_If_The_Insert_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlActivityRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `activity`")).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.CreateActivity(context.Background(), &domain.ActivityRecord{})
+
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlActivityRepository_CreateActivity_Creates_The_Activity(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlActivityRepository(db)
+
+	now := time.Now()
+	record := domain.ActivityRecord{UserID: 1, ListID: 2, Action: domain.ActivityListCreated, Summary: `Created the list "list"`, CreatedAt: now}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `activity` (`userId`,`listId`,`relatedListId`,`action`,`summary`,`createdAt`) VALUES (?,?,?,?,?,?)")).
+		WithArgs(int32(1), int32(2), nil, domain.ActivityListCreated, `Created the list "list"`, now).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateActivity(context.Background(), &record)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), record.ID)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlActivityRepository_GetActivity_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlActivityRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `activity` WHERE userId = ? ORDER BY id DESC LIMIT 10")).
+		WithArgs(int32(1)).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetActivity(context.Background(), domain.ActivityQuery{UserID: 1, Limit: 10})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlActivityRepository_GetActivity_Returns_The_Activity_Of_A_List(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlActivityRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `activity` WHERE userId = ? AND (listId = ? OR relatedListId = ?) AND id < ? ORDER BY id DESC LIMIT 10")).
+		WithArgs(int32(1), int32(2), int32(2), int32(30)).
+		WillReturnRows(sqlmock.NewRows(activityColumns).
+			AddRow(29, 1, 3, 2, domain.ActivityItemMoved, `Moved "item" from "list3" to "list2"`, now))
+
+	res, err := repo.GetActivity(context.Background(), domain.ActivityQuery{UserID: 1, ListID: 2, BeforeID: 30, Limit: 10})
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(res))
+	relatedListID := int32(2)
+	assert.Equal(t, domain.ActivityRecord{ID: 29, UserID: 1, ListID: 3, RelatedListID: &relatedListID, Action: domain.ActivityItemMoved, Summary: `Moved "item" from "list3" to "list2"`, CreatedAt: now}, res[0])
+	helpers.CheckSqlMockExpectations(mock, t)
+}
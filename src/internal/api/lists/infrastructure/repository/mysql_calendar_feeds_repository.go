@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+)
+
+type MySqlCalendarFeedsRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlCalendarFeedsRepository(db *gorm.DB) *MySqlCalendarFeedsRepository {
+	return &MySqlCalendarFeedsRepository{db}
+}
+
+func (r *MySqlCalendarFeedsRepository) FindCalendarFeed(ctx context.Context, query domain.CalendarFeedRecord) (*domain.CalendarFeedRecord, error) {
+	foundFeed := domain.CalendarFeedRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundFeed).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundFeed, nil
+}
+
+func (r *MySqlCalendarFeedsRepository) GetCalendarFeeds(ctx context.Context, query domain.CalendarFeedRecord) (domain.CalendarFeedRecords, error) {
+	foundFeeds := domain.CalendarFeedRecords{}
+	if err := r.db.WithContext(ctx).Where(query).Order("id").Find(&foundFeeds).Error; err != nil {
+		return nil, err
+	}
+
+	return foundFeeds, nil
+}
+
+func (r *MySqlCalendarFeedsRepository) CreateCalendarFeed(ctx context.Context, record *domain.CalendarFeedRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlCalendarFeedsRepository) DeleteCalendarFeed(ctx context.Context, query domain.CalendarFeedRecord) error {
+	return r.db.WithContext(ctx).Where(query).Delete(domain.CalendarFeedRecord{}).Error
+}
+
+func (r *MySqlCalendarFeedsRepository) UpdateCalendarFeedContent(ctx context.Context, feedID int32, contentHash string, lastModified time.Time) error {
+	return r.db.WithContext(ctx).Model(&domain.CalendarFeedRecord{}).Where(domain.CalendarFeedRecord{ID: feedID}).Updates(map[string]interface{}{"contentHash": contentHash, "lastModified": lastModified}).Error
+}
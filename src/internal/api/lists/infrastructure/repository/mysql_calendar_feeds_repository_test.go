@@ -0,0 +1,140 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySqlCalendarFeedsRepository_FindCalendarFeed_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `calendarFeeds` WHERE `calendarFeeds`.`tokenHash` = ? LIMIT 1")).
+		WithArgs("hash").
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.FindCalendarFeed(context.Background(), domain.CalendarFeedRecord{TokenHash: "hash"})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_FindCalendarFeed_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `calendarFeeds` WHERE `calendarFeeds`.`tokenHash` = ? LIMIT 1")).
+		WithArgs("hash").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "workspaceId", "tokenHash", "listId"}).AddRow(5, 1, 2, "hash", 3))
+
+	res, err := repo.FindCalendarFeed(context.Background(), domain.CalendarFeedRecord{TokenHash: "hash"})
+
+	listID := int32(3)
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2, TokenHash: "hash", ListID: &listID}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_GetCalendarFeeds_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `calendarFeeds` WHERE `calendarFeeds`.`userId` = ? AND `calendarFeeds`.`workspaceId` = ? ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetCalendarFeeds(context.Background(), domain.CalendarFeedRecord{UserID: 1, WorkspaceID: 2})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_GetCalendarFeeds_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `calendarFeeds` WHERE `calendarFeeds`.`userId` = ? AND `calendarFeeds`.`workspaceId` = ? ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "workspaceId"}).AddRow(5, 1, 2).AddRow(6, 1, 2))
+
+	res, err := repo.GetCalendarFeeds(context.Background(), domain.CalendarFeedRecord{UserID: 1, WorkspaceID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CalendarFeedRecords{{ID: 5, UserID: 1, WorkspaceID: 2}, {ID: 6, UserID: 1, WorkspaceID: 2}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_CreateCalendarFeed(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	categoryID := int32(4)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `calendarFeeds` (`userId`,`workspaceId`,`tokenHash`,`categoryId`,`listId`,`contentHash`,`lastModified`,`createdAt`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs(1, 2, "hash", 4, nil, "", nil, createdAt).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	record := domain.CalendarFeedRecord{UserID: 1, WorkspaceID: 2, TokenHash: "hash", CategoryID: &categoryID, CreatedAt: createdAt}
+	err := repo.CreateCalendarFeed(context.Background(), &record)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), record.ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_DeleteCalendarFeed(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `calendarFeeds` WHERE `calendarFeeds`.`id` = ? AND `calendarFeeds`.`userId` = ? AND `calendarFeeds`.`workspaceId` = ?")).
+		WithArgs(5, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteCalendarFeed(context.Background(), domain.CalendarFeedRecord{ID: 5, UserID: 1, WorkspaceID: 2})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCalendarFeedsRepository_UpdateCalendarFeedContent(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCalendarFeedsRepository(db)
+
+	lastModified := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `calendarFeeds` SET `contentHash`=?,`lastModified`=? WHERE `calendarFeeds`.`id` = ?")).
+		WithArgs("hash", lastModified, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdateCalendarFeedContent(context.Background(), 5, "hash", lastModified)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type MySqlCategoriesRepository struct {
@@ -33,10 +35,19 @@ func (r *MySqlCategoriesRepository) ExistsCategory(ctx context.Context, query do
 	return count > 0, nil
 }
 
+func (r *MySqlCategoriesRepository) CountCategories(ctx context.Context, query domain.CategoryRecord) (int64, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.CategoryRecord{}).Where(query).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *MySqlCategoriesRepository) GetCategories(ctx context.Context, query domain.CategoryRecord) (domain.CategoryRecords, error) {
 	foundCategories := []domain.CategoryRecord{}
 
-	if err := r.db.WithContext(ctx).Where(query).Find(&foundCategories).Error; err != nil {
+	if err := r.db.WithContext(ctx).Where(query).Order("position, id").Find(&foundCategories).Error; err != nil {
 		return nil, err
 	}
 
@@ -51,6 +62,153 @@ func (r *MySqlCategoriesRepository) DeleteCategory(ctx context.Context, query do
 	return r.db.WithContext(ctx).Delete(query).Error
 }
 
-func (r *MySqlCategoriesRepository) UpdateCategory(ctx context.Context, record *domain.CategoryRecord) error {
-	return r.db.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Updates(record).Error
+func (r *MySqlCategoriesRepository) UpdateCategory(ctx context.Context, record *domain.CategoryRecord, fields []string) error {
+	return r.db.WithContext(ctx).Select(fields).Updates(record).Error
+}
+
+func (r *MySqlCategoriesRepository) DeleteCategories(ctx context.Context, query domain.CategoryRecord) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		categoryIDs := tx.Model(&domain.CategoryRecord{}).Where(query).Select("id")
+
+		if err := tx.Model(&domain.ListRecord{}).Where("categoryId IN (?)", categoryIDs).Update("categoryId", nil).Error; err != nil {
+			return err
+		}
+
+		return tx.Where(query).Delete(&domain.CategoryRecord{}).Error
+	})
+}
+
+func (r *MySqlCategoriesRepository) TransferCategories(ctx context.Context, fromUserID int32, toUserID int32) error {
+	return r.db.WithContext(ctx).Model(&domain.CategoryRecord{}).Where("userId = ?", fromUserID).Update("userId", toUserID).Error
+}
+
+// ReassignAndDeleteCategory locks the lists of the category before moving them, so the returned
+// lists are the ones that have really been moved
+func (r *MySqlCategoriesRepository) ReassignAndDeleteCategory(ctx context.Context, categoryID int32, targetCategoryID *int32) (domain.ListRecords, error) {
+	movedLists := domain.ListRecords{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCategoryLists(tx, categoryID, &movedLists); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.ListRecord{}).Where("categoryId = ?", categoryID).Update("categoryId", targetCategoryID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.CategoryRecord{ID: categoryID}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movedLists, nil
+}
+
+// TrashListsAndDeleteCategory locks the lists of the category before moving them to the trash,
+// so the returned lists are the ones that have really been trashed. The trashed lists are left
+// without category because it's deleted
+func (r *MySqlCategoriesRepository) TrashListsAndDeleteCategory(ctx context.Context, categoryID int32) (domain.ListRecords, error) {
+	trashedLists := domain.ListRecords{}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockCategoryLists(tx, categoryID, &trashedLists); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&domain.ListRecord{}).Where("categoryId = ?", categoryID).Updates(map[string]interface{}{"categoryId": nil, "trashedAt": time.Now()}).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.CategoryRecord{ID: categoryID}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trashedLists, nil
+}
+
+func lockCategoryLists(tx *gorm.DB, categoryID int32, lists *domain.ListRecords) error {
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("categoryId = ?", categoryID).Find(lists).Error
+}
+
+func (r *MySqlCategoriesRepository) ReorderCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range categoryIDs {
+			if err := tx.Model(&domain.CategoryRecord{}).Where(domain.CategoryRecord{ID: id, WorkspaceID: workspaceID}).Update("position", i).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *MySqlCategoriesRepository) FindDefaultCategoryID(ctx context.Context, userID int32, workspaceID int32) (*int32, error) {
+	found := []domain.DefaultCategoryRecord{}
+	if err := r.db.WithContext(ctx).Where(domain.DefaultCategoryRecord{UserID: userID, WorkspaceID: workspaceID}).Limit(1).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	return &found[0].CategoryID, nil
+}
+
+func (r *MySqlCategoriesRepository) SetDefaultCategory(ctx context.Context, userID int32, workspaceID int32, categoryID *int32) error {
+	if categoryID == nil {
+		return r.db.WithContext(ctx).Where(domain.DefaultCategoryRecord{UserID: userID, WorkspaceID: workspaceID}).Delete(&domain.DefaultCategoryRecord{}).Error
+	}
+
+	record := domain.DefaultCategoryRecord{UserID: userID, WorkspaceID: workspaceID, CategoryID: *categoryID}
+
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"categoryId"})}).Create(&record).Error
+}
+
+func (r *MySqlCategoriesRepository) GetCategoriesStats(ctx context.Context, query domain.CategoryStatsQuery) (domain.CategoryStatsRecords, error) {
+	lastActivity := r.db.Model(&domain.ActivityRecord{}).Select("listId, MAX(createdAt) AS createdAt").Group("listId")
+
+	found := domain.CategoryStatsRecords{}
+
+	err := r.statsLists(ctx, query).
+		Select("lists.categoryId AS categoryId, COUNT(*) AS listsCount, COALESCE(SUM(lists.itemsCount), 0) AS itemsCount, MAX(lastActivity.createdAt) AS lastActivityAt").
+		Joins("LEFT JOIN (?) AS lastActivity ON lastActivity.listId = lists.id", lastActivity).
+		Group("lists.categoryId").
+		Scan(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *MySqlCategoriesRepository) GetLargestLists(ctx context.Context, query domain.CategoryStatsQuery, limit int) (domain.ListRecords, error) {
+	ranked := r.statsLists(ctx, query).
+		Select("lists.id, lists.name, lists.itemsCount, lists.categoryId, ROW_NUMBER() OVER (PARTITION BY lists.categoryId ORDER BY lists.itemsCount DESC, lists.id) AS rowNumber")
+
+	found := domain.ListRecords{}
+
+	err := r.db.WithContext(ctx).Table("(?) AS ranked", ranked).
+		Select("id, name, itemsCount, categoryId").
+		Where("rowNumber <= ?", limit).
+		Order("categoryId, rowNumber").
+		Find(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *MySqlCategoriesRepository) statsLists(ctx context.Context, query domain.CategoryStatsQuery) *gorm.DB {
+	tx := r.db.WithContext(ctx).Model(&domain.ListRecord{}).Scopes(untrashed).Where("lists.workspaceId = ? AND lists.categoryId IS NOT NULL", query.WorkspaceID)
+
+	if query.CategoryID > 0 {
+		tx = tx.Where("lists.categoryId = ?", query.CategoryID)
+	}
+
+	return tx
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
@@ -94,16 +95,48 @@ func TestMySqlCategoriesRepository_ExistsCategory_WhenItDoesNotFail(t *testing.T
 	helpers.CheckSqlMockExpectations(mock, t)
 }
 
+func TestMySqlCategoriesRepository_CountCategories_WhenTheQueryFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `categories` WHERE `categories`.`userId` = ?")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.CountCategories(context.Background(), domain.CategoryRecord{UserID: 1})
+
+	assert.Equal(t, int64(0), res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_CountCategories_WhenItDoesNotFail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `categories` WHERE `categories`.`userId` = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.CountCategories(context.Background(), domain.CategoryRecord{UserID: 1})
+
+	assert.Equal(t, int64(2), res)
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
 func TestMySqlCategoriesRepository_GetCategories_WhenItFails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 
 	repo := NewMySqlCategoriesRepository(db)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`userId` = ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`workspaceId` = ? ORDER BY position, id")).
 		WithArgs(1).
 		WillReturnError(fmt.Errorf("some error"))
 
-	res, err := repo.GetCategories(context.Background(), domain.CategoryRecord{UserID: 1})
+	res, err := repo.GetCategories(context.Background(), domain.CategoryRecord{WorkspaceID: 1})
 
 	assert.Nil(t, res)
 	assert.EqualError(t, err, "some error")
@@ -116,13 +149,13 @@ func TestMySqlCategoriesRepository_GetCategories_When_It_Does_Not_Fail_Including
 
 	repo := NewMySqlCategoriesRepository(db)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`userId` = ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE `categories`.`workspaceId` = ? ORDER BY position, id")).
 		WithArgs(1).
 		WillReturnRows(sqlmock.NewRows(categoryColumns).
 			AddRow(11, "category1", "desc 1").
 			AddRow(12, "category2", "desc 2"))
 
-	res, err := repo.GetCategories(context.Background(), domain.CategoryRecord{UserID: 1})
+	res, err := repo.GetCategories(context.Background(), domain.CategoryRecord{WorkspaceID: 1})
 
 	assert.Nil(t, err)
 	require.NotNil(t, res)
@@ -140,12 +173,12 @@ func TestMySqlCategoriesRepository_GetCategories_When_It_Does_Not_Fail_Including
 func TestMySqlCategoriesRepository_CreateCategory_When_The_Create_Fails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`name`,`description`,`userId`) VALUES (?,?,?)")).
-		WithArgs("name", "category description", 2).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`name`,`description`,`userId`,`workspaceId`,`parentId`,`color`,`icon`,`position`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs("name", "category description", 2, 3, nil, "#1a2b3c", "cart", 4).
 		WillReturnError(fmt.Errorf("some error"))
 	mock.ExpectRollback()
 
-	category := domain.CategoryRecord{Name: "name", Description: "category description", UserID: 2}
+	category := domain.CategoryRecord{Name: "name", Description: "category description", UserID: 2, WorkspaceID: 3, Color: "#1a2b3c", Icon: "cart", Position: 4}
 
 	repo := NewMySqlCategoriesRepository(db)
 
@@ -159,12 +192,12 @@ func TestMySqlCategoriesRepository_CreateCategory_When_The_Create_Fails(t *testi
 func TestMySqlCategoriesRepository_CreateCategory_When_It_Does_Not_Fail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`name`,`description`,`userId`) VALUES (?,?,?)")).
-		WithArgs("name", "category description", 2).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `categories` (`name`,`description`,`userId`,`workspaceId`,`parentId`,`color`,`icon`,`position`) VALUES (?,?,?,?,?,?,?,?)")).
+		WithArgs("name", "category description", 2, 3, nil, "#1a2b3c", "cart", 4).
 		WillReturnResult(sqlmock.NewResult(12, 0))
 	mock.ExpectCommit()
 
-	category := domain.CategoryRecord{Name: "name", Description: "category description", UserID: 2}
+	category := domain.CategoryRecord{Name: "name", Description: "category description", UserID: 2, WorkspaceID: 3, Color: "#1a2b3c", Icon: "cart", Position: 4}
 
 	repo := NewMySqlCategoriesRepository(db)
 
@@ -216,15 +249,15 @@ func TestMySqlCategoriesRepository_DeleteCategory_When_It_Does_Not_Fail(t *testi
 func TestMySqlCategoriesRepository_UpdateCategory_When_The_Update_Fails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `name`=?,`description`=? WHERE `id` = ?")).
-		WithArgs("name", "category description", 11).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `name`=?,`description`=?,`parentId`=?,`color`=?,`icon`=? WHERE `id` = ?")).
+		WithArgs("name", "category description", nil, "", "", 11).
 		WillReturnError(fmt.Errorf("some error"))
 	mock.ExpectRollback()
 
 	repo := NewMySqlCategoriesRepository(db)
 	category := domain.CategoryRecord{ID: 11, Name: "name", Description: "category description"}
 
-	err := repo.UpdateCategory(context.Background(), &category)
+	err := repo.UpdateCategory(context.Background(), &category, []string{"Name", "Description", "ParentID", "Color", "Icon"})
 
 	assert.EqualError(t, err, "some error")
 
@@ -234,17 +267,342 @@ func TestMySqlCategoriesRepository_UpdateCategory_When_The_Update_Fails(t *testi
 func TestMySqlCategoriesRepository_UpdateCategory_When_The_Update_Does_Not_Fail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `name`=?,`description`=? WHERE `id` = ?")).
-		WithArgs("name", "category description", 11).
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `name`=?,`parentId`=? WHERE `id` = ?")).
+		WithArgs("name", nil, 11).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
 	repo := NewMySqlCategoriesRepository(db)
 	category := domain.CategoryRecord{ID: 11, Name: "name", Description: "category description"}
 
-	err := repo.UpdateCategory(context.Background(), &category)
+	err := repo.UpdateCategory(context.Background(), &category, []string{"Name", "ParentID"})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_DeleteCategories_When_Updating_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=? WHERE categoryId IN (SELECT `id` FROM `categories` WHERE `categories`.`userId` = ?)")).
+		WithArgs(nil, 2).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.DeleteCategories(context.Background(), domain.CategoryRecord{UserID: 2})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_DeleteCategories_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=? WHERE categoryId IN (SELECT `id` FROM `categories` WHERE `categories`.`userId` = ?)")).
+		WithArgs(nil, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`userId` = ?")).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.DeleteCategories(context.Background(), domain.CategoryRecord{UserID: 2})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_TransferCategories(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.TransferCategories(context.Background(), 1, 2)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_ReassignAndDeleteCategory_When_Updating_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE categoryId = ? FOR UPDATE")).
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "categoryId"}).AddRow(5, "list1", 11))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=? WHERE categoryId = ?")).
+		WithArgs(12, 11).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	targetCategoryID := int32(12)
+	res, err := repo.ReassignAndDeleteCategory(context.Background(), 11, &targetCategoryID)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_ReassignAndDeleteCategory_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE categoryId = ? FOR UPDATE")).
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "categoryId"}).AddRow(5, "list1", 11).AddRow(6, "list2", 11))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=? WHERE categoryId = ?")).
+		WithArgs(nil, 11).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.ReassignAndDeleteCategory(context.Background(), 11, nil)
+
+	assert.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(5), res[0].ID)
+	assert.Equal(t, int32(6), res[1].ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_TrashListsAndDeleteCategory_When_Trashing_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE categoryId = ? FOR UPDATE")).
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "categoryId"}).AddRow(5, "list1", 11))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=?,`trashedAt`=? WHERE categoryId = ?")).
+		WithArgs(nil, sqlmock.AnyArg(), 11).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.TrashListsAndDeleteCategory(context.Background(), 11)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_TrashListsAndDeleteCategory_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE categoryId = ? FOR UPDATE")).
+		WithArgs(11).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "categoryId"}).AddRow(5, "list1", 11).AddRow(6, "list2", 11))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `categoryId`=?,`trashedAt`=? WHERE categoryId = ?")).
+		WithArgs(nil, sqlmock.AnyArg(), 11).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE `categories`.`id` = ?")).
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.TrashListsAndDeleteCategory(context.Background(), 11)
+
+	assert.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(5), res[0].ID)
+	assert.Equal(t, int32(6), res[1].ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_ReorderCategories_When_Updating_A_Category_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `position`=? WHERE `categories`.`id` = ? AND `categories`.`workspaceId` = ?")).
+		WithArgs(0, 12, 3).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.ReorderCategories(context.Background(), 3, []int32{12, 11})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_ReorderCategories_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `position`=? WHERE `categories`.`id` = ? AND `categories`.`workspaceId` = ?")).
+		WithArgs(0, 12, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `categories` SET `position`=? WHERE `categories`.`id` = ? AND `categories`.`workspaceId` = ?")).
+		WithArgs(1, 11, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.ReorderCategories(context.Background(), 3, []int32{12, 11})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_FindDefaultCategoryID_When_The_User_Does_Not_Have_One(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `defaultCategories` WHERE `defaultCategories`.`userId` = ? AND `defaultCategories`.`workspaceId` = ? LIMIT 1")).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"userId", "workspaceId", "categoryId"}))
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.FindDefaultCategoryID(context.Background(), 1, 3)
+
+	assert.Nil(t, err)
+	assert.Nil(t, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_FindDefaultCategoryID_When_The_User_Has_One(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `defaultCategories` WHERE `defaultCategories`.`userId` = ? AND `defaultCategories`.`workspaceId` = ? LIMIT 1")).
+		WithArgs(1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"userId", "workspaceId", "categoryId"}).AddRow(1, 3, 11))
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	res, err := repo.FindDefaultCategoryID(context.Background(), 1, 3)
+
+	assert.Nil(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, int32(11), *res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_SetDefaultCategory(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `defaultCategories` (`userId`,`workspaceId`,`categoryId`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `categoryId`=VALUES(`categoryId`)")).
+		WithArgs(1, 3, 11).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	categoryID := int32(11)
+	err := repo.SetDefaultCategory(context.Background(), 1, 3, &categoryID)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_SetDefaultCategory_Removes_The_Default_Category(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `defaultCategories` WHERE `defaultCategories`.`userId` = ? AND `defaultCategories`.`workspaceId` = ?")).
+		WithArgs(1, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlCategoriesRepository(db)
+
+	err := repo.SetDefaultCategory(context.Background(), 1, 3, nil)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_GetCategoriesStats_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCategoriesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT lists.categoryId AS categoryId, COUNT(*) AS listsCount, COALESCE(SUM(lists.itemsCount), 0) AS itemsCount, MAX(lastActivity.createdAt) AS lastActivityAt FROM `lists` LEFT JOIN (SELECT listId, MAX(createdAt) AS createdAt FROM `activity` GROUP BY `listId`) AS lastActivity ON lastActivity.listId = lists.id WHERE (lists.workspaceId = ? AND lists.categoryId IS NOT NULL) AND lists.trashedAt IS NULL GROUP BY `lists`.`categoryId`")).
+		WithArgs(int32(1)).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetCategoriesStats(context.Background(), domain.CategoryStatsQuery{WorkspaceID: 1})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_GetCategoriesStats_Of_A_Category(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCategoriesRepository(db)
+
+	lastActivityAt := time.Date(2022, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT lists.categoryId AS categoryId, COUNT(*) AS listsCount, COALESCE(SUM(lists.itemsCount), 0) AS itemsCount, MAX(lastActivity.createdAt) AS lastActivityAt FROM `lists` LEFT JOIN (SELECT listId, MAX(createdAt) AS createdAt FROM `activity` GROUP BY `listId`) AS lastActivity ON lastActivity.listId = lists.id WHERE (lists.workspaceId = ? AND lists.categoryId IS NOT NULL) AND lists.categoryId = ? AND lists.trashedAt IS NULL GROUP BY `lists`.`categoryId`")).
+		WithArgs(int32(1), int32(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"categoryId", "listsCount", "itemsCount", "lastActivityAt"}).AddRow(2, 3, 10, lastActivityAt))
+
+	res, err := repo.GetCategoriesStats(context.Background(), domain.CategoryStatsQuery{WorkspaceID: 1, CategoryID: 2})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CategoryStatsRecords{{CategoryID: 2, ListsCount: 3, ItemsCount: 10, LastActivityAt: &lastActivityAt}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_GetLargestLists_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCategoriesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, itemsCount, categoryId FROM (SELECT lists.id, lists.name, lists.itemsCount, lists.categoryId, ROW_NUMBER() OVER (PARTITION BY lists.categoryId ORDER BY lists.itemsCount DESC, lists.id) AS rowNumber FROM `lists` WHERE (lists.workspaceId = ? AND lists.categoryId IS NOT NULL) AND lists.trashedAt IS NULL) AS ranked WHERE rowNumber <= ? ORDER BY categoryId, rowNumber")).
+		WithArgs(int32(1), 5).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetLargestLists(context.Background(), domain.CategoryStatsQuery{WorkspaceID: 1}, 5)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlCategoriesRepository_GetLargestLists_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlCategoriesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, name, itemsCount, categoryId FROM (SELECT lists.id, lists.name, lists.itemsCount, lists.categoryId, ROW_NUMBER() OVER (PARTITION BY lists.categoryId ORDER BY lists.itemsCount DESC, lists.id) AS rowNumber FROM `lists` WHERE (lists.workspaceId = ? AND lists.categoryId IS NOT NULL) AND lists.trashedAt IS NULL) AS ranked WHERE rowNumber <= ? ORDER BY categoryId, rowNumber")).
+		WithArgs(int32(1), 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "itemsCount", "categoryId"}).AddRow(5, "list5", 7, 2).AddRow(6, "list6", 3, 2))
+
+	res, err := repo.GetLargestLists(context.Background(), domain.CategoryStatsQuery{WorkspaceID: 1}, 5)
 
 	assert.Nil(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(5), res[0].ID)
+	assert.Equal(t, "list5", res[0].Name)
+	assert.Equal(t, int32(7), res[0].ItemsCount)
+	assert.Equal(t, int32(2), res[0].CategoryID.Int32)
+	assert.Equal(t, int32(6), res[1].ID)
 
 	helpers.CheckSqlMockExpectations(mock, t)
 }
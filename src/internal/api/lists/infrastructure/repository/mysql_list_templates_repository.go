@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+)
+
+type MySqlListTemplatesRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlListTemplatesRepository(db *gorm.DB) *MySqlListTemplatesRepository {
+	return &MySqlListTemplatesRepository{db}
+}
+
+func (r *MySqlListTemplatesRepository) FindListTemplate(ctx context.Context, query domain.ListTemplateRecord) (*domain.ListTemplateRecord, error) {
+	foundTemplate := domain.ListTemplateRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Preload("Shares").Take(&foundTemplate).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundTemplate, nil
+}
+
+func (r *MySqlListTemplatesRepository) FindAccessibleListTemplate(ctx context.Context, templateID int32, userID int32) (*domain.ListTemplateRecord, error) {
+	foundTemplate := domain.ListTemplateRecord{}
+	if err := r.accessibleBy(ctx, userID).Where("id = ?", templateID).Preload("Shares").Take(&foundTemplate).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundTemplate, nil
+}
+
+func (r *MySqlListTemplatesRepository) GetAccessibleListTemplates(ctx context.Context, userID int32) (domain.ListTemplateRecords, error) {
+	foundTemplates := domain.ListTemplateRecords{}
+	if err := r.accessibleBy(ctx, userID).Order("name").Preload("Shares").Find(&foundTemplates).Error; err != nil {
+		return nil, err
+	}
+
+	return foundTemplates, nil
+}
+
+func (r *MySqlListTemplatesRepository) ExistsListTemplate(ctx context.Context, query domain.ListTemplateRecord) (bool, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.ListTemplateRecord{}).Where(query).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *MySqlListTemplatesRepository) CreateListTemplate(ctx context.Context, record *domain.ListTemplateRecord) error {
+	return r.db.WithContext(ctx).Omit("Shares").Create(record).Error
+}
+
+func (r *MySqlListTemplatesRepository) DeleteListTemplate(ctx context.Context, templateID int32) error {
+	return r.db.WithContext(ctx).Where(domain.ListTemplateRecord{ID: templateID}).Delete(domain.ListTemplateRecord{}).Error
+}
+
+func (r *MySqlListTemplatesRepository) TransferListTemplates(ctx context.Context, fromUserID int32, toUserID int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("UPDATE `listTemplates` t1 INNER JOIN `listTemplates` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` SET t1.`name` = CONCAT(LEFT(t1.`name`, 37), ' (', t1.`id`, ')') WHERE t1.`userId` = ?", toUserID, fromUserID).Error; err != nil {
+			return err
+		}
+
+		userTemplateIDs := tx.Model(&domain.ListTemplateRecord{}).Where("userId = ?", fromUserID).Select("id")
+
+		if err := tx.Where("userId = ? AND templateId IN (?)", toUserID, userTemplateIDs).Delete(&domain.ListTemplateShareRecord{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.ListTemplateRecord{}).Where("userId = ?", fromUserID).Update("userId", toUserID).Error
+	})
+}
+
+func (r *MySqlListTemplatesRepository) ExistsListTemplateShare(ctx context.Context, query domain.ListTemplateShareRecord) (bool, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.ListTemplateShareRecord{}).Where(query).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *MySqlListTemplatesRepository) CreateListTemplateShare(ctx context.Context, record *domain.ListTemplateShareRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlListTemplatesRepository) DeleteListTemplateShare(ctx context.Context, query domain.ListTemplateShareRecord) error {
+	return r.db.WithContext(ctx).Where(query).Delete(domain.ListTemplateShareRecord{}).Error
+}
+
+// accessibleBy returns the query of the templates owned by or shared with the user
+func (r *MySqlListTemplatesRepository) accessibleBy(ctx context.Context, userID int32) *gorm.DB {
+	sharedTemplateIDs := r.db.Model(&domain.ListTemplateShareRecord{}).Select("templateId").Where("userId = ?", userID)
+
+	return r.db.WithContext(ctx).Where("userId = ? OR id IN (?)", userID, sharedTemplateIDs)
+}
@@ -0,0 +1,279 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	findAccessibleListTemplateQuery = "SELECT * FROM `listTemplates` WHERE (userId = ? OR id IN (SELECT `templateId` FROM `listTemplateShares` WHERE userId = ?)) AND id = ? LIMIT 1"
+	getAccessibleListTemplatesQuery = "SELECT * FROM `listTemplates` WHERE userId = ? OR id IN (SELECT `templateId` FROM `listTemplateShares` WHERE userId = ?) ORDER BY name"
+	listTemplateSharesPreloadQuery  = "SELECT * FROM `listTemplateShares` WHERE `listTemplateShares`.`templateId` = ?"
+	listTemplatesSharesPreloadQuery = "SELECT * FROM `listTemplateShares` WHERE `listTemplateShares`.`templateId` IN (?,?)"
+	listTemplateColumns             = []string{"id", "userId", "name", "items"}
+)
+
+func TestMySqlListTemplatesRepository_FindListTemplate_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listTemplates` WHERE `listTemplates`.`id` = ? AND `listTemplates`.`userId` = ? LIMIT 1")).
+		WithArgs(5, 1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.FindListTemplate(context.Background(), domain.ListTemplateRecord{ID: 5, UserID: 1})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_FindListTemplate_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listTemplates` WHERE `listTemplates`.`id` = ? AND `listTemplates`.`userId` = ? LIMIT 1")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows(listTemplateColumns).AddRow(5, 1, "packing", "[]"))
+	mock.ExpectQuery(regexp.QuoteMeta(listTemplateSharesPreloadQuery)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"templateId", "userId"}).AddRow(5, 2))
+
+	res, err := repo.FindListTemplate(context.Background(), domain.ListTemplateRecord{ID: 5, UserID: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.ListTemplateRecord{ID: 5, UserID: 1, Name: "packing", Items: "[]", Shares: []domain.ListTemplateShareRecord{{TemplateID: 5, UserID: 2}}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_FindAccessibleListTemplate_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(findAccessibleListTemplateQuery)).
+		WithArgs(1, 1, 5).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.FindAccessibleListTemplate(context.Background(), 5, 1)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_FindAccessibleListTemplate_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(findAccessibleListTemplateQuery)).
+		WithArgs(1, 1, 5).
+		WillReturnRows(sqlmock.NewRows(listTemplateColumns).AddRow(5, 2, "packing", "[]"))
+	mock.ExpectQuery(regexp.QuoteMeta(listTemplateSharesPreloadQuery)).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"templateId", "userId"}).AddRow(5, 1))
+
+	res, err := repo.FindAccessibleListTemplate(context.Background(), 5, 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.ListTemplateRecord{ID: 5, UserID: 2, Name: "packing", Items: "[]", Shares: []domain.ListTemplateShareRecord{{TemplateID: 5, UserID: 1}}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_GetAccessibleListTemplates_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getAccessibleListTemplatesQuery)).
+		WithArgs(1, 1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetAccessibleListTemplates(context.Background(), 1)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_GetAccessibleListTemplates_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(getAccessibleListTemplatesQuery)).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows(listTemplateColumns).AddRow(5, 1, "packing", "[]").AddRow(6, 2, "sprint", "[]"))
+	mock.ExpectQuery(regexp.QuoteMeta(listTemplatesSharesPreloadQuery)).
+		WithArgs(5, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"templateId", "userId"}).AddRow(6, 1))
+
+	res, err := repo.GetAccessibleListTemplates(context.Background(), 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ListTemplateRecords{
+		{ID: 5, UserID: 1, Name: "packing", Items: "[]", Shares: []domain.ListTemplateShareRecord{}},
+		{ID: 6, UserID: 2, Name: "sprint", Items: "[]", Shares: []domain.ListTemplateShareRecord{{TemplateID: 6, UserID: 1}}},
+	}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_ExistsListTemplate(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `listTemplates` WHERE `listTemplates`.`userId` = ? AND `listTemplates`.`name` = ?")).
+		WithArgs(1, "packing").
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+
+	res, err := repo.ExistsListTemplate(context.Background(), domain.ListTemplateRecord{UserID: 1, Name: "packing"})
+
+	assert.Nil(t, err)
+	assert.True(t, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_CreateListTemplate(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listTemplates` (`userId`,`name`,`items`,`createdAt`) VALUES (?,?,?,?)")).
+		WithArgs(1, "packing", `[{"title":"item","description":""}]`, createdAt).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	record := domain.ListTemplateRecord{UserID: 1, Name: "packing", Items: `[{"title":"item","description":""}]`, CreatedAt: createdAt}
+	err := repo.CreateListTemplate(context.Background(), &record)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), record.ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_DeleteListTemplate(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTemplates` WHERE `listTemplates`.`id` = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteListTemplate(context.Background(), 5)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_ExistsListTemplateShare(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `listTemplateShares` WHERE `listTemplateShares`.`templateId` = ? AND `listTemplateShares`.`userId` = ?")).
+		WithArgs(5, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+
+	res, err := repo.ExistsListTemplateShare(context.Background(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 2})
+
+	assert.Nil(t, err)
+	assert.False(t, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_CreateListTemplateShare(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listTemplateShares` (`templateId`,`userId`,`createdAt`) VALUES (?,?,?)")).
+		WithArgs(5, 2, createdAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateListTemplateShare(context.Background(), &domain.ListTemplateShareRecord{TemplateID: 5, UserID: 2, CreatedAt: createdAt})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_DeleteListTemplateShare(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTemplateShares` WHERE `listTemplateShares`.`templateId` = ? AND `listTemplateShares`.`userId` = ?")).
+		WithArgs(5, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteListTemplateShare(context.Background(), domain.ListTemplateShareRecord{TemplateID: 5, UserID: 2})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_TransferListTemplates_When_Renaming_The_Templates_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `listTemplates` t1 INNER JOIN `listTemplates` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` SET t1.`name` = CONCAT(LEFT(t1.`name`, 37), ' (', t1.`id`, ')') WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.TransferListTemplates(context.Background(), 1, 2)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListTemplatesRepository_TransferListTemplates_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListTemplatesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `listTemplates` t1 INNER JOIN `listTemplates` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` SET t1.`name` = CONCAT(LEFT(t1.`name`, 37), ' (', t1.`id`, ')') WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTemplateShares` WHERE userId = ? AND templateId IN (SELECT `id` FROM `listTemplates` WHERE userId = ?)")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `listTemplates` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.TransferListTemplates(context.Background(), 1, 2)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
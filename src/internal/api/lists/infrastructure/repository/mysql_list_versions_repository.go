@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+)
+
+type MySqlListVersionsRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlListVersionsRepository(db *gorm.DB) *MySqlListVersionsRepository {
+	return &MySqlListVersionsRepository{db}
+}
+
+func (r *MySqlListVersionsRepository) GetListVersions(ctx context.Context, listID int32) (domain.ListVersionRecords, error) {
+	res := domain.ListVersionRecords{}
+	if err := r.db.WithContext(ctx).Where("listId = ?", listID).Order("version DESC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MySqlListVersionsRepository) FindListVersion(ctx context.Context, query domain.ListVersionRecord) (*domain.ListVersionRecord, error) {
+	foundVersion := domain.ListVersionRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundVersion).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundVersion, nil
+}
@@ -0,0 +1,88 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var listVersionColumns = []string{"id", "listId", "userId", "version", "name", "categoryId", "items", "createdAt"}
+
+func TestMySqlListVersionsRepository_GetListVersions(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListVersionsRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listVersions` WHERE listId = ? ORDER BY version DESC")).
+			WithArgs(int32(1))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.GetListVersions(context.Background(), 1)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the versions", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(listVersionColumns).
+			AddRow(8, 1, 2, 2, "list", nil, "[]", now).
+			AddRow(5, 1, 2, 1, "old list", 3, "[]", now))
+
+		res, err := repo.GetListVersions(context.Background(), 1)
+
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(res))
+		assert.Equal(t, int32(2), res[0].Version)
+		assert.Nil(t, res[0].CategoryID)
+		assert.Equal(t, int32(1), res[1].Version)
+		assert.Equal(t, int32(3), *res[1].CategoryID)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlListVersionsRepository_FindListVersion(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListVersionsRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listVersions` WHERE `listVersions`.`listId` = ? AND `listVersions`.`version` = ? LIMIT 1")).
+			WithArgs(int32(1), int32(2))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.FindListVersion(context.Background(), domain.ListVersionRecord{ListID: 1, Version: 2})
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the version", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(listVersionColumns).AddRow(8, 1, 2, 2, "list", nil, "[]", now))
+
+		res, err := repo.FindListVersion(context.Background(), domain.ListVersionRecord{ListID: 1, Version: 2})
+
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.ListVersionRecord{ID: 8, ListID: 1, UserID: 2, Version: 2, Name: "list", Items: "[]", CreatedAt: now}, res)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
@@ -19,9 +19,14 @@ func orderItems(db *gorm.DB) *gorm.DB {
 	return db.Order("position ASC")
 }
 
+// untrashed leaves out the lists that are in the trash
+func untrashed(db *gorm.DB) *gorm.DB {
+	return db.Where("lists.trashedAt IS NULL")
+}
+
 func (r *MySqlListsRepository) FindList(ctx context.Context, query domain.ListRecord) (*domain.ListRecord, error) {
 	foundList := domain.ListRecord{}
-	if err := r.db.WithContext(ctx).Where(query).Preload("Items", orderItems).Take(&foundList).Error; err != nil {
+	if err := r.db.WithContext(ctx).Scopes(untrashed).Where(query).Preload("Items", orderItems).Take(&foundList).Error; err != nil {
 		return nil, err
 	}
 
@@ -30,50 +35,169 @@ func (r *MySqlListsRepository) FindList(ctx context.Context, query domain.ListRe
 
 func (r *MySqlListsRepository) ExistsList(ctx context.Context, query domain.ListRecord) (bool, error) {
 	count := int64(0)
-	if err := r.db.WithContext(ctx).Model(&domain.ListRecord{}).Where(query).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&domain.ListRecord{}).Scopes(untrashed).Where(query).Count(&count).Error; err != nil {
 		return false, err
 	}
 
 	return count > 0, nil
 }
 
+func (r *MySqlListsRepository) CountLists(ctx context.Context, query domain.ListRecord) (int64, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.ListRecord{}).Scopes(untrashed).Where(query).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *MySqlListsRepository) GetLists(ctx context.Context, query domain.ListRecord) (domain.ListRecords, error) {
 	foundLists := []domain.ListRecord{}
 
-	if err := r.db.WithContext(ctx).Where(query).Find(&foundLists).Error; err != nil {
+	if err := r.db.WithContext(ctx).Scopes(untrashed).Where(query).Find(&foundLists).Error; err != nil {
 		return nil, err
 	}
 
 	return foundLists, nil
 }
 
+func (r *MySqlListsRepository) ForEachListsBatch(ctx context.Context, query domain.ListRecord, batchSize int, fn func(lists domain.ListRecords) error) error {
+	foundLists := []domain.ListRecord{}
+
+	return r.db.WithContext(ctx).Scopes(untrashed).Where(query).Preload("Items", orderItems).FindInBatches(&foundLists, batchSize, func(tx *gorm.DB, batch int) error {
+		return fn(foundLists)
+	}).Error
+}
+
+func (r *MySqlListsRepository) GetListsByCategories(ctx context.Context, workspaceID int32, categoryIDs []int32) (domain.ListRecords, error) {
+	foundLists := []domain.ListRecord{}
+
+	if err := r.db.WithContext(ctx).Scopes(untrashed).Where(domain.ListRecord{WorkspaceID: workspaceID}).Where("categoryId IN ?", categoryIDs).Find(&foundLists).Error; err != nil {
+		return nil, err
+	}
+
+	return foundLists, nil
+}
+
+func (r *MySqlListsRepository) GetListsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (domain.ListRecords, error) {
+	foundLists := []domain.ListRecord{}
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN listTags ON listTags.listId = lists.id").
+		Joins("JOIN tags ON tags.id = listTags.tagId").
+		Where("lists.workspaceId = ? AND tags.userId = ? AND tags.name = ?", workspaceID, userID, tagName).
+		Scopes(untrashed).
+		Find(&foundLists).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return foundLists, nil
+}
+
+func (r *MySqlListsRepository) GetItemsByTag(ctx context.Context, workspaceID int32, userID int32, tagName string) (domain.ListItemRecords, error) {
+	foundItems := []domain.ListItemRecord{}
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN lists ON lists.id = listItems.listId").
+		Joins("JOIN listItemTags ON listItemTags.listItemId = listItems.id").
+		Joins("JOIN tags ON tags.id = listItemTags.tagId").
+		Where("lists.workspaceId = ? AND tags.userId = ? AND tags.name = ?", workspaceID, userID, tagName).
+		Scopes(untrashed).
+		Order("listItems.listId, listItems.position").
+		Find(&foundItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return foundItems, nil
+}
+
+func (r *MySqlListsRepository) GetDueItems(ctx context.Context, workspaceID int32, listIDs []int32) (domain.ListItemRecords, error) {
+	foundItems := []domain.ListItemRecord{}
+
+	query := r.db.WithContext(ctx).
+		Joins("JOIN lists ON lists.id = listItems.listId").
+		Where("lists.workspaceId = ? AND listItems.dueDate IS NOT NULL", workspaceID).
+		Scopes(untrashed)
+
+	if listIDs != nil {
+		query = query.Where("listItems.listId IN ?", listIDs)
+	}
+
+	if err := query.Order("listItems.listId, listItems.position").Find(&foundItems).Error; err != nil {
+		return nil, err
+	}
+
+	return foundItems, nil
+}
+
 func (r *MySqlListsRepository) CreateList(ctx context.Context, record *domain.ListRecord) error {
 	return r.db.WithContext(ctx).Create(record).Error
 }
 
+func (r *MySqlListsRepository) CreateLists(ctx context.Context, records []*domain.ListRecord) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, v := range records {
+			if err := tx.Create(v).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 func (r *MySqlListsRepository) DeleteList(ctx context.Context, query domain.ListRecord) error {
 	return r.db.WithContext(ctx).Select("Items").Delete(query).Error
 }
 
-func (r *MySqlListsRepository) UpdateList(ctx context.Context, record *domain.ListRecord) error {
-	error := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.WithContext(ctx).Session(&gorm.Session{FullSaveAssociations: true}).Updates(record).Error; err != nil {
+func (r *MySqlListsRepository) DeleteTrashedLists(ctx context.Context, query domain.ListRecord) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		trashedLists := tx.Model(&domain.ListRecord{}).Select("id").Where(query).Where("lists.trashedAt IS NOT NULL")
+
+		if err := tx.Where("listId IN (?)", trashedLists).Delete(&domain.ListItemRecord{}).Error; err != nil {
 			return err
 		}
 
-		var currentItems []int32
-		for _, v := range record.Items {
-			currentItems = append(currentItems, v.ID)
+		return tx.Where(query).Where("lists.trashedAt IS NOT NULL").Delete(&domain.ListRecord{}).Error
+	})
+}
+
+func (r *MySqlListsRepository) UpdateList(ctx context.Context, record *domain.ListRecord) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return updateList(tx.WithContext(ctx), record)
+	})
+}
+
+func (r *MySqlListsRepository) UpdateListWithVersion(ctx context.Context, record *domain.ListRecord, version *domain.ListVersionRecord) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		lastVersion := int32(0)
+		if err := tx.WithContext(ctx).Model(&domain.ListVersionRecord{}).Where("listId = ?", version.ListID).Select("COALESCE(MAX(version), 0)").Scan(&lastVersion).Error; err != nil {
+			return err
 		}
 
-		if err := tx.WithContext(ctx).Not(currentItems).Delete(&domain.ListItemRecord{}, "listId = ?", record.ID).Error; err != nil {
+		version.Version = lastVersion + 1
+
+		if err := tx.WithContext(ctx).Create(version).Error; err != nil {
 			return err
 		}
 
-		return nil
+		return updateList(tx.WithContext(ctx), record)
 	})
+}
+
+func updateList(tx *gorm.DB, record *domain.ListRecord) error {
+	if err := tx.Session(&gorm.Session{FullSaveAssociations: true}).Updates(record).Error; err != nil {
+		return err
+	}
 
-	return error
+	var currentItems []int32
+	for _, v := range record.Items {
+		currentItems = append(currentItems, v.ID)
+	}
+
+	return tx.Not(currentItems).Delete(&domain.ListItemRecord{}, "listId = ?", record.ID).Error
 }
 
 func (r *MySqlListsRepository) UpdateListItemsCount(ctx context.Context, listID int32) error {
@@ -81,3 +205,13 @@ func (r *MySqlListsRepository) UpdateListItemsCount(ctx context.Context, listID
 
 	return r.db.WithContext(ctx).Model(domain.ListRecord{}).Where(domain.ListRecord{ID: listID}).UpdateColumn("itemsCount", subquery).Error
 }
+
+func (r *MySqlListsRepository) TransferLists(ctx context.Context, fromUserID int32, toUserID int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.ListRecord{}).Where("userId = ?", fromUserID).Update("userId", toUserID).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.ListItemRecord{}).Where("userId = ?", fromUserID).Update("userId", toUserID).Error
+	})
+}
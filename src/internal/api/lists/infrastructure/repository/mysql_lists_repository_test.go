@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
@@ -27,13 +28,13 @@ func TestMySqlListsRepository_FindList_WhenTheQueryFails(t *testing.T) {
 	repo := NewMySqlListsRepository(db)
 
 	listID := int32(11)
-	userID := int32(1)
+	workspaceID := int32(3)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`id` = ? AND `lists`.`userId` = ?")).
-		WithArgs(listID, userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`id` = ? AND `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL")).
+		WithArgs(listID, workspaceID).
 		WillReturnError(fmt.Errorf("some error"))
 
-	_, err := repo.FindList(context.Background(), domain.ListRecord{ID: listID, UserID: userID})
+	_, err := repo.FindList(context.Background(), domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
 
 	assert.EqualError(t, err, "some error")
 
@@ -46,9 +47,10 @@ func TestMySqlListsRepository_FindList_WhenTheQueryDoesNotFail(t *testing.T) {
 
 	listID := int32(11)
 	userID := int32(1)
+	workspaceID := int32(3)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`id` = ? AND `lists`.`userId` = ?")).
-		WithArgs(listID, userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`id` = ? AND `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL")).
+		WithArgs(listID, workspaceID).
 		WillReturnRows(sqlmock.NewRows(listColumns).
 			AddRow(listID, "list1", userID, 3))
 	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listItems` WHERE `listItems`.`listId` = ? ORDER BY position ASC")).
@@ -57,7 +59,7 @@ func TestMySqlListsRepository_FindList_WhenTheQueryDoesNotFail(t *testing.T) {
 			AddRow(21, listID, userID, "item1_title", "item1_desc", 0).
 			AddRow(31, listID, userID, "item2_title", "item2_desc", 1))
 
-	res, err := repo.FindList(context.Background(), domain.ListRecord{ID: listID, UserID: userID})
+	res, err := repo.FindList(context.Background(), domain.ListRecord{ID: listID, WorkspaceID: workspaceID})
 
 	require.NotNil(t, res)
 	require.IsType(t, &domain.ListRecord{}, res)
@@ -86,7 +88,7 @@ func TestMySqlListsRepository_FindList_WhenTheQueryDoesNotFail(t *testing.T) {
 func TestMySqlListsRepository_ExistsList_WhenTheQueryFails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	userID := int32(1)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`name` = ? AND `lists`.`userId` = ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`name` = ? AND `lists`.`userId` = ? AND lists.trashedAt IS NULL")).
 		WithArgs("list name", userID).
 		WillReturnError(fmt.Errorf("some error"))
 
@@ -104,7 +106,7 @@ func TestMySqlListsRepository_ExistsList_WhenTheQueryFails(t *testing.T) {
 func TestMySqlListsRepository_ExistsList_WhenItDoesNotFail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	userID := int32(1)
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`name` = ? AND `lists`.`userId` = ?")).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`name` = ? AND `lists`.`userId` = ? AND lists.trashedAt IS NULL")).
 		WithArgs("list name", userID).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 
@@ -119,17 +121,51 @@ func TestMySqlListsRepository_ExistsList_WhenItDoesNotFail(t *testing.T) {
 	helpers.CheckSqlMockExpectations(mock, t)
 }
 
-func TestMySqlListsRepository_GetLists_WhenItFails(t *testing.T) {
+func TestMySqlListsRepository_CountLists_WhenTheQueryFails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	userID := int32(1)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`userId` = ? AND lists.trashedAt IS NULL")).
+		WithArgs(userID).
+		WillReturnError(fmt.Errorf("some error"))
 
 	repo := NewMySqlListsRepository(db)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`userId` = ?")).
+	res, err := repo.CountLists(context.Background(), domain.ListRecord{UserID: userID})
+
+	assert.Equal(t, int64(0), res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_CountLists_WhenItDoesNotFail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	userID := int32(1)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `lists` WHERE `lists`.`userId` = ? AND lists.trashedAt IS NULL")).
 		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	repo := NewMySqlListsRepository(db)
+
+	res, err := repo.CountLists(context.Background(), domain.ListRecord{UserID: userID})
+
+	assert.Equal(t, int64(3), res)
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetLists_WhenItFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	workspaceID := int32(3)
+
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL")).
+		WithArgs(workspaceID).
 		WillReturnError(fmt.Errorf("some error"))
 
-	res, err := repo.GetLists(context.Background(), domain.ListRecord{UserID: userID})
+	res, err := repo.GetLists(context.Background(), domain.ListRecord{WorkspaceID: workspaceID})
 
 	assert.Nil(t, res)
 	assert.EqualError(t, err, "some error")
@@ -140,16 +176,17 @@ func TestMySqlListsRepository_GetLists_WhenItFails(t *testing.T) {
 func TestMySqlListsRepository_GetLists_When_It_Does_Not_Fail_Without_Including_Items(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	userID := int32(1)
+	workspaceID := int32(3)
 
 	repo := NewMySqlListsRepository(db)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`userId` = ?")).
-		WithArgs(userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL")).
+		WithArgs(workspaceID).
 		WillReturnRows(sqlmock.NewRows(listColumns).
 			AddRow(11, "list1", userID, 3).
 			AddRow(12, "list2", userID, 4))
 
-	res, err := repo.GetLists(context.Background(), domain.ListRecord{UserID: userID})
+	res, err := repo.GetLists(context.Background(), domain.ListRecord{WorkspaceID: workspaceID})
 
 	assert.Nil(t, err)
 	require.NotNil(t, res)
@@ -169,12 +206,12 @@ func TestMySqlListsRepository_GetLists_When_It_Does_Not_Fail_Without_Including_I
 func TestMySqlListsRepository_CreateList_When_The_Create_Fails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?)")).
-		WithArgs("list1", 1, 2, 0).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list1", 1, 3, 2, 0).
 		WillReturnError(fmt.Errorf("some error"))
 	mock.ExpectRollback()
 
-	list := domain.ListRecord{UserID: 1, Name: "list1", CategoryID: &sql.NullInt32{Int32: 2, Valid: true}}
+	list := domain.ListRecord{UserID: 1, WorkspaceID: 3, Name: "list1", CategoryID: &sql.NullInt32{Int32: 2, Valid: true}}
 	repo := NewMySqlListsRepository(db)
 
 	err := repo.CreateList(context.Background(), &list)
@@ -187,18 +224,19 @@ func TestMySqlListsRepository_CreateList_When_The_Create_Fails(t *testing.T) {
 func TestMySqlListsRepository_CreateList_When_It_Does_Not_Fail(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	mock.ExpectBegin()
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?)")).
-		WithArgs("list1", 1, 2, 0).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list1", 1, 3, 2, 0).
 		WillReturnResult(sqlmock.NewResult(12, 0))
-	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listItems` (`listId`,`userId`,`title`,`description`,`position`) VALUES (?,?,?,?,?) ON DUPLICATE KEY UPDATE `listId`=VALUES(`listId`)")).
-		WithArgs(0, 1, "item1 title", "item1 desc", 0).
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listItems` (`listId`,`userId`,`title`,`description`,`position`,`dueDate`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `listId`=VALUES(`listId`)")).
+		WithArgs(0, 1, "item1 title", "item1 desc", 0, nil).
 		WillReturnResult(sqlmock.NewResult(12, 0))
 	mock.ExpectCommit()
 
 	list := domain.ListRecord{
-		UserID:     1,
-		Name:       "list1",
-		CategoryID: &sql.NullInt32{Int32: 2, Valid: true},
+		UserID:      1,
+		WorkspaceID: 3,
+		Name:        "list1",
+		CategoryID:  &sql.NullInt32{Int32: 2, Valid: true},
 		Items: []domain.ListItemRecord{
 			{UserID: 1, Title: "item1 title", Description: "item1 desc", Position: 0},
 		},
@@ -212,6 +250,57 @@ func TestMySqlListsRepository_CreateList_When_It_Does_Not_Fail(t *testing.T) {
 	helpers.CheckSqlMockExpectations(mock, t)
 }
 
+func TestMySqlListsRepository_CreateLists_When_Creating_One_Of_Them_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list1", 1, 3, nil, 0).
+		WillReturnResult(sqlmock.NewResult(12, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list2", 1, 3, nil, 0).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	lists := []*domain.ListRecord{
+		{UserID: 1, WorkspaceID: 3, Name: "list1", CategoryID: &sql.NullInt32{}},
+		{UserID: 1, WorkspaceID: 3, Name: "list2", CategoryID: &sql.NullInt32{}},
+	}
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.CreateLists(context.Background(), lists)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_CreateLists_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list1", 1, 3, nil, 0).
+		WillReturnResult(sqlmock.NewResult(12, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listItems` (`listId`,`userId`,`title`,`description`,`position`,`dueDate`) VALUES (?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `listId`=VALUES(`listId`)")).
+		WithArgs(0, 1, "item1 title", "", 0, nil).
+		WillReturnResult(sqlmock.NewResult(20, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `lists` (`name`,`userId`,`workspaceId`,`categoryId`,`itemsCount`) VALUES (?,?,?,?,?)")).
+		WithArgs("list2", 1, 3, nil, 0).
+		WillReturnResult(sqlmock.NewResult(13, 0))
+	mock.ExpectCommit()
+
+	lists := []*domain.ListRecord{
+		{UserID: 1, WorkspaceID: 3, Name: "list1", CategoryID: &sql.NullInt32{}, Items: []domain.ListItemRecord{{UserID: 1, Title: "item1 title"}}},
+		{UserID: 1, WorkspaceID: 3, Name: "list2", CategoryID: &sql.NullInt32{}},
+	}
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.CreateLists(context.Background(), lists)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
 func TestMySqlListsRepository_DeleteList_When_Deleting_The_ListItems_Fails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	listID := int32(11)
@@ -336,6 +425,80 @@ func TestMySqlListsRepository_UpdateList_When_The_Update_Does_Not_Fail(t *testin
 	helpers.CheckSqlMockExpectations(mock, t)
 }
 
+func TestMySqlListsRepository_UpdateListWithVersion_When_The_Query_To_Get_The_Last_Version_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version), 0) FROM `listVersions` WHERE listId = ?")).
+		WithArgs(int32(11)).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.UpdateListWithVersion(context.Background(), &domain.ListRecord{ID: 11, UserID: 1, Name: "list1"}, &domain.ListVersionRecord{ListID: 11})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_UpdateListWithVersion_Does_Not_Keep_The_Version_When_The_Update_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	now := time.Now()
+	version := domain.ListVersionRecord{ListID: 11, UserID: 1, Name: "list1", Items: "[]", CreatedAt: now}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version), 0) FROM `listVersions` WHERE listId = ?")).
+		WithArgs(int32(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listVersions` (`listId`,`userId`,`version`,`name`,`categoryId`,`items`,`createdAt`) VALUES (?,?,?,?,?,?,?)")).
+		WithArgs(int32(11), int32(1), int32(4), "list1", nil, "[]", now).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `name`=?,`userId`=? WHERE `id` = ?")).
+		WithArgs("list2", 1, 11).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.UpdateListWithVersion(context.Background(), &domain.ListRecord{ID: 11, UserID: 1, Name: "list2"}, &version)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_UpdateListWithVersion_Creates_The_Next_Version_And_Updates_The_List(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	now := time.Now()
+	version := domain.ListVersionRecord{ListID: 11, UserID: 1, Name: "list1", Items: "[]", CreatedAt: now}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(version), 0) FROM `listVersions` WHERE listId = ?")).
+		WithArgs(int32(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(3))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listVersions` (`listId`,`userId`,`version`,`name`,`categoryId`,`items`,`createdAt`) VALUES (?,?,?,?,?,?,?)")).
+		WithArgs(int32(11), int32(1), int32(4), "list1", nil, "[]", now).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `name`=?,`userId`=? WHERE `id` = ?")).
+		WithArgs("list2", 1, 11).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listItems` WHERE listId = ?")).
+		WithArgs(11).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.UpdateListWithVersion(context.Background(), &domain.ListRecord{ID: 11, UserID: 1, Name: "list2"}, &version)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(7), version.ID)
+	assert.Equal(t, int32(4), version.Version)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
 func TestMySqlListsRepository_IncrementListCounter_When_It_Fails(t *testing.T) {
 	mock, db := helpers.GetMockedDb(t)
 	repo := NewMySqlListsRepository(db)
@@ -369,3 +532,245 @@ func TestMySqlListsRepository_UpdateListItemsCounter_When_The_Update_Does_Not_Fa
 
 	helpers.CheckSqlMockExpectations(mock, t)
 }
+
+func TestMySqlListsRepository_DeleteTrashedLists_When_Deleting_The_Items_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listItems` WHERE listId IN (SELECT `id` FROM `lists` WHERE `lists`.`userId` = ? AND lists.trashedAt IS NOT NULL)")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.DeleteTrashedLists(context.Background(), domain.ListRecord{UserID: 1})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_DeleteTrashedLists_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listItems` WHERE listId IN (SELECT `id` FROM `lists` WHERE `lists`.`userId` = ? AND lists.trashedAt IS NOT NULL)")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `lists` WHERE `lists`.`userId` = ? AND lists.trashedAt IS NOT NULL")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.DeleteTrashedLists(context.Background(), domain.ListRecord{UserID: 1})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_TransferLists_When_Updating_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.TransferLists(context.Background(), 1, 2)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_TransferLists_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `lists` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `listItems` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectCommit()
+
+	repo := NewMySqlListsRepository(db)
+
+	err := repo.TransferLists(context.Background(), 1, 2)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_ForEachListsBatch_WhenItFails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	workspaceID := int32(3)
+
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL ORDER BY `lists`.`id` LIMIT 2")).
+		WithArgs(workspaceID).
+		WillReturnError(fmt.Errorf("some error"))
+
+	err := repo.ForEachListsBatch(context.Background(), domain.ListRecord{WorkspaceID: workspaceID}, 2, func(lists domain.ListRecords) error {
+		return nil
+	})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_ForEachListsBatch_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	userID := int32(1)
+	workspaceID := int32(3)
+
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND lists.trashedAt IS NULL ORDER BY `lists`.`id` LIMIT 2")).
+		WithArgs(workspaceID).
+		WillReturnRows(sqlmock.NewRows(listColumns).
+			AddRow(11, "list1", userID, 2).
+			AddRow(12, "list2", userID, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listItems` WHERE `listItems`.`listId` IN (?,?) ORDER BY position ASC")).
+		WithArgs(11, 12).
+		WillReturnRows(sqlmock.NewRows(listItemsColumns).
+			AddRow(21, 11, userID, "item1_title", "item1_desc", 0).
+			AddRow(22, 11, userID, "item2_title", "item2_desc", 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND `lists`.`id` > ? AND lists.trashedAt IS NULL ORDER BY `lists`.`id` LIMIT 2")).
+		WithArgs(workspaceID, 12).
+		WillReturnRows(sqlmock.NewRows(listColumns).
+			AddRow(13, "list3", userID, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `listItems` WHERE `listItems`.`listId` = ? ORDER BY position ASC")).
+		WithArgs(13).
+		WillReturnRows(sqlmock.NewRows(listItemsColumns))
+
+	batches := []domain.ListRecords{}
+
+	err := repo.ForEachListsBatch(context.Background(), domain.ListRecord{WorkspaceID: workspaceID}, 2, func(lists domain.ListRecords) error {
+		batches = append(batches, append(domain.ListRecords{}, lists...))
+		return nil
+	})
+
+	assert.Nil(t, err)
+	require.Equal(t, 2, len(batches))
+	require.Equal(t, 2, len(batches[0]))
+	assert.Equal(t, int32(11), batches[0][0].ID)
+	require.Equal(t, 2, len(batches[0][0].Items))
+	assert.Equal(t, "item1_title", batches[0][0].Items[0].Title)
+	assert.Equal(t, "item2_title", batches[0][0].Items[1].Title)
+	assert.Equal(t, int32(12), batches[0][1].ID)
+	assert.Equal(t, 0, len(batches[0][1].Items))
+	require.Equal(t, 1, len(batches[1]))
+	assert.Equal(t, int32(13), batches[1][0].ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetListsByCategories(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `lists` WHERE `lists`.`workspaceId` = ? AND categoryId IN (?,?)")).
+		WithArgs(3, 5, 6).
+		WillReturnRows(sqlmock.NewRows(listColumns).AddRow(11, "list1", 1, 3))
+
+	res, err := repo.GetListsByCategories(context.Background(), 3, []int32{5, 6})
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, int32(11), res[0].ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetListsByTag(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `lists`.`id`,`lists`.`name`,`lists`.`userId`,`lists`.`workspaceId`,`lists`.`categoryId`,`lists`.`itemsCount` FROM `lists` JOIN listTags ON listTags.listId = lists.id JOIN tags ON tags.id = listTags.tagId WHERE (lists.workspaceId = ? AND tags.userId = ? AND tags.name = ?) AND lists.trashedAt IS NULL")).
+		WithArgs(1, 2, "work").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "itemsCount"}).AddRow(3, "list3", 4))
+
+	res, err := repo.GetListsByTag(context.Background(), 1, 2, "work")
+
+	assert.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, int32(3), res[0].ID)
+	assert.Equal(t, "list3", res[0].Name)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetItemsByTag_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `listItems`.`id`,`listItems`.`listId`,`listItems`.`userId`,`listItems`.`title`,`listItems`.`description`,`listItems`.`position`,`listItems`.`dueDate` FROM `listItems` JOIN lists ON lists.id = listItems.listId JOIN listItemTags ON listItemTags.listItemId = listItems.id JOIN tags ON tags.id = listItemTags.tagId WHERE (lists.workspaceId = ? AND tags.userId = ? AND tags.name = ?) AND lists.trashedAt IS NULL ORDER BY listItems.listId, listItems.position")).
+		WithArgs(1, 2, "work").
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetItemsByTag(context.Background(), 1, 2, "work")
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetItemsByTag_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `listItems`.`id`,`listItems`.`listId`,`listItems`.`userId`,`listItems`.`title`,`listItems`.`description`,`listItems`.`position`,`listItems`.`dueDate` FROM `listItems` JOIN lists ON lists.id = listItems.listId JOIN listItemTags ON listItemTags.listItemId = listItems.id JOIN tags ON tags.id = listItemTags.tagId WHERE (lists.workspaceId = ? AND tags.userId = ? AND tags.name = ?) AND lists.trashedAt IS NULL ORDER BY listItems.listId, listItems.position")).
+		WithArgs(1, 2, "work").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "listId", "title"}).AddRow(7, 3, "item7"))
+
+	res, err := repo.GetItemsByTag(context.Background(), 1, 2, "work")
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ListItemRecords{{ID: 7, ListID: 3, Title: "item7"}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetDueItems_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `listItems`.`id`,`listItems`.`listId`,`listItems`.`userId`,`listItems`.`title`,`listItems`.`description`,`listItems`.`position`,`listItems`.`dueDate` FROM `listItems` JOIN lists ON lists.id = listItems.listId WHERE (lists.workspaceId = ? AND listItems.dueDate IS NOT NULL) AND lists.trashedAt IS NULL ORDER BY listItems.listId, listItems.position")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetDueItems(context.Background(), 1, nil)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlListsRepository_GetDueItems_Of_Some_Lists(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlListsRepository(db)
+
+	dueDate := time.Date(2023, 2, 1, 10, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `listItems`.`id`,`listItems`.`listId`,`listItems`.`userId`,`listItems`.`title`,`listItems`.`description`,`listItems`.`position`,`listItems`.`dueDate` FROM `listItems` JOIN lists ON lists.id = listItems.listId WHERE (lists.workspaceId = ? AND listItems.dueDate IS NOT NULL) AND listItems.listId IN (?,?) AND lists.trashedAt IS NULL ORDER BY listItems.listId, listItems.position")).
+		WithArgs(1, 3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "listId", "title", "dueDate"}).AddRow(7, 3, "item7", dueDate))
+
+	res, err := repo.GetDueItems(context.Background(), 1, []int32{3, 4})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ListItemRecords{{ID: 7, ListID: 3, Title: "item7", DueDate: &dueDate}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
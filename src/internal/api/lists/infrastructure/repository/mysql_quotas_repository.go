@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type MySqlQuotasRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlQuotasRepository(db *gorm.DB) *MySqlQuotasRepository {
+	return &MySqlQuotasRepository{db}
+}
+
+func (r *MySqlQuotasRepository) GetUserQuota(ctx context.Context, userID int32) (*domain.UserQuotaRecord, error) {
+	found := []domain.UserQuotaRecord{}
+	if err := r.db.WithContext(ctx).Where(domain.UserQuotaRecord{UserID: userID}).Limit(1).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	if len(found) == 0 {
+		return &domain.UserQuotaRecord{UserID: userID}, nil
+	}
+
+	return &found[0], nil
+}
+
+func (r *MySqlQuotasRepository) SaveUserQuota(ctx context.Context, record *domain.UserQuotaRecord) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"maxLists", "maxItemsPerList", "maxCategories"})}).Create(record).Error
+}
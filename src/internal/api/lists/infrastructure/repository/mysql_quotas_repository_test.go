@@ -0,0 +1,76 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySqlQuotasRepository_GetUserQuota(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlQuotasRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `userQuotas` WHERE `userQuotas`.`userId` = ? LIMIT 1")).
+			WithArgs(int32(1))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.GetUserQuota(context.Background(), 1)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return a quota without overrides if the user doesn't have one", func(t *testing.T) {
+		expectedQuery().WillReturnRows(sqlmock.NewRows([]string{"userId", "maxLists", "maxItemsPerList", "maxCategories"}))
+
+		res, err := repo.GetUserQuota(context.Background(), 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.UserQuotaRecord{UserID: 1}, res)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the quota of the user", func(t *testing.T) {
+		expectedQuery().WillReturnRows(sqlmock.NewRows([]string{"userId", "maxLists", "maxItemsPerList", "maxCategories"}).AddRow(1, 50, nil, nil))
+
+		res, err := repo.GetUserQuota(context.Background(), 1)
+
+		maxLists := int32(50)
+		assert.Nil(t, err)
+		assert.Equal(t, &domain.UserQuotaRecord{UserID: 1, MaxLists: &maxLists}, res)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlQuotasRepository_SaveUserQuota(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `userQuotas` (`maxLists`,`maxItemsPerList`,`maxCategories`,`userId`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `maxLists`=VALUES(`maxLists`),`maxItemsPerList`=VALUES(`maxItemsPerList`),`maxCategories`=VALUES(`maxCategories`)")).
+		WithArgs(10, nil, 0, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlQuotasRepository(db)
+
+	maxLists := int32(10)
+	maxCategories := int32(0)
+	err := repo.SaveUserQuota(context.Background(), &domain.UserQuotaRecord{UserID: 1, MaxLists: &maxLists, MaxCategories: &maxCategories})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
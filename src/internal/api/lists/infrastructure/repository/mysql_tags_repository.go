@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"gorm.io/gorm"
+)
+
+type MySqlTagsRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlTagsRepository(db *gorm.DB) *MySqlTagsRepository {
+	return &MySqlTagsRepository{db}
+}
+
+func (r *MySqlTagsRepository) FindTag(ctx context.Context, query domain.TagRecord) (*domain.TagRecord, error) {
+	foundTag := domain.TagRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundTag).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundTag, nil
+}
+
+func (r *MySqlTagsRepository) ExistsTag(ctx context.Context, query domain.TagRecord) (bool, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.TagRecord{}).Where(query).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *MySqlTagsRepository) GetTags(ctx context.Context, userID int32) (domain.TagRecords, error) {
+	listsCount := r.db.Model(&domain.ListTagRecord{}).Select("COUNT(*)").Where("listTags.tagId = tags.id")
+	itemsCount := r.db.Model(&domain.ListItemTagRecord{}).Select("COUNT(*)").Where("listItemTags.tagId = tags.id")
+
+	foundTags := domain.TagRecords{}
+
+	err := r.db.WithContext(ctx).Model(&domain.TagRecord{}).
+		Select("tags.*, (?) AS listsCount, (?) AS itemsCount", listsCount, itemsCount).
+		Where(domain.TagRecord{UserID: userID}).
+		Order("name").
+		Find(&foundTags).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return foundTags, nil
+}
+
+func (r *MySqlTagsRepository) CreateTag(ctx context.Context, record *domain.TagRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlTagsRepository) UpdateTag(ctx context.Context, record *domain.TagRecord) error {
+	return r.db.WithContext(ctx).Select("Name").Updates(record).Error
+}
+
+func (r *MySqlTagsRepository) DeleteTag(ctx context.Context, query domain.TagRecord) error {
+	return r.db.WithContext(ctx).Where(query).Delete(&domain.TagRecord{}).Error
+}
+
+func (r *MySqlTagsRepository) MergeTags(ctx context.Context, tagID int32, targetTagID int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT `listId`, ? FROM `listTags` WHERE `tagId` = ?", targetTagID, tagID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("INSERT IGNORE INTO `listItemTags` (`listItemId`, `tagId`) SELECT `listItemId`, ? FROM `listItemTags` WHERE `tagId` = ?", targetTagID, tagID).Error; err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.TagRecord{ID: tagID}).Error
+	})
+}
+
+func (r *MySqlTagsRepository) TransferTags(ctx context.Context, fromUserID int32, toUserID int32) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT lt.`listId`, t2.`id` FROM `listTags` lt INNER JOIN `tags` t1 ON t1.`id` = lt.`tagId` INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?", toUserID, fromUserID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("INSERT IGNORE INTO `listItemTags` (`listItemId`, `tagId`) SELECT lit.`listItemId`, t2.`id` FROM `listItemTags` lit INNER JOIN `tags` t1 ON t1.`id` = lit.`tagId` INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?", toUserID, fromUserID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE t1 FROM `tags` t1 INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?", toUserID, fromUserID).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.TagRecord{}).Where("userId = ?", fromUserID).Update("userId", toUserID).Error
+	})
+}
+
+func (r *MySqlTagsRepository) GetTagListIDs(ctx context.Context, tagID int32) ([]int32, error) {
+	listIDs := []int32{}
+	if err := r.db.WithContext(ctx).Model(&domain.ListTagRecord{}).Where("tagId = ?", tagID).Pluck("listId", &listIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return listIDs, nil
+}
+
+func (r *MySqlTagsRepository) SetListTags(ctx context.Context, userID int32, listID int32, names []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userTagIDs := tx.Model(&domain.TagRecord{}).Where("userId = ?", userID).Select("id")
+
+		if err := tx.Where("listId = ? AND tagId IN (?)", listID, userTagIDs).Delete(&domain.ListTagRecord{}).Error; err != nil {
+			return err
+		}
+
+		tagIDs, err := r.findOrCreateTags(tx, userID, names)
+		if err != nil || len(tagIDs) == 0 {
+			return err
+		}
+
+		records := make([]domain.ListTagRecord, len(tagIDs))
+		for i, id := range tagIDs {
+			records[i] = domain.ListTagRecord{ListID: listID, TagID: id}
+		}
+
+		return tx.Create(&records).Error
+	})
+}
+
+func (r *MySqlTagsRepository) SetItemTags(ctx context.Context, userID int32, itemID int32, names []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		userTagIDs := tx.Model(&domain.TagRecord{}).Where("userId = ?", userID).Select("id")
+
+		if err := tx.Where("listItemId = ? AND tagId IN (?)", itemID, userTagIDs).Delete(&domain.ListItemTagRecord{}).Error; err != nil {
+			return err
+		}
+
+		tagIDs, err := r.findOrCreateTags(tx, userID, names)
+		if err != nil || len(tagIDs) == 0 {
+			return err
+		}
+
+		records := make([]domain.ListItemTagRecord, len(tagIDs))
+		for i, id := range tagIDs {
+			records[i] = domain.ListItemTagRecord{ListItemID: itemID, TagID: id}
+		}
+
+		return tx.Create(&records).Error
+	})
+}
+
+// findOrCreateTags returns the ids of the tags of the user with the names, in the same order,
+// creating the ones that don't exist
+func (r *MySqlTagsRepository) findOrCreateTags(tx *gorm.DB, userID int32, names []string) ([]int32, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	foundTags := domain.TagRecords{}
+	if err := tx.Where("userId = ? AND name IN ?", userID, names).Find(&foundTags).Error; err != nil {
+		return nil, err
+	}
+
+	idsByName := map[string]int32{}
+	for _, t := range foundTags {
+		idsByName[t.Name] = t.ID
+	}
+
+	newTags := domain.TagRecords{}
+	for _, n := range names {
+		if _, ok := idsByName[n]; !ok {
+			newTags = append(newTags, domain.TagRecord{UserID: userID, Name: n})
+		}
+	}
+
+	if len(newTags) > 0 {
+		if err := tx.Create(&newTags).Error; err != nil {
+			return nil, err
+		}
+
+		for _, t := range newTags {
+			idsByName[t.Name] = t.ID
+		}
+	}
+
+	res := make([]int32, len(names))
+	for i, n := range names {
+		res[i] = idsByName[n]
+	}
+
+	return res, nil
+}
+
+func (r *MySqlTagsRepository) GetListsTags(ctx context.Context, userID int32, listIDs []int32) (domain.TaggedRecords, error) {
+	found := domain.TaggedRecords{}
+	if len(listIDs) == 0 {
+		return found, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&domain.ListTagRecord{}).
+		Select("listTags.listId AS id, tags.name AS name").
+		Joins("JOIN tags ON tags.id = listTags.tagId").
+		Where("tags.userId = ? AND listTags.listId IN ?", userID, listIDs).
+		Order("tags.name").
+		Scan(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *MySqlTagsRepository) GetItemsTags(ctx context.Context, userID int32, itemIDs []int32) (domain.TaggedRecords, error) {
+	found := domain.TaggedRecords{}
+	if len(itemIDs) == 0 {
+		return found, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&domain.ListItemTagRecord{}).
+		Select("listItemTags.listItemId AS id, tags.name AS name").
+		Joins("JOIN tags ON tags.id = listItemTags.tagId").
+		Where("tags.userId = ? AND listItemTags.listItemId IN ?", userID, itemIDs).
+		Order("tags.name").
+		Scan(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
+
+func (r *MySqlTagsRepository) GetListsOwnerTags(ctx context.Context, listIDs []int32) (domain.TaggedRecords, error) {
+	found := domain.TaggedRecords{}
+	if len(listIDs) == 0 {
+		return found, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&domain.ListTagRecord{}).
+		Select("listTags.listId AS id, tags.name AS name").
+		Joins("JOIN tags ON tags.id = listTags.tagId").
+		Joins("JOIN lists ON lists.id = listTags.listId AND lists.userId = tags.userId").
+		Where("listTags.listId IN ?", listIDs).
+		Order("tags.name").
+		Scan(&found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return found, nil
+}
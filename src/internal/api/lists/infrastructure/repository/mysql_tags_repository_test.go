@@ -0,0 +1,396 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySqlTagsRepository_FindTag_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tags` WHERE `tags`.`id` = ? AND `tags`.`userId` = ? LIMIT 1")).
+		WithArgs(5, 1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.FindTag(context.Background(), domain.TagRecord{ID: 5, UserID: 1})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_FindTag_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tags` WHERE `tags`.`id` = ? AND `tags`.`userId` = ? LIMIT 1")).
+		WithArgs(5, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "name"}).AddRow(5, 1, "work"))
+
+	res, err := repo.FindTag(context.Background(), domain.TagRecord{ID: 5, UserID: 1})
+
+	assert.Nil(t, err)
+	assert.Equal(t, &domain.TagRecord{ID: 5, UserID: 1, Name: "work"}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_ExistsTag(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tags` WHERE `tags`.`userId` = ? AND `tags`.`name` = ?")).
+		WithArgs(1, "work").
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+
+	res, err := repo.ExistsTag(context.Background(), domain.TagRecord{UserID: 1, Name: "work"})
+
+	assert.Nil(t, err)
+	assert.True(t, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetTags_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT tags.*, (SELECT COUNT(*) FROM `listTags` WHERE listTags.tagId = tags.id) AS listsCount, (SELECT COUNT(*) FROM `listItemTags` WHERE listItemTags.tagId = tags.id) AS itemsCount FROM `tags` WHERE `tags`.`userId` = ? ORDER BY name")).
+		WithArgs(1).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetTags(context.Background(), 1)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetTags_When_The_Query_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT tags.*, (SELECT COUNT(*) FROM `listTags` WHERE listTags.tagId = tags.id) AS listsCount, (SELECT COUNT(*) FROM `listItemTags` WHERE listItemTags.tagId = tags.id) AS itemsCount FROM `tags` WHERE `tags`.`userId` = ? ORDER BY name")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "name", "listsCount", "itemsCount"}).AddRow(5, 1, "home", 2, 0).AddRow(6, 1, "work", 1, 3))
+
+	res, err := repo.GetTags(context.Background(), 1)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.TagRecords{
+		{ID: 5, UserID: 1, Name: "home", ListsCount: 2},
+		{ID: 6, UserID: 1, Name: "work", ListsCount: 1, ItemsCount: 3},
+	}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_CreateTag(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `tags` (`userId`,`name`) VALUES (?,?)")).
+		WithArgs(1, "work").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	record := domain.TagRecord{UserID: 1, Name: "work"}
+	err := repo.CreateTag(context.Background(), &record)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), record.ID)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_UpdateTag(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `tags` SET `name`=? WHERE `id` = ?")).
+		WithArgs("home", 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.UpdateTag(context.Background(), &domain.TagRecord{ID: 5, UserID: 1, Name: "home"})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_DeleteTag(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `tags` WHERE `tags`.`id` = ? AND `tags`.`userId` = ?")).
+		WithArgs(5, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.DeleteTag(context.Background(), domain.TagRecord{ID: 5, UserID: 1})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_MergeTags_When_Moving_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT `listId`, ? FROM `listTags` WHERE `tagId` = ?")).
+		WithArgs(6, 5).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.MergeTags(context.Background(), 5, 6)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_MergeTags_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT `listId`, ? FROM `listTags` WHERE `tagId` = ?")).
+		WithArgs(6, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listItemTags` (`listItemId`, `tagId`) SELECT `listItemId`, ? FROM `listItemTags` WHERE `tagId` = ?")).
+		WithArgs(6, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `tags` WHERE `tags`.`id` = ?")).
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.MergeTags(context.Background(), 5, 6)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_TransferTags_When_Merging_The_Lists_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT lt.`listId`, t2.`id` FROM `listTags` lt INNER JOIN `tags` t1 ON t1.`id` = lt.`tagId` INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.TransferTags(context.Background(), 1, 2)
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_TransferTags_When_It_Does_Not_Fail(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listTags` (`listId`, `tagId`) SELECT lt.`listId`, t2.`id` FROM `listTags` lt INNER JOIN `tags` t1 ON t1.`id` = lt.`tagId` INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT IGNORE INTO `listItemTags` (`listItemId`, `tagId`) SELECT lit.`listItemId`, t2.`id` FROM `listItemTags` lit INNER JOIN `tags` t1 ON t1.`id` = lit.`tagId` INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE t1 FROM `tags` t1 INNER JOIN `tags` t2 ON t2.`userId` = ? AND t2.`name` = t1.`name` WHERE t1.`userId` = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `tags` SET `userId`=? WHERE userId = ?")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.TransferTags(context.Background(), 1, 2)
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetTagListIDs(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `listId` FROM `listTags` WHERE tagId = ?")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"listId"}).AddRow(2).AddRow(3))
+
+	res, err := repo.GetTagListIDs(context.Background(), 5)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []int32{2, 3}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_SetListTags_When_Deleting_The_Current_Tags_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTags` WHERE listId = ? AND tagId IN (SELECT `id` FROM `tags` WHERE userId = ?)")).
+		WithArgs(2, 1).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.SetListTags(context.Background(), 1, 2, []string{"work"})
+
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_SetListTags_Without_Tags(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTags` WHERE listId = ? AND tagId IN (SELECT `id` FROM `tags` WHERE userId = ?)")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.SetListTags(context.Background(), 1, 2, []string{})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_SetListTags_Creates_The_Missing_Tags(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listTags` WHERE listId = ? AND tagId IN (SELECT `id` FROM `tags` WHERE userId = ?)")).
+		WithArgs(2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tags` WHERE userId = ? AND name IN (?,?)")).
+		WithArgs(1, "work", "home").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "name"}).AddRow(5, 1, "work"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `tags` (`userId`,`name`) VALUES (?,?)")).
+		WithArgs(1, "home").
+		WillReturnResult(sqlmock.NewResult(6, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listTags` (`listId`,`tagId`) VALUES (?,?),(?,?)")).
+		WithArgs(2, 5, 2, 6).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.SetListTags(context.Background(), 1, 2, []string{"work", "home"})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_SetItemTags(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `listItemTags` WHERE listItemId = ? AND tagId IN (SELECT `id` FROM `tags` WHERE userId = ?)")).
+		WithArgs(7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tags` WHERE userId = ? AND name IN (?)")).
+		WithArgs(1, "work").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "userId", "name"}).AddRow(5, 1, "work"))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `listItemTags` (`listItemId`,`tagId`) VALUES (?,?)")).
+		WithArgs(7, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	repo := NewMySqlTagsRepository(db)
+
+	err := repo.SetItemTags(context.Background(), 1, 7, []string{"work"})
+
+	assert.Nil(t, err)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetListsTags(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT listTags.listId AS id, tags.name AS name FROM `listTags` JOIN tags ON tags.id = listTags.tagId WHERE tags.userId = ? AND listTags.listId IN (?,?) ORDER BY tags.name")).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "home").AddRow(3, "work"))
+
+	res, err := repo.GetListsTags(context.Background(), 1, []int32{2, 3})
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.TaggedRecords{{ID: 2, Name: "home"}, {ID: 3, Name: "work"}}, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetListsTags_Without_Lists(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	res, err := repo.GetListsTags(context.Background(), 1, []int32{})
+
+	assert.Nil(t, err)
+	assert.Empty(t, res)
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetItemsTags_When_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT listItemTags.listItemId AS id, tags.name AS name FROM `listItemTags` JOIN tags ON tags.id = listItemTags.tagId WHERE tags.userId = ? AND listItemTags.listItemId IN (?) ORDER BY tags.name")).
+		WithArgs(1, 7).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetItemsTags(context.Background(), 1, []int32{7})
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlTagsRepository_GetListsOwnerTags(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlTagsRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT listTags.listId AS id, tags.name AS name FROM `listTags` JOIN tags ON tags.id = listTags.tagId JOIN lists ON lists.id = listTags.listId AND lists.userId = tags.userId WHERE listTags.listId IN (?) ORDER BY tags.name")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "work"))
+
+	res, err := repo.GetListsOwnerTags(context.Background(), []int32{2})
+
+	assert.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, domain.TaggedRecord{ID: 2, Name: "work"}, res[0])
+
+	helpers.CheckSqlMockExpectations(mock, t)
+}
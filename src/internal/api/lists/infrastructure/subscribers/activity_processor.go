@@ -0,0 +1,60 @@
+package subscribers
+
+import (
+	"context"
+	"log"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/honeybadger-io/honeybadger-go"
+	"github.com/newrelic/go-agent/v3/newrelic"
+)
+
+type ActivityProcessor struct {
+	eventName      string
+	eventBus       events.EventBus
+	channel        chan events.DataEvent
+	activityRepo   domain.ActivityRepository
+	categoriesRepo domain.CategoriesRepository
+	doneFunc       func(eventName string, err error)
+	newRelicApp    *newrelic.Application
+}
+
+func NewActivityProcessor(eventName string, eventBus events.EventBus, activityRepo domain.ActivityRepository, categoriesRepo domain.CategoriesRepository, newRelicApp *newrelic.Application) *ActivityProcessor {
+	doneFunc := func(eventName string, err error) {
+		if err != nil {
+			log.Printf("Recording the activity of the event %q failed with error %v", eventName, err)
+			honeybadger.Notify(err)
+		} else {
+			log.Printf("Recorded the activity of the event %q\n", eventName)
+		}
+	}
+
+	return &ActivityProcessor{
+		eventName:      eventName,
+		eventBus:       eventBus,
+		channel:        make(chan events.DataEvent),
+		activityRepo:   activityRepo,
+		categoriesRepo: categoriesRepo,
+		doneFunc:       doneFunc,
+		newRelicApp:    newRelicApp,
+	}
+}
+
+func (s *ActivityProcessor) Subscribe() {
+	s.eventBus.Subscribe(s.eventName, s.channel)
+}
+
+func (s *ActivityProcessor) Start() {
+	for d := range s.channel {
+		txn := s.newRelicApp.StartTransaction("activityProcessor")
+		ctx := newrelic.NewContext(context.Background(), txn)
+
+		srv := application.NewRecordActivityService(s.activityRepo, s.categoriesRepo)
+		err := srv.RecordActivity(ctx, d.Topic, d.Data)
+		s.doneFunc(d.Topic, err)
+
+		txn.End()
+	}
+}
@@ -0,0 +1,115 @@
+//go:build !e2e
+// +build !e2e
+
+package subscribers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func activityMatcher(expected domain.ActivityRecord) interface{} {
+	return mock.MatchedBy(func(r *domain.ActivityRecord) bool {
+		expected.CreatedAt = r.CreatedAt
+
+		return !r.CreatedAt.IsZero() && assert.ObjectsAreEqual(expected, *r)
+	})
+}
+
+func TestActivityProcessor(t *testing.T) {
+	ch := make(chan events.DataEvent)
+	mockedActivityRepo := listsRepository.MockedActivityRepository{}
+	mockedCategoriesRepo := listsRepository.MockedCategoriesRepository{}
+	ctx := newrelic.NewContext(context.Background(), nil)
+
+	doneChan := make(chan error)
+	f := func(eventName string, err error) {
+		doneChan <- err
+	}
+
+	subscriber := &ActivityProcessor{
+		channel:        ch,
+		activityRepo:   &mockedActivityRepo,
+		categoriesRepo: &mockedCategoriesRepo,
+		doneFunc:       f,
+	}
+
+	go subscriber.Start()
+
+	t.Run("Records the creation of a list", func(t *testing.T) {
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityListCreated, Summary: `Created the list "list1"`})).Return(nil).Once()
+
+		ch <- events.DataEvent{Topic: events.ListCreated, Data: domain.ListEvent{ListID: 11, UserID: 1, Name: "list1", PreviousName: "list1"}}
+
+		assert.Nil(t, <-doneChan)
+		mockedActivityRepo.AssertExpectations(t)
+	})
+
+	t.Run("Records the rename and the new category of a list", func(t *testing.T) {
+		categoryID := int32(5)
+		mockedCategoriesRepo.On("FindCategory", ctx, domain.CategoryRecord{ID: 5, WorkspaceID: 3}).Return(&domain.CategoryRecord{ID: 5, Name: "category"}, nil).Once()
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityListRenamed, Summary: `Renamed the list "list1" to "list2"`})).Return(nil).Once()
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityListRecategorized, Summary: `Moved the list "list2" to the category "category"`})).Return(nil).Once()
+
+		ch <- events.DataEvent{Topic: events.ListUpdated, Data: domain.ListEvent{ListID: 11, UserID: 1, WorkspaceID: 3, Name: "list2", PreviousName: "list1", CategoryID: &categoryID}}
+
+		assert.Nil(t, <-doneChan)
+		mockedActivityRepo.AssertExpectations(t)
+		mockedCategoriesRepo.AssertExpectations(t)
+	})
+
+	t.Run("Records the removal of the category of a list", func(t *testing.T) {
+		categoryID := int32(5)
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityListRecategorized, Summary: `Removed the list "list1" from its category`})).Return(nil).Once()
+
+		ch <- events.DataEvent{Topic: events.ListUpdated, Data: domain.ListEvent{ListID: 11, UserID: 1, Name: "list1", PreviousName: "list1", PreviousCategoryID: &categoryID}}
+
+		assert.Nil(t, <-doneChan)
+		mockedActivityRepo.AssertExpectations(t)
+	})
+
+	t.Run("Does not record anything when a list update only changes its items", func(t *testing.T) {
+		ch <- events.DataEvent{Topic: events.ListUpdated, Data: domain.ListEvent{ListID: 11, UserID: 1, Name: "list1", PreviousName: "list1"}}
+
+		assert.Nil(t, <-doneChan)
+		mockedActivityRepo.AssertExpectations(t)
+	})
+
+	t.Run("Records the changes of the items", func(t *testing.T) {
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityItemAdded, Summary: `Added "item" to "list1"`})).Return(nil).Once()
+		ch <- events.DataEvent{Topic: events.ListItemAdded, Data: domain.ListItemEvent{ItemID: 2, ListID: 11, UserID: 1, ListName: "list1", Title: "item"}}
+		assert.Nil(t, <-doneChan)
+
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityItemRenamed, Summary: `Renamed "item" to "item2" in "list1"`})).Return(nil).Once()
+		ch <- events.DataEvent{Topic: events.ListItemRenamed, Data: domain.ListItemEvent{ItemID: 2, ListID: 11, UserID: 1, ListName: "list1", Title: "item2", PreviousTitle: "item"}}
+		assert.Nil(t, <-doneChan)
+
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityItemRemoved, Summary: `Removed "item2" from "list1"`})).Return(nil).Once()
+		ch <- events.DataEvent{Topic: events.ListItemRemoved, Data: domain.ListItemEvent{ItemID: 2, ListID: 11, UserID: 1, ListName: "list1", Title: "item2"}}
+		assert.Nil(t, <-doneChan)
+
+		destinationListID := int32(20)
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, RelatedListID: &destinationListID, Action: domain.ActivityItemMoved, Summary: `Moved "item3" from "list1" to "list2"`})).Return(nil).Once()
+		ch <- events.DataEvent{Topic: events.ListItemMoved, Data: domain.ListItemEvent{ItemID: 3, ListID: 11, UserID: 1, ListName: "list1", Title: "item3", DestinationListID: 20, DestinationListName: "list2"}}
+		assert.Nil(t, <-doneChan)
+
+		mockedActivityRepo.AssertExpectations(t)
+	})
+
+	t.Run("Returns an error when the activity can't be recorded", func(t *testing.T) {
+		mockedActivityRepo.On("CreateActivity", ctx, activityMatcher(domain.ActivityRecord{UserID: 1, ListID: 11, Action: domain.ActivityListDeleted, Summary: `Deleted the list "list1"`})).Return(fmt.Errorf("some error")).Once()
+
+		ch <- events.DataEvent{Topic: events.ListDeleted, Data: domain.ListEvent{ListID: 11, UserID: 1, Name: "list1", PreviousName: "list1"}}
+
+		assert.EqualError(t, <-doneChan, "Error recording the activity")
+		mockedActivityRepo.AssertExpectations(t)
+	})
+}
@@ -17,12 +17,13 @@ type IndexAllListsProcessor struct {
 	eventBus          events.EventBus
 	channel           chan events.DataEvent
 	listsRepo         domain.ListsRepository
+	tagsRepo          domain.TagsRepository
 	listsSearchClient search.SearchIndexClient
 	doneFunc          func(err error)
 	newRelicApp       *newrelic.Application
 }
 
-func NewIndexAllListsProcessor(eventName string, eventBus events.EventBus, listsRepo domain.ListsRepository, listsSearchClient search.SearchIndexClient, newRelicApp *newrelic.Application) *IndexAllListsProcessor {
+func NewIndexAllListsProcessor(eventName string, eventBus events.EventBus, listsRepo domain.ListsRepository, tagsRepo domain.TagsRepository, listsSearchClient search.SearchIndexClient, newRelicApp *newrelic.Application) *IndexAllListsProcessor {
 	doneFunc := func(err error) {
 		if err != nil {
 			log.Printf("Index all lists failed with error %v", err)
@@ -35,6 +36,7 @@ func NewIndexAllListsProcessor(eventName string, eventBus events.EventBus, lists
 		eventBus:          eventBus,
 		channel:           make(chan events.DataEvent),
 		listsRepo:         listsRepo,
+		tagsRepo:          tagsRepo,
 		listsSearchClient: listsSearchClient,
 		doneFunc:          doneFunc,
 		newRelicApp:       newRelicApp,
@@ -50,7 +52,7 @@ func (s *IndexAllListsProcessor) Start() {
 		txn := s.newRelicApp.StartTransaction(s.eventName)
 		ctx := newrelic.NewContext(context.Background(), txn)
 
-		srv := application.NewIndexAllListsService(s.listsRepo, s.listsSearchClient)
+		srv := application.NewIndexAllListsService(s.listsRepo, s.tagsRepo, s.listsSearchClient)
 		err := srv.IndexAllLists(ctx)
 
 		s.doneFunc(err)
@@ -13,6 +13,7 @@ import (
 
 func TestIndexAllListsProcessor(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
 	mockedSearchClient := search.MockedSearchIndexClient{}
 
 	ctx := newrelic.NewContext(context.Background(), nil)
@@ -22,10 +23,11 @@ func TestIndexAllListsProcessor(t *testing.T) {
 		{ID: 12, UserID: 2, Name: "list2"},
 	}
 	mockedRepo.On("GetLists", ctx, domain.ListRecord{}).Return(foundLists, nil).Once()
+	mockedTagsRepo.On("GetListsOwnerTags", ctx, []int32{11, 12}).Return(domain.TaggedRecords{{ID: 11, Name: "home"}, {ID: 11, Name: "urgent"}}, nil).Once()
 
 	listDocuments := []domain.ListSearchDocument{
-		{ObjectID: "11", UserID: 2, Name: "list1", ItemsTitles: []string{"title1", "title2"}, ItemsDescriptions: []string{"desc1", "desc2"}},
-		{ObjectID: "12", UserID: 2, Name: "list2", ItemsTitles: []string{}, ItemsDescriptions: []string{}},
+		{ObjectID: "11", UserID: 2, Name: "list1", ItemsTitles: []string{"title1", "title2"}, ItemsDescriptions: []string{"desc1", "desc2"}, Tags: []string{"home", "urgent"}},
+		{ObjectID: "12", UserID: 2, Name: "list2", ItemsTitles: []string{}, ItemsDescriptions: []string{}, Tags: []string{}},
 	}
 	mockedSearchClient.On("SaveObjects", listDocuments).Once().Return(nil)
 
@@ -37,6 +39,7 @@ func TestIndexAllListsProcessor(t *testing.T) {
 	subscriber := &IndexAllListsProcessor{
 		channel:           ch,
 		listsRepo:         &mockedRepo,
+		tagsRepo:          &mockedTagsRepo,
 		listsSearchClient: &mockedSearchClient,
 		doneFunc:          f,
 	}
@@ -47,5 +50,6 @@ func TestIndexAllListsProcessor(t *testing.T) {
 
 	<-doneChan
 	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
 	mockedSearchClient.AssertExpectations(t)
 }
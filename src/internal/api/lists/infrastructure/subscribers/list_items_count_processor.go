@@ -46,7 +46,8 @@ func (s *ListItemsCountProcessor) Subscribe() {
 
 func (s *ListItemsCountProcessor) Start() {
 	for d := range s.channel {
-		listID, _ := d.Data.(int32)
+		event, _ := d.Data.(domain.ListEvent)
+		listID := event.ListID
 		txn := s.newRelicApp.StartTransaction("listItemsCountProcessor")
 		ctx := newrelic.NewContext(context.Background(), txn)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -31,7 +32,7 @@ func TestListItemsCountProcessor(t *testing.T) {
 
 	go subscriber.Start()
 
-	ch <- events.DataEvent{Data: int32(11)}
+	ch <- events.DataEvent{Data: domain.ListEvent{ListID: 11}}
 
 	<-doneChan
 	mockedRepo.AssertExpectations(t)
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/lists/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
 	"github.com/honeybadger-io/honeybadger-go"
@@ -44,7 +45,8 @@ func (s *RemoveSearchIndexDocumentProcessor) Subscribe() {
 
 func (s *RemoveSearchIndexDocumentProcessor) Start() {
 	for d := range s.channel {
-		listID, _ := d.Data.(int32)
+		event, _ := d.Data.(domain.ListEvent)
+		listID := event.ListID
 		txn := s.newRelicApp.StartTransaction(s.eventName)
 		ctx := newrelic.NewContext(context.Background(), txn)
 
@@ -3,6 +3,7 @@ package subscribers
 import (
 	"testing"
 
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
 )
@@ -25,7 +26,7 @@ func TestRemoveSearchIndexDocumentProcessor(t *testing.T) {
 
 	go subscriber.Start()
 
-	ch <- events.DataEvent{Data: int32(12)}
+	ch <- events.DataEvent{Data: domain.ListEvent{ListID: 12}}
 
 	<-doneChan
 	mockedSearchClient.AssertExpectations(t)
@@ -17,12 +17,13 @@ type UpdateSearchIndexDocumentProcessor struct {
 	eventBus          events.EventBus
 	channel           chan events.DataEvent
 	listsRepo         domain.ListsRepository
+	tagsRepo          domain.TagsRepository
 	listsSearchClient search.SearchIndexClient
 	doneFunc          func(listID int32, err error)
 	newRelicApp       *newrelic.Application
 }
 
-func NewUpdateSearchIndexDocumentProcessor(eventName string, eventBus events.EventBus, listsRepo domain.ListsRepository, listsSearchClient search.SearchIndexClient, newRelicApp *newrelic.Application) *UpdateSearchIndexDocumentProcessor {
+func NewUpdateSearchIndexDocumentProcessor(eventName string, eventBus events.EventBus, listsRepo domain.ListsRepository, tagsRepo domain.TagsRepository, listsSearchClient search.SearchIndexClient, newRelicApp *newrelic.Application) *UpdateSearchIndexDocumentProcessor {
 	doneFunc := func(listID int32, err error) {
 		if err != nil {
 			log.Printf("Creting or updating search index document for list with ID %v\n failed with error %v", listID, err)
@@ -35,6 +36,7 @@ func NewUpdateSearchIndexDocumentProcessor(eventName string, eventBus events.Eve
 		eventBus:          eventBus,
 		channel:           make(chan events.DataEvent),
 		listsRepo:         listsRepo,
+		tagsRepo:          tagsRepo,
 		listsSearchClient: listsSearchClient,
 		doneFunc:          doneFunc,
 		newRelicApp:       newRelicApp,
@@ -47,11 +49,12 @@ func (s *UpdateSearchIndexDocumentProcessor) Subscribe() {
 
 func (s *UpdateSearchIndexDocumentProcessor) Start() {
 	for d := range s.channel {
-		listID, _ := d.Data.(int32)
+		event, _ := d.Data.(domain.ListEvent)
+		listID := event.ListID
 		txn := s.newRelicApp.StartTransaction(s.eventName)
 		ctx := newrelic.NewContext(context.Background(), txn)
 
-		srv := application.NewAddListToSearchIndexService(s.listsRepo, s.listsSearchClient)
+		srv := application.NewAddListToSearchIndexService(s.listsRepo, s.tagsRepo, s.listsSearchClient)
 		err := srv.AddListToSearchIndexService(ctx, listID)
 
 		s.doneFunc(listID, err)
@@ -13,6 +13,7 @@ import (
 
 func TestUpdateSearchIndexDocumentProcessor(t *testing.T) {
 	mockedRepo := listsRepository.MockedListsRepository{}
+	mockedTagsRepo := listsRepository.MockedTagsRepository{}
 	mockedSearchClient := search.MockedSearchIndexClient{}
 
 	ctx := newrelic.NewContext(context.Background(), nil)
@@ -23,6 +24,7 @@ func TestUpdateSearchIndexDocumentProcessor(t *testing.T) {
 		Name:   "list1",
 	}
 	mockedRepo.On("FindList", ctx, domain.ListRecord{ID: 12}).Return(&foundList, nil).Once()
+	mockedTagsRepo.On("GetListsOwnerTags", ctx, []int32{12}).Return(domain.TaggedRecords{{ID: 12, Name: "home"}}, nil).Once()
 
 	listDocument := domain.ListSearchDocument{
 		ObjectID:          "12",
@@ -30,6 +32,7 @@ func TestUpdateSearchIndexDocumentProcessor(t *testing.T) {
 		Name:              "list1",
 		ItemsTitles:       []string{},
 		ItemsDescriptions: []string{},
+		Tags:              []string{"home"},
 	}
 	mockedSearchClient.On("SaveObjects", listDocument).Once().Return(nil)
 
@@ -41,15 +44,17 @@ func TestUpdateSearchIndexDocumentProcessor(t *testing.T) {
 	subscriber := &UpdateSearchIndexDocumentProcessor{
 		channel:           ch,
 		listsRepo:         &mockedRepo,
+		tagsRepo:          &mockedTagsRepo,
 		listsSearchClient: &mockedSearchClient,
 		doneFunc:          f,
 	}
 
 	go subscriber.Start()
 
-	ch <- events.DataEvent{Data: int32(12)}
+	ch <- events.DataEvent{Data: domain.ListEvent{ListID: 12}}
 
 	<-doneChan
 	mockedRepo.AssertExpectations(t)
+	mockedTagsRepo.AssertExpectations(t)
 	mockedSearchClient.AssertExpectations(t)
 }
@@ -0,0 +1,30 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
+)
+
+type TagInput struct {
+	Name domain.TagNameValueObject `json:"name"`
+}
+
+func (i *TagInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	nvo, err := domain.NewTagNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	*i = TagInput{Name: nvo}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package infrastructure
+
+type UserQuotaInput struct {
+	MaxLists        *int32 `json:"maxLists"`
+	MaxItemsPerList *int32 `json:"maxItemsPerList"`
+	MaxCategories   *int32 `json:"maxCategories"`
+}
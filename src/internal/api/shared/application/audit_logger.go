@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+)
+
+// AuditLogger writes the audit log entries of the application services. The actor, the
+// request id, the ip and the user agent are taken from the request context
+type AuditLogger struct {
+	repo audit.AuditLogRepository
+}
+
+func NewAuditLogger(repo audit.AuditLogRepository) *AuditLogger {
+	return &AuditLogger{repo}
+}
+
+// The sizes of the audit_log columns filled with values that come from the request
+const (
+	requestIDMaxLength = 64
+	ipMaxLength        = 45
+	userAgentMaxLength = 255
+)
+
+// Log records an action done over a target, where a targetID of 0 means that the action
+// isn't done over a single entity. A failure writing the entry is logged and returned, so
+// the callers that can't go on without the entry can fail, while the others ignore it
+// because the action has already been done
+func (l *AuditLogger) Log(ctx context.Context, action string, targetType string, targetID int32, before interface{}, after interface{}) error {
+	requestID, _ := ctx.Value(consts.ReqContextRequestKey).(string)
+
+	diff, err := audit.NewDiff(before, after)
+	if err != nil {
+		log.Printf("[%v] Error building the audit log diff of %q: %v", requestID, action, err)
+		diff = "{}"
+	}
+
+	entry := audit.AuditLogEntryEntity{
+		ActorID:        contextInt32(ctx, consts.ReqContextUserIDKey),
+		ImpersonatorID: contextInt32(ctx, consts.ReqContextImpersonatorIDKey),
+		Action:         action,
+		TargetType:     targetType,
+		RequestID:      columnValue(requestID, requestIDMaxLength),
+		Diff:           diff,
+		CreatedAt:      time.Now(),
+	}
+
+	if targetID > 0 {
+		entry.TargetID = &targetID
+	}
+
+	ip, _ := ctx.Value(consts.ReqContextRemoteAddrKey).(string)
+	entry.IP = columnValue(ip, ipMaxLength)
+	userAgent, _ := ctx.Value(consts.ReqContextUserAgentKey).(string)
+	entry.UserAgent = columnValue(userAgent, userAgentMaxLength)
+
+	if err := l.repo.Create(ctx, &entry); err != nil {
+		log.Printf("[%v] Error writing the audit log entry %q: %v", requestID, action, err)
+
+		return err
+	}
+
+	return nil
+}
+
+// columnValue makes a value sent by the client fit in a utf8 varchar column of the given
+// length, replacing the characters that the column can't store and truncating it
+func columnValue(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r > 0xFFFF {
+			return utf8.RuneError
+		}
+
+		return r
+	}, strings.ToValidUTF8(value, string(utf8.RuneError)))
+
+	if utf8.RuneCountInString(value) <= maxLength {
+		return value
+	}
+
+	return string([]rune(value)[:maxLength])
+}
+
+func contextInt32(ctx context.Context, key interface{}) *int32 {
+	value, ok := ctx.Value(key).(int32)
+	if !ok || value == 0 {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,48 @@
+//go:build !e2e
+// +build !e2e
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditLogger_Log_Makes_The_Request_Values_Fit_In_Their_Columns(t *testing.T) {
+	mockedRepo := repository.MockedAuditLogRepository{}
+	logger := NewAuditLogger(&mockedRepo)
+
+	ctx := context.WithValue(context.Background(), consts.ReqContextRequestKey, strings.Repeat("r", 70))
+	ctx = context.WithValue(ctx, consts.ReqContextRemoteAddrKey, strings.Repeat("1", 50))
+	ctx = context.WithValue(ctx, consts.ReqContextUserAgentKey, "agent😀"+strings.Repeat("á", 300))
+
+	mockedRepo.On("Create", ctx, mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+		return e.RequestID == strings.Repeat("r", 64) && e.IP == strings.Repeat("1", 45) &&
+			e.UserAgent == "agent�"+strings.Repeat("á", 249)
+	})).Return(nil).Once()
+
+	err := logger.Log(ctx, audit.ActionUserCreated, audit.TargetUser, 1, nil, nil)
+
+	assert.Nil(t, err)
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestAuditLogger_Log_Returns_An_Error_When_The_Entry_Can_Not_Be_Written(t *testing.T) {
+	mockedRepo := repository.MockedAuditLogRepository{}
+	logger := NewAuditLogger(&mockedRepo)
+
+	mockedRepo.On("Create", context.Background(), mock.AnythingOfType("*audit.AuditLogEntryEntity")).Return(fmt.Errorf("some error")).Once()
+
+	err := logger.Log(context.Background(), audit.ActionUserCreated, audit.TargetUser, 1, nil, nil)
+
+	assert.EqualError(t, err, "some error")
+	mockedRepo.AssertExpectations(t)
+}
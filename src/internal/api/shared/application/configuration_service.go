@@ -5,7 +5,13 @@ import "time"
 type ConfigurationService interface {
 	GetDatasource() string
 	GetPort() string
-	GetJwtSecret() string
+	GetJwtSigningAlgorithm() string
+	GetJwtKeysPath() string
+	GetJwtKeys() string
+	GetJwtKeyRotationInterval() time.Duration
+	GetJwtAllowEphemeralKey() bool
+	GetJwtIssuer() string
+	GetJwtAudience() string
 	GetCorsAllowedOrigins() []string
 	GetTokenExpirationTime() time.Time
 	GetRefreshTokenExpirationTime() time.Time
@@ -19,4 +25,31 @@ type ConfigurationService interface {
 	GetAlgoliaAppId() string
 	GetAlgoliaApiKey() string
 	GetAlgoliaSearchOnlyKey() string
+	GetFrontendUrl() string
+	GetMailer() string
+	GetMailerFilePath() string
+	GetMailFrom() string
+	GetSmtpHost() string
+	GetSmtpPort() string
+	GetSmtpUser() string
+	GetSmtpPassword() string
+	GetPasswordResetTokenExpirationTime() time.Time
+	GetEmailVerificationTokenExpirationTime() time.Time
+	GetInviteExpirationTime() time.Time
+	GetAdminSetupToken() string
+	GetImpersonationExpirationTime() time.Time
+	GetPasswordMinLength() int
+	GetPasswordCharacterClasses() []string
+	GetPasswordRejectCommon() bool
+	GetPasswordHashAlgorithm() string
+	GetBcryptCost() int
+	GetOidcProvider(name string) (*OidcProviderConfig, bool)
+	GetRateLimit(policyName string) (int, time.Duration)
+	GetMaxListsPerUser() int
+	GetMaxItemsPerList() int
+	GetMaxCategoriesPerUser() int
+	GetStorage() string
+	GetStoragePath() string
+	GetUserExportExpirationTime() time.Time
+	GetTrustedProxyHops() int
 }
@@ -0,0 +1,26 @@
+package application
+
+import (
+	"context"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type GetAuditLogService struct {
+	repo audit.AuditLogRepository
+}
+
+func NewGetAuditLogService(repo audit.AuditLogRepository) *GetAuditLogService {
+	return &GetAuditLogService{repo}
+}
+
+func (s *GetAuditLogService) GetAuditLog(ctx context.Context, filter audit.AuditLogFilter, pagInfo *sharedDomain.PaginationInfo) ([]*audit.AuditLogEntryEntity, error) {
+	found, err := s.repo.GetAll(ctx, filter, pagInfo)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the audit log", InternalError: err}
+	}
+
+	return found, nil
+}
@@ -26,7 +26,43 @@ func (m *MockedConfigurationService) GetPort() string {
 	return args.String(0)
 }
 
-func (m *MockedConfigurationService) GetJwtSecret() string {
+func (m *MockedConfigurationService) GetJwtSigningAlgorithm() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetJwtKeysPath() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetJwtKeys() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetJwtKeyRotationInterval() time.Duration {
+	args := m.Called()
+
+	return args.Get(0).(time.Duration)
+}
+
+func (m *MockedConfigurationService) GetJwtAllowEphemeralKey() bool {
+	args := m.Called()
+
+	return args.Bool(0)
+}
+
+func (m *MockedConfigurationService) GetJwtIssuer() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetJwtAudience() string {
 	args := m.Called()
 
 	return args.String(0)
@@ -108,3 +144,169 @@ func (m *MockedConfigurationService) GetAlgoliaSearchOnlyKey() string {
 
 	return args.String(0)
 }
+
+func (m *MockedConfigurationService) GetFrontendUrl() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetMailer() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetMailerFilePath() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetMailFrom() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetSmtpHost() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetSmtpPort() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetSmtpUser() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetSmtpPassword() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetPasswordResetTokenExpirationTime() time.Time {
+	args := m.Called()
+
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockedConfigurationService) GetEmailVerificationTokenExpirationTime() time.Time {
+	args := m.Called()
+
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockedConfigurationService) GetInviteExpirationTime() time.Time {
+	args := m.Called()
+
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockedConfigurationService) GetAdminSetupToken() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetImpersonationExpirationTime() time.Time {
+	args := m.Called()
+
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockedConfigurationService) GetPasswordMinLength() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
+
+func (m *MockedConfigurationService) GetPasswordCharacterClasses() []string {
+	args := m.Called()
+
+	return args.Get(0).([]string)
+}
+
+func (m *MockedConfigurationService) GetPasswordRejectCommon() bool {
+	args := m.Called()
+
+	return args.Bool(0)
+}
+
+func (m *MockedConfigurationService) GetPasswordHashAlgorithm() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetBcryptCost() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
+
+func (m *MockedConfigurationService) GetOidcProvider(name string) (*OidcProviderConfig, bool) {
+	args := m.Called(name)
+
+	if args.Get(0) == nil {
+		return nil, args.Bool(1)
+	}
+
+	return args.Get(0).(*OidcProviderConfig), args.Bool(1)
+}
+
+func (m *MockedConfigurationService) GetRateLimit(policyName string) (int, time.Duration) {
+	args := m.Called(policyName)
+
+	return args.Int(0), args.Get(1).(time.Duration)
+}
+
+func (m *MockedConfigurationService) GetMaxListsPerUser() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
+
+func (m *MockedConfigurationService) GetMaxItemsPerList() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
+
+func (m *MockedConfigurationService) GetMaxCategoriesPerUser() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
+
+func (m *MockedConfigurationService) GetStorage() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetStoragePath() string {
+	args := m.Called()
+
+	return args.String(0)
+}
+
+func (m *MockedConfigurationService) GetUserExportExpirationTime() time.Time {
+	args := m.Called()
+
+	return args.Get(0).(time.Time)
+}
+
+func (m *MockedConfigurationService) GetTrustedProxyHops() int {
+	args := m.Called()
+
+	return args.Int(0)
+}
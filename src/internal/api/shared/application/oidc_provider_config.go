@@ -0,0 +1,11 @@
+package application
+
+// OidcProviderConfig contains the settings of an OpenID Connect identity provider
+type OidcProviderConfig struct {
+	Name         string
+	IssuerUrl    string
+	ClientID     string
+	ClientSecret string
+	RedirectUrl  string
+	Scopes       []string
+}
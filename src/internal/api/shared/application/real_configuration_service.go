@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -31,8 +32,37 @@ func (c *RealConfigurationService) GetPort() string {
 	return c.getEnvOrFallback("PORT", "5001")
 }
 
-func (c *RealConfigurationService) GetJwtSecret() string {
-	return c.getEnvOrFallback("JWT_SECRET", "mySecret")
+func (c *RealConfigurationService) GetJwtSigningAlgorithm() string {
+	return c.getEnvOrFallback("JWT_SIGNING_ALGORITHM", "RS256")
+}
+
+// GetJwtKeysPath returns the folder with the PEM files of the jwt keys. The name of each
+// file, without the extension, is used as the key id
+func (c *RealConfigurationService) GetJwtKeysPath() string {
+	return c.getEnvOrFallback("JWT_KEYS_PATH", "")
+}
+
+// GetJwtKeys returns PEM encoded jwt keys. Each block can set its key id with a "kid" header
+func (c *RealConfigurationService) GetJwtKeys() string {
+	return c.getEnvOrFallback("JWT_KEYS", "")
+}
+
+func (c *RealConfigurationService) GetJwtKeyRotationInterval() time.Duration {
+	return c.getDurationEnvVar("JWT_KEY_ROTATION_INTERVAL", "0")
+}
+
+// GetJwtAllowEphemeralKey returns if a key generated in memory can be used when there isn't any
+// jwt key configured. It's only meant for development
+func (c *RealConfigurationService) GetJwtAllowEphemeralKey() bool {
+	return c.getEnvOrFallback("JWT_ALLOW_EPHEMERAL_KEY", "false") == "true"
+}
+
+func (c *RealConfigurationService) GetJwtIssuer() string {
+	return c.getEnvOrFallback("JWT_ISSUER", "todos_backend")
+}
+
+func (c *RealConfigurationService) GetJwtAudience() string {
+	return c.getEnvOrFallback("JWT_AUDIENCE", "todos")
 }
 
 func (c *RealConfigurationService) GetCorsAllowedOrigins() []string {
@@ -87,6 +117,197 @@ func (c *RealConfigurationService) GetAlgoliaSearchOnlyKey() string {
 	return c.getEnvOrFallback("ALGOLIA_SEARCH_ONLY_KEY", "algolia-search-only-key")
 }
 
+func (c *RealConfigurationService) GetFrontendUrl() string {
+	return c.getEnvOrFallback("FRONTEND_URL", "http://localhost:3000")
+}
+
+// GetMailer returns the mailer used to send emails, "smtp" or "file"
+func (c *RealConfigurationService) GetMailer() string {
+	return c.getEnvOrFallback("MAILER", "file")
+}
+
+// GetMailerFilePath returns the file where the file mailer writes the emails. When it is
+// empty the emails are written to the log
+func (c *RealConfigurationService) GetMailerFilePath() string {
+	return c.getEnvOrFallback("MAILER_FILE_PATH", "")
+}
+
+func (c *RealConfigurationService) GetMailFrom() string {
+	return c.getEnvOrFallback("MAIL_FROM", "todos@localhost")
+}
+
+func (c *RealConfigurationService) GetSmtpHost() string {
+	return c.getEnvOrFallback("SMTP_HOST", "localhost")
+}
+
+func (c *RealConfigurationService) GetSmtpPort() string {
+	return c.getEnvOrFallback("SMTP_PORT", "25")
+}
+
+func (c *RealConfigurationService) GetSmtpUser() string {
+	return c.getEnvOrFallback("SMTP_USER", "")
+}
+
+func (c *RealConfigurationService) GetSmtpPassword() string {
+	return c.getEnvOrFallback("SMTP_PASSWORD", "")
+}
+
+func (c *RealConfigurationService) GetPasswordResetTokenExpirationTime() time.Time {
+	return time.Now().Add(c.getDurationEnvVar("PASSWORD_RESET_TOKEN_EXPIRATION_TIME", "1h"))
+}
+
+func (c *RealConfigurationService) GetEmailVerificationTokenExpirationTime() time.Time {
+	return time.Now().Add(c.getDurationEnvVar("EMAIL_VERIFICATION_TOKEN_EXPIRATION_TIME", "48h"))
+}
+
+func (c *RealConfigurationService) GetInviteExpirationTime() time.Time {
+	return time.Now().Add(c.getDurationEnvVar("INVITE_EXPIRATION_TIME", "168h"))
+}
+
+func (c *RealConfigurationService) GetAdminSetupToken() string {
+	return c.getEnvOrFallback("ADMIN_SETUP_TOKEN", "")
+}
+
+func (c *RealConfigurationService) GetImpersonationExpirationTime() time.Time {
+	return time.Now().Add(c.getDurationEnvVar("IMPERSONATION_EXPIRATION_TIME", "15m"))
+}
+
+func (c *RealConfigurationService) GetPasswordMinLength() int {
+	return c.getIntEnvVar("PASSWORD_MIN_LENGTH", "8")
+}
+
+// GetPasswordCharacterClasses returns the character classes a new password must contain.
+// The valid classes are "lower", "upper", "digit" and "symbol"
+func (c *RealConfigurationService) GetPasswordCharacterClasses() []string {
+	value := c.getEnvOrFallback("PASSWORD_CHARACTER_CLASSES", "")
+	if len(value) == 0 {
+		return []string{}
+	}
+
+	return strings.Split(value, ",")
+}
+
+func (c *RealConfigurationService) GetPasswordRejectCommon() bool {
+	return c.getEnvOrFallback("PASSWORD_REJECT_COMMON", "true") == "true"
+}
+
+// GetPasswordHashAlgorithm returns the algorithm used to hash new passwords, "bcrypt" or "argon2id"
+func (c *RealConfigurationService) GetPasswordHashAlgorithm() string {
+	return c.getEnvOrFallback("PASSWORD_HASH_ALGORITHM", "bcrypt")
+}
+
+func (c *RealConfigurationService) GetBcryptCost() int {
+	return c.getIntEnvVar("BCRYPT_COST", "10")
+}
+
+func (c *RealConfigurationService) getIntEnvVar(key string, fallback string) int {
+	i, err := strconv.Atoi(c.getEnvOrFallback(key, fallback))
+	if err != nil {
+		i, _ = strconv.Atoi(fallback)
+	}
+
+	return i
+}
+
+// GetOidcProvider returns the settings of one of the providers in OIDC_PROVIDERS. The settings
+// of each provider are read from the OIDC_<NAME>_* environment variables
+func (c *RealConfigurationService) GetOidcProvider(name string) (*OidcProviderConfig, bool) {
+	found := false
+	for _, provider := range strings.Split(c.getEnvOrFallback("OIDC_PROVIDERS", ""), ",") {
+		if len(name) > 0 && strings.TrimSpace(provider) == name {
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, false
+	}
+
+	prefix := fmt.Sprintf("OIDC_%v_", strings.ToUpper(name))
+
+	return &OidcProviderConfig{
+		Name:         name,
+		IssuerUrl:    c.getEnvOrFallback(prefix+"ISSUER_URL", ""),
+		ClientID:     c.getEnvOrFallback(prefix+"CLIENT_ID", ""),
+		ClientSecret: c.getEnvOrFallback(prefix+"CLIENT_SECRET", ""),
+		RedirectUrl:  c.getEnvOrFallback(prefix+"REDIRECT_URL", ""),
+		Scopes:       strings.Fields(c.getEnvOrFallback(prefix+"SCOPES", "openid profile email")),
+	}, true
+}
+
+// GetRateLimit returns the number of requests allowed by a rate limit policy and the window
+// in which they are allowed. They are read from RATE_LIMIT_<POLICY> as "<limit>/<window>",
+// for example "300/1m", and a limit of 0 disables the policy
+func (c *RealConfigurationService) GetRateLimit(policyName string) (int, time.Duration) {
+	defaults := map[string]string{
+		"anonymous": "20/1m",
+		"user":      "300/1m",
+		"admin":     "60/1m",
+	}
+
+	fallback := defaults[policyName]
+	value := c.getEnvOrFallback("RATE_LIMIT_"+strings.ToUpper(policyName), fallback)
+
+	limit, window, err := parseRateLimit(value)
+	if err != nil {
+		log.Printf("Invalid rate limit %q for the policy %q, using %q", value, policyName, fallback)
+		limit, window, _ = parseRateLimit(fallback)
+	}
+
+	return limit, window
+}
+
+func parseRateLimit(value string) (int, time.Duration, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("the rate limit must be <limit>/<window>")
+	}
+
+	limit, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	window, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return limit, window, nil
+}
+
+func (c *RealConfigurationService) GetMaxListsPerUser() int {
+	return c.getIntEnvVar("MAX_LISTS_PER_USER", "200")
+}
+
+func (c *RealConfigurationService) GetMaxItemsPerList() int {
+	return c.getIntEnvVar("MAX_ITEMS_PER_LIST", "500")
+}
+
+func (c *RealConfigurationService) GetMaxCategoriesPerUser() int {
+	return c.getIntEnvVar("MAX_CATEGORIES_PER_USER", "50")
+}
+
+// GetStorage returns where the files are stored, "bucket" or "local"
+func (c *RealConfigurationService) GetStorage() string {
+	return c.getEnvOrFallback("STORAGE", "local")
+}
+
+// GetStoragePath returns the folder where the local storage keeps the files
+func (c *RealConfigurationService) GetStoragePath() string {
+	return c.getEnvOrFallback("STORAGE_PATH", "storage")
+}
+
+func (c *RealConfigurationService) GetUserExportExpirationTime() time.Time {
+	return time.Now().Add(c.getDurationEnvVar("USER_EXPORT_EXPIRATION_TIME", "24h"))
+}
+
+// GetTrustedProxyHops returns how many proxies in front of the api append an entry to the
+// X-Forwarded-For header. Cloud Run's front end is one of them, so it defaults to 1
+func (c *RealConfigurationService) GetTrustedProxyHops() int {
+	return c.getIntEnvVar("TRUSTED_PROXY_HOPS", "1")
+}
+
 func (c *RealConfigurationService) getDurationEnvVar(key string, fallback string) time.Duration {
 	d, _ := time.ParseDuration(c.getEnvOrFallback(key, fallback))
 
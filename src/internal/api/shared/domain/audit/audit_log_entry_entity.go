@@ -0,0 +1,60 @@
+package audit
+
+import "time"
+
+const (
+	ActionUserCreated           = "user.created"
+	ActionUserUpdated           = "user.updated"
+	ActionUserDeleted           = "user.deleted"
+	ActionUserDeletionRequested = "user.deletionRequested"
+	ActionUserExportRequested   = "user.exportRequested"
+	ActionRefreshTokensRevoked  = "refreshTokens.revoked"
+	ActionListsIndexRequested   = "lists.indexRequested"
+	ActionImpersonationStarted  = "impersonation.started"
+	ActionImpersonationEnded    = "impersonation.ended"
+	ActionImpersonatedRequest   = "impersonation.request"
+	ActionAdminBootstrapTried   = "adminBootstrap.tried"
+	ActionAdminBootstrapDone    = "adminBootstrap.done"
+	ActionAdminBootstrapFailed  = "adminBootstrap.failed"
+	ActionUserQuotaUpdated      = "userQuota.updated"
+)
+
+const (
+	TargetUser           = "user"
+	TargetRefreshToken   = "refreshToken"
+	TargetLists          = "lists"
+	TargetImpersonation  = "impersonation"
+	TargetAdminBootstrap = "adminBootstrap"
+)
+
+// AuditLogEntryEntity is a record of an action done by someone. The actor is nil when the
+// action is done by an anonymous request and the diff is a json object with the changed fields
+type AuditLogEntryEntity struct {
+	ID             int32
+	ActorID        *int32
+	ImpersonatorID *int32
+	Action         string
+	TargetType     string
+	TargetID       *int32
+	RequestID      string
+	IP             string
+	UserAgent      string
+	Diff           string
+	CreatedAt      time.Time
+}
+
+func (e *AuditLogEntryEntity) ToAuditLogEntryRecord() *AuditLogEntryRecord {
+	return &AuditLogEntryRecord{
+		ID:             e.ID,
+		ActorID:        e.ActorID,
+		ImpersonatorID: e.ImpersonatorID,
+		Action:         e.Action,
+		TargetType:     e.TargetType,
+		TargetID:       e.TargetID,
+		RequestID:      e.RequestID,
+		IP:             e.IP,
+		UserAgent:      e.UserAgent,
+		Diff:           e.Diff,
+		CreatedAt:      e.CreatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package audit
+
+import "time"
+
+type AuditLogEntryRecord struct {
+	ID             int32     `gorm:"type:int(32);primary_key"`
+	ActorID        *int32    `gorm:"column:actorId;type:int(32)"`
+	ImpersonatorID *int32    `gorm:"column:impersonatorId;type:int(32)"`
+	Action         string    `gorm:"column:action;type:varchar(50)"`
+	TargetType     string    `gorm:"column:targetType;type:varchar(50)"`
+	TargetID       *int32    `gorm:"column:targetId;type:int(32)"`
+	RequestID      string    `gorm:"column:requestId;type:varchar(64)"`
+	IP             string    `gorm:"column:ip;type:varchar(45)"`
+	UserAgent      string    `gorm:"column:userAgent;type:varchar(255)"`
+	Diff           string    `gorm:"column:diff;type:json"`
+	CreatedAt      time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+func (AuditLogEntryRecord) TableName() string {
+	return "audit_log"
+}
+
+func (r *AuditLogEntryRecord) ToAuditLogEntryEntity() *AuditLogEntryEntity {
+	return &AuditLogEntryEntity{
+		ID:             r.ID,
+		ActorID:        r.ActorID,
+		ImpersonatorID: r.ImpersonatorID,
+		Action:         r.Action,
+		TargetType:     r.TargetType,
+		TargetID:       r.TargetID,
+		RequestID:      r.RequestID,
+		IP:             r.IP,
+		UserAgent:      r.UserAgent,
+		Diff:           r.Diff,
+		CreatedAt:      r.CreatedAt,
+	}
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+// AuditLogFilter has the optional conditions used to search the audit log
+type AuditLogFilter struct {
+	ActorID    *int32
+	Action     string
+	TargetType string
+	TargetID   *int32
+	From       *time.Time
+	To         *time.Time
+}
+
+// NewAuditLogFilterFromUrl reads the filter from the query string. The dates must use the
+// RFC3339 format and only the id and createdAt fields are allowed to sort the entries
+func NewAuditLogFilterFromUrl(url *url.URL) (AuditLogFilter, error) {
+	query := url.Query()
+	filter := AuditLogFilter{Action: query.Get("action"), TargetType: query.Get("targetType")}
+
+	if sort := query.Get("sort"); sort != "" && sort != "id" && sort != "createdAt" {
+		return AuditLogFilter{}, &appErrors.BadRequestError{Msg: "The audit log can only be sorted by id or createdAt"}
+	}
+
+	var err error
+	if filter.ActorID, err = parseInt32Param(query, "actorId"); err != nil {
+		return AuditLogFilter{}, err
+	}
+
+	if filter.TargetID, err = parseInt32Param(query, "targetId"); err != nil {
+		return AuditLogFilter{}, err
+	}
+
+	if filter.From, err = parseTimeParam(query, "from"); err != nil {
+		return AuditLogFilter{}, err
+	}
+
+	if filter.To, err = parseTimeParam(query, "to"); err != nil {
+		return AuditLogFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func parseInt32Param(query url.Values, name string) (*int32, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	res, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, &appErrors.BadRequestError{Msg: "Invalid " + name, InternalError: err}
+	}
+
+	id := int32(res)
+
+	return &id, nil
+}
+
+func parseTimeParam(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	res, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, &appErrors.BadRequestError{Msg: "Invalid " + name + " date", InternalError: err}
+	}
+
+	return &res, nil
+}
+
+// AuditLogRepository is append only, the entries can't be updated or deleted
+type AuditLogRepository interface {
+	Create(ctx context.Context, entry *AuditLogEntryEntity) error
+	GetAll(ctx context.Context, filter AuditLogFilter, paginationInfo *sharedDomain.PaginationInfo) ([]*AuditLogEntryEntity, error)
+}
@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditLogFilterFromUrl_Returns_An_Empty_Filter_Without_Params(t *testing.T) {
+	u, _ := url.Parse("/tools/audit")
+
+	filter, err := NewAuditLogFilterFromUrl(u)
+
+	require.Nil(t, err)
+	assert.Equal(t, AuditLogFilter{}, filter)
+}
+
+func TestNewAuditLogFilterFromUrl_Reads_The_Params(t *testing.T) {
+	u, _ := url.Parse("/tools/audit?actorId=1&action=user.deleted&targetType=user&targetId=2&from=2022-01-01T00:00:00Z&to=2022-02-01T00:00:00Z&sort=createdAt")
+
+	filter, err := NewAuditLogFilterFromUrl(u)
+
+	require.Nil(t, err)
+	actorID := int32(1)
+	targetID := int32(2)
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, AuditLogFilter{ActorID: &actorID, Action: ActionUserDeleted, TargetType: TargetUser, TargetID: &targetID, From: &from, To: &to}, filter)
+}
+
+func TestNewAuditLogFilterFromUrl_Returns_An_Error_With_Invalid_Params(t *testing.T) {
+	tests := map[string]string{
+		"actorId":  "Invalid actorId",
+		"targetId": "Invalid targetId",
+		"from":     "Invalid from date",
+		"to":       "Invalid to date",
+		"sort":     "The audit log can only be sorted by id or createdAt",
+	}
+
+	for name, expectedErr := range tests {
+		t.Run(name, func(t *testing.T) {
+			u, _ := url.Parse("/tools/audit?" + name + "=wadus")
+
+			_, err := NewAuditLogFilterFromUrl(u)
+
+			assert.EqualError(t, err, expectedErr)
+		})
+	}
+}
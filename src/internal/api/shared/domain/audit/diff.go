@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// FieldChange is the old and the new value of a changed field
+type FieldChange struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// NewDiff returns a json object with the fields that have a different value in before and
+// after. Both are marshalled to json first so the json field names are used, and any of
+// them can be nil for creations and deletions
+func NewDiff(before interface{}, after interface{}) (string, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return "", err
+	}
+
+	afterFields, err := toFields(after)
+	if err != nil {
+		return "", err
+	}
+
+	diff := map[string]FieldChange{}
+
+	for name, oldValue := range beforeFields {
+		if newValue, found := afterFields[name]; !found || !reflect.DeepEqual(oldValue, newValue) {
+			diff[name] = FieldChange{Old: oldValue, New: afterFields[name]}
+		}
+	}
+
+	for name, newValue := range afterFields {
+		if _, found := beforeFields[name]; !found {
+			diff[name] = FieldChange{New: newValue}
+		}
+	}
+
+	res, err := json.Marshal(diff)
+	if err != nil {
+		return "", err
+	}
+
+	return string(res), nil
+}
+
+func toFields(value interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if value == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
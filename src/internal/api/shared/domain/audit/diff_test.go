@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type diffTestStruct struct {
+	Name    string `json:"name"`
+	IsAdmin bool   `json:"isAdmin"`
+}
+
+func TestNewDiff_Returns_The_Changed_Fields(t *testing.T) {
+	diff, err := NewDiff(diffTestStruct{Name: "wadus", IsAdmin: false}, diffTestStruct{Name: "wadus", IsAdmin: true})
+
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"isAdmin":{"old":false,"new":true}}`, diff)
+}
+
+func TestNewDiff_Returns_All_The_Fields_For_Creations_And_Deletions(t *testing.T) {
+	created, err := NewDiff(nil, diffTestStruct{Name: "wadus"})
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"name":{"old":null,"new":"wadus"},"isAdmin":{"old":null,"new":false}}`, created)
+
+	deleted, err := NewDiff(diffTestStruct{Name: "wadus"}, nil)
+	require.Nil(t, err)
+	assert.JSONEq(t, `{"name":{"old":"wadus","new":null},"isAdmin":{"old":false,"new":null}}`, deleted)
+}
+
+func TestNewDiff_Returns_An_Empty_Object_Without_Changes(t *testing.T) {
+	diff, err := NewDiff(map[string]interface{}{"ids": []int32{1, 2}}, map[string]interface{}{"ids": []int32{1, 2}})
+
+	require.Nil(t, err)
+	assert.Equal(t, "{}", diff)
+}
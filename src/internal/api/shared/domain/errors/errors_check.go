@@ -35,6 +35,13 @@ func CheckUnathorizedError(t *testing.T, err interface{}, errorMsg string, inter
 	}
 }
 
+func CheckForbiddenError(t *testing.T, err interface{}, errorMsg string) {
+	require.NotNil(t, err)
+	forbiddenErr, isForbiddenErr := err.(*ForbiddenError)
+	require.True(t, isForbiddenErr, "should be a forbidden error")
+	CheckErrorMsg(t, forbiddenErr, errorMsg)
+}
+
 func CheckBadRequestError(t *testing.T, err interface{}, errorMsg string, internalErrorMsg string) {
 	require.NotNil(t, err)
 	badReqErr, isBadReqErr := err.(*BadRequestError)
@@ -0,0 +1,11 @@
+package errors
+
+// ForbiddenError happens when the user is not allowed to do something
+type ForbiddenError struct {
+	Msg           string
+	InternalError error
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Msg
+}
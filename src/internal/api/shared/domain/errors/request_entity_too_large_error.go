@@ -0,0 +1,11 @@
+package errors
+
+// RequestEntityTooLargeError happens when the body of the request is bigger than the allowed one
+type RequestEntityTooLargeError struct {
+	Msg           string
+	InternalError error
+}
+
+func (e *RequestEntityTooLargeError) Error() string {
+	return e.Msg
+}
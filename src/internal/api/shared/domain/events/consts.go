@@ -4,5 +4,12 @@ const (
 	ListCreated            string = "listCreated"
 	ListUpdated            string = "listUpdated"
 	ListDeleted            string = "listDeleted"
+	ListItemAdded          string = "listItemAdded"
+	ListItemRenamed        string = "listItemRenamed"
+	ListItemRemoved        string = "listItemRemoved"
+	ListItemMoved          string = "listItemMoved"
+	ListTagsChanged        string = "listTagsChanged"
 	IndexAllListsRequested string = "indexAllListsRequested"
+	UserDeletionRequested  string = "userDeletionRequested"
+	UserExportRequested    string = "userExportRequested"
 )
@@ -3,9 +3,15 @@ package consts
 type contextKey string
 
 const (
-	ReqContextUserIDKey      contextKey = "userID"
-	ReqContextUserNameKey    contextKey = "userName"
-	ReqContextUserIsAdminKey contextKey = "userIsAdmin"
-	ReqContextRequestKey     contextKey = "requestID"
-	ReqContextStartTime      contextKey = "startTime"
+	ReqContextUserIDKey          contextKey = "userID"
+	ReqContextUserNameKey        contextKey = "userName"
+	ReqContextUserIsAdminKey     contextKey = "userIsAdmin"
+	ReqContextImpersonatorIDKey  contextKey = "impersonatorID"
+	ReqContextImpersonationIDKey contextKey = "impersonationID"
+	ReqContextWorkspaceIDKey     contextKey = "workspaceID"
+	ReqContextWorkspaceRoleKey   contextKey = "workspaceRole"
+	ReqContextRequestKey         contextKey = "requestID"
+	ReqContextRemoteAddrKey      contextKey = "remoteAddr"
+	ReqContextUserAgentKey       contextKey = "userAgent"
+	ReqContextStartTime          contextKey = "startTime"
 )
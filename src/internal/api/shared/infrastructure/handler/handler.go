@@ -10,12 +10,16 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
 	"github.com/gorilla/mux"
 	"github.com/honeybadger-io/honeybadger-go"
 	"gorm.io/gorm"
@@ -24,16 +28,26 @@ import (
 // Handler is the type used to handle the endpoints
 type Handler struct {
 	HandlerFunc
-	AuthRepository       authDomain.AuthRepository
-	UsersRepository      authDomain.UsersRepository
-	ListsRepository      listsDomain.ListsRepository
-	CategoriesRepository listsDomain.CategoriesRepository
-	CfgSrv               sharedApp.ConfigurationService
-	TokenSrv             authDomain.TokenService
-	PassGen              passgen.PasswordGenerator
-	EventBus             events.EventBus
-	RequestInput         interface{}
-	SearchClient         search.SearchIndexClient
+	AuthRepository          authDomain.AuthRepository
+	UsersRepository         authDomain.UsersRepository
+	ListsRepository         listsDomain.ListsRepository
+	CategoriesRepository    listsDomain.CategoriesRepository
+	CfgSrv                  sharedApp.ConfigurationService
+	TokenSrv                authDomain.TokenService
+	PassGen                 passgen.PasswordGenerator
+	EventBus                events.EventBus
+	RequestInput            interface{}
+	SearchClient            search.SearchIndexClient
+	Mailer                  mailer.Mailer
+	AuditLogRepository      audit.AuditLogRepository
+	ActivityRepository      listsDomain.ActivityRepository
+	ListVersionsRepository  listsDomain.ListVersionsRepository
+	QuotasRepository        listsDomain.QuotasRepository
+	WorkspacesRepository    workspacesDomain.WorkspacesRepository
+	Storage                 storage.Storage
+	TagsRepository          listsDomain.TagsRepository
+	CalendarFeedsRepository listsDomain.CalendarFeedsRepository
+	ListTemplatesRepository listsDomain.ListTemplatesRepository
 }
 
 type HandlerResult interface {
@@ -50,20 +64,40 @@ func NewHandler(f HandlerFunc,
 	passGen passgen.PasswordGenerator,
 	eventBus events.EventBus,
 	requestInput interface{},
-	searchClient search.SearchIndexClient) Handler {
+	searchClient search.SearchIndexClient,
+	mailer mailer.Mailer,
+	auditLogRepo audit.AuditLogRepository,
+	activityRepo listsDomain.ActivityRepository,
+	listVersionsRepo listsDomain.ListVersionsRepository,
+	quotasRepo listsDomain.QuotasRepository,
+	workspacesRepo workspacesDomain.WorkspacesRepository,
+	storage storage.Storage,
+	tagsRepo listsDomain.TagsRepository,
+	calendarFeedsRepo listsDomain.CalendarFeedsRepository,
+	listTemplatesRepo listsDomain.ListTemplatesRepository) Handler {
 
 	return Handler{
-		HandlerFunc:          f,
-		AuthRepository:       authRepo,
-		UsersRepository:      usersRepo,
-		ListsRepository:      listsRepo,
-		CategoriesRepository: categoriesRepo,
-		CfgSrv:               cfgSrv,
-		PassGen:              passGen,
-		TokenSrv:             tokenSrv,
-		EventBus:             eventBus,
-		RequestInput:         requestInput,
-		SearchClient:         searchClient,
+		HandlerFunc:             f,
+		AuthRepository:          authRepo,
+		UsersRepository:         usersRepo,
+		ListsRepository:         listsRepo,
+		CategoriesRepository:    categoriesRepo,
+		CfgSrv:                  cfgSrv,
+		PassGen:                 passGen,
+		TokenSrv:                tokenSrv,
+		EventBus:                eventBus,
+		RequestInput:            requestInput,
+		SearchClient:            searchClient,
+		Mailer:                  mailer,
+		AuditLogRepository:      auditLogRepo,
+		ActivityRepository:      activityRepo,
+		ListVersionsRepository:  listVersionsRepo,
+		QuotasRepository:        quotasRepo,
+		WorkspacesRepository:    workspacesRepo,
+		Storage:                 storage,
+		TagsRepository:          tagsRepo,
+		CalendarFeedsRepository: calendarFeedsRepo,
+		ListTemplatesRepository: listTemplatesRepo,
 	}
 }
 
@@ -92,14 +126,22 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			helpers.WriteErrorResponse(r, w, http.StatusInternalServerError, unexErr.Error(), unexErr.InternalError)
 		} else if unauthErr, ok := err.(*appErrors.UnauthorizedError); ok {
 			helpers.WriteErrorResponse(r, w, http.StatusUnauthorized, unauthErr.Error(), unauthErr.InternalError)
+		} else if forbiddenErr, ok := err.(*appErrors.ForbiddenError); ok {
+			helpers.WriteErrorResponse(r, w, http.StatusForbidden, forbiddenErr.Error(), forbiddenErr.InternalError)
 		} else if badRequestErr, ok := err.(*appErrors.BadRequestError); ok {
 			helpers.WriteErrorResponse(r, w, http.StatusBadRequest, badRequestErr.Error(), badRequestErr.InternalError)
+		} else if tooLargeErr, ok := err.(*appErrors.RequestEntityTooLargeError); ok {
+			helpers.WriteErrorResponse(r, w, http.StatusRequestEntityTooLarge, tooLargeErr.Error(), tooLargeErr.InternalError)
 		} else if errors.Is(err, gorm.ErrRecordNotFound) {
 			helpers.WriteErrorResponse(r, w, http.StatusNotFound, "Not found", err)
 		} else {
 			honeybadger.Notify(err)
 			helpers.WriteErrorResponse(r, w, http.StatusInternalServerError, "Internal error", err)
 		}
+	} else if streamRes, ok := res.(results.StreamResult); ok {
+		if err := helpers.WriteStreamResponse(r, w, streamRes.ContentType, streamRes.FileName, streamRes.Write); err != nil {
+			honeybadger.Notify(err)
+		}
 	} else {
 		okRes, _ := res.(results.OkResult)
 		helpers.WriteOkResponse(r, w, okRes.StatusCode, okRes.Content)
@@ -139,3 +181,16 @@ func (h Handler) GetUserIDFromContext(r *http.Request) int32 {
 
 	return userID
 }
+
+// GetWorkspaceIDFromContext returns the workspace selected by the workspace middleware
+func (h Handler) GetWorkspaceIDFromContext(r *http.Request) int32 {
+	workspaceIDRaw := r.Context().Value(consts.ReqContextWorkspaceIDKey)
+
+	workspaceID, _ := workspaceIDRaw.(int32)
+
+	return workspaceID
+}
+
+func (h Handler) GetImpersonationIDFromContext(r *http.Request) int32 {
+	return helpers.GetImpersonationIDFromContext(r)
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -172,6 +173,42 @@ func TestHandlerServeHTTP(t *testing.T) {
 		assert.Equal(t, "wadus\n", string(response.Body.String()))
 	})
 
+	t.Run("Returns 403 when a forbidden error happens", func(t *testing.T) {
+		f := func(w http.ResponseWriter, r *http.Request, h Handler) HandlerResult {
+			return results.ErrorResult{Err: &appErrors.ForbiddenError{Msg: "wadus"}}
+		}
+
+		handler := Handler{
+			HandlerFunc: f,
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusForbidden, response.Result().StatusCode)
+		assert.Equal(t, "wadus\n", string(response.Body.String()))
+	})
+
+	t.Run("Returns 413 when a request entity too large error happens", func(t *testing.T) {
+		f := func(w http.ResponseWriter, r *http.Request, h Handler) HandlerResult {
+			return results.ErrorResult{Err: &appErrors.RequestEntityTooLargeError{Msg: "wadus"}}
+		}
+
+		handler := Handler{
+			HandlerFunc: f,
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, response.Result().StatusCode)
+		assert.Equal(t, "wadus\n", string(response.Body.String()))
+	})
+
 	t.Run("Returns 500 when an unhandled error happens", func(t *testing.T) {
 		f := func(w http.ResponseWriter, r *http.Request, h Handler) HandlerResult {
 			return results.ErrorResult{Err: errors.New("wadus")}
@@ -189,4 +226,30 @@ func TestHandlerServeHTTP(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, response.Result().StatusCode)
 		assert.Equal(t, "Internal error\n", string(response.Body.String()))
 	})
+	t.Run("Returns 200 with the streamed content when the result is a stream", func(t *testing.T) {
+		f := func(w http.ResponseWriter, r *http.Request, h Handler) HandlerResult {
+			return results.StreamResult{
+				ContentType: "text/csv",
+				FileName:    "lists.csv",
+				Write: func(w io.Writer) error {
+					_, err := io.WriteString(w, "wadus")
+					return err
+				},
+			}
+		}
+
+		handler := Handler{
+			HandlerFunc: f,
+		}
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		handler.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		assert.Equal(t, "text/csv", response.Result().Header.Get("content-type"))
+		assert.Equal(t, `attachment; filename="lists.csv"`, response.Result().Header.Get("content-disposition"))
+		assert.Equal(t, "wadus", response.Body.String())
+	})
 }
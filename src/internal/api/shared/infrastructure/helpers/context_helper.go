@@ -1,6 +1,7 @@
 package helpers
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
@@ -13,3 +14,31 @@ func GetRequestIDFromContext(r *http.Request) string {
 
 	return requestID
 }
+
+func GetImpersonatorIDFromContext(r *http.Request) int32 {
+	impersonatorIDRaw := r.Context().Value(consts.ReqContextImpersonatorIDKey)
+
+	impersonatorID, _ := impersonatorIDRaw.(int32)
+
+	return impersonatorID
+}
+
+func GetImpersonationIDFromContext(r *http.Request) int32 {
+	impersonationIDRaw := r.Context().Value(consts.ReqContextImpersonationIDKey)
+
+	impersonationID, _ := impersonationIDRaw.(int32)
+
+	return impersonationID
+}
+
+// GetLogTagFromContext returns the tag used to prefix the log lines of a request. It's the
+// request id, followed by the impersonation info when an admin is impersonating a user
+func GetLogTagFromContext(r *http.Request) string {
+	requestID := GetRequestIDFromContext(r)
+
+	if impersonationID := GetImpersonationIDFromContext(r); impersonationID > 0 {
+		return fmt.Sprintf("%v impersonation:%v impersonator:%v", requestID, impersonationID, GetImpersonatorIDFromContext(r))
+	}
+
+	return requestID
+}
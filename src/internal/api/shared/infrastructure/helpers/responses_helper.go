@@ -2,6 +2,8 @@ package helpers
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"time"
@@ -11,7 +13,7 @@ import (
 
 // WriteOkResponse is used when and endpoind does not respond with an error
 func WriteOkResponse(r *http.Request, w http.ResponseWriter, statusCode int, content interface{}) {
-	log.Printf("[%v] %v %v", GetRequestIDFromContext(r), statusCode, time.Since(getRequestStartTimeFromContext(r)))
+	log.Printf("[%v] %v %v", GetLogTagFromContext(r), statusCode, time.Since(getRequestStartTimeFromContext(r)))
 
 	if content == nil {
 		w.WriteHeader(statusCode)
@@ -23,15 +25,35 @@ func WriteOkResponse(r *http.Request, w http.ResponseWriter, statusCode int, con
 	json.NewEncoder(w).Encode(content)
 }
 
+// WriteStreamResponse is used when an endpoint writes its content while generating it. The
+// status code is always 200 because the headers are sent before the content
+func WriteStreamResponse(r *http.Request, w http.ResponseWriter, contentType string, fileName string, write func(w io.Writer) error) error {
+	w.Header().Set("content-type", contentType)
+	if len(fileName) > 0 {
+		w.Header().Set("content-disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	err := write(w)
+
+	if err != nil {
+		log.Printf("[%v] %v %v streaming failed (%v)", GetLogTagFromContext(r), http.StatusOK, time.Since(getRequestStartTimeFromContext(r)), err)
+	} else {
+		log.Printf("[%v] %v %v", GetLogTagFromContext(r), http.StatusOK, time.Since(getRequestStartTimeFromContext(r)))
+	}
+
+	return err
+}
+
 // WriteErrorResponse is used when and endpoind responds with an error
 func WriteErrorResponse(r *http.Request, w http.ResponseWriter, statusCode int, msg string, internalError error) {
-	requestID := GetRequestIDFromContext(r)
+	logTag := GetLogTagFromContext(r)
 	timeSinceReqStart := time.Since(getRequestStartTimeFromContext(r))
 
 	if internalError != nil {
-		log.Printf("[%v] %v %v %v (%v)", requestID, statusCode, timeSinceReqStart, msg, internalError)
+		log.Printf("[%v] %v %v %v (%v)", logTag, statusCode, timeSinceReqStart, msg, internalError)
 	} else {
-		log.Printf("[%v] %v %v %v", requestID, statusCode, timeSinceReqStart, msg)
+		log.Printf("[%v] %v %v %v", logTag, statusCode, timeSinceReqStart, msg)
 	}
 
 	http.Error(w, msg, statusCode)
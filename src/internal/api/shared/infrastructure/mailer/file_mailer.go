@@ -0,0 +1,45 @@
+package mailer
+
+import (
+	"log"
+	"os"
+	"sync"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+)
+
+// FileMailer doesn't send the emails, it appends them to a file or writes them to the log
+// when no file is configured. It is meant to be used in development
+type FileMailer struct {
+	cfgSvr sharedApp.ConfigurationService
+	mu     sync.Mutex
+}
+
+func NewFileMailer(cfgSvr sharedApp.ConfigurationService) *FileMailer {
+	return &FileMailer{cfgSvr: cfgSvr}
+}
+
+func (m *FileMailer) Send(message *Message) error {
+	content := message.toRFC822(m.cfgSvr.GetMailFrom())
+
+	filePath := m.cfgSvr.GetMailerFilePath()
+	if len(filePath) == 0 {
+		log.Printf("Email sent:\n%s", content)
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(content, []byte("\r\n\r\n")...)); err != nil {
+		return err
+	}
+
+	return nil
+}
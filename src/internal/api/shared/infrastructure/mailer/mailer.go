@@ -0,0 +1,30 @@
+package mailer
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Mailer interface {
+	Send(message *Message) error
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+func (m *Message) toRFC822(from string) []byte {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "From: %v\r\n", from)
+	fmt.Fprintf(&sb, "To: %v\r\n", m.To)
+	fmt.Fprintf(&sb, "Subject: %v\r\n", m.Subject)
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(m.Body)
+
+	return []byte(sb.String())
+}
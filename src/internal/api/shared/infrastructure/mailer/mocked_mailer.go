@@ -0,0 +1,17 @@
+package mailer
+
+import "github.com/stretchr/testify/mock"
+
+type MockedMailer struct {
+	mock.Mock
+}
+
+func NewMockedMailer() *MockedMailer {
+	return &MockedMailer{}
+}
+
+func (m *MockedMailer) Send(message *Message) error {
+	args := m.Called(message)
+
+	return args.Error(0)
+}
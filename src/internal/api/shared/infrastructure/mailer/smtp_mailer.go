@@ -0,0 +1,29 @@
+package mailer
+
+import (
+	"fmt"
+	"net/smtp"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+)
+
+type SmtpMailer struct {
+	cfgSvr sharedApp.ConfigurationService
+}
+
+func NewSmtpMailer(cfgSvr sharedApp.ConfigurationService) *SmtpMailer {
+	return &SmtpMailer{cfgSvr}
+}
+
+func (m *SmtpMailer) Send(message *Message) error {
+	host := m.cfgSvr.GetSmtpHost()
+	addr := fmt.Sprintf("%v:%v", host, m.cfgSvr.GetSmtpPort())
+	from := m.cfgSvr.GetMailFrom()
+
+	var auth smtp.Auth
+	if user := m.cfgSvr.GetSmtpUser(); len(user) > 0 {
+		auth = smtp.PlainAuth("", user, m.cfgSvr.GetSmtpPassword(), host)
+	}
+
+	return smtp.SendMail(addr, auth, from, []string{message.To}, message.toRFC822(from))
+}
@@ -2,21 +2,32 @@ package authmdw
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
 )
 
+// impersonationBlockedPaths are the endpoints, and the ones below them, that change the
+// credentials or the sessions of the user, so an admin can't use them while impersonating
+var impersonationBlockedPaths = []string{"/me/email", "/me/password", "/me/sessions"}
+
 type RealAuthMiddleware struct {
-	tokenSrv domain.TokenService
+	tokenSrv    domain.TokenService
+	authRepo    domain.AuthRepository
+	auditLogger *sharedApp.AuditLogger
 }
 
-func NewRealAuthMiddleware(tokenSrv domain.TokenService) *RealAuthMiddleware {
-	return &RealAuthMiddleware{tokenSrv}
+func NewRealAuthMiddleware(tokenSrv domain.TokenService, authRepo domain.AuthRepository, auditRepo audit.AuditLogRepository) *RealAuthMiddleware {
+	return &RealAuthMiddleware{tokenSrv, authRepo, sharedApp.NewAuditLogger(auditRepo)}
 }
 
 func (m *RealAuthMiddleware) Middleware(next http.Handler) http.Handler {
@@ -35,17 +46,67 @@ func (m *RealAuthMiddleware) Middleware(next http.Handler) http.Handler {
 
 		tokenInfo := m.tokenSrv.GetTokenInfo(parsedToken)
 
-		log.Printf("[%v] User: name %q, id %v, isAdmin: %v", helpers.GetRequestIDFromContext(r), tokenInfo.UserName, tokenInfo.UserID, tokenInfo.IsAdmin)
-
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, tokenInfo.UserID)
 		ctx = context.WithValue(ctx, consts.ReqContextUserNameKey, tokenInfo.UserName)
 		ctx = context.WithValue(ctx, consts.ReqContextUserIsAdminKey, tokenInfo.IsAdmin)
 
+		if tokenInfo.IsImpersonating() {
+			if err := m.checkImpersonation(r, tokenInfo); err != nil {
+				helpers.WriteErrorResponse(r, w, http.StatusUnauthorized, "The impersonation session has ended", err)
+				return
+			}
+
+			ctx = context.WithValue(ctx, consts.ReqContextImpersonatorIDKey, tokenInfo.ImpersonatorID)
+			ctx = context.WithValue(ctx, consts.ReqContextImpersonationIDKey, tokenInfo.ImpersonationID)
+			r = r.WithContext(ctx)
+
+			log.Printf("[%v] User: name %q, id %v, isAdmin: %v, impersonated by name %q, id %v", helpers.GetLogTagFromContext(r), tokenInfo.UserName, tokenInfo.UserID, tokenInfo.IsAdmin, tokenInfo.ImpersonatorName, tokenInfo.ImpersonatorID)
+
+			// Every impersonated request is audited, including the blocked ones, and it isn't done
+			// when it can't be audited
+			requestInfo := map[string]string{"method": r.Method, "path": r.URL.Path}
+			if err := m.auditLogger.Log(ctx, audit.ActionImpersonatedRequest, audit.TargetImpersonation, tokenInfo.ImpersonationID, nil, requestInfo); err != nil {
+				helpers.WriteErrorResponse(r, w, http.StatusInternalServerError, "Error writing the audit log", err)
+				return
+			}
+
+			if isImpersonationBlockedPath(r.URL.Path) {
+				helpers.WriteErrorResponse(r, w, http.StatusForbidden, "Not allowed while impersonating a user", nil)
+				return
+			}
+		} else {
+			log.Printf("[%v] User: name %q, id %v, isAdmin: %v", helpers.GetRequestIDFromContext(r), tokenInfo.UserName, tokenInfo.UserID, tokenInfo.IsAdmin)
+		}
+
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// checkImpersonation returns an error if the impersonation session of the token has been ended
+func (m *RealAuthMiddleware) checkImpersonation(r *http.Request, tokenInfo *domain.TokenClaimsInfo) error {
+	impersonation, err := m.authRepo.FindImpersonation(r.Context(), domain.ImpersonationEntity{ID: tokenInfo.ImpersonationID})
+	if err != nil {
+		return err
+	}
+
+	if impersonation.AdminID != tokenInfo.ImpersonatorID || impersonation.UserID != tokenInfo.UserID || !impersonation.IsActive(time.Now()) {
+		return fmt.Errorf("the impersonation session %v is not active", impersonation.ID)
+	}
+
+	return nil
+}
+
+func isImpersonationBlockedPath(path string) bool {
+	for _, v := range impersonationBlockedPaths {
+		if path == v || strings.HasPrefix(path, v+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (m *RealAuthMiddleware) getAuthToken(r *http.Request) (string, error) {
 	cookie, err := r.Cookie("token")
 	if err != nil {
@@ -8,17 +8,24 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/golang-jwt/jwt"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestRealAuthMiddleware(t *testing.T) {
 	mockedTokenSrv := domain.NewMockedTokenService()
-	md := NewRealAuthMiddleware(mockedTokenSrv)
+	mockedAuthRepo := repository.NewMockedAuthRepository()
+	mockedAuditLogRepo := sharedRepository.NewMockedAuditLogRepository()
+	md := NewRealAuthMiddleware(mockedTokenSrv, mockedAuthRepo, mockedAuditLogRepo)
 
 	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
@@ -35,6 +42,14 @@ func TestRealAuthMiddleware(t *testing.T) {
 		return &http.Cookie{Name: "token", Value: rt}
 	}
 
+	expectImpersonatedRequest := func(method string, path string, err error) {
+		mockedAuditLogRepo.On("Create", mock.Anything, mock.MatchedBy(func(e *audit.AuditLogEntryEntity) bool {
+			return e.Action == audit.ActionImpersonatedRequest && e.TargetType == audit.TargetImpersonation && *e.TargetID == 5 &&
+				*e.ActorID == 1 && *e.ImpersonatorID == 2 &&
+				e.Diff == fmt.Sprintf(`{"method":{"old":null,"new":%q},"path":{"old":null,"new":%q}}`, method, path)
+		})).Return(err).Once()
+	}
+
 	t.Run("Should return an error if there isn't token cookie", func(t *testing.T) {
 		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
 		response := httptest.NewRecorder()
@@ -78,4 +93,101 @@ func TestRealAuthMiddleware(t *testing.T) {
 
 		mockedTokenSrv.AssertExpectations(t)
 	})
+
+	t.Run("Should add the impersonation info to the request context if the impersonation session is active", func(t *testing.T) {
+		token := jwt.Token{Valid: true}
+		mockedTokenSrv.On("ParseToken", "impersonationToken").Return(&token, nil).Once()
+		mockedTokenSrv.On("GetTokenInfo", &token).Return(&domain.TokenClaimsInfo{UserID: 1, UserName: "user", IsAdmin: true, ImpersonatorID: 2, ImpersonatorName: "admin", ImpersonationID: 5}).Once()
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		request.AddCookie(getTokenCookie("impersonationToken"))
+		mockedAuthRepo.On("FindImpersonation", request.Context(), domain.ImpersonationEntity{ID: 5}).Return(&domain.ImpersonationEntity{ID: 5, AdminID: 2, UserID: 1, ExpirationDate: time.Now().Add(time.Minute)}, nil).Once()
+		expectImpersonatedRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+		handlerToTest := md.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			nextHandler.ServeHTTP(w, r)
+
+			impersonatorID, _ := r.Context().Value(consts.ReqContextImpersonatorIDKey).(int32)
+			impersonationID, _ := r.Context().Value(consts.ReqContextImpersonationIDKey).(int32)
+			assert.Equal(t, int32(2), impersonatorID)
+			assert.Equal(t, int32(5), impersonationID)
+		}))
+
+		handlerToTest.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+
+		mockedTokenSrv.AssertExpectations(t)
+		mockedAuthRepo.AssertExpectations(t)
+		mockedAuditLogRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the impersonated request can not be audited", func(t *testing.T) {
+		token := jwt.Token{Valid: true}
+		mockedTokenSrv.On("ParseToken", "impersonationToken").Return(&token, nil).Once()
+		mockedTokenSrv.On("GetTokenInfo", &token).Return(&domain.TokenClaimsInfo{UserID: 1, UserName: "user", ImpersonatorID: 2, ImpersonationID: 5}).Once()
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		request.AddCookie(getTokenCookie("impersonationToken"))
+		mockedAuthRepo.On("FindImpersonation", request.Context(), domain.ImpersonationEntity{ID: 5}).Return(&domain.ImpersonationEntity{ID: 5, AdminID: 2, UserID: 1, ExpirationDate: time.Now().Add(time.Minute)}, nil).Once()
+		expectImpersonatedRequest(http.MethodGet, "/wadus", fmt.Errorf("some error"))
+		response := httptest.NewRecorder()
+		handlerToTest := md.Middleware(nextHandler)
+
+		handlerToTest.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusInternalServerError, response.Result().StatusCode)
+		assert.Equal(t, "Error writing the audit log\n", string(response.Body.String()))
+
+		mockedTokenSrv.AssertExpectations(t)
+		mockedAuthRepo.AssertExpectations(t)
+		mockedAuditLogRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the endpoint can not be used while impersonating", func(t *testing.T) {
+		for _, path := range []string{"/me/email", "/me/email/verification", "/me/password", "/me/sessions", "/me/sessions/3"} {
+			token := jwt.Token{Valid: true}
+			mockedTokenSrv.On("ParseToken", "impersonationToken").Return(&token, nil).Once()
+			mockedTokenSrv.On("GetTokenInfo", &token).Return(&domain.TokenClaimsInfo{UserID: 1, UserName: "user", ImpersonatorID: 2, ImpersonationID: 5}).Once()
+
+			request, _ := http.NewRequest(http.MethodPost, path, nil)
+			request.AddCookie(getTokenCookie("impersonationToken"))
+			mockedAuthRepo.On("FindImpersonation", request.Context(), domain.ImpersonationEntity{ID: 5}).Return(&domain.ImpersonationEntity{ID: 5, AdminID: 2, UserID: 1, ExpirationDate: time.Now().Add(time.Minute)}, nil).Once()
+			expectImpersonatedRequest(http.MethodPost, path, nil)
+			response := httptest.NewRecorder()
+			handlerToTest := md.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Error("the next handler shouldn't be called")
+			}))
+
+			handlerToTest.ServeHTTP(response, request)
+
+			assert.Equal(t, http.StatusForbidden, response.Result().StatusCode, path)
+			assert.Equal(t, "Not allowed while impersonating a user\n", string(response.Body.String()))
+		}
+
+		mockedTokenSrv.AssertExpectations(t)
+		mockedAuthRepo.AssertExpectations(t)
+		mockedAuditLogRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the impersonation session has ended", func(t *testing.T) {
+		token := jwt.Token{Valid: true}
+		mockedTokenSrv.On("ParseToken", "endedImpersonationToken").Return(&token, nil).Once()
+		mockedTokenSrv.On("GetTokenInfo", &token).Return(&domain.TokenClaimsInfo{UserID: 1, UserName: "user", ImpersonatorID: 2, ImpersonationID: 6}).Once()
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		request.AddCookie(getTokenCookie("endedImpersonationToken"))
+		endedAt := time.Now()
+		mockedAuthRepo.On("FindImpersonation", request.Context(), domain.ImpersonationEntity{ID: 6}).Return(&domain.ImpersonationEntity{ID: 6, AdminID: 2, UserID: 1, ExpirationDate: time.Now().Add(time.Minute), EndedAt: &endedAt}, nil).Once()
+		response := httptest.NewRecorder()
+		handlerToTest := md.Middleware(nextHandler)
+
+		handlerToTest.ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusUnauthorized, response.Result().StatusCode)
+		assert.Equal(t, "The impersonation session has ended\n", string(response.Body.String()))
+
+		mockedTokenSrv.AssertExpectations(t)
+		mockedAuthRepo.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,62 @@
+package ratelimitmdw
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/ratelimit"
+	"github.com/honeybadger-io/honeybadger-go"
+)
+
+type RateLimitMiddleware struct {
+	store   ratelimit.Store
+	policy  ratelimit.Policy
+	keyFunc ratelimit.KeyFunc
+}
+
+func NewRateLimitMiddleware(store ratelimit.Store, policy ratelimit.Policy, keyFunc ratelimit.KeyFunc) *RateLimitMiddleware {
+	return &RateLimitMiddleware{store, policy, keyFunc}
+}
+
+// Middleware takes a token of the bucket of the request and returns 429 when it's empty. The
+// request goes on if the store fails, because a broken store shouldn't take the api down
+func (m *RateLimitMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.policy.IsDisabled() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key := fmt.Sprintf("%v:%v", m.policy.Name, m.keyFunc(r))
+
+		res, err := m.store.Take(r.Context(), key, m.policy)
+		if err != nil {
+			log.Printf("[%v] Rate limit store failed with error %v", helpers.GetLogTagFromContext(r), err)
+			honeybadger.Notify(err)
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("RateLimit-Policy", fmt.Sprintf("%v;w=%v", m.policy.Limit, ceilSeconds(m.policy.Window)))
+		w.Header().Set("RateLimit-Limit", strconv.Itoa(m.policy.Limit))
+		w.Header().Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		w.Header().Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+
+		if !res.Allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			helpers.WriteErrorResponse(r, w, http.StatusTooManyRequests, "Too many requests", nil)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,113 @@
+//go:build !e2e
+// +build !e2e
+
+package ratelimitmdw
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/reqid"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/ratelimit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitMiddleware(t *testing.T) {
+	policy := ratelimit.NewPolicy("user", 10, time.Minute)
+	keyFunc := func(r *http.Request) string { return "user:1" }
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	t.Run("should call the next handler and set the headers when the request is allowed", func(t *testing.T) {
+		mockedStore := ratelimit.MockedStore{}
+		md := NewRateLimitMiddleware(&mockedStore, policy, keyFunc)
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		mockedStore.On("Take", request.Context(), "user:user:1", policy).Return(&ratelimit.Result{Allowed: true, Remaining: 9, ResetAfter: 6 * time.Second}, nil).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		assert.Equal(t, "10;w=60", response.Header().Get("RateLimit-Policy"))
+		assert.Equal(t, "10", response.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "9", response.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "6", response.Header().Get("RateLimit-Reset"))
+		assert.Empty(t, response.Header().Get("Retry-After"))
+		mockedStore.AssertExpectations(t)
+	})
+
+	t.Run("should return 429 when the bucket is empty", func(t *testing.T) {
+		mockedStore := ratelimit.MockedStore{}
+		md := NewRateLimitMiddleware(&mockedStore, policy, keyFunc)
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		mockedStore.On("Take", request.Context(), "user:user:1", policy).Return(&ratelimit.Result{Allowed: false, RetryAfter: 5500 * time.Millisecond, ResetAfter: time.Minute}, nil).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusTooManyRequests, response.Result().StatusCode)
+		assert.Equal(t, "Too many requests\n", response.Body.String())
+		assert.Equal(t, "6", response.Header().Get("Retry-After"))
+		assert.Equal(t, "0", response.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", response.Header().Get("RateLimit-Reset"))
+		mockedStore.AssertExpectations(t)
+	})
+
+	t.Run("should call the next handler when the store fails", func(t *testing.T) {
+		mockedStore := ratelimit.MockedStore{}
+		md := NewRateLimitMiddleware(&mockedStore, policy, keyFunc)
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		mockedStore.On("Take", request.Context(), "user:user:1", policy).Return(nil, fmt.Errorf("some error")).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		assert.Empty(t, response.Header().Get("RateLimit-Limit"))
+		mockedStore.AssertExpectations(t)
+	})
+
+	t.Run("should not use the store when the policy is disabled", func(t *testing.T) {
+		mockedStore := ratelimit.MockedStore{}
+		md := NewRateLimitMiddleware(&mockedStore, ratelimit.NewPolicy("user", 0, time.Minute), keyFunc)
+
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		response := httptest.NewRecorder()
+
+		md.Middleware(nextHandler).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		mockedStore.AssertExpectations(t)
+	})
+}
+
+func TestRateLimitMiddleware_ByIP_Ignores_The_X_Forwarded_For_Entries_Sent_By_The_Client(t *testing.T) {
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedCfgSrv.On("GetTrustedProxyHops").Return(1).Once()
+	reqIdMdw := reqid.NewRequestIdMiddleware(mockedCfgSrv)
+	md := NewRateLimitMiddleware(ratelimit.NewMemoryStore(), ratelimit.NewPolicy("anonymous", 1, time.Minute), ratelimit.ByIP)
+	handler := reqIdMdw.Middleware(md.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})))
+
+	statusCodes := []int{}
+	for _, forwardedFor := range []string{"1.1.1.1, 203.0.113.7", "2.2.2.2, 203.0.113.7"} {
+		request, _ := http.NewRequest(http.MethodPost, "/auth/login", nil)
+		request.Header.Set("X-Forwarded-For", forwardedFor)
+		response := httptest.NewRecorder()
+
+		handler.ServeHTTP(response, request)
+
+		statusCodes = append(statusCodes, response.Result().StatusCode)
+	}
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusTooManyRequests}, statusCodes)
+	mockedCfgSrv.AssertExpectations(t)
+}
@@ -2,16 +2,21 @@ package reqid
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"strings"
 
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/google/uuid"
 )
 
-type RequestIdMiddleware struct{}
+type RequestIdMiddleware struct {
+	trustedProxyHops int
+}
 
-func NewRequestIdMiddleware() *RequestIdMiddleware {
-	return &RequestIdMiddleware{}
+func NewRequestIdMiddleware(cfgSrv sharedApp.ConfigurationService) *RequestIdMiddleware {
+	return &RequestIdMiddleware{cfgSrv.GetTrustedProxyHops()}
 }
 
 func (m *RequestIdMiddleware) Middleware(next http.Handler) http.Handler {
@@ -25,7 +30,40 @@ func (m *RequestIdMiddleware) Middleware(next http.Handler) http.Handler {
 
 		ctx := r.Context()
 		ctx = context.WithValue(ctx, consts.ReqContextRequestKey, reqId)
+		ctx = context.WithValue(ctx, consts.ReqContextRemoteAddrKey, m.remoteAddr(r))
+		ctx = context.WithValue(ctx, consts.ReqContextUserAgentKey, r.UserAgent())
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// remoteAddr returns the ip of the client. The client can send any X-Forwarded-For header, so
+// only the entries appended by the trusted proxies are used: with n trusted proxies the ip of
+// the client is the n-th entry from the right
+func (m *RequestIdMiddleware) remoteAddr(r *http.Request) string {
+	if m.trustedProxyHops > 0 {
+		entries := []string{}
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for _, entry := range strings.Split(value, ",") {
+				if entry = strings.TrimSpace(entry); len(entry) > 0 {
+					entries = append(entries, entry)
+				}
+			}
+		}
+
+		if len(entries) > 0 {
+			index := len(entries) - m.trustedProxyHops
+			if index < 0 {
+				index = 0
+			}
+
+			return entries[index]
+		}
+	}
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+
+	return r.RemoteAddr
+}
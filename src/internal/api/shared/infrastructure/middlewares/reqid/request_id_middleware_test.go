@@ -0,0 +1,61 @@
+//go:build !e2e
+// +build !e2e
+
+package reqid
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveRequest(trustedProxyHops int, forwardedFor []string) string {
+	mockedCfgSrv := sharedApp.NewMockedConfigurationService()
+	mockedCfgSrv.On("GetTrustedProxyHops").Return(trustedProxyHops).Once()
+	md := NewRequestIdMiddleware(mockedCfgSrv)
+
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request.RemoteAddr = "10.0.0.1:4321"
+	for _, v := range forwardedFor {
+		request.Header.Add("X-Forwarded-For", v)
+	}
+
+	var remoteAddr string
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		remoteAddr, _ = r.Context().Value(consts.ReqContextRemoteAddrKey).(string)
+	})
+
+	md.Middleware(nextHandler).ServeHTTP(httptest.NewRecorder(), request)
+
+	return remoteAddr
+}
+
+func TestRequestIdMiddleware_RemoteAddr(t *testing.T) {
+	t.Run("should use the entry appended by the trusted proxy", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", serveRequest(1, []string{"1.2.3.4, 203.0.113.7"}))
+	})
+
+	t.Run("should ignore the entries sent by the client in previous headers", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", serveRequest(1, []string{"1.2.3.4", "5.6.7.8, 203.0.113.7"}))
+	})
+
+	t.Run("should strip the configured number of trusted hops", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", serveRequest(2, []string{"1.2.3.4, 203.0.113.7, 10.1.1.1"}))
+	})
+
+	t.Run("should use the left-most entry when there are less entries than trusted hops", func(t *testing.T) {
+		assert.Equal(t, "203.0.113.7", serveRequest(2, []string{"203.0.113.7"}))
+	})
+
+	t.Run("should use the address of the connection when there isn't a X-Forwarded-For header", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", serveRequest(1, nil))
+	})
+
+	t.Run("should ignore the X-Forwarded-For header when there aren't trusted proxies", func(t *testing.T) {
+		assert.Equal(t, "10.0.0.1", serveRequest(0, []string{"1.2.3.4"}))
+	})
+}
@@ -0,0 +1,31 @@
+package workspacemdw
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+// RequireWorkspaceManagerMiddleware only lets the owner and the admins of the workspace
+// selected by the WorkspaceMiddleware go on, so it must run after it
+type RequireWorkspaceManagerMiddleware struct {
+}
+
+func NewRequireWorkspaceManagerMiddleware() *RequireWorkspaceManagerMiddleware {
+	return &RequireWorkspaceManagerMiddleware{}
+}
+
+func (m *RequireWorkspaceManagerMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, _ := r.Context().Value(consts.ReqContextWorkspaceRoleKey).(string)
+
+		if !domain.CanManageContent(role) {
+			helpers.WriteErrorResponse(r, w, http.StatusForbidden, "Access forbidden", nil)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
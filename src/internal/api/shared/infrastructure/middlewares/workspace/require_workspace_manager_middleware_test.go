@@ -0,0 +1,55 @@
+//go:build !e2e
+// +build !e2e
+
+package workspacemdw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequireWorkspaceManagerMiddleware(t *testing.T) {
+	md := NewRequireWorkspaceManagerMiddleware()
+
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	newRequest := func(role string) *http.Request {
+		request, _ := http.NewRequest(http.MethodDelete, "/wadus", nil)
+		ctx := context.WithValue(request.Context(), consts.ReqContextWorkspaceRoleKey, role)
+
+		return request.WithContext(ctx)
+	}
+
+	t.Run("should return 403 if the user is a member of the workspace", func(t *testing.T) {
+		response := httptest.NewRecorder()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest("member"))
+
+		assert.Equal(t, http.StatusForbidden, response.Result().StatusCode)
+		assert.Equal(t, "Access forbidden\n", response.Body.String())
+	})
+
+	t.Run("should return 403 if there isn't a role", func(t *testing.T) {
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest(http.MethodDelete, "/wadus", nil)
+
+		md.Middleware(nextHandler).ServeHTTP(response, request)
+
+		assert.Equal(t, http.StatusForbidden, response.Result().StatusCode)
+	})
+
+	t.Run("should call the next handler if the user is the owner or an admin of the workspace", func(t *testing.T) {
+		for _, role := range []string{"owner", "admin"} {
+			response := httptest.NewRecorder()
+
+			md.Middleware(nextHandler).ServeHTTP(response, newRequest(role))
+
+			assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package workspacemdw
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"gorm.io/gorm"
+)
+
+const WorkspaceIDHeader = "X-Workspace-ID"
+
+// WorkspaceMiddleware selects the workspace of the request and the role of the user in it.
+// It's the one of the X-Workspace-ID header, which the user must be a member of, or the
+// personal workspace of the user, who is its owner, when there isn't any header
+type WorkspaceMiddleware struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewWorkspaceMiddleware(repo domain.WorkspacesRepository) *WorkspaceMiddleware {
+	return &WorkspaceMiddleware{repo}
+}
+
+func (m *WorkspaceMiddleware) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value(consts.ReqContextUserIDKey).(int32)
+
+		var workspaceID int32
+		var role string
+
+		if rawID := r.Header.Get(WorkspaceIDHeader); len(rawID) > 0 {
+			id, err := strconv.ParseInt(rawID, 10, 32)
+			if err != nil {
+				helpers.WriteErrorResponse(r, w, http.StatusBadRequest, "Invalid workspace id", err)
+				return
+			}
+
+			workspaceID = int32(id)
+
+			member, err := m.repo.FindWorkspaceMember(r.Context(), domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: userID})
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				helpers.WriteErrorResponse(r, w, http.StatusForbidden, "Access forbidden", err)
+				return
+			} else if err != nil {
+				helpers.WriteErrorResponse(r, w, http.StatusInternalServerError, "Error getting the workspace", err)
+				return
+			}
+
+			role = member.Role
+		} else {
+			personalWorkspace, err := m.repo.GetOrCreatePersonalWorkspace(r.Context(), userID)
+			if err != nil {
+				helpers.WriteErrorResponse(r, w, http.StatusInternalServerError, "Error getting the personal workspace", err)
+				return
+			}
+
+			workspaceID = personalWorkspace.ID
+			role = domain.WorkspaceRoleOwner
+		}
+
+		ctx := context.WithValue(r.Context(), consts.ReqContextWorkspaceIDKey, workspaceID)
+		ctx = context.WithValue(ctx, consts.ReqContextWorkspaceRoleKey, role)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,121 @@
+//go:build !e2e
+// +build !e2e
+
+package workspacemdw
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+func TestWorkspaceMiddleware(t *testing.T) {
+	newRequest := func(workspaceID string) *http.Request {
+		request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+		if len(workspaceID) > 0 {
+			request.Header.Set(WorkspaceIDHeader, workspaceID)
+		}
+
+		ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+
+		return request.WithContext(ctx)
+	}
+
+	var nextCtx context.Context
+	nextHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCtx = r.Context()
+	})
+
+	t.Run("should return 400 if the workspace id is not valid", func(t *testing.T) {
+		md := NewWorkspaceMiddleware(workspacesRepository.NewMockedWorkspacesRepository())
+		response := httptest.NewRecorder()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest("wadus"))
+
+		assert.Equal(t, http.StatusBadRequest, response.Result().StatusCode)
+		assert.Equal(t, "Invalid workspace id\n", response.Body.String())
+	})
+
+	t.Run("should return 403 if the user is not a member of the workspace", func(t *testing.T) {
+		mockedRepo := workspacesRepository.NewMockedWorkspacesRepository()
+		md := NewWorkspaceMiddleware(mockedRepo)
+		response := httptest.NewRecorder()
+
+		mockedRepo.On("FindWorkspaceMember", mock.Anything, domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1}).Return(nil, gorm.ErrRecordNotFound).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest("3"))
+
+		assert.Equal(t, http.StatusForbidden, response.Result().StatusCode)
+		assert.Equal(t, "Access forbidden\n", response.Body.String())
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return 500 if the query to find the member fails", func(t *testing.T) {
+		mockedRepo := workspacesRepository.NewMockedWorkspacesRepository()
+		md := NewWorkspaceMiddleware(mockedRepo)
+		response := httptest.NewRecorder()
+
+		mockedRepo.On("FindWorkspaceMember", mock.Anything, domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1}).Return(nil, fmt.Errorf("some error")).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest("3"))
+
+		assert.Equal(t, http.StatusInternalServerError, response.Result().StatusCode)
+		assert.Equal(t, "Error getting the workspace\n", response.Body.String())
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("should select the workspace of the header", func(t *testing.T) {
+		mockedRepo := workspacesRepository.NewMockedWorkspacesRepository()
+		md := NewWorkspaceMiddleware(mockedRepo)
+		response := httptest.NewRecorder()
+
+		member := domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1, Role: "member"}
+		mockedRepo.On("FindWorkspaceMember", mock.Anything, domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1}).Return(&member, nil).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest("3"))
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		assert.Equal(t, int32(3), nextCtx.Value(consts.ReqContextWorkspaceIDKey))
+		assert.Equal(t, "member", nextCtx.Value(consts.ReqContextWorkspaceRoleKey))
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return 500 if getting the personal workspace fails", func(t *testing.T) {
+		mockedRepo := workspacesRepository.NewMockedWorkspacesRepository()
+		md := NewWorkspaceMiddleware(mockedRepo)
+		response := httptest.NewRecorder()
+
+		mockedRepo.On("GetOrCreatePersonalWorkspace", mock.Anything, int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest(""))
+
+		assert.Equal(t, http.StatusInternalServerError, response.Result().StatusCode)
+		assert.Equal(t, "Error getting the personal workspace\n", response.Body.String())
+		mockedRepo.AssertExpectations(t)
+	})
+
+	t.Run("should select the personal workspace when there isn't any header", func(t *testing.T) {
+		mockedRepo := workspacesRepository.NewMockedWorkspacesRepository()
+		md := NewWorkspaceMiddleware(mockedRepo)
+		response := httptest.NewRecorder()
+
+		userID := int32(1)
+		mockedRepo.On("GetOrCreatePersonalWorkspace", mock.Anything, int32(1)).Return(&domain.WorkspaceRecord{ID: 2, PersonalUserID: &userID}, nil).Once()
+
+		md.Middleware(nextHandler).ServeHTTP(response, newRequest(""))
+
+		assert.Equal(t, http.StatusOK, response.Result().StatusCode)
+		assert.Equal(t, int32(2), nextCtx.Value(consts.ReqContextWorkspaceIDKey))
+		assert.Equal(t, "owner", nextCtx.Value(consts.ReqContextWorkspaceRoleKey))
+		mockedRepo.AssertExpectations(t)
+	})
+}
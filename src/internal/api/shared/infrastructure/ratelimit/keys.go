@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+)
+
+// KeyFunc returns the key of the bucket used for a request
+type KeyFunc func(r *http.Request) string
+
+// ByIP uses the ip of the client, as it was resolved by the request id middleware from the
+// entries of the trusted proxies, so the client can't get a new bucket by changing its headers
+func ByIP(r *http.Request) string {
+	if remoteAddr, _ := r.Context().Value(consts.ReqContextRemoteAddrKey).(string); len(remoteAddr) > 0 {
+		return "ip:" + remoteAddr
+	}
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return "ip:" + host
+	}
+
+	return "ip:" + r.RemoteAddr
+}
+
+// ByUser uses the id of the authenticated user, or the ip of the client when the request
+// isn't authenticated
+func ByUser(r *http.Request) string {
+	if userID, _ := r.Context().Value(consts.ReqContextUserIDKey).(int32); userID > 0 {
+		return fmt.Sprintf("user:%v", userID)
+	}
+
+	return ByIP(r)
+}
@@ -0,0 +1,28 @@
+//go:build !e2e
+// +build !e2e
+
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyFuncs(t *testing.T) {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request.RemoteAddr = "10.0.0.1:1234"
+
+	assert.Equal(t, "ip:10.0.0.1", ByIP(request))
+	assert.Equal(t, "ip:10.0.0.1", ByUser(request))
+
+	ctx := context.WithValue(request.Context(), consts.ReqContextRemoteAddrKey, "10.0.0.2")
+	ctx = context.WithValue(ctx, consts.ReqContextUserIDKey, int32(3))
+	request = request.WithContext(ctx)
+
+	assert.Equal(t, "ip:10.0.0.2", ByIP(request))
+	assert.Equal(t, "user:3", ByUser(request))
+}
@@ -0,0 +1,80 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+const memoryStoreSweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time
+}
+
+type MemoryStore struct {
+	mu          sync.Mutex
+	buckets     map[string]*bucket
+	lastSweepAt time.Time
+	now         func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: map[string]*bucket{}, now: time.Now}
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy) (*Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	rate := policy.refillRate()
+	limit := float64(policy.Limit)
+
+	b, found := s.buckets[key]
+	if !found {
+		b = &bucket{tokens: limit, updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(limit, b.tokens+now.Sub(b.updatedAt).Seconds()*rate)
+	b.updatedAt = now
+
+	res := &Result{}
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = secondsToDuration((1 - b.tokens) / rate)
+	}
+
+	res.Remaining = int(math.Floor(b.tokens))
+	res.ResetAfter = secondsToDuration((limit - b.tokens) / rate)
+	b.fullAt = now.Add(res.ResetAfter)
+
+	return res, nil
+}
+
+// sweep removes the buckets that are already full, because they are the same as a new one
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweepAt) < memoryStoreSweepInterval {
+		return
+	}
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+
+	s.lastSweepAt = now
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,50 @@
+//go:build !e2e
+// +build !e2e
+
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	policy := NewPolicy("test", 2, 10*time.Second)
+
+	t.Run("should allow the requests until the bucket is empty", func(t *testing.T) {
+		res, _ := store.Take(context.Background(), "key", policy)
+		assert.Equal(t, &Result{Allowed: true, Remaining: 1, ResetAfter: 5 * time.Second}, res)
+
+		res, _ = store.Take(context.Background(), "key", policy)
+		assert.Equal(t, &Result{Allowed: true, Remaining: 0, ResetAfter: 10 * time.Second}, res)
+
+		res, _ = store.Take(context.Background(), "key", policy)
+		assert.Equal(t, &Result{Allowed: false, Remaining: 0, RetryAfter: 5 * time.Second, ResetAfter: 10 * time.Second}, res)
+	})
+
+	t.Run("should not share the bucket between keys", func(t *testing.T) {
+		res, _ := store.Take(context.Background(), "other key", policy)
+		assert.True(t, res.Allowed)
+	})
+
+	t.Run("should refill the bucket with the time", func(t *testing.T) {
+		now = now.Add(5 * time.Second)
+
+		res, _ := store.Take(context.Background(), "key", policy)
+		assert.Equal(t, &Result{Allowed: true, Remaining: 0, ResetAfter: 10 * time.Second}, res)
+	})
+
+	t.Run("should remove the full buckets", func(t *testing.T) {
+		now = now.Add(time.Minute)
+
+		store.Take(context.Background(), "key", policy)
+
+		assert.Len(t, store.buckets, 1)
+	})
+}
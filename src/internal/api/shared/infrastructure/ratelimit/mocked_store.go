@@ -0,0 +1,24 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedStore struct {
+	mock.Mock
+}
+
+func NewMockedStore() *MockedStore {
+	return &MockedStore{}
+}
+
+func (m *MockedStore) Take(ctx context.Context, key string, policy Policy) (*Result, error) {
+	args := m.Called(ctx, key, policy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*Result), args.Error(1)
+}
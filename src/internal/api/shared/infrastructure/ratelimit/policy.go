@@ -0,0 +1,24 @@
+package ratelimit
+
+import "time"
+
+// Policy is a token bucket that holds Limit tokens and is completely refilled after Window.
+// A policy with a Limit of 0 doesn't limit anything
+type Policy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+}
+
+func NewPolicy(name string, limit int, window time.Duration) Policy {
+	return Policy{Name: name, Limit: limit, Window: window}
+}
+
+func (p Policy) IsDisabled() bool {
+	return p.Limit <= 0 || p.Window <= 0
+}
+
+// refillRate returns the number of tokens added to the bucket each second
+func (p Policy) refillRate() float64 {
+	return float64(p.Limit) / p.Window.Seconds()
+}
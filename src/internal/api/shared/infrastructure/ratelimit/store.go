@@ -0,0 +1,20 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Result is the state of a bucket after trying to take a token from it
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// Store keeps the buckets of the policies. The in-memory store is only valid for a single
+// instance, several instances need a store shared between all of them
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy) (*Result, error)
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedAuditLogRepository struct {
+	mock.Mock
+}
+
+func NewMockedAuditLogRepository() *MockedAuditLogRepository {
+	return &MockedAuditLogRepository{}
+}
+
+func (m *MockedAuditLogRepository) Create(ctx context.Context, entry *audit.AuditLogEntryEntity) error {
+	args := m.Called(ctx, entry)
+
+	return args.Error(0)
+}
+
+func (m *MockedAuditLogRepository) GetAll(ctx context.Context, filter audit.AuditLogFilter, paginationInfo *sharedDomain.PaginationInfo) ([]*audit.AuditLogEntryEntity, error) {
+	args := m.Called(ctx, filter, paginationInfo)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*audit.AuditLogEntryEntity), args.Error(1)
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"gorm.io/gorm"
+)
+
+type MySqlAuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlAuditLogRepository(db *gorm.DB) *MySqlAuditLogRepository {
+	return &MySqlAuditLogRepository{db}
+}
+
+func (r *MySqlAuditLogRepository) Create(ctx context.Context, entry *audit.AuditLogEntryEntity) error {
+	record := entry.ToAuditLogEntryRecord()
+	if err := r.db.WithContext(ctx).Create(record).Error; err != nil {
+		return err
+	}
+
+	entry.ID = record.ID
+
+	return nil
+}
+
+func (r *MySqlAuditLogRepository) GetAll(ctx context.Context, filter audit.AuditLogFilter, paginationInfo *sharedDomain.PaginationInfo) ([]*audit.AuditLogEntryEntity, error) {
+	query := r.db.WithContext(ctx)
+
+	if filter.ActorID != nil {
+		query = query.Where("actorId = ?", *filter.ActorID)
+	}
+
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if filter.TargetType != "" {
+		query = query.Where("targetType = ?", filter.TargetType)
+	}
+
+	if filter.TargetID != nil {
+		query = query.Where("targetId = ?", *filter.TargetID)
+	}
+
+	if filter.From != nil {
+		query = query.Where("createdAt >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("createdAt < ?", *filter.To)
+	}
+
+	foundEntries := []audit.AuditLogEntryRecord{}
+	if err := query.
+		Limit(paginationInfo.Limit).Offset(paginationInfo.Offset).
+		Order(paginationInfo.Order).
+		Find(&foundEntries).
+		Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]*audit.AuditLogEntryEntity, len(foundEntries))
+
+	for i, e := range foundEntries {
+		res[i] = e.ToAuditLogEntryEntity()
+	}
+
+	return res, nil
+}
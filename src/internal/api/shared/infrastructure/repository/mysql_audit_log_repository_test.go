@@ -0,0 +1,110 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySqlAuditLogRepository_Create_Returns_An_Error_If_The_Insert_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuditLogRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_log`")).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.Create(context.Background(), &audit.AuditLogEntryEntity{Action: audit.ActionUserCreated})
+
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuditLogRepository_Create_Creates_The_Entry(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuditLogRepository(db)
+
+	actorID := int32(1)
+	targetID := int32(2)
+	now := time.Now()
+	entry := audit.AuditLogEntryEntity{
+		ActorID:    &actorID,
+		Action:     audit.ActionUserCreated,
+		TargetType: audit.TargetUser,
+		TargetID:   &targetID,
+		RequestID:  "reqId",
+		IP:         "127.0.0.1",
+		UserAgent:  "agent",
+		Diff:       "{}",
+		CreatedAt:  now,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `audit_log` (`actorId`,`impersonatorId`,`action`,`targetType`,`targetId`,`requestId`,`ip`,`userAgent`,`diff`,`createdAt`) VALUES (?,?,?,?,?,?,?,?,?,?)")).
+		WithArgs(actorID, nil, audit.ActionUserCreated, audit.TargetUser, targetID, "reqId", "127.0.0.1", "agent", "{}", now).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+
+	err := repo.Create(context.Background(), &entry)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), entry.ID)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuditLogRepository_GetAll_Returns_An_Error_If_The_Query_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuditLogRepository(db)
+
+	paginationInfo := sharedDomain.NewPaginationInfo(10, 0, "id", sharedDomain.OrderDesc)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_log` ORDER BY id desc LIMIT 10")).
+		WillReturnError(fmt.Errorf("some error"))
+
+	res, err := repo.GetAll(context.Background(), audit.AuditLogFilter{}, paginationInfo)
+
+	assert.Nil(t, res)
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlAuditLogRepository_GetAll_Returns_The_Filtered_Entries(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlAuditLogRepository(db)
+
+	actorID := int32(1)
+	targetID := int32(2)
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	filter := audit.AuditLogFilter{ActorID: &actorID, Action: audit.ActionUserDeleted, TargetType: audit.TargetUser, TargetID: &targetID, From: &from, To: &to}
+	paginationInfo := sharedDomain.NewPaginationInfo(10, 20, "createdAt", sharedDomain.OrderAsc)
+
+	columns := []string{"id", "actorId", "impersonatorId", "action", "targetType", "targetId", "requestId", "ip", "userAgent", "diff", "createdAt"}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `audit_log` WHERE actorId = ? AND action = ? AND targetType = ? AND targetId = ? AND createdAt >= ? AND createdAt < ? ORDER BY createdAt asc LIMIT 10 OFFSET 20")).
+		WithArgs(actorID, audit.ActionUserDeleted, audit.TargetUser, targetID, from, to).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(7, actorID, nil, audit.ActionUserDeleted, audit.TargetUser, targetID, "reqId", "127.0.0.1", "agent", "{}", from))
+
+	res, err := repo.GetAll(context.Background(), filter, paginationInfo)
+
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(res))
+	assert.Equal(t, int32(7), res[0].ID)
+	assert.Equal(t, &actorID, res[0].ActorID)
+	assert.Nil(t, res[0].ImpersonatorID)
+	assert.Equal(t, &targetID, res[0].TargetID)
+	assert.Equal(t, "{}", res[0].Diff)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
@@ -52,3 +52,23 @@ func CheckUnauthorizedErrorErrorResult(t *testing.T, result interface{}, errorMs
 	require.Equal(t, true, isUnauthError, "should be an unauthorized error")
 	assert.Equal(t, errorMsg, unauthpErr.Error())
 }
+
+func CheckForbiddenErrorResult(t *testing.T, result interface{}, errorMsg string) {
+	require.NotNil(t, result)
+	errorRes, isErrorResult := result.(ErrorResult)
+	require.Equal(t, true, isErrorResult, "should be an error result")
+
+	forbiddenErr, isForbiddenError := errorRes.Err.(*appErrors.ForbiddenError)
+	require.Equal(t, true, isForbiddenError, "should be a forbidden error")
+	assert.Equal(t, errorMsg, forbiddenErr.Error())
+}
+
+func CheckRequestEntityTooLargeErrorResult(t *testing.T, result interface{}, errorMsg string) {
+	require.NotNil(t, result)
+	errorRes, isErrorResult := result.(ErrorResult)
+	require.Equal(t, true, isErrorResult, "should be an error result")
+
+	tooLargeErr, isTooLargeError := errorRes.Err.(*appErrors.RequestEntityTooLargeError)
+	require.Equal(t, true, isTooLargeError, "should be a request entity too large error")
+	assert.Equal(t, errorMsg, tooLargeErr.Error())
+}
@@ -0,0 +1,36 @@
+package results
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// StreamResult is used when the content is written to the response while it is generated
+// instead of being built in memory. Write is called after the headers are sent, so it can't
+// change the status code
+type StreamResult struct {
+	ContentType string
+	FileName    string
+	Write       func(w io.Writer) error
+}
+
+func (r StreamResult) IsError() bool {
+	return false
+}
+
+// CheckStreamResult writes the content of the result and returns it
+func CheckStreamResult(t *testing.T, result interface{}, expectedContentType string) string {
+	require.NotNil(t, result)
+	streamRes, isStreamResult := result.(StreamResult)
+	require.Equal(t, true, isStreamResult, "should be a stream result")
+	assert.Equal(t, expectedContentType, streamRes.ContentType)
+
+	var buf bytes.Buffer
+	require.Nil(t, streamRes.Write(&buf))
+
+	return buf.String()
+}
@@ -8,16 +8,25 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	authInfra "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure"
 	authHandlers "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/handlers"
+	authSubscribers "github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/subscribers"
 	listsDomain "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
 	listsInfra "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure"
 	listsHandlers "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/handlers"
 	listSubscribers "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/subscribers"
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/recover"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/ratelimit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
 	"github.com/AngelVlc/todos_backend/src/internal/api/wire"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesInfra "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure"
+	workspacesHandlers "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/handlers"
 	algoliaOpt "github.com/algolia/algoliasearch-client-go/v3/algolia/opt"
 	algoliaSearch "github.com/algolia/algoliasearch-client-go/v3/algolia/search"
 	"github.com/gorilla/mux"
@@ -39,11 +48,21 @@ type server struct {
 	subscribers       []events.Subscriber
 	newRelicApp       *newrelic.Application
 	listsSearchClient search.SearchIndexClient
+	mailer            mailer.Mailer
+	auditLogRepo      audit.AuditLogRepository
+	activityRepo      listsDomain.ActivityRepository
+	listVersionsRepo  listsDomain.ListVersionsRepository
+	quotasRepo        listsDomain.QuotasRepository
+	workspacesRepo    workspacesDomain.WorkspacesRepository
+	storage           storage.Storage
+	tagsRepo          listsDomain.TagsRepository
+	calendarFeedsRepo listsDomain.CalendarFeedsRepository
+	listTemplatesRepo listsDomain.ListTemplatesRepository
 }
 
 func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Application) *server {
 	listSearchSettings := algoliaSearch.Settings{
-		AttributesForFaceting:            algoliaOpt.AttributesForFaceting("filterOnly(userID)"),
+		AttributesForFaceting:            algoliaOpt.AttributesForFaceting("filterOnly(userID)", "tags"),
 		SearchableAttributes:             algoliaOpt.SearchableAttributes("name", "itemsTitles", "itemsDescriptions"),
 		DisableTypoToleranceOnAttributes: algoliaOpt.DisableTypoToleranceOnAttributes("name", "itemsTitles", "itemsDescriptions"),
 	}
@@ -60,6 +79,16 @@ func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Applicatio
 		subscribers:       []events.Subscriber{},
 		newRelicApp:       newRelicApp,
 		listsSearchClient: wire.InitSearchIndexClient("lists", listSearchSettings),
+		mailer:            wire.InitMailer(),
+		storage:           wire.InitStorage(),
+		auditLogRepo:      wire.InitAuditLogRepository(db),
+		activityRepo:      wire.InitActivityRepository(db),
+		listVersionsRepo:  wire.InitListVersionsRepository(db),
+		quotasRepo:        wire.InitQuotasRepository(db),
+		workspacesRepo:    wire.InitWorkspacesRepository(db),
+		tagsRepo:          wire.InitTagsRepository(db),
+		calendarFeedsRepo: wire.InitCalendarFeedsRepository(db),
+		listTemplatesRepo: wire.InitListTemplatesRepository(db),
 	}
 
 	router := mux.NewRouter()
@@ -74,31 +103,115 @@ func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Applicatio
 
 	authMdw := wire.InitAuthMiddleware(db)
 	requireAdminMdw := wire.InitRequireAdminMiddleware()
+	workspaceMdw := wire.InitWorkspaceMiddleware(db)
+	workspaceManagerMdw := wire.InitRequireWorkspaceManagerMiddleware()
+
+	rateLimitStore := wire.InitRateLimitStore()
+	anonymousRateLimitMdw := s.getRateLimitMiddleware(rateLimitStore, "anonymous", ratelimit.ByIP)
+	userRateLimitMdw := s.getRateLimitMiddleware(rateLimitStore, "user", ratelimit.ByUser)
+	adminRateLimitMdw := s.getRateLimitMiddleware(rateLimitStore, "admin", ratelimit.ByUser)
 
 	router.HandleFunc("/", rootHandler).Methods(http.MethodGet)
+	router.Handle("/.well-known/jwks.json", s.getHandler(authHandlers.GetJwksHandler, nil)).Methods(http.MethodGet)
 
 	listsSubRouter := router.PathPrefix("/lists").Subrouter()
 	listsSubRouter.Handle("", s.getHandler(listsHandlers.GetAllListsHandler, nil)).Methods(http.MethodGet)
 	listsSubRouter.Handle("", s.getHandler(listsHandlers.CreateListHandler, &listsInfra.ListInput{})).Methods(http.MethodPost)
 	listsSubRouter.Handle("/search-key", s.getHandler((listsHandlers.GetSearchSecureKeyHandler), nil)).Methods(http.MethodGet)
+	listsSubRouter.Handle("/export", s.getHandler(listsHandlers.ExportListsHandler, nil)).Methods(http.MethodGet)
 	listsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.GetListHandler, nil)).Methods(http.MethodGet)
-	listsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.DeleteListHandler, nil)).Methods(http.MethodDelete)
+	listsSubRouter.Handle("/{id:[0-9]+}", workspaceManagerMdw.Middleware(s.getHandler(listsHandlers.DeleteListHandler, nil))).Methods(http.MethodDelete)
 	listsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.UpdateListHandler, &listsInfra.ListInput{})).Methods(http.MethodPatch)
+	listsSubRouter.Handle("/{id:[0-9]+}/export", s.getHandler(listsHandlers.ExportListHandler, nil)).Methods(http.MethodGet)
+	listsSubRouter.Handle("/{id:[0-9]+}/activity", s.getHandler(listsHandlers.GetListActivityHandler, nil)).Methods(http.MethodGet)
+	listsSubRouter.Handle("/{id:[0-9]+}/versions", s.getHandler(listsHandlers.GetListVersionsHandler, nil)).Methods(http.MethodGet)
+	listsSubRouter.Handle("/{id:[0-9]+}/versions/{version:[0-9]+}", s.getHandler(listsHandlers.GetListVersionHandler, nil)).Methods(http.MethodGet)
+	listsSubRouter.Handle("/{id:[0-9]+}/versions/{version:[0-9]+}/restore", s.getHandler(listsHandlers.RestoreListVersionHandler, nil)).Methods(http.MethodPost)
 	listsSubRouter.Handle("/{id:[0-9]+}/move_item", s.getHandler(listsHandlers.MoveListItemHandler, &listsInfra.MoveListItemInput{})).Methods(http.MethodPost)
+	listsSubRouter.Handle("/{id:[0-9]+}/save-as-template", s.getHandler(listsHandlers.SaveListAsTemplateHandler, &listsInfra.SaveListAsTemplateInput{})).Methods(http.MethodPost)
 	listsSubRouter.Use(authMdw.Middleware)
+	listsSubRouter.Use(userRateLimitMdw.Middleware)
+	listsSubRouter.Use(workspaceMdw.Middleware)
 
 	categoriesSubRouter := router.PathPrefix("/categories").Subrouter()
 	categoriesSubRouter.Handle("", s.getHandler(listsHandlers.GetAllCategoriesHandler, nil)).Methods(http.MethodGet)
-	categoriesSubRouter.Handle("", s.getHandler(listsHandlers.CreateCategoryHandler, &listsInfra.CategoryInput{})).Methods(http.MethodPost)
+	categoriesSubRouter.Handle("", workspaceManagerMdw.Middleware(s.getHandler(listsHandlers.CreateCategoryHandler, &listsInfra.CategoryInput{}))).Methods(http.MethodPost)
+	categoriesSubRouter.Handle("/order", workspaceManagerMdw.Middleware(s.getHandler(listsHandlers.ReorderCategoriesHandler, &listsInfra.CategoriesOrderInput{}))).Methods(http.MethodPut)
+	categoriesSubRouter.Handle("/stats", s.getHandler(listsHandlers.GetCategoriesStatsHandler, nil)).Methods(http.MethodGet)
 	categoriesSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.GetCategoryHandler, nil)).Methods(http.MethodGet)
-	categoriesSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.DeleteCategoryHandler, nil)).Methods(http.MethodDelete)
-	categoriesSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.UpdateCategoryHandler, &listsInfra.CategoryInput{})).Methods(http.MethodPatch)
+	categoriesSubRouter.Handle("/{id:[0-9]+}", workspaceManagerMdw.Middleware(s.getHandler(listsHandlers.DeleteCategoryHandler, nil))).Methods(http.MethodDelete)
+	categoriesSubRouter.Handle("/{id:[0-9]+}", workspaceManagerMdw.Middleware(s.getHandler(listsHandlers.UpdateCategoryHandler, &listsInfra.UpdateCategoryInput{}))).Methods(http.MethodPatch)
+	categoriesSubRouter.Handle("/{id:[0-9]+}/stats", s.getHandler(listsHandlers.GetCategoryStatsHandler, nil)).Methods(http.MethodGet)
 	categoriesSubRouter.Use(authMdw.Middleware)
+	categoriesSubRouter.Use(userRateLimitMdw.Middleware)
+	categoriesSubRouter.Use(workspaceMdw.Middleware)
+
+	itemsSubRouter := router.PathPrefix("/items").Subrouter()
+	itemsSubRouter.Handle("", s.getHandler(listsHandlers.GetItemsHandler, nil)).Methods(http.MethodGet)
+	itemsSubRouter.Use(authMdw.Middleware)
+	itemsSubRouter.Use(userRateLimitMdw.Middleware)
+	itemsSubRouter.Use(workspaceMdw.Middleware)
+
+	importSubRouter := router.PathPrefix("/import").Subrouter()
+	importSubRouter.Handle("", s.getHandler(listsHandlers.ImportListsHandler, nil)).Methods(http.MethodPost)
+	importSubRouter.Use(authMdw.Middleware)
+	importSubRouter.Use(userRateLimitMdw.Middleware)
+	importSubRouter.Use(workspaceMdw.Middleware)
+
+	tagsSubRouter := router.PathPrefix("/tags").Subrouter()
+	tagsSubRouter.Handle("", s.getHandler(listsHandlers.GetAllTagsHandler, nil)).Methods(http.MethodGet)
+	tagsSubRouter.Handle("", s.getHandler(listsHandlers.CreateTagHandler, &listsInfra.TagInput{})).Methods(http.MethodPost)
+	tagsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.UpdateTagHandler, &listsInfra.TagInput{})).Methods(http.MethodPatch)
+	tagsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.DeleteTagHandler, nil)).Methods(http.MethodDelete)
+	tagsSubRouter.Handle("/{id:[0-9]+}/merge", s.getHandler(listsHandlers.MergeTagsHandler, &listsInfra.MergeTagsInput{})).Methods(http.MethodPost)
+	tagsSubRouter.Use(authMdw.Middleware)
+	tagsSubRouter.Use(userRateLimitMdw.Middleware)
+
+	activitySubRouter := router.PathPrefix("/activity").Subrouter()
+	activitySubRouter.Handle("", s.getHandler(listsHandlers.GetActivityHandler, nil)).Methods(http.MethodGet)
+	activitySubRouter.Use(authMdw.Middleware)
+	activitySubRouter.Use(userRateLimitMdw.Middleware)
+	activitySubRouter.Use(workspaceMdw.Middleware)
+
+	templatesSubRouter := router.PathPrefix("/templates").Subrouter()
+	templatesSubRouter.Handle("", s.getHandler(listsHandlers.GetListTemplatesHandler, nil)).Methods(http.MethodGet)
+	templatesSubRouter.Handle("", s.getHandler(listsHandlers.CreateListTemplateHandler, &listsInfra.ListTemplateInput{})).Methods(http.MethodPost)
+	templatesSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.GetListTemplateHandler, nil)).Methods(http.MethodGet)
+	templatesSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.DeleteListTemplateHandler, nil)).Methods(http.MethodDelete)
+	templatesSubRouter.Handle("/{id:[0-9]+}/instantiate", s.getHandler(listsHandlers.InstantiateListTemplateHandler, &listsInfra.InstantiateListTemplateInput{})).Methods(http.MethodPost)
+	templatesSubRouter.Handle("/{id:[0-9]+}/shares", s.getHandler(listsHandlers.ShareListTemplateHandler, &listsInfra.ListTemplateShareInput{})).Methods(http.MethodPost)
+	templatesSubRouter.Handle("/{id:[0-9]+}/shares/{userId:[0-9]+}", s.getHandler(listsHandlers.UnshareListTemplateHandler, nil)).Methods(http.MethodDelete)
+	templatesSubRouter.Use(authMdw.Middleware)
+	templatesSubRouter.Use(userRateLimitMdw.Middleware)
+	templatesSubRouter.Use(workspaceMdw.Middleware)
+
+	calendarFeedsSubRouter := router.PathPrefix("/calendar-feeds").Subrouter()
+	calendarFeedsSubRouter.Handle("", s.getHandler(listsHandlers.GetCalendarFeedsHandler, nil)).Methods(http.MethodGet)
+	calendarFeedsSubRouter.Handle("", s.getHandler(listsHandlers.CreateCalendarFeedHandler, &listsInfra.CalendarFeedInput{})).Methods(http.MethodPost)
+	calendarFeedsSubRouter.Handle("/{id:[0-9]+}", s.getHandler(listsHandlers.DeleteCalendarFeedHandler, nil)).Methods(http.MethodDelete)
+	calendarFeedsSubRouter.Use(authMdw.Middleware)
+	calendarFeedsSubRouter.Use(userRateLimitMdw.Middleware)
+	calendarFeedsSubRouter.Use(workspaceMdw.Middleware)
+
+	workspacesSubRouter := router.PathPrefix("/workspaces").Subrouter()
+	workspacesSubRouter.Handle("", s.getHandler(workspacesHandlers.GetWorkspacesHandler, nil)).Methods(http.MethodGet)
+	workspacesSubRouter.Handle("", s.getHandler(workspacesHandlers.CreateWorkspaceHandler, &workspacesInfra.WorkspaceInput{})).Methods(http.MethodPost)
+	workspacesSubRouter.Handle("/{id:[0-9]+}/members", s.getHandler(workspacesHandlers.GetWorkspaceMembersHandler, nil)).Methods(http.MethodGet)
+	workspacesSubRouter.Handle("/{id:[0-9]+}/members", s.getHandler(workspacesHandlers.AddWorkspaceMemberHandler, &workspacesInfra.WorkspaceMemberInput{})).Methods(http.MethodPost)
+	workspacesSubRouter.Handle("/{id:[0-9]+}/members/{userId:[0-9]+}", s.getHandler(workspacesHandlers.RemoveWorkspaceMemberHandler, nil)).Methods(http.MethodDelete)
+	workspacesSubRouter.Use(authMdw.Middleware)
+	workspacesSubRouter.Use(userRateLimitMdw.Middleware)
 
 	toolsSubRouter := router.PathPrefix("/tools").Subrouter()
 	toolsSubRouter.Handle("/index-lists", s.getHandler(listsHandlers.IndexAllListsHandler, nil)).Methods(http.MethodPost)
+	toolsSubRouter.Handle("/invites", s.getHandler(authHandlers.GetAllInvitesHandler, nil)).Methods(http.MethodGet)
+	toolsSubRouter.Handle("/invites", s.getHandler(authHandlers.CreateInviteHandler, &authInfra.CreateInviteInput{})).Methods(http.MethodPost)
+	toolsSubRouter.Handle("/invites/{id:[0-9]+}", s.getHandler(authHandlers.DeleteInviteHandler, nil)).Methods(http.MethodDelete)
+	toolsSubRouter.Handle("/audit", s.getHandler(authHandlers.GetAuditLogHandler, nil)).Methods(http.MethodGet)
+	toolsSubRouter.Handle("/users/{id:[0-9]+}/quota", s.getHandler(listsHandlers.UpdateUserQuotaHandler, &listsInfra.UserQuotaInput{})).Methods(http.MethodPut)
 	toolsSubRouter.Use(authMdw.Middleware)
 	toolsSubRouter.Use(requireAdminMdw.Middleware)
+	toolsSubRouter.Use(adminRateLimitMdw.Middleware)
 
 	usersSubRouter := router.PathPrefix("/users").Subrouter()
 	usersSubRouter.Handle("", s.getHandler(authHandlers.CreateUserHandler, &authInfra.CreateUserInput{})).Methods(http.MethodPost)
@@ -106,19 +219,53 @@ func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Applicatio
 	usersSubRouter.Handle("/{id:[0-9]+}", s.getHandler(authHandlers.GetUserHandler, nil)).Methods(http.MethodGet)
 	usersSubRouter.Handle("/{id:[0-9]+}", s.getHandler(authHandlers.DeleteUserHandler, nil)).Methods(http.MethodDelete)
 	usersSubRouter.Handle("/{id:[0-9]+}", s.getHandler(authHandlers.UpdateUserHandler, &authInfra.UpdateUserInput{})).Methods(http.MethodPatch)
+	usersSubRouter.Handle("/{id:[0-9]+}/impersonate", s.getHandler(authHandlers.ImpersonateUserHandler, nil)).Methods(http.MethodPost)
+	usersSubRouter.Handle("/{id:[0-9]+}/export", s.getHandler(authHandlers.RequestUserExportHandler, nil)).Methods(http.MethodPost)
+	usersSubRouter.Handle("/deletion-jobs/{id:[0-9]+}", s.getHandler(authHandlers.GetUserDeletionJobHandler, nil)).Methods(http.MethodGet)
 	usersSubRouter.Use(authMdw.Middleware)
 	usersSubRouter.Use(requireAdminMdw.Middleware)
+	usersSubRouter.Use(adminRateLimitMdw.Middleware)
+
+	meSubRouter := router.PathPrefix("/me").Subrouter()
+	meSubRouter.Handle("", s.getHandler(authHandlers.GetMeHandler, nil)).Methods(http.MethodGet)
+	meSubRouter.Handle("", s.getHandler(authHandlers.UpdateMeHandler, &authInfra.UpdateMeInput{})).Methods(http.MethodPatch)
+	meSubRouter.Handle("/password", s.getHandler(authHandlers.ChangeMyPasswordHandler, &authInfra.ChangePasswordInput{})).Methods(http.MethodPost)
+	meSubRouter.Handle("/sessions", s.getHandler(authHandlers.GetMySessionsHandler, nil)).Methods(http.MethodGet)
+	meSubRouter.Handle("/sessions/{id:[0-9]+}", s.getHandler(authHandlers.DeleteMySessionHandler, nil)).Methods(http.MethodDelete)
+	meSubRouter.Handle("/email", s.getHandler(authHandlers.UpdateMyEmailHandler, &authInfra.UpdateEmailInput{})).Methods(http.MethodPatch)
+	meSubRouter.Handle("/email/verification", s.getHandler(authHandlers.SendMyEmailVerificationHandler, nil)).Methods(http.MethodPost)
+	meSubRouter.Handle("/usage", s.getHandler(listsHandlers.GetMyUsageHandler, nil)).Methods(http.MethodGet)
+	meSubRouter.Handle("/impersonation", s.getHandler(authHandlers.EndMyImpersonationHandler, nil)).Methods(http.MethodDelete)
+	meSubRouter.Handle("/export", s.getHandler(authHandlers.RequestMyExportHandler, nil)).Methods(http.MethodPost)
+	meSubRouter.Use(authMdw.Middleware)
+	meSubRouter.Use(userRateLimitMdw.Middleware)
 
 	refreshTokensSubRouter := router.PathPrefix("/refreshtokens").Subrouter()
 	refreshTokensSubRouter.Handle("", s.getHandler(authHandlers.GetAllRefreshTokensHandler, nil)).Methods(http.MethodGet)
 	refreshTokensSubRouter.Handle("", s.getHandler(authHandlers.DeleteRefreshTokensHandler, &[]int32{})).Methods(http.MethodDelete)
 	refreshTokensSubRouter.Use(authMdw.Middleware)
 	refreshTokensSubRouter.Use(requireAdminMdw.Middleware)
+	refreshTokensSubRouter.Use(adminRateLimitMdw.Middleware)
+
+	exportsSubRouter := router.PathPrefix("/exports").Subrouter()
+	exportsSubRouter.Handle("/{token:[0-9a-f]+}", s.getHandler(authHandlers.DownloadUserExportHandler, nil)).Methods(http.MethodGet)
+	exportsSubRouter.Use(anonymousRateLimitMdw.Middleware)
+
+	feedsSubRouter := router.PathPrefix("/feeds").Subrouter()
+	feedsSubRouter.Handle("/{token:[0-9a-f]+}/calendar.ics", s.getHandler(listsHandlers.GetCalendarFeedHandler, nil)).Methods(http.MethodGet)
+	feedsSubRouter.Use(anonymousRateLimitMdw.Middleware)
 
 	authSubRouter := router.PathPrefix("/auth").Subrouter()
 	authSubRouter.Handle("/login", s.getHandler(authHandlers.LoginHandler, &authInfra.LoginInput{})).Methods(http.MethodPost)
 	authSubRouter.Handle("/refreshtoken", s.getHandler(authHandlers.RefreshTokenHandler, nil)).Methods(http.MethodPost)
-	authSubRouter.Handle("/create_admin", s.getHandler(authHandlers.CreateUserHandler, &authInfra.CreateUserInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/register", s.getHandler(authHandlers.RegisterHandler, &authInfra.RegisterInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/create_admin", s.getHandler(authHandlers.AdminBootstrapHandler, &authInfra.AdminBootstrapInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/password-reset/request", s.getHandler(authHandlers.RequestPasswordResetHandler, &authInfra.PasswordResetRequestInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/password-reset/confirm", s.getHandler(authHandlers.ConfirmPasswordResetHandler, &authInfra.PasswordResetConfirmInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/email-verification/confirm", s.getHandler(authHandlers.VerifyEmailHandler, &authInfra.VerifyEmailInput{})).Methods(http.MethodPost)
+	authSubRouter.Handle("/oidc/{provider}/login", s.getHandler(authHandlers.OidcLoginHandler, nil)).Methods(http.MethodGet)
+	authSubRouter.Handle("/oidc/{provider}/callback", s.getHandler(authHandlers.OidcCallbackHandler, nil)).Methods(http.MethodGet)
+	authSubRouter.Use(anonymousRateLimitMdw.Middleware)
 
 	pprofSubRouter := router.PathPrefix("/debug/pprof").Subrouter()
 	pprofSubRouter.Handle("/heap", pprof.Handler("heap"))
@@ -130,10 +277,17 @@ func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Applicatio
 
 	s.addSubscriber(listSubscribers.NewListItemsCountProcessor(events.ListCreated, s.eventBus, s.listsRepo, s.newRelicApp))
 	s.addSubscriber(listSubscribers.NewListItemsCountProcessor(events.ListUpdated, s.eventBus, s.listsRepo, s.newRelicApp))
-	s.addSubscriber(listSubscribers.NewIndexAllListsProcessor(events.IndexAllListsRequested, s.eventBus, s.listsRepo, s.listsSearchClient, s.newRelicApp))
-	s.addSubscriber(listSubscribers.NewUpdateSearchIndexDocumentProcessor(events.ListCreated, s.eventBus, s.listsRepo, s.listsSearchClient, s.newRelicApp))
-	s.addSubscriber(listSubscribers.NewUpdateSearchIndexDocumentProcessor(events.ListUpdated, s.eventBus, s.listsRepo, s.listsSearchClient, s.newRelicApp))
+	s.addSubscriber(listSubscribers.NewIndexAllListsProcessor(events.IndexAllListsRequested, s.eventBus, s.listsRepo, s.tagsRepo, s.listsSearchClient, s.newRelicApp))
+	s.addSubscriber(listSubscribers.NewUpdateSearchIndexDocumentProcessor(events.ListCreated, s.eventBus, s.listsRepo, s.tagsRepo, s.listsSearchClient, s.newRelicApp))
+	s.addSubscriber(listSubscribers.NewUpdateSearchIndexDocumentProcessor(events.ListUpdated, s.eventBus, s.listsRepo, s.tagsRepo, s.listsSearchClient, s.newRelicApp))
+	s.addSubscriber(listSubscribers.NewUpdateSearchIndexDocumentProcessor(events.ListTagsChanged, s.eventBus, s.listsRepo, s.tagsRepo, s.listsSearchClient, s.newRelicApp))
 	s.addSubscriber(listSubscribers.NewRemoveSearchIndexDocumentProcessor(events.ListDeleted, s.eventBus, s.listsSearchClient, s.newRelicApp))
+	s.addSubscriber(authSubscribers.NewUserExportProcessor(events.UserExportRequested, s.eventBus, s.usersRepo, s.authRepo, s.listsRepo, s.categoriesRepo, s.auditLogRepo, s.storage, s.newRelicApp))
+	s.addSubscriber(authSubscribers.NewUserDeletionProcessor(events.UserDeletionRequested, s.eventBus, s.usersRepo, s.authRepo, s.listsRepo, s.categoriesRepo, s.workspacesRepo, s.tagsRepo, s.listTemplatesRepo, s.listsSearchClient, s.newRelicApp))
+
+	for _, eventName := range []string{events.ListCreated, events.ListUpdated, events.ListDeleted, events.ListItemAdded, events.ListItemRenamed, events.ListItemRemoved, events.ListItemMoved} {
+		s.addSubscriber(listSubscribers.NewActivityProcessor(eventName, s.eventBus, s.activityRepo, s.categoriesRepo, s.newRelicApp))
+	}
 
 	s.startSubscribers()
 
@@ -141,7 +295,13 @@ func NewServer(db *gorm.DB, eb events.EventBus, newRelicApp *newrelic.Applicatio
 }
 
 func (s *server) getHandler(handlerFunc handler.HandlerFunc, requestInput interface{}) handler.Handler {
-	return handler.NewHandler(handlerFunc, s.authRepo, s.usersRepo, s.listsRepo, s.categoriesRepo, s.cfgSrv, s.tokenSrv, s.passGen, s.eventBus, requestInput, s.listsSearchClient)
+	return handler.NewHandler(handlerFunc, s.authRepo, s.usersRepo, s.listsRepo, s.categoriesRepo, s.cfgSrv, s.tokenSrv, s.passGen, s.eventBus, requestInput, s.listsSearchClient, s.mailer, s.auditLogRepo, s.activityRepo, s.listVersionsRepo, s.quotasRepo, s.workspacesRepo, s.storage, s.tagsRepo, s.calendarFeedsRepo, s.listTemplatesRepo)
+}
+
+func (s *server) getRateLimitMiddleware(store ratelimit.Store, policyName string, keyFunc ratelimit.KeyFunc) sharedDomain.Middleware {
+	limit, window := s.cfgSrv.GetRateLimit(policyName)
+
+	return wire.InitRateLimitMiddleware(store, ratelimit.NewPolicy(policyName, limit, window), keyFunc)
 }
 
 func (s *server) addSubscriber(subscriber events.Subscriber) {
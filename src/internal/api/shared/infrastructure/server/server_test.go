@@ -11,6 +11,7 @@ import (
 	"os"
 	"testing"
 
+	authDomain "github.com/AngelVlc/todos_backend/src/internal/api/auth/domain"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
 	"github.com/stretchr/testify/assert"
@@ -28,9 +29,15 @@ func initServer(t *testing.T) *server {
 	mockedEventBus.On("Subscribe", events.ListUpdated, mock.AnythingOfType("events.DataChannel")).Once()
 	mockedEventBus.On("Subscribe", events.ListCreated, mock.AnythingOfType("events.DataChannel")).Once()
 	mockedEventBus.On("Subscribe", events.ListUpdated, mock.AnythingOfType("events.DataChannel")).Once()
+	mockedEventBus.On("Subscribe", events.ListTagsChanged, mock.AnythingOfType("events.DataChannel")).Once()
 	mockedEventBus.On("Subscribe", events.ListDeleted, mock.AnythingOfType("events.DataChannel")).Once()
 	mockedEventBus.On("Subscribe", events.IndexAllListsRequested, mock.AnythingOfType("events.DataChannel")).Once()
-	mockedEventBus.Wg.Add(6)
+	mockedEventBus.On("Subscribe", events.UserDeletionRequested, mock.AnythingOfType("events.DataChannel")).Once()
+	mockedEventBus.On("Subscribe", events.UserExportRequested, mock.AnythingOfType("events.DataChannel")).Once()
+	for _, eventName := range []string{events.ListCreated, events.ListUpdated, events.ListDeleted, events.ListItemAdded, events.ListItemRenamed, events.ListItemRemoved, events.ListItemMoved} {
+		mockedEventBus.On("Subscribe", eventName, mock.AnythingOfType("events.DataChannel")).Once()
+	}
+	mockedEventBus.Wg.Add(16)
 	s := NewServer(nil, &mockedEventBus, nil)
 	mockedEventBus.Wg.Wait()
 	mockedEventBus.AssertExpectations(t)
@@ -40,6 +47,7 @@ func initServer(t *testing.T) *server {
 
 func TestServerPublicRoutes(t *testing.T) {
 	s := initServer(t)
+	s.tokenSrv.(*authDomain.MockedTokenService).On("GetJsonWebKeySet").Return(authDomain.JsonWebKeySet{})
 
 	var publicRoutes = []struct {
 		url            string
@@ -48,7 +56,14 @@ func TestServerPublicRoutes(t *testing.T) {
 	}{
 		{"/auth/login", http.MethodPost, http.StatusBadRequest},
 		{"/auth/refreshtoken", http.MethodPost, http.StatusBadRequest},
+		{"/auth/register", http.MethodPost, http.StatusBadRequest},
 		{"/auth/create_admin", http.MethodPost, http.StatusBadRequest},
+		{"/auth/password-reset/request", http.MethodPost, http.StatusBadRequest},
+		{"/auth/password-reset/confirm", http.MethodPost, http.StatusBadRequest},
+		{"/auth/email-verification/confirm", http.MethodPost, http.StatusBadRequest},
+		{"/.well-known/jwks.json", http.MethodGet, http.StatusOK},
+		{"/auth/oidc/wadus/login", http.MethodGet, http.StatusNotFound},
+		{"/auth/oidc/wadus/callback", http.MethodGet, http.StatusNotFound},
 	}
 
 	for _, r := range publicRoutes {
@@ -75,9 +90,16 @@ func TestServerAdminRoutes(t *testing.T) {
 		{"/users/12", http.MethodDelete},
 		{"/users/12", http.MethodPatch},
 		{"/users/12", http.MethodGet},
+		{"/users/12/impersonate", http.MethodPost},
+		{"/users/12/export", http.MethodPost},
+		{"/users/deletion-jobs/3", http.MethodGet},
 		{"/refreshtokens", http.MethodGet},
 		{"/refreshtokens", http.MethodDelete},
 		{"/tools/index-lists", http.MethodPost},
+		{"/tools/invites", http.MethodGet},
+		{"/tools/invites", http.MethodPost},
+		{"/tools/invites/1", http.MethodDelete},
+		{"/tools/audit", http.MethodGet},
 	}
 
 	for _, r := range adminRoutes {
@@ -119,6 +141,46 @@ func TestServerPrivateRoutes(t *testing.T) {
 		{"/lists/12", http.MethodGet},
 		{"/lists/12", http.MethodDelete},
 		{"/lists/12/move_item", http.MethodPost},
+		{"/lists/12/activity", http.MethodGet},
+		{"/lists/export", http.MethodGet},
+		{"/lists/12/export", http.MethodGet},
+		{"/lists/12/versions", http.MethodGet},
+		{"/lists/12/versions/2", http.MethodGet},
+		{"/lists/12/versions/2/restore", http.MethodPost},
+		{"/activity", http.MethodGet},
+		{"/items", http.MethodGet},
+		{"/import", http.MethodPost},
+		{"/tags", http.MethodGet},
+		{"/tags", http.MethodPost},
+		{"/tags/3", http.MethodPatch},
+		{"/tags/3", http.MethodDelete},
+		{"/tags/3/merge", http.MethodPost},
+		{"/templates", http.MethodGet},
+		{"/templates", http.MethodPost},
+		{"/templates/3", http.MethodGet},
+		{"/templates/3", http.MethodDelete},
+		{"/templates/3/instantiate", http.MethodPost},
+		{"/templates/3/shares", http.MethodPost},
+		{"/templates/3/shares/12", http.MethodDelete},
+		{"/lists/3/save-as-template", http.MethodPost},
+		{"/calendar-feeds", http.MethodGet},
+		{"/calendar-feeds", http.MethodPost},
+		{"/calendar-feeds/3", http.MethodDelete},
+		{"/me", http.MethodGet},
+		{"/me", http.MethodPatch},
+		{"/me/password", http.MethodPost},
+		{"/me/sessions", http.MethodGet},
+		{"/me/sessions/12", http.MethodDelete},
+		{"/me/email", http.MethodPatch},
+		{"/me/email/verification", http.MethodPost},
+		{"/me/impersonation", http.MethodDelete},
+		{"/me/usage", http.MethodGet},
+		{"/me/export", http.MethodPost},
+		{"/workspaces", http.MethodGet},
+		{"/workspaces", http.MethodPost},
+		{"/workspaces/3/members", http.MethodGet},
+		{"/workspaces/3/members", http.MethodPost},
+		{"/workspaces/3/members/12", http.MethodDelete},
 	}
 
 	for _, r := range privateRoutes {
@@ -141,11 +203,21 @@ func TestServerBadRoutes(t *testing.T) {
 		method string
 	}{
 		{"/users/wadus", http.MethodDelete},
+		{"/tools/invites/wadus", http.MethodDelete},
 		{"/users/wadus", http.MethodPatch},
 		{"/users/wadus", http.MethodGet},
+		{"/users/wadus/impersonate", http.MethodPost},
+		{"/users/wadus/export", http.MethodPost},
+		{"/users/deletion-jobs/wadus", http.MethodGet},
+		{"/exports/wadus", http.MethodGet},
 		{"/lists/wadus", http.MethodPatch},
 		{"/lists/wadus", http.MethodGet},
 		{"/lists/wadus", http.MethodDelete},
+		{"/lists/wadus/activity", http.MethodGet},
+		{"/lists/wadus/export", http.MethodGet},
+		{"/lists/wadus/versions", http.MethodGet},
+		{"/lists/12/versions/wadus", http.MethodGet},
+		{"/lists/12/versions/wadus/restore", http.MethodPost},
 		{"/lists/wadus/items", http.MethodPost},
 		{"/lists/wadus/items/3", http.MethodGet},
 		{"/lists/wadus/items/3", http.MethodDelete},
@@ -153,6 +225,21 @@ func TestServerBadRoutes(t *testing.T) {
 		{"/lists/3/items/wadus", http.MethodGet},
 		{"/lists/3/items/wadus", http.MethodDelete},
 		{"/lists/3/items/wadus", http.MethodPatch},
+		{"/me/sessions/wadus", http.MethodDelete},
+		{"/tags/wadus", http.MethodPatch},
+		{"/tags/wadus", http.MethodDelete},
+		{"/tags/wadus/merge", http.MethodPost},
+		{"/templates/wadus", http.MethodGet},
+		{"/templates/wadus", http.MethodDelete},
+		{"/templates/wadus/instantiate", http.MethodPost},
+		{"/templates/wadus/shares", http.MethodPost},
+		{"/templates/3/shares/wadus", http.MethodDelete},
+		{"/lists/wadus/save-as-template", http.MethodPost},
+		{"/calendar-feeds/wadus", http.MethodDelete},
+		{"/feeds/wadus/calendar.ics", http.MethodGet},
+		{"/workspaces/wadus/members", http.MethodGet},
+		{"/workspaces/wadus/members", http.MethodPost},
+		{"/workspaces/3/members/wadus", http.MethodDelete},
 	}
 
 	for _, r := range badParamsRoutes {
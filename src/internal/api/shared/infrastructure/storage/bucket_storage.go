@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+)
+
+const (
+	metadataTokenUrl = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	storageApiUrl    = "https://storage.googleapis.com"
+)
+
+// BucketStorage keeps the files in a Google Cloud Storage bucket. It authenticates with the
+// service account of the instance, so it only works when the api runs inside Google Cloud
+type BucketStorage struct {
+	cfgSvr     sharedApp.ConfigurationService
+	httpClient *http.Client
+}
+
+func NewBucketStorage(cfgSvr sharedApp.ConfigurationService) *BucketStorage {
+	return &BucketStorage{cfgSvr, http.DefaultClient}
+}
+
+func (s *BucketStorage) Save(ctx context.Context, name string, content []byte) error {
+	u := fmt.Sprintf("%v/upload/storage/v1/b/%v/o?uploadType=media&name=%v", storageApiUrl, url.PathEscape(s.cfgSvr.GetBucketName()), url.QueryEscape(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	_, err = s.do(ctx, req)
+
+	return err
+}
+
+func (s *BucketStorage) Load(ctx context.Context, name string) ([]byte, error) {
+	u := fmt.Sprintf("%v/storage/v1/b/%v/o/%v?alt=media", storageApiUrl, url.PathEscape(s.cfgSvr.GetBucketName()), url.PathEscape(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.do(ctx, req)
+}
+
+// Delete removes the object, doing nothing when it doesn't exist
+func (s *BucketStorage) Delete(ctx context.Context, name string) error {
+	u := fmt.Sprintf("%v/storage/v1/b/%v/o/%v", storageApiUrl, url.PathEscape(s.cfgSvr.GetBucketName()), url.PathEscape(name))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.do(ctx, req, http.StatusNotFound)
+
+	return err
+}
+
+// do sends the request and returns the body of the response. Besides the 2xx statuses, the
+// given ones are also considered successful
+func (s *BucketStorage) do(ctx context.Context, req *http.Request, allowedStatuses ...int) ([]byte, error) {
+	token, err := s.accessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the access token: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSuccessfulStatus(res.StatusCode, allowedStatuses) {
+		return nil, fmt.Errorf("unexpected status %v from the bucket: %s", res.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+func isSuccessfulStatus(status int, allowedStatuses []int) bool {
+	if status >= http.StatusOK && status < http.StatusMultipleChoices {
+		return true
+	}
+
+	for _, s := range allowedStatuses {
+		if status == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *BucketStorage) accessToken(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataTokenUrl, nil)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %v from the metadata server", res.StatusCode)
+	}
+
+	token := struct {
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
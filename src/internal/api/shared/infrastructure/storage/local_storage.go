@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
+)
+
+// LocalStorage keeps the files in a folder of the local filesystem. It is meant to be used
+// in development or when the api runs in a single instance with a persistent disk
+type LocalStorage struct {
+	cfgSvr sharedApp.ConfigurationService
+}
+
+func NewLocalStorage(cfgSvr sharedApp.ConfigurationService) *LocalStorage {
+	return &LocalStorage{cfgSvr}
+}
+
+func (s *LocalStorage) Save(ctx context.Context, name string, content []byte) error {
+	path := s.path(name)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0600)
+}
+
+func (s *LocalStorage) Load(ctx context.Context, name string) ([]byte, error) {
+	return os.ReadFile(s.path(name))
+}
+
+// Delete removes the file, doing nothing when it doesn't exist
+func (s *LocalStorage) Delete(ctx context.Context, name string) error {
+	if err := os.Remove(s.path(name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) path(name string) string {
+	return filepath.Join(s.cfgSvr.GetStoragePath(), filepath.FromSlash(name))
+}
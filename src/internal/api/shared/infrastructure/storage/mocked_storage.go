@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedStorage struct {
+	mock.Mock
+}
+
+func NewMockedStorage() *MockedStorage {
+	return &MockedStorage{}
+}
+
+func (m *MockedStorage) Save(ctx context.Context, name string, content []byte) error {
+	args := m.Called(ctx, name, content)
+
+	return args.Error(0)
+}
+
+func (m *MockedStorage) Load(ctx context.Context, name string) ([]byte, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockedStorage) Delete(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+
+	return args.Error(0)
+}
@@ -0,0 +1,10 @@
+package storage
+
+import "context"
+
+// Storage keeps files, like the user exports, outside of the database
+type Storage interface {
+	Save(ctx context.Context, name string, content []byte) error
+	Load(ctx context.Context, name string) ([]byte, error)
+	Delete(ctx context.Context, name string) error
+}
@@ -13,13 +13,22 @@ import (
 	listsRepository "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
 	sharedApp "github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	sharedDomain "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	events "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
 	authMiddleware "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/auth"
 	fakemdw "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/fake"
 	logMdw "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/log"
+	ratelimitmdw "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/ratelimit"
 	reqadminmdw "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/reqadmin"
 	reqid "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/reqid"
+	workspacemdw "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/workspace"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/ratelimit"
+	sharedRepository "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	workspacesDomain "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
 	algoliaSearch "github.com/algolia/algoliasearch-client-go/v3/algolia/search"
 	"github.com/google/wire"
 	"gorm.io/gorm"
@@ -47,11 +56,11 @@ func InitAuthMiddleware(db *gorm.DB) authMiddleware.AuthMiddleware {
 	if inTestingMode() {
 		return initFakeAuthMiddleware()
 	} else {
-		return initDefaultAuthMiddleware()
+		return initDefaultAuthMiddleware(db)
 	}
 }
 
-func initDefaultAuthMiddleware() authMiddleware.AuthMiddleware {
+func initDefaultAuthMiddleware(db *gorm.DB) authMiddleware.AuthMiddleware {
 	wire.Build(AuthMiddlewareSet)
 	return nil
 }
@@ -66,19 +75,37 @@ func InitRequireAdminMiddleware() sharedDomain.Middleware {
 	return nil
 }
 
-func InitRequestIdMiddleware(db *gorm.DB) sharedDomain.Middleware {
+func InitRequestIdMiddleware() sharedDomain.Middleware {
 	if inTestingMode() {
 		return initFakeMiddleware()
 	} else {
-		return initRequestIdMiddleware(db)
+		return initRequestIdMiddleware()
 	}
 }
 
-func initRequestIdMiddleware(db *gorm.DB) sharedDomain.Middleware {
+func initRequestIdMiddleware() sharedDomain.Middleware {
 	wire.Build(RequestIdMiddlewareSet)
 	return nil
 }
 
+func InitRateLimitMiddleware(store ratelimit.Store, policy ratelimit.Policy, keyFunc ratelimit.KeyFunc) sharedDomain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initRateLimitMiddleware(store, policy, keyFunc)
+	}
+}
+
+func initRateLimitMiddleware(store ratelimit.Store, policy ratelimit.Policy, keyFunc ratelimit.KeyFunc) sharedDomain.Middleware {
+	wire.Build(RateLimitMiddlewareSet)
+	return nil
+}
+
+func InitRateLimitStore() ratelimit.Store {
+	wire.Build(MemoryRateLimitStoreSet)
+	return nil
+}
+
 func InitConfigurationService() sharedApp.ConfigurationService {
 	wire.Build(RealConfigurationServiceSet)
 	return nil
@@ -123,6 +150,8 @@ func initMySqlUsersRepository(db *gorm.DB) authDomain.UsersRepository {
 func InitPasswordGenerator() passgen.PasswordGenerator {
 	if inTestingMode() {
 		return initMockedPasswordGenerator()
+	} else if InitConfigurationService().GetPasswordHashAlgorithm() == "argon2id" {
+		return initArgon2idPasswordGenerator()
 	} else {
 		return initBryptPasswordGenerator()
 	}
@@ -133,6 +162,11 @@ func initBryptPasswordGenerator() passgen.PasswordGenerator {
 	return nil
 }
 
+func initArgon2idPasswordGenerator() passgen.PasswordGenerator {
+	wire.Build(Argon2idPasswordGeneratorSet)
+	return nil
+}
+
 func initMockedPasswordGenerator() passgen.PasswordGenerator {
 	wire.Build(MockedPasswordGeneratorSet)
 	return nil
@@ -228,6 +262,226 @@ func initMySqlCategoriesRepository(db *gorm.DB) listsDomain.CategoriesRepository
 	return nil
 }
 
+func InitActivityRepository(db *gorm.DB) listsDomain.ActivityRepository {
+	if inTestingMode() {
+		return initMockedActivityRepository()
+	} else {
+		return initMySqlActivityRepository(db)
+	}
+}
+
+func initMockedActivityRepository() listsDomain.ActivityRepository {
+	wire.Build(MockedActivityRepositorySet)
+	return nil
+}
+
+func initMySqlActivityRepository(db *gorm.DB) listsDomain.ActivityRepository {
+	wire.Build(MySqlActivityRepositorySet)
+	return nil
+}
+
+func InitListVersionsRepository(db *gorm.DB) listsDomain.ListVersionsRepository {
+	if inTestingMode() {
+		return initMockedListVersionsRepository()
+	} else {
+		return initMySqlListVersionsRepository(db)
+	}
+}
+
+func initMockedListVersionsRepository() listsDomain.ListVersionsRepository {
+	wire.Build(MockedListVersionsRepositorySet)
+	return nil
+}
+
+func initMySqlListVersionsRepository(db *gorm.DB) listsDomain.ListVersionsRepository {
+	wire.Build(MySqlListVersionsRepositorySet)
+	return nil
+}
+
+func InitQuotasRepository(db *gorm.DB) listsDomain.QuotasRepository {
+	if inTestingMode() {
+		return initMockedQuotasRepository()
+	} else {
+		return initMySqlQuotasRepository(db)
+	}
+}
+
+func initMockedQuotasRepository() listsDomain.QuotasRepository {
+	wire.Build(MockedQuotasRepositorySet)
+	return nil
+}
+
+func initMySqlQuotasRepository(db *gorm.DB) listsDomain.QuotasRepository {
+	wire.Build(MySqlQuotasRepositorySet)
+	return nil
+}
+
+func InitWorkspacesRepository(db *gorm.DB) workspacesDomain.WorkspacesRepository {
+	if inTestingMode() {
+		return initMockedWorkspacesRepository()
+	} else {
+		return initMySqlWorkspacesRepository(db)
+	}
+}
+
+func initMockedWorkspacesRepository() workspacesDomain.WorkspacesRepository {
+	wire.Build(MockedWorkspacesRepositorySet)
+	return nil
+}
+
+func initMySqlWorkspacesRepository(db *gorm.DB) workspacesDomain.WorkspacesRepository {
+	wire.Build(MySqlWorkspacesRepositorySet)
+	return nil
+}
+
+func InitWorkspaceMiddleware(db *gorm.DB) sharedDomain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initWorkspaceMiddleware(db)
+	}
+}
+
+func initWorkspaceMiddleware(db *gorm.DB) sharedDomain.Middleware {
+	wire.Build(WorkspaceMiddlewareSet)
+	return nil
+}
+
+func InitRequireWorkspaceManagerMiddleware() sharedDomain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initRequireWorkspaceManagerMiddleware()
+	}
+}
+
+func initRequireWorkspaceManagerMiddleware() sharedDomain.Middleware {
+	wire.Build(RequireWorkspaceManagerMiddlewareSet)
+	return nil
+}
+
+func InitTagsRepository(db *gorm.DB) listsDomain.TagsRepository {
+	if inTestingMode() {
+		return initMockedTagsRepository()
+	} else {
+		return initMySqlTagsRepository(db)
+	}
+}
+
+func initMockedTagsRepository() listsDomain.TagsRepository {
+	wire.Build(MockedTagsRepositorySet)
+	return nil
+}
+
+func initMySqlTagsRepository(db *gorm.DB) listsDomain.TagsRepository {
+	wire.Build(MySqlTagsRepositorySet)
+	return nil
+}
+
+func InitCalendarFeedsRepository(db *gorm.DB) listsDomain.CalendarFeedsRepository {
+	if inTestingMode() {
+		return initMockedCalendarFeedsRepository()
+	} else {
+		return initMySqlCalendarFeedsRepository(db)
+	}
+}
+
+func initMockedCalendarFeedsRepository() listsDomain.CalendarFeedsRepository {
+	wire.Build(MockedCalendarFeedsRepositorySet)
+	return nil
+}
+
+func initMySqlCalendarFeedsRepository(db *gorm.DB) listsDomain.CalendarFeedsRepository {
+	wire.Build(MySqlCalendarFeedsRepositorySet)
+	return nil
+}
+
+func InitListTemplatesRepository(db *gorm.DB) listsDomain.ListTemplatesRepository {
+	if inTestingMode() {
+		return initMockedListTemplatesRepository()
+	} else {
+		return initMySqlListTemplatesRepository(db)
+	}
+}
+
+func initMockedListTemplatesRepository() listsDomain.ListTemplatesRepository {
+	wire.Build(MockedListTemplatesRepositorySet)
+	return nil
+}
+
+func initMySqlListTemplatesRepository(db *gorm.DB) listsDomain.ListTemplatesRepository {
+	wire.Build(MySqlListTemplatesRepositorySet)
+	return nil
+}
+
+func InitAuditLogRepository(db *gorm.DB) audit.AuditLogRepository {
+	if inTestingMode() {
+		return initMockedAuditLogRepository()
+	} else {
+		return initMySqlAuditLogRepository(db)
+	}
+}
+
+func initMockedAuditLogRepository() audit.AuditLogRepository {
+	wire.Build(MockedAuditLogRepositorySet)
+	return nil
+}
+
+func initMySqlAuditLogRepository(db *gorm.DB) audit.AuditLogRepository {
+	wire.Build(MySqlAuditLogRepositorySet)
+	return nil
+}
+
+func InitMailer() mailer.Mailer {
+	if inTestingMode() {
+		return initMockedMailer()
+	} else if InitConfigurationService().GetMailer() == "smtp" {
+		return initSmtpMailer()
+	} else {
+		return initFileMailer()
+	}
+}
+
+func initMockedMailer() mailer.Mailer {
+	wire.Build(MockedMailerSet)
+	return nil
+}
+
+func initSmtpMailer() mailer.Mailer {
+	wire.Build(SmtpMailerSet)
+	return nil
+}
+
+func initFileMailer() mailer.Mailer {
+	wire.Build(FileMailerSet)
+	return nil
+}
+
+func InitStorage() storage.Storage {
+	if inTestingMode() {
+		return initMockedStorage()
+	} else if InitConfigurationService().GetStorage() == "bucket" {
+		return initBucketStorage()
+	} else {
+		return initLocalStorage()
+	}
+}
+
+func initMockedStorage() storage.Storage {
+	wire.Build(MockedStorageSet)
+	return nil
+}
+
+func initBucketStorage() storage.Storage {
+	wire.Build(BucketStorageSet)
+	return nil
+}
+
+func initLocalStorage() storage.Storage {
+	wire.Build(LocalStorageSet)
+	return nil
+}
+
 func inTestingMode() bool {
 	return len(os.Getenv("TESTING")) > 0
 }
@@ -245,6 +499,7 @@ var FakeMiddlewareSet = wire.NewSet(
 	wire.Bind(new(sharedDomain.Middleware), new(*fakemdw.FakeMiddleware)))
 
 var RequestIdMiddlewareSet = wire.NewSet(
+	RealConfigurationServiceSet,
 	reqid.NewRequestIdMiddleware,
 	wire.Bind(new(sharedDomain.Middleware), new(*reqid.RequestIdMiddleware)))
 
@@ -254,6 +509,8 @@ var LogMiddlewareSet = wire.NewSet(
 
 var AuthMiddlewareSet = wire.NewSet(
 	RealTokenServiceSet,
+	MySqlAuthRepositorySet,
+	MySqlAuditLogRepositorySet,
 	authMiddleware.NewRealAuthMiddleware,
 	wire.Bind(new(authMiddleware.AuthMiddleware), new(*authMiddleware.RealAuthMiddleware)))
 
@@ -261,6 +518,14 @@ var FakeAuthMiddlewareSet = wire.NewSet(
 	authMiddleware.NewFakeAuthMiddleware,
 	wire.Bind(new(authMiddleware.AuthMiddleware), new(*authMiddleware.FakeAuthMiddleware)))
 
+var RateLimitMiddlewareSet = wire.NewSet(
+	ratelimitmdw.NewRateLimitMiddleware,
+	wire.Bind(new(sharedDomain.Middleware), new(*ratelimitmdw.RateLimitMiddleware)))
+
+var MemoryRateLimitStoreSet = wire.NewSet(
+	ratelimit.NewMemoryStore,
+	wire.Bind(new(ratelimit.Store), new(*ratelimit.MemoryStore)))
+
 var RequireAdminMiddlewareSet = wire.NewSet(
 	reqadminmdw.NewRequireAdminMiddleware,
 	wire.Bind(new(sharedDomain.Middleware), new(*reqadminmdw.RequireAdminMiddleware)))
@@ -282,9 +547,14 @@ var MockedUsersRepositorySet = wire.NewSet(
 	wire.Bind(new(authDomain.UsersRepository), new(*authRepository.MockedUsersRepository)))
 
 var BcryptPasswordGeneratorSet = wire.NewSet(
+	RealConfigurationServiceSet,
 	passgen.NewBcryptPasswordGenerator,
 	wire.Bind(new(passgen.PasswordGenerator), new(*passgen.BcryptPasswordGenerator)))
 
+var Argon2idPasswordGeneratorSet = wire.NewSet(
+	passgen.NewArgon2idPasswordGenerator,
+	wire.Bind(new(passgen.PasswordGenerator), new(*passgen.Argon2idPasswordGenerator)))
+
 var MockedPasswordGeneratorSet = wire.NewSet(
 	passgen.NewMockedPasswordGenerator,
 	wire.Bind(new(passgen.PasswordGenerator), new(*passgen.MockedPasswordGenerator)))
@@ -334,3 +604,126 @@ var MockedCategoriesRepositorySet = wire.NewSet(
 	listsRepository.NewMockedCategoriesRepository,
 	wire.Bind(new(listsDomain.CategoriesRepository), new(*listsRepository.MockedCategoriesRepository)),
 )
+
+var MySqlActivityRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlActivityRepository,
+	wire.Bind(new(listsDomain.ActivityRepository), new(*listsRepository.MySqlActivityRepository)),
+)
+
+var MockedActivityRepositorySet = wire.NewSet(
+	listsRepository.NewMockedActivityRepository,
+	wire.Bind(new(listsDomain.ActivityRepository), new(*listsRepository.MockedActivityRepository)),
+)
+
+var MySqlListVersionsRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlListVersionsRepository,
+	wire.Bind(new(listsDomain.ListVersionsRepository), new(*listsRepository.MySqlListVersionsRepository)),
+)
+
+var MockedListVersionsRepositorySet = wire.NewSet(
+	listsRepository.NewMockedListVersionsRepository,
+	wire.Bind(new(listsDomain.ListVersionsRepository), new(*listsRepository.MockedListVersionsRepository)),
+)
+
+var MySqlQuotasRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlQuotasRepository,
+	wire.Bind(new(listsDomain.QuotasRepository), new(*listsRepository.MySqlQuotasRepository)),
+)
+
+var MockedQuotasRepositorySet = wire.NewSet(
+	listsRepository.NewMockedQuotasRepository,
+	wire.Bind(new(listsDomain.QuotasRepository), new(*listsRepository.MockedQuotasRepository)),
+)
+
+var MySqlWorkspacesRepositorySet = wire.NewSet(
+	workspacesRepository.NewMySqlWorkspacesRepository,
+	wire.Bind(new(workspacesDomain.WorkspacesRepository), new(*workspacesRepository.MySqlWorkspacesRepository)),
+)
+
+var MockedWorkspacesRepositorySet = wire.NewSet(
+	workspacesRepository.NewMockedWorkspacesRepository,
+	wire.Bind(new(workspacesDomain.WorkspacesRepository), new(*workspacesRepository.MockedWorkspacesRepository)),
+)
+
+var WorkspaceMiddlewareSet = wire.NewSet(
+	MySqlWorkspacesRepositorySet,
+	workspacemdw.NewWorkspaceMiddleware,
+	wire.Bind(new(sharedDomain.Middleware), new(*workspacemdw.WorkspaceMiddleware)))
+
+var RequireWorkspaceManagerMiddlewareSet = wire.NewSet(
+	workspacemdw.NewRequireWorkspaceManagerMiddleware,
+	wire.Bind(new(sharedDomain.Middleware), new(*workspacemdw.RequireWorkspaceManagerMiddleware)))
+
+var MySqlTagsRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlTagsRepository,
+	wire.Bind(new(listsDomain.TagsRepository), new(*listsRepository.MySqlTagsRepository)),
+)
+
+var MockedTagsRepositorySet = wire.NewSet(
+	listsRepository.NewMockedTagsRepository,
+	wire.Bind(new(listsDomain.TagsRepository), new(*listsRepository.MockedTagsRepository)),
+)
+
+var MySqlCalendarFeedsRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlCalendarFeedsRepository,
+	wire.Bind(new(listsDomain.CalendarFeedsRepository), new(*listsRepository.MySqlCalendarFeedsRepository)),
+)
+
+var MockedCalendarFeedsRepositorySet = wire.NewSet(
+	listsRepository.NewMockedCalendarFeedsRepository,
+	wire.Bind(new(listsDomain.CalendarFeedsRepository), new(*listsRepository.MockedCalendarFeedsRepository)),
+)
+
+var MySqlListTemplatesRepositorySet = wire.NewSet(
+	listsRepository.NewMySqlListTemplatesRepository,
+	wire.Bind(new(listsDomain.ListTemplatesRepository), new(*listsRepository.MySqlListTemplatesRepository)),
+)
+
+var MockedListTemplatesRepositorySet = wire.NewSet(
+	listsRepository.NewMockedListTemplatesRepository,
+	wire.Bind(new(listsDomain.ListTemplatesRepository), new(*listsRepository.MockedListTemplatesRepository)),
+)
+
+var MySqlAuditLogRepositorySet = wire.NewSet(
+	sharedRepository.NewMySqlAuditLogRepository,
+	wire.Bind(new(audit.AuditLogRepository), new(*sharedRepository.MySqlAuditLogRepository)),
+)
+
+var MockedAuditLogRepositorySet = wire.NewSet(
+	sharedRepository.NewMockedAuditLogRepository,
+	wire.Bind(new(audit.AuditLogRepository), new(*sharedRepository.MockedAuditLogRepository)),
+)
+
+var SmtpMailerSet = wire.NewSet(
+	RealConfigurationServiceSet,
+	mailer.NewSmtpMailer,
+	wire.Bind(new(mailer.Mailer), new(*mailer.SmtpMailer)),
+)
+
+var FileMailerSet = wire.NewSet(
+	RealConfigurationServiceSet,
+	mailer.NewFileMailer,
+	wire.Bind(new(mailer.Mailer), new(*mailer.FileMailer)),
+)
+
+var MockedMailerSet = wire.NewSet(
+	mailer.NewMockedMailer,
+	wire.Bind(new(mailer.Mailer), new(*mailer.MockedMailer)),
+)
+
+var BucketStorageSet = wire.NewSet(
+	RealConfigurationServiceSet,
+	storage.NewBucketStorage,
+	wire.Bind(new(storage.Storage), new(*storage.BucketStorage)),
+)
+
+var LocalStorageSet = wire.NewSet(
+	RealConfigurationServiceSet,
+	storage.NewLocalStorage,
+	wire.Bind(new(storage.Storage), new(*storage.LocalStorage)),
+)
+
+var MockedStorageSet = wire.NewSet(
+	storage.NewMockedStorage,
+	wire.Bind(new(storage.Storage), new(*storage.MockedStorage)),
+)
@@ -11,16 +11,25 @@ import (
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/domain/passgen"
 	"github.com/AngelVlc/todos_backend/src/internal/api/auth/infrastructure/repository"
 	domain3 "github.com/AngelVlc/todos_backend/src/internal/api/lists/domain"
-	repository2 "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
+	repository3 "github.com/AngelVlc/todos_backend/src/internal/api/lists/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/application"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/audit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/events"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/mailer"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/auth"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/fake"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/log"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/ratelimit"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/reqadmin"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/reqid"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/middlewares/workspace"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/ratelimit"
+	repository2 "github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/repository"
 	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/search"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/storage"
+	domain4 "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	repository4 "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
 	search2 "github.com/algolia/algoliasearch-client-go/v3/algolia/search"
 	"github.com/google/wire"
 	"gorm.io/gorm"
@@ -39,10 +48,12 @@ func initLogMiddleware() domain.Middleware {
 	return logMiddleware
 }
 
-func initDefaultAuthMiddleware() authmdw.AuthMiddleware {
+func initDefaultAuthMiddleware(db *gorm.DB) authmdw.AuthMiddleware {
 	realConfigurationService := application.NewRealConfigurationService()
 	realTokenService := domain2.NewRealTokenService(realConfigurationService)
-	realAuthMiddleware := authmdw.NewRealAuthMiddleware(realTokenService)
+	mySqlAuthRepository := repository.NewMySqlAuthRepository(db)
+	mySqlAuditLogRepository := repository2.NewMySqlAuditLogRepository(db)
+	realAuthMiddleware := authmdw.NewRealAuthMiddleware(realTokenService, mySqlAuthRepository, mySqlAuditLogRepository)
 	return realAuthMiddleware
 }
 
@@ -57,10 +68,21 @@ func InitRequireAdminMiddleware() domain.Middleware {
 }
 
 func initRequestIdMiddleware() domain.Middleware {
-	requestIdMiddleware := reqid.NewRequestIdMiddleware()
+	realConfigurationService := application.NewRealConfigurationService()
+	requestIdMiddleware := reqid.NewRequestIdMiddleware(realConfigurationService)
 	return requestIdMiddleware
 }
 
+func initRateLimitMiddleware(store ratelimit.Store, policy ratelimit.Policy, keyFunc ratelimit.KeyFunc) domain.Middleware {
+	rateLimitMiddleware := ratelimitmdw.NewRateLimitMiddleware(store, policy, keyFunc)
+	return rateLimitMiddleware
+}
+
+func InitRateLimitStore() ratelimit.Store {
+	memoryStore := ratelimit.NewMemoryStore()
+	return memoryStore
+}
+
 func InitConfigurationService() application.ConfigurationService {
 	realConfigurationService := application.NewRealConfigurationService()
 	return realConfigurationService
@@ -87,22 +109,28 @@ func initMySqlUsersRepository(db *gorm.DB) domain2.UsersRepository {
 }
 
 func initBryptPasswordGenerator() passgen.PasswordGenerator {
-	bcryptPasswordGenerator := passgen.NewBcryptPasswordGenerator()
+	realConfigurationService := application.NewRealConfigurationService()
+	bcryptPasswordGenerator := passgen.NewBcryptPasswordGenerator(realConfigurationService)
 	return bcryptPasswordGenerator
 }
 
+func initArgon2idPasswordGenerator() passgen.PasswordGenerator {
+	argon2idPasswordGenerator := passgen.NewArgon2idPasswordGenerator()
+	return argon2idPasswordGenerator
+}
+
 func initMockedPasswordGenerator() passgen.PasswordGenerator {
 	mockedPasswordGenerator := passgen.NewMockedPasswordGenerator()
 	return mockedPasswordGenerator
 }
 
 func initMockedListsRepository() domain3.ListsRepository {
-	mockedListsRepository := repository2.NewMockedListsRepository()
+	mockedListsRepository := repository3.NewMockedListsRepository()
 	return mockedListsRepository
 }
 
 func initMySqlListsRepository(db *gorm.DB) domain3.ListsRepository {
-	mySqlListsRepository := repository2.NewMySqlListsRepository(db)
+	mySqlListsRepository := repository3.NewMySqlListsRepository(db)
 	return mySqlListsRepository
 }
 
@@ -139,15 +167,140 @@ func initAlgoliaIndexClient(indexName string, settings search2.Settings) search.
 }
 
 func initMockedCategoriesRepository() domain3.CategoriesRepository {
-	mockedCategoriesRepository := repository2.NewMockedCategoriesRepository()
+	mockedCategoriesRepository := repository3.NewMockedCategoriesRepository()
 	return mockedCategoriesRepository
 }
 
 func initMySqlCategoriesRepository(db *gorm.DB) domain3.CategoriesRepository {
-	mySqlCategoriesRepository := repository2.NewMySqlCategoriesRepository(db)
+	mySqlCategoriesRepository := repository3.NewMySqlCategoriesRepository(db)
 	return mySqlCategoriesRepository
 }
 
+func initMockedActivityRepository() domain3.ActivityRepository {
+	mockedActivityRepository := repository3.NewMockedActivityRepository()
+	return mockedActivityRepository
+}
+
+func initMySqlActivityRepository(db *gorm.DB) domain3.ActivityRepository {
+	mySqlActivityRepository := repository3.NewMySqlActivityRepository(db)
+	return mySqlActivityRepository
+}
+
+func initMockedListVersionsRepository() domain3.ListVersionsRepository {
+	mockedListVersionsRepository := repository3.NewMockedListVersionsRepository()
+	return mockedListVersionsRepository
+}
+
+func initMySqlListVersionsRepository(db *gorm.DB) domain3.ListVersionsRepository {
+	mySqlListVersionsRepository := repository3.NewMySqlListVersionsRepository(db)
+	return mySqlListVersionsRepository
+}
+
+func initMockedQuotasRepository() domain3.QuotasRepository {
+	mockedQuotasRepository := repository3.NewMockedQuotasRepository()
+	return mockedQuotasRepository
+}
+
+func initMySqlQuotasRepository(db *gorm.DB) domain3.QuotasRepository {
+	mySqlQuotasRepository := repository3.NewMySqlQuotasRepository(db)
+	return mySqlQuotasRepository
+}
+
+func initMockedWorkspacesRepository() domain4.WorkspacesRepository {
+	mockedWorkspacesRepository := repository4.NewMockedWorkspacesRepository()
+	return mockedWorkspacesRepository
+}
+
+func initMySqlWorkspacesRepository(db *gorm.DB) domain4.WorkspacesRepository {
+	mySqlWorkspacesRepository := repository4.NewMySqlWorkspacesRepository(db)
+	return mySqlWorkspacesRepository
+}
+
+func initWorkspaceMiddleware(db *gorm.DB) domain.Middleware {
+	mySqlWorkspacesRepository := repository4.NewMySqlWorkspacesRepository(db)
+	workspaceMiddleware := workspacemdw.NewWorkspaceMiddleware(mySqlWorkspacesRepository)
+	return workspaceMiddleware
+}
+
+func initRequireWorkspaceManagerMiddleware() domain.Middleware {
+	requireWorkspaceManagerMiddleware := workspacemdw.NewRequireWorkspaceManagerMiddleware()
+	return requireWorkspaceManagerMiddleware
+}
+
+func initMockedTagsRepository() domain3.TagsRepository {
+	mockedTagsRepository := repository3.NewMockedTagsRepository()
+	return mockedTagsRepository
+}
+
+func initMySqlTagsRepository(db *gorm.DB) domain3.TagsRepository {
+	mySqlTagsRepository := repository3.NewMySqlTagsRepository(db)
+	return mySqlTagsRepository
+}
+
+func initMockedCalendarFeedsRepository() domain3.CalendarFeedsRepository {
+	mockedCalendarFeedsRepository := repository3.NewMockedCalendarFeedsRepository()
+	return mockedCalendarFeedsRepository
+}
+
+func initMySqlCalendarFeedsRepository(db *gorm.DB) domain3.CalendarFeedsRepository {
+	mySqlCalendarFeedsRepository := repository3.NewMySqlCalendarFeedsRepository(db)
+	return mySqlCalendarFeedsRepository
+}
+
+func initMockedListTemplatesRepository() domain3.ListTemplatesRepository {
+	mockedListTemplatesRepository := repository3.NewMockedListTemplatesRepository()
+	return mockedListTemplatesRepository
+}
+
+func initMySqlListTemplatesRepository(db *gorm.DB) domain3.ListTemplatesRepository {
+	mySqlListTemplatesRepository := repository3.NewMySqlListTemplatesRepository(db)
+	return mySqlListTemplatesRepository
+}
+
+func initMockedAuditLogRepository() audit.AuditLogRepository {
+	mockedAuditLogRepository := repository2.NewMockedAuditLogRepository()
+	return mockedAuditLogRepository
+}
+
+func initMySqlAuditLogRepository(db *gorm.DB) audit.AuditLogRepository {
+	mySqlAuditLogRepository := repository2.NewMySqlAuditLogRepository(db)
+	return mySqlAuditLogRepository
+}
+
+func initMockedMailer() mailer.Mailer {
+	mockedMailer := mailer.NewMockedMailer()
+	return mockedMailer
+}
+
+func initSmtpMailer() mailer.Mailer {
+	realConfigurationService := application.NewRealConfigurationService()
+	smtpMailer := mailer.NewSmtpMailer(realConfigurationService)
+	return smtpMailer
+}
+
+func initFileMailer() mailer.Mailer {
+	realConfigurationService := application.NewRealConfigurationService()
+	fileMailer := mailer.NewFileMailer(realConfigurationService)
+	return fileMailer
+}
+
+func initMockedStorage() storage.Storage {
+	mockedStorage := storage.NewMockedStorage()
+	return mockedStorage
+}
+
+func initBucketStorage() storage.Storage {
+	realConfigurationService := application.NewRealConfigurationService()
+	bucketStorage := storage.NewBucketStorage(realConfigurationService)
+	return bucketStorage
+}
+
+func initLocalStorage() storage.Storage {
+	realConfigurationService := application.NewRealConfigurationService()
+	localStorage := storage.NewLocalStorage(realConfigurationService)
+	return localStorage
+}
+
 // wire.go:
 
 func InitLogMiddleware() domain.Middleware {
@@ -162,7 +315,7 @@ func InitAuthMiddleware(db *gorm.DB) authmdw.AuthMiddleware {
 	if inTestingMode() {
 		return initFakeAuthMiddleware()
 	} else {
-		return initDefaultAuthMiddleware()
+		return initDefaultAuthMiddleware(db)
 	}
 }
 
@@ -174,6 +327,14 @@ func InitRequestIdMiddleware() domain.Middleware {
 	}
 }
 
+func InitRateLimitMiddleware(store ratelimit.Store, policy ratelimit.Policy, keyFunc ratelimit.KeyFunc) domain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initRateLimitMiddleware(store, policy, keyFunc)
+	}
+}
+
 func InitAuthRepository(db *gorm.DB) domain2.AuthRepository {
 	if inTestingMode() {
 		return initMockedAuthRepository()
@@ -193,6 +354,8 @@ func InitUsersRepository(db *gorm.DB) domain2.UsersRepository {
 func InitPasswordGenerator() passgen.PasswordGenerator {
 	if inTestingMode() {
 		return initMockedPasswordGenerator()
+	} else if InitConfigurationService().GetPasswordHashAlgorithm() == "argon2id" {
+		return initArgon2idPasswordGenerator()
 	} else {
 		return initBryptPasswordGenerator()
 	}
@@ -238,6 +401,106 @@ func InitCategoriesRepository(db *gorm.DB) domain3.CategoriesRepository {
 	}
 }
 
+func InitActivityRepository(db *gorm.DB) domain3.ActivityRepository {
+	if inTestingMode() {
+		return initMockedActivityRepository()
+	} else {
+		return initMySqlActivityRepository(db)
+	}
+}
+
+func InitListVersionsRepository(db *gorm.DB) domain3.ListVersionsRepository {
+	if inTestingMode() {
+		return initMockedListVersionsRepository()
+	} else {
+		return initMySqlListVersionsRepository(db)
+	}
+}
+
+func InitQuotasRepository(db *gorm.DB) domain3.QuotasRepository {
+	if inTestingMode() {
+		return initMockedQuotasRepository()
+	} else {
+		return initMySqlQuotasRepository(db)
+	}
+}
+
+func InitWorkspacesRepository(db *gorm.DB) domain4.WorkspacesRepository {
+	if inTestingMode() {
+		return initMockedWorkspacesRepository()
+	} else {
+		return initMySqlWorkspacesRepository(db)
+	}
+}
+
+func InitWorkspaceMiddleware(db *gorm.DB) domain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initWorkspaceMiddleware(db)
+	}
+}
+
+func InitRequireWorkspaceManagerMiddleware() domain.Middleware {
+	if inTestingMode() {
+		return initFakeMiddleware()
+	} else {
+		return initRequireWorkspaceManagerMiddleware()
+	}
+}
+
+func InitTagsRepository(db *gorm.DB) domain3.TagsRepository {
+	if inTestingMode() {
+		return initMockedTagsRepository()
+	} else {
+		return initMySqlTagsRepository(db)
+	}
+}
+
+func InitCalendarFeedsRepository(db *gorm.DB) domain3.CalendarFeedsRepository {
+	if inTestingMode() {
+		return initMockedCalendarFeedsRepository()
+	} else {
+		return initMySqlCalendarFeedsRepository(db)
+	}
+}
+
+func InitListTemplatesRepository(db *gorm.DB) domain3.ListTemplatesRepository {
+	if inTestingMode() {
+		return initMockedListTemplatesRepository()
+	} else {
+		return initMySqlListTemplatesRepository(db)
+	}
+}
+
+func InitAuditLogRepository(db *gorm.DB) audit.AuditLogRepository {
+	if inTestingMode() {
+		return initMockedAuditLogRepository()
+	} else {
+		return initMySqlAuditLogRepository(db)
+	}
+}
+
+func InitMailer() mailer.Mailer {
+	if inTestingMode() {
+		return initMockedMailer()
+	} else if InitConfigurationService().GetMailer() == "smtp" {
+		return initSmtpMailer()
+	} else {
+		return initFileMailer()
+	}
+}
+
+func InitStorage() storage.Storage {
+	if inTestingMode() {
+		return initMockedStorage()
+	} else if InitConfigurationService().GetStorage() == "bucket" {
+		return initBucketStorage()
+	} else {
+		return initLocalStorage()
+	}
+}
+
 func inTestingMode() bool {
 	return len(os.Getenv("TESTING")) > 0
 }
@@ -248,15 +511,22 @@ var MockedConfigurationServiceSet = wire.NewSet(application.NewMockedConfigurati
 
 var FakeMiddlewareSet = wire.NewSet(fakemdw.NewFakeMiddleware, wire.Bind(new(domain.Middleware), new(*fakemdw.FakeMiddleware)))
 
-var RequestIdMiddlewareSet = wire.NewSet(reqid.NewRequestIdMiddleware, wire.Bind(new(domain.Middleware), new(*reqid.RequestIdMiddleware)))
+var RequestIdMiddlewareSet = wire.NewSet(
+	RealConfigurationServiceSet, reqid.NewRequestIdMiddleware, wire.Bind(new(domain.Middleware), new(*reqid.RequestIdMiddleware)))
 
 var LogMiddlewareSet = wire.NewSet(logmdw.NewLogMiddleware, wire.Bind(new(domain.Middleware), new(*logmdw.LogMiddleware)))
 
 var AuthMiddlewareSet = wire.NewSet(
-	RealTokenServiceSet, authmdw.NewRealAuthMiddleware, wire.Bind(new(authmdw.AuthMiddleware), new(*authmdw.RealAuthMiddleware)))
+	RealTokenServiceSet,
+	MySqlAuthRepositorySet,
+	MySqlAuditLogRepositorySet, authmdw.NewRealAuthMiddleware, wire.Bind(new(authmdw.AuthMiddleware), new(*authmdw.RealAuthMiddleware)))
 
 var FakeAuthMiddlewareSet = wire.NewSet(authmdw.NewFakeAuthMiddleware, wire.Bind(new(authmdw.AuthMiddleware), new(*authmdw.FakeAuthMiddleware)))
 
+var RateLimitMiddlewareSet = wire.NewSet(ratelimitmdw.NewRateLimitMiddleware, wire.Bind(new(domain.Middleware), new(*ratelimitmdw.RateLimitMiddleware)))
+
+var MemoryRateLimitStoreSet = wire.NewSet(ratelimit.NewMemoryStore, wire.Bind(new(ratelimit.Store), new(*ratelimit.MemoryStore)))
+
 var RequireAdminMiddlewareSet = wire.NewSet(reqadminmdw.NewRequireAdminMiddleware, wire.Bind(new(domain.Middleware), new(*reqadminmdw.RequireAdminMiddleware)))
 
 var MySqlAuthRepositorySet = wire.NewSet(repository.NewMySqlAuthRepository, wire.Bind(new(domain2.AuthRepository), new(*repository.MySqlAuthRepository)))
@@ -267,13 +537,16 @@ var MySqlUsersRepositorySet = wire.NewSet(repository.NewMySqlUsersRepository, wi
 
 var MockedUsersRepositorySet = wire.NewSet(repository.NewMockedUsersRepository, wire.Bind(new(domain2.UsersRepository), new(*repository.MockedUsersRepository)))
 
-var BcryptPasswordGeneratorSet = wire.NewSet(passgen.NewBcryptPasswordGenerator, wire.Bind(new(passgen.PasswordGenerator), new(*passgen.BcryptPasswordGenerator)))
+var BcryptPasswordGeneratorSet = wire.NewSet(
+	RealConfigurationServiceSet, passgen.NewBcryptPasswordGenerator, wire.Bind(new(passgen.PasswordGenerator), new(*passgen.BcryptPasswordGenerator)))
+
+var Argon2idPasswordGeneratorSet = wire.NewSet(passgen.NewArgon2idPasswordGenerator, wire.Bind(new(passgen.PasswordGenerator), new(*passgen.Argon2idPasswordGenerator)))
 
 var MockedPasswordGeneratorSet = wire.NewSet(passgen.NewMockedPasswordGenerator, wire.Bind(new(passgen.PasswordGenerator), new(*passgen.MockedPasswordGenerator)))
 
-var MySqlListsRepositorySet = wire.NewSet(repository2.NewMySqlListsRepository, wire.Bind(new(domain3.ListsRepository), new(*repository2.MySqlListsRepository)))
+var MySqlListsRepositorySet = wire.NewSet(repository3.NewMySqlListsRepository, wire.Bind(new(domain3.ListsRepository), new(*repository3.MySqlListsRepository)))
 
-var MockedListsRepositorySet = wire.NewSet(repository2.NewMockedListsRepository, wire.Bind(new(domain3.ListsRepository), new(*repository2.MockedListsRepository)))
+var MockedListsRepositorySet = wire.NewSet(repository3.NewMockedListsRepository, wire.Bind(new(domain3.ListsRepository), new(*repository3.MockedListsRepository)))
 
 var RealTokenServiceSet = wire.NewSet(
 	RealConfigurationServiceSet, domain2.NewRealTokenService, wire.Bind(new(domain2.TokenService), new(*domain2.RealTokenService)))
@@ -290,6 +563,63 @@ var AlgoliaIndexClientSet = wire.NewSet(
 
 var MockedSearchIndexClientSet = wire.NewSet(search.NewMockedSearchIndexClient, wire.Bind(new(search.SearchIndexClient), new(*search.MockedSearchIndexClient)))
 
-var MySqlCategoriesRepositorySet = wire.NewSet(repository2.NewMySqlCategoriesRepository, wire.Bind(new(domain3.CategoriesRepository), new(*repository2.MySqlCategoriesRepository)))
+var MySqlCategoriesRepositorySet = wire.NewSet(repository3.NewMySqlCategoriesRepository, wire.Bind(new(domain3.CategoriesRepository), new(*repository3.MySqlCategoriesRepository)))
+
+var MockedCategoriesRepositorySet = wire.NewSet(repository3.NewMockedCategoriesRepository, wire.Bind(new(domain3.CategoriesRepository), new(*repository3.MockedCategoriesRepository)))
+
+var MySqlActivityRepositorySet = wire.NewSet(repository3.NewMySqlActivityRepository, wire.Bind(new(domain3.ActivityRepository), new(*repository3.MySqlActivityRepository)))
+
+var MockedActivityRepositorySet = wire.NewSet(repository3.NewMockedActivityRepository, wire.Bind(new(domain3.ActivityRepository), new(*repository3.MockedActivityRepository)))
+
+var MySqlListVersionsRepositorySet = wire.NewSet(repository3.NewMySqlListVersionsRepository, wire.Bind(new(domain3.ListVersionsRepository), new(*repository3.MySqlListVersionsRepository)))
+
+var MockedListVersionsRepositorySet = wire.NewSet(repository3.NewMockedListVersionsRepository, wire.Bind(new(domain3.ListVersionsRepository), new(*repository3.MockedListVersionsRepository)))
+
+var MySqlQuotasRepositorySet = wire.NewSet(repository3.NewMySqlQuotasRepository, wire.Bind(new(domain3.QuotasRepository), new(*repository3.MySqlQuotasRepository)))
+
+var MockedQuotasRepositorySet = wire.NewSet(repository3.NewMockedQuotasRepository, wire.Bind(new(domain3.QuotasRepository), new(*repository3.MockedQuotasRepository)))
+
+var MySqlWorkspacesRepositorySet = wire.NewSet(repository4.NewMySqlWorkspacesRepository, wire.Bind(new(domain4.WorkspacesRepository), new(*repository4.MySqlWorkspacesRepository)))
+
+var MockedWorkspacesRepositorySet = wire.NewSet(repository4.NewMockedWorkspacesRepository, wire.Bind(new(domain4.WorkspacesRepository), new(*repository4.MockedWorkspacesRepository)))
+
+var WorkspaceMiddlewareSet = wire.NewSet(
+	MySqlWorkspacesRepositorySet, workspacemdw.NewWorkspaceMiddleware, wire.Bind(new(domain.Middleware), new(*workspacemdw.WorkspaceMiddleware)))
+
+var RequireWorkspaceManagerMiddlewareSet = wire.NewSet(workspacemdw.NewRequireWorkspaceManagerMiddleware, wire.Bind(new(domain.Middleware), new(*workspacemdw.RequireWorkspaceManagerMiddleware)))
+
+var MySqlTagsRepositorySet = wire.NewSet(repository3.NewMySqlTagsRepository, wire.Bind(new(domain3.TagsRepository), new(*repository3.MySqlTagsRepository)))
+
+var MockedTagsRepositorySet = wire.NewSet(repository3.NewMockedTagsRepository, wire.Bind(new(domain3.TagsRepository), new(*repository3.MockedTagsRepository)))
+
+var MySqlCalendarFeedsRepositorySet = wire.NewSet(repository3.NewMySqlCalendarFeedsRepository, wire.Bind(new(domain3.CalendarFeedsRepository), new(*repository3.MySqlCalendarFeedsRepository)))
+
+var MockedCalendarFeedsRepositorySet = wire.NewSet(repository3.NewMockedCalendarFeedsRepository, wire.Bind(new(domain3.CalendarFeedsRepository), new(*repository3.MockedCalendarFeedsRepository)))
+
+var MySqlListTemplatesRepositorySet = wire.NewSet(repository3.NewMySqlListTemplatesRepository, wire.Bind(new(domain3.ListTemplatesRepository), new(*repository3.MySqlListTemplatesRepository)))
+
+var MockedListTemplatesRepositorySet = wire.NewSet(repository3.NewMockedListTemplatesRepository, wire.Bind(new(domain3.ListTemplatesRepository), new(*repository3.MockedListTemplatesRepository)))
+
+var MySqlAuditLogRepositorySet = wire.NewSet(repository2.NewMySqlAuditLogRepository, wire.Bind(new(audit.AuditLogRepository), new(*repository2.MySqlAuditLogRepository)))
+
+var MockedAuditLogRepositorySet = wire.NewSet(repository2.NewMockedAuditLogRepository, wire.Bind(new(audit.AuditLogRepository), new(*repository2.MockedAuditLogRepository)))
+
+var SmtpMailerSet = wire.NewSet(
+	RealConfigurationServiceSet, mailer.NewSmtpMailer, wire.Bind(new(mailer.Mailer), new(*mailer.SmtpMailer)),
+)
+
+var FileMailerSet = wire.NewSet(
+	RealConfigurationServiceSet, mailer.NewFileMailer, wire.Bind(new(mailer.Mailer), new(*mailer.FileMailer)),
+)
+
+var MockedMailerSet = wire.NewSet(mailer.NewMockedMailer, wire.Bind(new(mailer.Mailer), new(*mailer.MockedMailer)))
+
+var BucketStorageSet = wire.NewSet(
+	RealConfigurationServiceSet, storage.NewBucketStorage, wire.Bind(new(storage.Storage), new(*storage.BucketStorage)),
+)
+
+var LocalStorageSet = wire.NewSet(
+	RealConfigurationServiceSet, storage.NewLocalStorage, wire.Bind(new(storage.Storage), new(*storage.LocalStorage)),
+)
 
-var MockedCategoriesRepositorySet = wire.NewSet(repository2.NewMockedCategoriesRepository, wire.Bind(new(domain3.CategoriesRepository), new(*repository2.MockedCategoriesRepository)))
+var MockedStorageSet = wire.NewSet(storage.NewMockedStorage, wire.Bind(new(storage.Storage), new(*storage.MockedStorage)))
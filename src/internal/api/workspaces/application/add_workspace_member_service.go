@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type AddWorkspaceMemberService struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewAddWorkspaceMemberService(repo domain.WorkspacesRepository) *AddWorkspaceMemberService {
+	return &AddWorkspaceMemberService{repo}
+}
+
+func (s *AddWorkspaceMemberService) AddWorkspaceMember(ctx context.Context, workspaceID int32, userID int32, memberUserID int32, role string) (*domain.WorkspaceMemberEntity, error) {
+	if err := checkCanManageMembers(ctx, s.repo, workspaceID, userID); err != nil {
+		return nil, err
+	}
+
+	query := domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: memberUserID}
+	if existsMember, err := s.repo.ExistsWorkspaceMember(ctx, query); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error checking if the user is already a member of the workspace", InternalError: err}
+	} else if existsMember {
+		return nil, &appErrors.BadRequestError{Msg: "The user is already a member of the workspace"}
+	}
+
+	record := &domain.WorkspaceMemberRecord{
+		WorkspaceID: workspaceID,
+		UserID:      memberUserID,
+		Role:        role,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := s.repo.CreateWorkspaceMember(ctx, record); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error adding the workspace member", InternalError: err}
+	}
+
+	return record.ToWorkspaceMemberEntity(), nil
+}
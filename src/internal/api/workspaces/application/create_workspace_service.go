@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type CreateWorkspaceService struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewCreateWorkspaceService(repo domain.WorkspacesRepository) *CreateWorkspaceService {
+	return &CreateWorkspaceService{repo}
+}
+
+func (s *CreateWorkspaceService) CreateWorkspace(ctx context.Context, name domain.WorkspaceNameValueObject, userID int32) (*domain.WorkspaceEntity, error) {
+	record := &domain.WorkspaceRecord{
+		Name:      name.String(),
+		CreatedAt: time.Now(),
+	}
+
+	if err := s.repo.CreateWorkspace(ctx, record, userID); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error creating the workspace", InternalError: err}
+	}
+
+	return record.ToWorkspaceEntity(domain.WorkspaceRoleOwner), nil
+}
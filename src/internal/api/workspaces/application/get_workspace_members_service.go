@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type GetWorkspaceMembersService struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewGetWorkspaceMembersService(repo domain.WorkspacesRepository) *GetWorkspaceMembersService {
+	return &GetWorkspaceMembersService{repo}
+}
+
+func (s *GetWorkspaceMembersService) GetWorkspaceMembers(ctx context.Context, workspaceID int32, userID int32) ([]*domain.WorkspaceMemberEntity, error) {
+	if _, err := s.repo.FindWorkspaceMember(ctx, domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: userID}); err != nil {
+		return nil, err
+	}
+
+	foundMembers, err := s.repo.GetWorkspaceMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the workspace members", InternalError: err}
+	}
+
+	return foundMembers.ToWorkspaceMemberEntities(), nil
+}
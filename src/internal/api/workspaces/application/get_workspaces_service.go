@@ -0,0 +1,31 @@
+package application
+
+import (
+	"context"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type GetWorkspacesService struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewGetWorkspacesService(repo domain.WorkspacesRepository) *GetWorkspacesService {
+	return &GetWorkspacesService{repo}
+}
+
+// GetWorkspaces returns the workspaces of the user. The personal workspace is created first
+// if the user doesn't have it yet, so it's always in the result
+func (s *GetWorkspacesService) GetWorkspaces(ctx context.Context, userID int32) ([]*domain.WorkspaceEntity, error) {
+	if _, err := s.repo.GetOrCreatePersonalWorkspace(ctx, userID); err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the personal workspace", InternalError: err}
+	}
+
+	foundWorkspaces, err := s.repo.GetUserWorkspaces(ctx, userID)
+	if err != nil {
+		return nil, &appErrors.UnexpectedError{Msg: "Error getting the user workspaces", InternalError: err}
+	}
+
+	return foundWorkspaces.ToWorkspaceEntities(), nil
+}
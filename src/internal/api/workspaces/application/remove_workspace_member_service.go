@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type RemoveWorkspaceMemberService struct {
+	repo domain.WorkspacesRepository
+}
+
+func NewRemoveWorkspaceMemberService(repo domain.WorkspacesRepository) *RemoveWorkspaceMemberService {
+	return &RemoveWorkspaceMemberService{repo}
+}
+
+// RemoveWorkspaceMember removes a member from the workspace. Any member can leave the
+// workspace but only the owner and the admins can remove other members. The owner can't be
+// removed
+func (s *RemoveWorkspaceMemberService) RemoveWorkspaceMember(ctx context.Context, workspaceID int32, userID int32, memberUserID int32) error {
+	if memberUserID != userID {
+		if err := checkCanManageMembers(ctx, s.repo, workspaceID, userID); err != nil {
+			return err
+		}
+	}
+
+	query := domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: memberUserID}
+
+	foundMember, err := s.repo.FindWorkspaceMember(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if foundMember.Role == domain.WorkspaceRoleOwner {
+		return &appErrors.BadRequestError{Msg: "The owner can't be removed from the workspace"}
+	}
+
+	if err := s.repo.DeleteWorkspaceMember(ctx, query); err != nil {
+		return &appErrors.UnexpectedError{Msg: "Error removing the workspace member", InternalError: err}
+	}
+
+	return nil
+}
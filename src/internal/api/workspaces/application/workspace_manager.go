@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+// checkCanManageMembers returns an error unless the user is the owner or an admin of a
+// workspace which isn't a personal one
+func checkCanManageMembers(ctx context.Context, repo domain.WorkspacesRepository, workspaceID int32, userID int32) error {
+	foundMember, err := repo.FindWorkspaceMember(ctx, domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	if !domain.CanManageMembers(foundMember.Role) {
+		return &appErrors.ForbiddenError{Msg: "Only the owner or an admin can manage the workspace members"}
+	}
+
+	foundWorkspace, err := repo.FindWorkspace(ctx, domain.WorkspaceRecord{ID: workspaceID})
+	if err != nil {
+		return err
+	}
+
+	if foundWorkspace.IsPersonal() {
+		return &appErrors.BadRequestError{Msg: "A personal workspace can't have other members"}
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// WorkspaceEntity is a workspace as seen by one of its members
+type WorkspaceEntity struct {
+	ID       int32                    `json:"id"`
+	Name     WorkspaceNameValueObject `json:"name"`
+	Personal bool                     `json:"personal"`
+	Role     string                   `json:"role"`
+}
+
+type WorkspaceMemberEntity struct {
+	UserID    int32     `json:"userId"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+type WorkspaceMemberRecord struct {
+	WorkspaceID int32     `gorm:"column:workspaceId;type:int(32);primaryKey"`
+	UserID      int32     `gorm:"column:userId;type:int(32);primaryKey"`
+	Role        string    `gorm:"type:varchar(10)"`
+	CreatedAt   time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+type WorkspaceMemberRecords []WorkspaceMemberRecord
+
+func (WorkspaceMemberRecord) TableName() string {
+	return "workspaceMembers"
+}
+
+func (r *WorkspaceMemberRecord) ToWorkspaceMemberEntity() *WorkspaceMemberEntity {
+	return &WorkspaceMemberEntity{
+		UserID:    r.UserID,
+		Role:      r.Role,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func (a WorkspaceMemberRecords) ToWorkspaceMemberEntities() []*WorkspaceMemberEntity {
+	res := make([]*WorkspaceMemberEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToWorkspaceMemberEntity()
+	}
+
+	return res
+}
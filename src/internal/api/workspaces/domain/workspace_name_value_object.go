@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+type WorkspaceNameValueObject struct {
+	workspaceName string
+}
+
+const workspaceNameMaxLength = 50
+
+func NewWorkspaceNameValueObject(name string) (WorkspaceNameValueObject, error) {
+	if len(name) == 0 {
+		return WorkspaceNameValueObject{}, &appErrors.BadRequestError{Msg: "The workspace name can not be empty"}
+	}
+
+	if len(name) > workspaceNameMaxLength {
+		return WorkspaceNameValueObject{}, &appErrors.BadRequestError{Msg: fmt.Sprintf("The workspace name can not have more than %v characters", workspaceNameMaxLength)}
+	}
+
+	return WorkspaceNameValueObject{workspaceName: name}, nil
+}
+
+func (v WorkspaceNameValueObject) String() string {
+	return v.workspaceName
+}
+
+func (v WorkspaceNameValueObject) MarshalText() ([]byte, error) {
+	return []byte(v.workspaceName), nil
+}
+
+func (v *WorkspaceNameValueObject) UnmarshalText(d []byte) error {
+	var err error
+	*v, err = NewWorkspaceNameValueObject(string(d))
+	return err
+}
+
+func (v WorkspaceNameValueObject) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+func (v *WorkspaceNameValueObject) Scan(value interface{}) error {
+	if sv, err := driver.String.ConvertValue(value); err == nil {
+		*v, _ = NewWorkspaceNameValueObject(fmt.Sprintf("%s", sv))
+		return nil
+
+	}
+	return errors.New("failed to scan WorkspaceNameValueObject")
+}
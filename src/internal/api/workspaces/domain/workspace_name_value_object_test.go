@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkspaceName_Validates_MinLength(t *testing.T) {
+	workspaceName, err := NewWorkspaceNameValueObject("")
+
+	assert.Empty(t, workspaceName)
+
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The workspace name can not be empty", badReqErr.Error())
+}
+
+func TestNewWorkspaceName_Validates_MaxLength(t *testing.T) {
+	workspaceName, err := NewWorkspaceNameValueObject("012345678901234567890123456789012345678901234567890")
+
+	assert.Empty(t, workspaceName)
+	badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+	require.Equal(t, true, isBadReqErr, "should be a bad request error")
+	assert.Equal(t, "The workspace name can not have more than 50 characters", badReqErr.Error())
+}
+
+func TestNewWorkspaceName_Returns_A_Valid_WorkspaceName(t *testing.T) {
+	workspaceName, err := NewWorkspaceNameValueObject("a valid name")
+
+	assert.Equal(t, "a valid name", workspaceName.String())
+	assert.NoError(t, err)
+}
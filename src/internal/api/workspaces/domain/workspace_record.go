@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// WorkspaceRecord is a group of lists and categories. The personal workspace of a user has
+// the PersonalUserID set and can't have other members
+type WorkspaceRecord struct {
+	ID             int32     `gorm:"type:int(32);primary_key"`
+	Name           string    `gorm:"type:varchar(50)"`
+	PersonalUserID *int32    `gorm:"column:personalUserId;type:int(32)"`
+	CreatedAt      time.Time `gorm:"column:createdAt;type:timestamp"`
+}
+
+func (WorkspaceRecord) TableName() string {
+	return "workspaces"
+}
+
+func (r *WorkspaceRecord) IsPersonal() bool {
+	return r.PersonalUserID != nil
+}
+
+func (r *WorkspaceRecord) ToWorkspaceEntity(role string) *WorkspaceEntity {
+	nvo, _ := NewWorkspaceNameValueObject(r.Name)
+
+	return &WorkspaceEntity{
+		ID:       r.ID,
+		Name:     nvo,
+		Personal: r.IsPersonal(),
+		Role:     role,
+	}
+}
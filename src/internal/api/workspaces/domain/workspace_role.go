@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+)
+
+const (
+	WorkspaceRoleOwner  = "owner"
+	WorkspaceRoleAdmin  = "admin"
+	WorkspaceRoleMember = "member"
+)
+
+// NewMemberRole validates the role given to a new member. The owner role can't be given,
+// it belongs to the user who created the workspace
+func NewMemberRole(role string) (string, error) {
+	if role == WorkspaceRoleAdmin || role == WorkspaceRoleMember {
+		return role, nil
+	}
+
+	return "", &appErrors.BadRequestError{Msg: fmt.Sprintf("The role must be %q or %q", WorkspaceRoleAdmin, WorkspaceRoleMember)}
+}
+
+// CanManageMembers returns if a role allows adding and removing members
+func CanManageMembers(role string) bool {
+	return role == WorkspaceRoleOwner || role == WorkspaceRoleAdmin
+}
+
+// CanManageContent returns if a role allows deleting lists and changing the categories of a
+// workspace. The members can only create and edit its lists
+func CanManageContent(role string) bool {
+	return role == WorkspaceRoleOwner || role == WorkspaceRoleAdmin
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	appErrors "github.com/AngelVlc/todos_backend/src/internal/api/shared/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMemberRole_Returns_An_Error_If_The_Role_Is_Not_Valid(t *testing.T) {
+	for _, role := range []string{"", "owner", "wadus"} {
+		res, err := NewMemberRole(role)
+
+		assert.Empty(t, res)
+		badReqErr, isBadReqErr := err.(*appErrors.BadRequestError)
+		require.True(t, isBadReqErr, "should be a bad request error")
+		assert.Equal(t, `The role must be "admin" or "member"`, badReqErr.Error())
+	}
+}
+
+func TestNewMemberRole_Returns_The_Role(t *testing.T) {
+	for _, role := range []string{"admin", "member"} {
+		res, err := NewMemberRole(role)
+
+		assert.NoError(t, err)
+		assert.Equal(t, role, res)
+	}
+}
+
+func TestCanManageMembers(t *testing.T) {
+	assert.True(t, CanManageMembers(WorkspaceRoleOwner))
+	assert.True(t, CanManageMembers(WorkspaceRoleAdmin))
+	assert.False(t, CanManageMembers(WorkspaceRoleMember))
+}
+
+func TestCanManageContent(t *testing.T) {
+	assert.True(t, CanManageContent(WorkspaceRoleOwner))
+	assert.True(t, CanManageContent(WorkspaceRoleAdmin))
+	assert.False(t, CanManageContent(WorkspaceRoleMember))
+	assert.False(t, CanManageContent(""))
+}
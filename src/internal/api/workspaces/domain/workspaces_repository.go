@@ -0,0 +1,43 @@
+package domain
+
+import "context"
+
+// UserWorkspaceRecord is a workspace together with the role of one of its members
+type UserWorkspaceRecord struct {
+	WorkspaceRecord
+	Role string `gorm:"type:varchar(10)"`
+}
+
+type UserWorkspaceRecords []UserWorkspaceRecord
+
+func (a UserWorkspaceRecords) ToWorkspaceEntities() []*WorkspaceEntity {
+	res := make([]*WorkspaceEntity, len(a))
+
+	for i, v := range a {
+		res[i] = v.ToWorkspaceEntity(v.Role)
+	}
+
+	return res
+}
+
+type WorkspacesRepository interface {
+	FindWorkspace(ctx context.Context, query WorkspaceRecord) (*WorkspaceRecord, error)
+	GetUserWorkspaces(ctx context.Context, userID int32) (UserWorkspaceRecords, error)
+	// GetOrCreatePersonalWorkspace returns the personal workspace of the user, creating it
+	// the first time it's needed
+	GetOrCreatePersonalWorkspace(ctx context.Context, userID int32) (*WorkspaceRecord, error)
+	// CreateWorkspace creates the workspace with the user as its owner
+	CreateWorkspace(ctx context.Context, record *WorkspaceRecord, ownerID int32) error
+	FindWorkspaceMember(ctx context.Context, query WorkspaceMemberRecord) (*WorkspaceMemberRecord, error)
+	ExistsWorkspaceMember(ctx context.Context, query WorkspaceMemberRecord) (bool, error)
+	GetWorkspaceMembers(ctx context.Context, workspaceID int32) (WorkspaceMemberRecords, error)
+	CreateWorkspaceMember(ctx context.Context, record *WorkspaceMemberRecord) error
+	DeleteWorkspaceMember(ctx context.Context, query WorkspaceMemberRecord) error
+	// DeleteWorkspace deletes the workspace and its members. Its lists and categories must
+	// have been deleted before
+	DeleteWorkspace(ctx context.Context, workspaceID int32) error
+	// TransferOwnedWorkspaces makes a user the owner of the workspaces owned by another one.
+	// The personal workspace of the previous owner becomes a regular workspace with the
+	// given name
+	TransferOwnedWorkspaces(ctx context.Context, fromUserID int32, toUserID int32, personalWorkspaceName string) error
+}
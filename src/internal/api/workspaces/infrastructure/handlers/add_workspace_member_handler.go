@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure"
+)
+
+func AddWorkspaceMemberHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.WorkspaceMemberInput)
+
+	srv := application.NewAddWorkspaceMemberService(h.WorkspacesRepository)
+	addedMember, err := srv.AddWorkspaceMember(r.Context(), workspaceID, userID, input.UserID, input.Role)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: addedMember, StatusCode: http.StatusCreated}
+}
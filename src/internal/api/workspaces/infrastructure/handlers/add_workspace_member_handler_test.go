@@ -0,0 +1,117 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func addWorkspaceMemberHandler(mockedRepo *workspacesRepository.MockedWorkspacesRepository) handler.Handler {
+	return handler.Handler{
+		WorkspacesRepository: mockedRepo,
+		RequestInput:         &infrastructure.WorkspaceMemberInput{UserID: 2, Role: domain.WorkspaceRoleMember},
+	}
+}
+
+func TestAddWorkspaceMemberHandler_Returns_An_ErrorResult_With_A_ForbiddenError_If_The_User_Is_Not_The_Owner_Or_An_Admin(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := addWorkspaceMemberHandler(&mockedRepo)
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember}, nil).Once()
+
+	result := AddWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckForbiddenErrorResult(t, result, "Only the owner or an admin can manage the workspace members")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestAddWorkspaceMemberHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Workspace_Is_Personal(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := addWorkspaceMemberHandler(&mockedRepo)
+
+	userID := int32(1)
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Once()
+	mockedRepo.On("FindWorkspace", request.Context(), domain.WorkspaceRecord{ID: 4}).Return(&domain.WorkspaceRecord{ID: 4, Name: "Personal", PersonalUserID: &userID}, nil).Once()
+
+	result := AddWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "A personal workspace can't have other members")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestAddWorkspaceMemberHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_User_Is_Already_A_Member(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := addWorkspaceMemberHandler(&mockedRepo)
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleAdmin}, nil).Once()
+	mockedRepo.On("FindWorkspace", request.Context(), domain.WorkspaceRecord{ID: 4}).Return(&domain.WorkspaceRecord{ID: 4, Name: "Team"}, nil).Once()
+	mockedRepo.On("ExistsWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 2}).Return(true, nil).Once()
+
+	result := AddWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The user is already a member of the workspace")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestAddWorkspaceMemberHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Adding_The_Member_Fails(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := addWorkspaceMemberHandler(&mockedRepo)
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Once()
+	mockedRepo.On("FindWorkspace", request.Context(), domain.WorkspaceRecord{ID: 4}).Return(&domain.WorkspaceRecord{ID: 4, Name: "Team"}, nil).Once()
+	mockedRepo.On("ExistsWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 2}).Return(false, nil).Once()
+	mockedRepo.On("CreateWorkspaceMember", request.Context(), mock.AnythingOfType("*domain.WorkspaceMemberRecord")).Return(fmt.Errorf("some error")).Once()
+
+	result := AddWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error adding the workspace member")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestAddWorkspaceMemberHandler_Adds_The_Member(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := addWorkspaceMemberHandler(&mockedRepo)
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Once()
+	mockedRepo.On("FindWorkspace", request.Context(), domain.WorkspaceRecord{ID: 4}).Return(&domain.WorkspaceRecord{ID: 4, Name: "Team"}, nil).Once()
+	mockedRepo.On("ExistsWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 2}).Return(false, nil).Once()
+	mockedRepo.On("CreateWorkspaceMember", request.Context(), mock.AnythingOfType("*domain.WorkspaceMemberRecord")).Run(func(args mock.Arguments) {
+		record := args.Get(1).(*domain.WorkspaceMemberRecord)
+		assert.Equal(t, int32(4), record.WorkspaceID)
+		assert.Equal(t, int32(2), record.UserID)
+		assert.Equal(t, domain.WorkspaceRoleMember, record.Role)
+	}).Return(nil).Once()
+
+	result := AddWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.WorkspaceMemberEntity)
+	require.True(t, isOk, "should be a WorkspaceMemberEntity")
+	assert.Equal(t, int32(2), res.UserID)
+	assert.Equal(t, domain.WorkspaceRoleMember, res.Role)
+
+	mockedRepo.AssertExpectations(t)
+}
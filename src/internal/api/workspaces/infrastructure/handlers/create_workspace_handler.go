@@ -0,0 +1,23 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/application"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure"
+)
+
+func CreateWorkspaceHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	userID := h.GetUserIDFromContext(r)
+	input, _ := h.RequestInput.(*infrastructure.WorkspaceInput)
+
+	srv := application.NewCreateWorkspaceService(h.WorkspacesRepository)
+	createdWorkspace, err := srv.CreateWorkspace(r.Context(), input.Name, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: createdWorkspace, StatusCode: http.StatusCreated}
+}
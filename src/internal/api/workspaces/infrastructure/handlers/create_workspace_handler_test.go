@@ -0,0 +1,68 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWorkspaceHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Creating_The_Workspace_Fails(t *testing.T) {
+	request := getWorkspacesRequest()
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	nvo, _ := domain.NewWorkspaceNameValueObject("Team")
+	h := handler.Handler{
+		WorkspacesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.WorkspaceInput{Name: nvo},
+	}
+
+	mockedRepo.On("CreateWorkspace", request.Context(), mock.AnythingOfType("*domain.WorkspaceRecord"), int32(1)).Return(fmt.Errorf("some error")).Once()
+
+	result := CreateWorkspaceHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error creating the workspace")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestCreateWorkspaceHandler_Creates_The_Workspace(t *testing.T) {
+	request := getWorkspacesRequest()
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	nvo, _ := domain.NewWorkspaceNameValueObject("Team")
+	h := handler.Handler{
+		WorkspacesRepository: &mockedRepo,
+		RequestInput:         &infrastructure.WorkspaceInput{Name: nvo},
+	}
+
+	mockedRepo.On("CreateWorkspace", request.Context(), mock.AnythingOfType("*domain.WorkspaceRecord"), int32(1)).Run(func(args mock.Arguments) {
+		record := args.Get(1).(*domain.WorkspaceRecord)
+		assert.Equal(t, "Team", record.Name)
+		assert.Nil(t, record.PersonalUserID)
+		record.ID = 4
+	}).Return(nil).Once()
+
+	result := CreateWorkspaceHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusCreated)
+	res, isOk := okRes.Content.(*domain.WorkspaceEntity)
+	require.True(t, isOk, "should be a WorkspaceEntity")
+	assert.Equal(t, int32(4), res.ID)
+	assert.Equal(t, "Team", res.Name.String())
+	assert.False(t, res.Personal)
+	assert.Equal(t, domain.WorkspaceRoleOwner, res.Role)
+
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/application"
+)
+
+func GetWorkspaceMembersHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.ParseInt32UrlVar(r, "id")
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewGetWorkspaceMembersService(h.WorkspacesRepository)
+	foundMembers, err := srv.GetWorkspaceMembers(r.Context(), workspaceID, userID)
+	if err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: foundMembers, StatusCode: http.StatusOK}
+}
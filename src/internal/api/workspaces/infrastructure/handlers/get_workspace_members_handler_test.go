@@ -0,0 +1,87 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func workspaceMembersRequest(vars map[string]string) *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	request = mux.SetURLVars(request, vars)
+	ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetWorkspaceMembersHandler_Returns_An_Error_If_The_User_Is_Not_A_Member(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(nil, gorm.ErrRecordNotFound).Once()
+
+	result := GetWorkspaceMembersHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckError(t, result, gorm.ErrRecordNotFound.Error())
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetWorkspaceMembersHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember}, nil).Once()
+	mockedRepo.On("GetWorkspaceMembers", request.Context(), int32(4)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetWorkspaceMembersHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the workspace members")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetWorkspaceMembersHandler_Returns_The_Members(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	found := domain.WorkspaceMemberRecords{
+		{WorkspaceID: 4, UserID: 2, Role: domain.WorkspaceRoleOwner},
+		{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember},
+	}
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&found[1], nil).Once()
+	mockedRepo.On("GetWorkspaceMembers", request.Context(), int32(4)).Return(found, nil).Once()
+
+	result := GetWorkspaceMembersHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]*domain.WorkspaceMemberEntity)
+	require.True(t, isOk, "should be an array of WorkspaceMemberEntity")
+
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(2), res[0].UserID)
+	assert.Equal(t, domain.WorkspaceRoleOwner, res[0].Role)
+	assert.Equal(t, int32(1), res[1].UserID)
+	assert.Equal(t, domain.WorkspaceRoleMember, res[1].Role)
+
+	mockedRepo.AssertExpectations(t)
+}
@@ -0,0 +1,88 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/consts"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getWorkspacesRequest() *http.Request {
+	request, _ := http.NewRequest(http.MethodGet, "/wadus", nil)
+	ctx := context.WithValue(request.Context(), consts.ReqContextUserIDKey, int32(1))
+
+	return request.WithContext(ctx)
+}
+
+func TestGetWorkspacesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Getting_The_Personal_Workspace_Fails(t *testing.T) {
+	request := getWorkspacesRequest()
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("GetOrCreatePersonalWorkspace", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetWorkspacesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the personal workspace")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetWorkspacesHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_The_Query_Fails(t *testing.T) {
+	request := getWorkspacesRequest()
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("GetOrCreatePersonalWorkspace", request.Context(), int32(1)).Return(&domain.WorkspaceRecord{ID: 3}, nil).Once()
+	mockedRepo.On("GetUserWorkspaces", request.Context(), int32(1)).Return(nil, fmt.Errorf("some error")).Once()
+
+	result := GetWorkspacesHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error getting the user workspaces")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestGetWorkspacesHandler_Returns_The_Workspaces(t *testing.T) {
+	request := getWorkspacesRequest()
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	userID := int32(1)
+	found := domain.UserWorkspaceRecords{
+		{WorkspaceRecord: domain.WorkspaceRecord{ID: 3, Name: "Personal", PersonalUserID: &userID}, Role: domain.WorkspaceRoleOwner},
+		{WorkspaceRecord: domain.WorkspaceRecord{ID: 4, Name: "Team"}, Role: domain.WorkspaceRoleMember},
+	}
+	mockedRepo.On("GetOrCreatePersonalWorkspace", request.Context(), int32(1)).Return(&found[0].WorkspaceRecord, nil).Once()
+	mockedRepo.On("GetUserWorkspaces", request.Context(), int32(1)).Return(found, nil).Once()
+
+	result := GetWorkspacesHandler(httptest.NewRecorder(), request, h)
+
+	okRes := results.CheckOkResult(t, result, http.StatusOK)
+	res, isOk := okRes.Content.([]*domain.WorkspaceEntity)
+	require.True(t, isOk, "should be an array of WorkspaceEntity")
+
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(3), res[0].ID)
+	assert.True(t, res[0].Personal)
+	assert.Equal(t, domain.WorkspaceRoleOwner, res[0].Role)
+	assert.Equal(t, int32(4), res[1].ID)
+	assert.Equal(t, "Team", res[1].Name.String())
+	assert.False(t, res[1].Personal)
+	assert.Equal(t, domain.WorkspaceRoleMember, res[1].Role)
+
+	mockedRepo.AssertExpectations(t)
+}
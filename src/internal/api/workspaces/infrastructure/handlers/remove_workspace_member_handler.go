@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/application"
+)
+
+func RemoveWorkspaceMemberHandler(w http.ResponseWriter, r *http.Request, h handler.Handler) handler.HandlerResult {
+	workspaceID := h.ParseInt32UrlVar(r, "id")
+	memberUserID := h.ParseInt32UrlVar(r, "userId")
+	userID := h.GetUserIDFromContext(r)
+
+	srv := application.NewRemoveWorkspaceMemberService(h.WorkspacesRepository)
+	if err := srv.RemoveWorkspaceMember(r.Context(), workspaceID, userID, memberUserID); err != nil {
+		return results.ErrorResult{Err: err}
+	}
+
+	return results.OkResult{Content: nil, StatusCode: http.StatusNoContent}
+}
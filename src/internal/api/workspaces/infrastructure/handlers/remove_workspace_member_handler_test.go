@@ -0,0 +1,94 @@
+//go:build !e2e
+// +build !e2e
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/handler"
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/results"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	workspacesRepository "github.com/AngelVlc/todos_backend/src/internal/api/workspaces/infrastructure/repository"
+)
+
+func TestRemoveWorkspaceMemberHandler_Returns_An_ErrorResult_With_A_ForbiddenError_If_The_User_Can_Not_Remove_Other_Members(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4", "userId": "2"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember}, nil).Once()
+
+	result := RemoveWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckForbiddenErrorResult(t, result, "Only the owner or an admin can manage the workspace members")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestRemoveWorkspaceMemberHandler_Returns_An_ErrorResult_With_A_BadRequestError_If_The_Member_Is_The_Owner(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4", "userId": "1"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleOwner}, nil).Once()
+
+	result := RemoveWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckBadRequestErrorResult(t, result, "The owner can't be removed from the workspace")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestRemoveWorkspaceMemberHandler_Returns_An_ErrorResult_With_An_UnexpectedError_If_Removing_The_Member_Fails(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4", "userId": "1"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	query := domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}
+	mockedRepo.On("FindWorkspaceMember", request.Context(), query).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember}, nil).Once()
+	mockedRepo.On("DeleteWorkspaceMember", request.Context(), query).Return(fmt.Errorf("some error")).Once()
+
+	result := RemoveWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckUnexpectedErrorResult(t, result, "Error removing the workspace member")
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestRemoveWorkspaceMemberHandler_Lets_A_Member_Leave_The_Workspace(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4", "userId": "1"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	query := domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}
+	mockedRepo.On("FindWorkspaceMember", request.Context(), query).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleMember}, nil).Once()
+	mockedRepo.On("DeleteWorkspaceMember", request.Context(), query).Return(nil).Once()
+
+	result := RemoveWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
+
+func TestRemoveWorkspaceMemberHandler_Lets_An_Admin_Remove_A_Member(t *testing.T) {
+	request := workspaceMembersRequest(map[string]string{"id": "4", "userId": "2"})
+
+	mockedRepo := workspacesRepository.MockedWorkspacesRepository{}
+	h := handler.Handler{WorkspacesRepository: &mockedRepo}
+
+	mockedRepo.On("FindWorkspaceMember", request.Context(), domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1}).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 1, Role: domain.WorkspaceRoleAdmin}, nil).Once()
+	mockedRepo.On("FindWorkspace", request.Context(), domain.WorkspaceRecord{ID: 4}).Return(&domain.WorkspaceRecord{ID: 4, Name: "Team"}, nil).Once()
+	query := domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 2}
+	mockedRepo.On("FindWorkspaceMember", request.Context(), query).Return(&domain.WorkspaceMemberRecord{WorkspaceID: 4, UserID: 2, Role: domain.WorkspaceRoleMember}, nil).Once()
+	mockedRepo.On("DeleteWorkspaceMember", request.Context(), query).Return(nil).Once()
+
+	result := RemoveWorkspaceMemberHandler(httptest.NewRecorder(), request, h)
+
+	results.CheckOkResult(t, result, http.StatusNoContent)
+	mockedRepo.AssertExpectations(t)
+}
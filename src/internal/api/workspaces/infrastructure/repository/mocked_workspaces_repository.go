@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockedWorkspacesRepository struct {
+	mock.Mock
+}
+
+func NewMockedWorkspacesRepository() *MockedWorkspacesRepository {
+	return &MockedWorkspacesRepository{}
+}
+
+func (m *MockedWorkspacesRepository) FindWorkspace(ctx context.Context, query domain.WorkspaceRecord) (*domain.WorkspaceRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.WorkspaceRecord), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) GetUserWorkspaces(ctx context.Context, userID int32) (domain.UserWorkspaceRecords, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.UserWorkspaceRecords), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) GetOrCreatePersonalWorkspace(ctx context.Context, userID int32) (*domain.WorkspaceRecord, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.WorkspaceRecord), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) CreateWorkspace(ctx context.Context, record *domain.WorkspaceRecord, ownerID int32) error {
+	args := m.Called(ctx, record, ownerID)
+
+	return args.Error(0)
+}
+
+func (m *MockedWorkspacesRepository) FindWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) (*domain.WorkspaceMemberRecord, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.WorkspaceMemberRecord), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) ExistsWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) (bool, error) {
+	args := m.Called(ctx, query)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) GetWorkspaceMembers(ctx context.Context, workspaceID int32) (domain.WorkspaceMemberRecords, error) {
+	args := m.Called(ctx, workspaceID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(domain.WorkspaceMemberRecords), args.Error(1)
+}
+
+func (m *MockedWorkspacesRepository) CreateWorkspaceMember(ctx context.Context, record *domain.WorkspaceMemberRecord) error {
+	args := m.Called(ctx, record)
+
+	return args.Error(0)
+}
+
+func (m *MockedWorkspacesRepository) DeleteWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) error {
+	args := m.Called(ctx, query)
+
+	return args.Error(0)
+}
+
+func (m *MockedWorkspacesRepository) DeleteWorkspace(ctx context.Context, workspaceID int32) error {
+	args := m.Called(ctx, workspaceID)
+
+	return args.Error(0)
+}
+
+func (m *MockedWorkspacesRepository) TransferOwnedWorkspaces(ctx context.Context, fromUserID int32, toUserID int32, personalWorkspaceName string) error {
+	args := m.Called(ctx, fromUserID, toUserID, personalWorkspaceName)
+
+	return args.Error(0)
+}
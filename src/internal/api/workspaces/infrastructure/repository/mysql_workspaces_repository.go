@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	personalWorkspaceName = "Personal"
+	mysqlDuplicateEntry   = 1062
+)
+
+type MySqlWorkspacesRepository struct {
+	db *gorm.DB
+}
+
+func NewMySqlWorkspacesRepository(db *gorm.DB) *MySqlWorkspacesRepository {
+	return &MySqlWorkspacesRepository{db}
+}
+
+func (r *MySqlWorkspacesRepository) FindWorkspace(ctx context.Context, query domain.WorkspaceRecord) (*domain.WorkspaceRecord, error) {
+	foundWorkspace := domain.WorkspaceRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundWorkspace).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundWorkspace, nil
+}
+
+func (r *MySqlWorkspacesRepository) GetUserWorkspaces(ctx context.Context, userID int32) (domain.UserWorkspaceRecords, error) {
+	res := domain.UserWorkspaceRecords{}
+
+	err := r.db.WithContext(ctx).
+		Table("workspaces").
+		Select("workspaces.*, workspaceMembers.role").
+		Joins("INNER JOIN workspaceMembers ON workspaceMembers.workspaceId = workspaces.id").
+		Where("workspaceMembers.userId = ?", userID).
+		Order("workspaces.id").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetOrCreatePersonalWorkspace returns the personal workspace of the user, creating it the first
+// time. Concurrent requests can try to create it at the same time, so when the unique index
+// rejects the creation the one created by the other request is returned
+func (r *MySqlWorkspacesRepository) GetOrCreatePersonalWorkspace(ctx context.Context, userID int32) (*domain.WorkspaceRecord, error) {
+	found, err := r.findPersonalWorkspace(ctx, userID)
+	if err != nil || found != nil {
+		return found, err
+	}
+
+	record := domain.WorkspaceRecord{Name: personalWorkspaceName, PersonalUserID: &userID, CreatedAt: time.Now()}
+	if err := r.CreateWorkspace(ctx, &record, userID); err != nil {
+		var mysqlErr *mysql.MySQLError
+		if !errors.As(err, &mysqlErr) || mysqlErr.Number != mysqlDuplicateEntry {
+			return nil, err
+		}
+
+		found, err := r.findPersonalWorkspace(ctx, userID)
+		if err == nil && found == nil {
+			err = gorm.ErrRecordNotFound
+		}
+
+		return found, err
+	}
+
+	return &record, nil
+}
+
+func (r *MySqlWorkspacesRepository) findPersonalWorkspace(ctx context.Context, userID int32) (*domain.WorkspaceRecord, error) {
+	found := []domain.WorkspaceRecord{}
+	if err := r.db.WithContext(ctx).Where("personalUserId = ?", userID).Limit(1).Find(&found).Error; err != nil {
+		return nil, err
+	}
+
+	if len(found) == 0 {
+		return nil, nil
+	}
+
+	return &found[0], nil
+}
+
+func (r *MySqlWorkspacesRepository) CreateWorkspace(ctx context.Context, record *domain.WorkspaceRecord, ownerID int32) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.WithContext(ctx).Create(record).Error; err != nil {
+			return err
+		}
+
+		owner := domain.WorkspaceMemberRecord{WorkspaceID: record.ID, UserID: ownerID, Role: domain.WorkspaceRoleOwner, CreatedAt: record.CreatedAt}
+
+		return tx.WithContext(ctx).Create(&owner).Error
+	})
+}
+
+func (r *MySqlWorkspacesRepository) FindWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) (*domain.WorkspaceMemberRecord, error) {
+	foundMember := domain.WorkspaceMemberRecord{}
+	if err := r.db.WithContext(ctx).Where(query).Take(&foundMember).Error; err != nil {
+		return nil, err
+	}
+
+	return &foundMember, nil
+}
+
+func (r *MySqlWorkspacesRepository) ExistsWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) (bool, error) {
+	count := int64(0)
+	if err := r.db.WithContext(ctx).Model(&domain.WorkspaceMemberRecord{}).Where(query).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *MySqlWorkspacesRepository) GetWorkspaceMembers(ctx context.Context, workspaceID int32) (domain.WorkspaceMemberRecords, error) {
+	res := domain.WorkspaceMemberRecords{}
+	if err := r.db.WithContext(ctx).Where("workspaceId = ?", workspaceID).Order("createdAt").Find(&res).Error; err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *MySqlWorkspacesRepository) CreateWorkspaceMember(ctx context.Context, record *domain.WorkspaceMemberRecord) error {
+	return r.db.WithContext(ctx).Create(record).Error
+}
+
+func (r *MySqlWorkspacesRepository) DeleteWorkspaceMember(ctx context.Context, query domain.WorkspaceMemberRecord) error {
+	return r.db.WithContext(ctx).Where(query).Delete(&domain.WorkspaceMemberRecord{}).Error
+}
+
+func (r *MySqlWorkspacesRepository) DeleteWorkspace(ctx context.Context, workspaceID int32) error {
+	return r.db.WithContext(ctx).Delete(&domain.WorkspaceRecord{ID: workspaceID}).Error
+}
+
+func (r *MySqlWorkspacesRepository) TransferOwnedWorkspaces(ctx context.Context, fromUserID int32, toUserID int32, personalWorkspaceName string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		personalWorkspace := map[string]interface{}{"name": personalWorkspaceName, "personalUserId": nil}
+		if err := tx.Model(&domain.WorkspaceRecord{}).Where("personalUserId = ?", fromUserID).Updates(personalWorkspace).Error; err != nil {
+			return err
+		}
+
+		ownedWorkspaceIDs := []int32{}
+		ownedBy := domain.WorkspaceMemberRecord{UserID: fromUserID, Role: domain.WorkspaceRoleOwner}
+		if err := tx.Model(&domain.WorkspaceMemberRecord{}).Where(ownedBy).Pluck("workspaceId", &ownedWorkspaceIDs).Error; err != nil {
+			return err
+		}
+
+		for _, workspaceID := range ownedWorkspaceIDs {
+			// The new owner can already be a member of the workspace
+			owner := domain.WorkspaceMemberRecord{WorkspaceID: workspaceID, UserID: toUserID, Role: domain.WorkspaceRoleOwner, CreatedAt: time.Now()}
+			if err := tx.Clauses(clause.OnConflict{DoUpdates: clause.AssignmentColumns([]string{"role"})}).Create(&owner).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Where(ownedBy).Delete(&domain.WorkspaceMemberRecord{}).Error
+	})
+}
@@ -0,0 +1,379 @@
+//go:build !e2e
+// +build !e2e
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/shared/infrastructure/helpers"
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+var (
+	workspaceColumns       = []string{"id", "name", "personalUserId", "createdAt"}
+	workspaceMemberColumns = []string{"workspaceId", "userId", "role", "createdAt"}
+)
+
+func TestMySqlWorkspacesRepository_FindWorkspace(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workspaces` WHERE `workspaces`.`id` = ? LIMIT 1")).
+			WithArgs(int32(3))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.FindWorkspace(context.Background(), domain.WorkspaceRecord{ID: 3})
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the workspace", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns).AddRow(3, "team", nil, now))
+
+		res, err := repo.FindWorkspace(context.Background(), domain.WorkspaceRecord{ID: 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.WorkspaceRecord{ID: 3, Name: "team", CreatedAt: now}, res)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlWorkspacesRepository_GetUserWorkspaces(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT workspaces.*, workspaceMembers.role FROM `workspaces` INNER JOIN workspaceMembers ON workspaceMembers.workspaceId = workspaces.id WHERE workspaceMembers.userId = ? ORDER BY workspaces.id")).
+			WithArgs(int32(1))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.GetUserWorkspaces(context.Background(), 1)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the workspaces with the role of the user", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(append(workspaceColumns, "role")).
+			AddRow(1, "Personal", 1, now, "owner").
+			AddRow(3, "team", nil, now, "member"))
+
+		res, err := repo.GetUserWorkspaces(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "Personal", res[0].Name)
+		assert.True(t, res[0].IsPersonal())
+		assert.Equal(t, "owner", res[0].Role)
+		assert.Equal(t, int32(3), res[1].ID)
+		assert.False(t, res[1].IsPersonal())
+		assert.Equal(t, "member", res[1].Role)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlWorkspacesRepository_GetOrCreatePersonalWorkspace(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workspaces` WHERE personalUserId = ? LIMIT 1")).
+			WithArgs(int32(1))
+	}
+
+	t.Run("should return an error if the query fails", func(t *testing.T) {
+		expectedQuery().WillReturnError(fmt.Errorf("some error"))
+
+		res, err := repo.GetOrCreatePersonalWorkspace(context.Background(), 1)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the existing personal workspace", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns).AddRow(2, "Personal", 1, now))
+
+		res, err := repo.GetOrCreatePersonalWorkspace(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), res.ID)
+		assert.True(t, res.IsPersonal())
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should create the personal workspace when it doesn't exist", func(t *testing.T) {
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaces` (`name`,`personalUserId`,`createdAt`) VALUES (?,?,?)")).
+			WithArgs("Personal", int32(1), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?)")).
+			WithArgs(int32(5), int32(1), "owner", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		res, err := repo.GetOrCreatePersonalWorkspace(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(5), res.ID)
+		assert.Equal(t, "Personal", res.Name)
+		assert.True(t, res.IsPersonal())
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the personal workspace created by a concurrent request", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaces` (`name`,`personalUserId`,`createdAt`) VALUES (?,?,?)")).
+			WithArgs("Personal", int32(1), sqlmock.AnyArg()).
+			WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'idx_workspaces_personal_user_id'"})
+		mock.ExpectRollback()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns).AddRow(2, "Personal", 1, now))
+
+		res, err := repo.GetOrCreatePersonalWorkspace(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), res.ID)
+		assert.True(t, res.IsPersonal())
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return an error if the creation fails", func(t *testing.T) {
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceColumns))
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaces` (`name`,`personalUserId`,`createdAt`) VALUES (?,?,?)")).
+			WithArgs("Personal", int32(1), sqlmock.AnyArg()).
+			WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectRollback()
+
+		res, err := repo.GetOrCreatePersonalWorkspace(context.Background(), 1)
+
+		assert.Nil(t, res)
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlWorkspacesRepository_CreateWorkspace(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	now := time.Now()
+
+	t.Run("should rollback if creating the owner fails", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaces` (`name`,`personalUserId`,`createdAt`) VALUES (?,?,?)")).
+			WithArgs("team", nil, now).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?)")).
+			WithArgs(int32(5), int32(1), "owner", now).
+			WillReturnError(fmt.Errorf("some error"))
+		mock.ExpectRollback()
+
+		err := repo.CreateWorkspace(context.Background(), &domain.WorkspaceRecord{Name: "team", CreatedAt: now}, 1)
+
+		assert.EqualError(t, err, "some error")
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should create the workspace and its owner", func(t *testing.T) {
+		record := domain.WorkspaceRecord{Name: "team", CreatedAt: now}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaces` (`name`,`personalUserId`,`createdAt`) VALUES (?,?,?)")).
+			WithArgs("team", nil, now).
+			WillReturnResult(sqlmock.NewResult(5, 1))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?)")).
+			WithArgs(int32(5), int32(1), "owner", now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateWorkspace(context.Background(), &record, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int32(5), record.ID)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlWorkspacesRepository_FindWorkspaceMember(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	expectedQuery := func() *sqlmock.ExpectedQuery {
+		return mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workspaceMembers` WHERE `workspaceMembers`.`workspaceId` = ? AND `workspaceMembers`.`userId` = ? LIMIT 1")).
+			WithArgs(int32(3), int32(1))
+	}
+
+	t.Run("should return a not found error if the member doesn't exist", func(t *testing.T) {
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceMemberColumns))
+
+		res, err := repo.FindWorkspaceMember(context.Background(), domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1})
+
+		assert.Nil(t, res)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+
+	t.Run("should return the member", func(t *testing.T) {
+		now := time.Now()
+		expectedQuery().WillReturnRows(sqlmock.NewRows(workspaceMemberColumns).AddRow(3, 1, "admin", now))
+
+		res, err := repo.FindWorkspaceMember(context.Background(), domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1, Role: "admin", CreatedAt: now}, res)
+		helpers.CheckSqlMockExpectations(mock, t)
+	})
+}
+
+func TestMySqlWorkspacesRepository_ExistsWorkspaceMember(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `workspaceMembers` WHERE `workspaceMembers`.`workspaceId` = ? AND `workspaceMembers`.`userId` = ?")).
+		WithArgs(int32(3), int32(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	res, err := repo.ExistsWorkspaceMember(context.Background(), domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 1})
+
+	require.NoError(t, err)
+	assert.True(t, res)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_GetWorkspaceMembers(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	now := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workspaceMembers` WHERE workspaceId = ? ORDER BY createdAt")).
+		WithArgs(int32(3)).
+		WillReturnRows(sqlmock.NewRows(workspaceMemberColumns).AddRow(3, 1, "owner", now).AddRow(3, 2, "member", now))
+
+	res, err := repo.GetWorkspaceMembers(context.Background(), 3)
+
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, int32(2), res[1].UserID)
+	assert.Equal(t, "member", res[1].Role)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_CreateWorkspaceMember(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?)")).
+		WithArgs(int32(3), int32(2), "member", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateWorkspaceMember(context.Background(), &domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 2, Role: "member", CreatedAt: now})
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_DeleteWorkspaceMember(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `workspaceMembers` WHERE `workspaceMembers`.`workspaceId` = ? AND `workspaceMembers`.`userId` = ?")).
+		WithArgs(int32(3), int32(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteWorkspaceMember(context.Background(), domain.WorkspaceMemberRecord{WorkspaceID: 3, UserID: 2})
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_DeleteWorkspace(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `workspaces` WHERE `workspaces`.`id` = ?")).
+		WithArgs(int32(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteWorkspace(context.Background(), 3)
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_TransferOwnedWorkspaces_When_Updating_The_Personal_Workspace_Fails(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `workspaces` SET `name`=?,`personalUserId`=? WHERE personalUserId = ?")).
+		WithArgs("Workspace of user1", nil, int32(1)).
+		WillReturnError(fmt.Errorf("some error"))
+	mock.ExpectRollback()
+
+	err := repo.TransferOwnedWorkspaces(context.Background(), 1, 2, "Workspace of user1")
+
+	assert.EqualError(t, err, "some error")
+	helpers.CheckSqlMockExpectations(mock, t)
+}
+
+func TestMySqlWorkspacesRepository_TransferOwnedWorkspaces(t *testing.T) {
+	mock, db := helpers.GetMockedDb(t)
+	repo := NewMySqlWorkspacesRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `workspaces` SET `name`=?,`personalUserId`=? WHERE personalUserId = ?")).
+		WithArgs("Workspace of user1", nil, int32(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `workspaceId` FROM `workspaceMembers` WHERE `workspaceMembers`.`userId` = ? AND `workspaceMembers`.`role` = ?")).
+		WithArgs(int32(1), "owner").
+		WillReturnRows(sqlmock.NewRows([]string{"workspaceId"}).AddRow(3).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `role`=VALUES(`role`)")).
+		WithArgs(int32(3), int32(2), "owner", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workspaceMembers` (`workspaceId`,`userId`,`role`,`createdAt`) VALUES (?,?,?,?) ON DUPLICATE KEY UPDATE `role`=VALUES(`role`)")).
+		WithArgs(int32(4), int32(2), "owner", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `workspaceMembers` WHERE `workspaceMembers`.`userId` = ? AND `workspaceMembers`.`role` = ?")).
+		WithArgs(int32(1), "owner").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.TransferOwnedWorkspaces(context.Background(), 1, 2, "Workspace of user1")
+
+	assert.Nil(t, err)
+	helpers.CheckSqlMockExpectations(mock, t)
+}
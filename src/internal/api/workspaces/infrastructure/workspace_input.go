@@ -0,0 +1,30 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type WorkspaceInput struct {
+	Name domain.WorkspaceNameValueObject `json:"name"`
+}
+
+func (i *WorkspaceInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	nvo, err := domain.NewWorkspaceNameValueObject(realInput.Name)
+	if err != nil {
+		return err
+	}
+
+	*i = WorkspaceInput{Name: nvo}
+
+	return nil
+}
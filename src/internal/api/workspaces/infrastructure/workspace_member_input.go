@@ -0,0 +1,32 @@
+package infrastructure
+
+import (
+	"encoding/json"
+
+	"github.com/AngelVlc/todos_backend/src/internal/api/workspaces/domain"
+)
+
+type WorkspaceMemberInput struct {
+	UserID int32  `json:"userId"`
+	Role   string `json:"role"`
+}
+
+func (i *WorkspaceMemberInput) UnmarshalJSON(data []byte) error {
+	var realInput struct {
+		UserID int32  `json:"userId"`
+		Role   string `json:"role"`
+	}
+
+	if err := json.Unmarshal(data, &realInput); err != nil {
+		return err
+	}
+
+	role, err := domain.NewMemberRole(realInput.Role)
+	if err != nil {
+		return err
+	}
+
+	*i = WorkspaceMemberInput{UserID: realInput.UserID, Role: role}
+
+	return nil
+}
@@ -31,8 +31,8 @@ resource "google_cloud_run_service" "run_service" {
           value = var.mysql_password
         }
         env {
-          name  = "JWT_SECRET"
-          value = var.jwt_secret
+          name  = "JWT_KEYS"
+          value = var.jwt_keys
         }
         env {
           name  = "CORS_ALLOWED_ORIGINS"
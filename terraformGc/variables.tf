@@ -1,5 +1,5 @@
-variable "jwt_secret" {
-  description = "JWT secret"
+variable "jwt_keys" {
+  description = "PEM encoded JWT signing keys"
 }
 
 variable "cors_allowed_origins" {